@@ -20,8 +20,10 @@ import (
 	"time"
 
 	"github.com/dfuse-io/dfuse-eosio/abicodec"
+	searchclient "github.com/dfuse-io/dfuse-eosio/search-client"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/dgrpc"
+	"github.com/dfuse-io/dmetrics"
 	"github.com/dfuse-io/dstore"
 	pbhealth "github.com/dfuse-io/pbgo/grpc/health/v1"
 	"github.com/dfuse-io/shutter"
@@ -52,6 +54,8 @@ func New(config *Config) *App {
 }
 
 func (a *App) Run() error {
+	dmetrics.Register(searchclient.Metricset)
+
 	zlog.Info("initiating cache", zap.String("cache_state_path", a.config.CacheBaseURL), zap.String("cache_state_name", a.config.CacheStateName))
 	store, err := dstore.NewSimpleStore(a.config.CacheBaseURL)
 	if err != nil {
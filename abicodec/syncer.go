@@ -60,7 +60,7 @@ func NewSyncer(cache Cache, dbReader trxdb.DBReader, searchAddr string, onLive f
 	syncer := &ABISyncer{
 		Shutter:      shutter.New(),
 		cache:        cache,
-		client:       searchclient.NewEOSClient(searchConn, dbReader),
+		client:       searchclient.NewEOSClient(searchConn, dbReader, searchclient.WithHydration(searchclient.HydrateMatchingActionsOnly|searchclient.HydrateWithoutDBOps)),
 		onLive:       onLive,
 		syncCtx:      syncCtx,
 		cancelSyncer: cancelSyncer,
@@ -26,6 +26,7 @@ import (
 // --apiproxy-dgraphql-http-addr
 // --apiproxy-eosws-http-addr
 // --apiproxy-nodeos-http-addr
+// --apiproxy-fluxdb-http-addr [optional, routes nodeos state calls to fluxdb]
 // --apiproxy-root-http-addr  [defaults to: eosq? dashboard?]
 
 // Welcome:
@@ -43,6 +44,7 @@ type Config struct {
 	DgraphqlHTTPAddr string
 	EoswsHTTPAddr    string
 	NodeosHTTPAddr   string
+	FluxDBHTTPAddr   string
 	RootHTTPAddr     string
 	AutocertCacheDir string
 }
@@ -20,20 +20,37 @@ type proxy struct {
 	dgraphqlProxy *httputil.ReverseProxy
 	eoswsProxy    *httputil.ReverseProxy
 	nodeosProxy   *httputil.ReverseProxy
+	fluxdbProxy   *httputil.ReverseProxy
 	rootProxy     *httputil.ReverseProxy
 }
 
+// fluxdbChainAPICalls are the nodeos `chain_api_plugin` calls that FluxDB
+// is able to serve, optionally at any block height.
+var fluxdbChainAPICalls = []string{
+	"get_abi",
+	"get_currency_balance",
+	"get_currency_stats",
+	"get_table_by_scope",
+	"get_table_rows",
+}
+
 func newProxy(config *Config) *proxy {
 	createProxy := func(addr string) *httputil.ReverseProxy {
 		return httputil.NewSingleHostReverseProxy(&url.URL{Host: "localhost" + addr, Scheme: "http"})
 	}
 
+	var fluxdbProxy *httputil.ReverseProxy
+	if config.FluxDBHTTPAddr != "" {
+		fluxdbProxy = createProxy(config.FluxDBHTTPAddr)
+	}
+
 	return &proxy{
 		Shutter:       shutter.New(),
 		config:        config,
 		dgraphqlProxy: createProxy(config.DgraphqlHTTPAddr),
 		eoswsProxy:    createProxy(config.EoswsHTTPAddr),
 		nodeosProxy:   createProxy(config.NodeosHTTPAddr),
+		fluxdbProxy:   fluxdbProxy,
 		rootProxy:     createProxy(config.RootHTTPAddr),
 	}
 }
@@ -57,6 +74,11 @@ func (p *proxy) Launch() error {
 	router.PathPrefix("/graphiql").Handler(p.dgraphqlProxy)
 	router.PathPrefix("/v1/chain/push_transaction").Handler(p.eoswsProxy)
 	router.PathPrefix("/v1/chain/send_transaction").Handler(p.eoswsProxy)
+	if p.fluxdbProxy != nil {
+		for _, call := range fluxdbChainAPICalls {
+			router.Methods("POST").Path("/v1/chain/" + call).Handler(p.fluxdbProxy)
+		}
+	}
 	router.PathPrefix("/v1/chain").Handler(p.nodeosProxy)
 	router.PathPrefix("/v1/stream").Handler(p.eoswsProxy)
 	router.PathPrefix("/v1").Handler(p.eoswsProxy)
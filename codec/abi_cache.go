@@ -89,6 +89,24 @@ func (c *ABICache) addABI(contract string, globalSequence uint64, abi *eos.ABI)
 	return nil
 }
 
+// prependABI records `abi` as the one in effect for `contract` before any
+// ABI change known to the cache, unless one is already recorded.
+func (c *ABICache) prependABI(contract string, abi *eos.ABI) {
+	contractOrdering := c.abisOrdering[contract]
+	if len(contractOrdering) > 0 && contractOrdering[0] == 0 {
+		return
+	}
+
+	contractAbis, found := c.abis[contract]
+	if !found {
+		contractAbis = map[uint64]*eos.ABI{}
+		c.abis[contract] = contractAbis
+	}
+
+	contractAbis[0] = abi
+	c.abisOrdering[contract] = append([]uint64{0}, contractOrdering...)
+}
+
 // findABI for the given `contract` at which `globalSequence` was the most
 // recent active ABI.
 func (c *ABICache) findABI(contract string, globalSequence uint64) *eos.ABI {
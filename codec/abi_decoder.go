@@ -71,7 +71,9 @@ type ABIDecoder struct {
 	truncateOnNextGlobalSequence bool
 }
 
-func newABIDecoder() *ABIDecoder {
+// NewABIDecoder returns a decoder only knowing about the ABIs set in the
+// blocks it decoded so far, plus the ones it was seeded with (see `SeedABI`).
+func NewABIDecoder() *ABIDecoder {
 	return &ABIDecoder{
 		cache:            newABICache(),
 		activeBlockNum:   noActiveBlockNum,
@@ -79,6 +81,68 @@ func newABIDecoder() *ABIDecoder {
 	}
 }
 
+// SeedABI records `abi` as the one `contract` had before the first block
+// decoded, for decoders starting in the middle of the chain. It has no
+// effect when such an ABI is already known.
+func (c *ABIDecoder) SeedABI(contract string, abi *eos.ABI) {
+	abi.SetFitNodeos(true)
+
+	c.cache.Lock()
+	defer c.cache.Unlock()
+
+	c.cache.prependABI(contract, abi)
+}
+
+// DecodeBlock decodes in-place all the elements of the block requiring ABI
+// decoding, recording along the way the ABIs the block sets. Blocks must be
+// fed in sequential order, see the truncation logic above for forks.
+func (c *ABIDecoder) DecodeBlock(ctx context.Context, block *pbcodec.Block) error {
+	if err := c.startBlock(ctx, uint64(block.Number)); err != nil {
+		return fmt.Errorf("abi decoder: %w", err)
+	}
+
+	for _, trace := range block.TransactionTraces {
+		if err := c.processTransaction(trace); err != nil {
+			return fmt.Errorf("abi decoding trace: %w", err)
+		}
+	}
+
+	if err := c.endBlock(block); err != nil {
+		return fmt.Errorf("abi decoding post-process failed: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeTableRow decodes the `data` of a row of `contract`'s `table` against
+// the ABI that was active at `globalSequence`. An empty string is returned
+// when no ABI or no table definition is known for the row.
+func (c *ABIDecoder) DecodeTableRow(contract, table string, globalSequence uint64, data []byte) (string, error) {
+	if len(data) <= 0 {
+		return "", nil
+	}
+
+	c.cache.RLock()
+	abi := c.cache.findABI(contract, globalSequence)
+	c.cache.RUnlock()
+
+	if abi == nil {
+		return "", nil
+	}
+
+	tableDef := abi.TableForName(eos.TableName(table))
+	if tableDef == nil {
+		return "", nil
+	}
+
+	jsonData, err := abi.DecodeTableRowTyped(tableDef.Type, data)
+	if err != nil {
+		return "", fmt.Errorf("unable to decode row of table %s:%s against ABI: %w", contract, table, err)
+	}
+
+	return string(jsonData), nil
+}
+
 func (c *ABIDecoder) resetCache() {
 	c.cache = newABICache()
 }
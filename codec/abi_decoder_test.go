@@ -411,7 +411,7 @@ func TestABIDecoder(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			decoder := newABIDecoder()
+			decoder := NewABIDecoder()
 
 			for contract, abi := range test.abiDumps {
 				abiBinary, err := eos.MarshalBinary(abi)
@@ -475,6 +475,68 @@ func TestABIDecoder(t *testing.T) {
 	}
 }
 
+func TestABIDecoder_DecodeTableRow(t *testing.T) {
+	tableABI := readABI(t, "table.1.abi.json")
+	rowData, err := tableABI.EncodeTable("accounts", []byte(`{"balance":"1.0000 EOS"}`))
+	require.NoError(t, err)
+
+	decoder := NewABIDecoder()
+	require.NoError(t, decoder.DecodeBlock(context.Background(), testBlock(t, "00000002aa", "00000001aa",
+		trxTrace(t, actionTraceSetABI(t, "test", 0, 10, tableABI)),
+	)))
+
+	tests := []struct {
+		name           string
+		contract       string
+		table          string
+		globalSequence uint64
+		data           []byte
+		expected       string
+	}{
+		{"known table", "test", "accounts", 11, rowData, `{"balance":"1.0000 EOS"}`},
+		{"before abi was set", "test", "accounts", 9, rowData, ""},
+		{"unknown table", "test", "other", 11, rowData, ""},
+		{"unknown contract", "unknown", "accounts", 11, rowData, ""},
+		{"no data", "test", "accounts", 11, nil, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jsonData, err := decoder.DecodeTableRow(test.contract, test.table, test.globalSequence, test.data)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, jsonData)
+		})
+	}
+}
+
+func TestABIDecoder_SeedABI(t *testing.T) {
+	tableABI := readABI(t, "table.1.abi.json")
+	rowData, err := tableABI.EncodeTable("accounts", []byte(`{"balance":"1.0000 EOS"}`))
+	require.NoError(t, err)
+
+	decoder := NewABIDecoder()
+	require.NoError(t, decoder.DecodeBlock(context.Background(), testBlock(t, "00000002aa", "00000001aa",
+		trxTrace(t, actionTraceSetABI(t, "test", 0, 10, tableABI)),
+	)))
+
+	decoder.SeedABI("test", &eos.ABI{Version: "eosio::abi/1.1"})
+	decoder.SeedABI("test", tableABI)
+
+	// The seeded ABI is in effect before the one set in block 2, which still wins after it
+	jsonData, err := decoder.DecodeTableRow("test", "accounts", 9, rowData)
+	require.NoError(t, err)
+	assert.Equal(t, "", jsonData)
+
+	jsonData, err = decoder.DecodeTableRow("test", "accounts", 11, rowData)
+	require.NoError(t, err)
+	assert.Equal(t, `{"balance":"1.0000 EOS"}`, jsonData)
+
+	decoder.SeedABI("other", tableABI)
+	jsonData, err = decoder.DecodeTableRow("other", "accounts", 1, rowData)
+	require.NoError(t, err)
+	assert.Equal(t, `{"balance":"1.0000 EOS"}`, jsonData)
+}
+
 func fullMatchRegex(regex *regexp.Regexp, content string) []string {
 	match := regex.FindAllStringSubmatch(content, -1)
 	if match == nil {
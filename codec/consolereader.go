@@ -97,7 +97,7 @@ type parseCtx struct {
 
 func newParseCtx() *parseCtx {
 	return &parseCtx{
-		abiDecoder: newABIDecoder(),
+		abiDecoder: NewABIDecoder(),
 		block:      &pbcodec.Block{},
 		trx:        &pbcodec.TransactionTrace{},
 	}
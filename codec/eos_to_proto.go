@@ -335,6 +335,28 @@ func TransactionReceiptToDEOS(txReceipt *eos.TransactionReceipt) *pbcodec.Transa
 	return receipt
 }
 
+func TransactionReceiptToEOS(in *pbcodec.TransactionReceipt) (*eos.TransactionReceipt, error) {
+	receipt := &eos.TransactionReceipt{
+		TransactionReceiptHeader: eos.TransactionReceiptHeader{
+			Status:               TransactionStatusToEOS(in.Status),
+			CPUUsageMicroSeconds: in.CpuUsageMicroSeconds,
+			NetUsageWords:        eos.Varuint32(in.NetUsageWords),
+		},
+	}
+
+	receipt.Transaction.ID = ChecksumToEOS(in.Id)
+	if in.PackedTransaction != nil {
+		packed, err := pbcodecPackedTransactionToEOS(in.PackedTransaction)
+		if err != nil {
+			return nil, fmt.Errorf("pbcodec.PackedTransaction to EOS conversion failed: %s", err)
+		}
+
+		receipt.Transaction.Packed = packed
+	}
+
+	return receipt, nil
+}
+
 func TransactionReceiptHeaderToDEOS(in *eos.TransactionReceiptHeader) *pbcodec.TransactionReceiptHeader {
 	return &pbcodec.TransactionReceiptHeader{
 		Status:               TransactionStatusToDEOS(in.Status),
@@ -0,0 +1,849 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ship"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// StateHistoryReader reads blocks from the websocket endpoint of a standard
+// `nodeos` `state_history_plugin` (traces and table deltas) and converts them
+// into `pbcodec.Block`. It follows the same `Read`/`Done` contract as the
+// `ConsoleReader`, so it can be used as an alternate mindreader block source
+// when no deep-mind instrumented `nodeos` is available.
+//
+// The state history protocol does not expose everything deep-mind does, so
+// the produced blocks are flagged as partially instrumented (see
+// `pbcodec.Block.PartiallyInstrumented`). What can be derived is filled in:
+// block header & transaction receipts, transaction and action traces, DB ops
+// (from `contract_row` deltas), permission ops (from `permission` deltas) and
+// resource limits & usage ops (from `resource_limits` and `resource_usage`
+// deltas). Deltas only convey the final state of the rows changed by the
+// block, see `readStateHistoryDeltas` for how they are reported.
+//
+// When the connection is lost, the reader reconnects and resumes after the
+// last block it emitted, sending the reversible blocks it emitted so the
+// endpoint restarts from the fork point if they were forked out meanwhile.
+type StateHistoryReader struct {
+	addr                string
+	startBlockNum       uint32
+	maxMessagesInFlight uint32
+	connectRetryDelay   time.Duration
+
+	connLock sync.Mutex
+	conn     *websocket.Conn
+
+	// Reversible blocks emitted so far, the last one being the last emitted block
+	positions  []*ship.BlockPosition
+	abiDecoder *ABIDecoder
+
+	done      chan interface{}
+	closeOnce sync.Once
+}
+
+func NewStateHistoryReader(addr string, startBlockNum uint32) (*StateHistoryReader, error) {
+	if addr == "" {
+		return nil, fmt.Errorf("state history address is required")
+	}
+
+	return &StateHistoryReader{
+		addr:                addr,
+		startBlockNum:       startBlockNum,
+		maxMessagesInFlight: 10,
+		connectRetryDelay:   time.Second,
+		abiDecoder:          NewABIDecoder(),
+		done:                make(chan interface{}),
+	}, nil
+}
+
+func (r *StateHistoryReader) Done() <-chan interface{} {
+	return r.done
+}
+
+func (r *StateHistoryReader) Close() {
+	r.closeOnce.Do(func() {
+		r.connLock.Lock()
+		defer r.connLock.Unlock()
+
+		close(r.done)
+		if r.conn != nil {
+			r.conn.Close()
+		}
+	})
+}
+
+func (r *StateHistoryReader) isClosed() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *StateHistoryReader) Read() (out interface{}, err error) {
+	for {
+		conn, err := r.connection()
+		if err != nil {
+			return nil, err
+		}
+
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			if r.isClosed() {
+				return nil, io.EOF
+			}
+
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				r.Close()
+				return nil, io.EOF
+			}
+
+			r.reconnectAfter(conn, fmt.Errorf("reading state history message: %w", err))
+			continue
+		}
+
+		if messageType != websocket.BinaryMessage {
+			zlog.Debug("skipping non-binary state history message", zap.Int("message_type", messageType))
+			continue
+		}
+
+		if err := conn.WriteMessage(websocket.BinaryMessage, ship.NewGetBlocksAck(1)); err != nil {
+			if r.isClosed() {
+				return nil, io.EOF
+			}
+
+			r.reconnectAfter(conn, fmt.Errorf("acknowledging state history message: %w", err))
+			continue
+		}
+
+		result, err := decodeStateHistoryBlocksResult(message)
+		if err != nil {
+			return nil, fmt.Errorf("decoding state history result: %w", err)
+		}
+
+		// A result without a block happens when the requested range is exhausted or not yet
+		// available, there is nothing to emit in this case.
+		if result.ThisBlock == nil || result.Block == nil {
+			continue
+		}
+
+		block, err := r.readBlock(result)
+		if err != nil {
+			return nil, fmt.Errorf("block #%d: %w", result.ThisBlock.BlockNum, err)
+		}
+
+		r.trackPosition(result.ThisBlock, result.LastIrreversible)
+		return block, nil
+	}
+}
+
+// trackPosition records the emitted block, dropping the positions it forks
+// out and the ones that became irreversible.
+func (r *StateHistoryReader) trackPosition(block *ship.BlockPosition, lastIrreversible *ship.BlockPosition) {
+	var positions []*ship.BlockPosition
+	for _, position := range r.positions {
+		if position.BlockNum < block.BlockNum && (lastIrreversible == nil || position.BlockNum > lastIrreversible.BlockNum) {
+			positions = append(positions, position)
+		}
+	}
+
+	r.positions = append(positions, block)
+}
+
+// connection returns the current connection, connecting first if needed.
+func (r *StateHistoryReader) connection() (*websocket.Conn, error) {
+	r.connLock.Lock()
+	conn := r.conn
+	r.connLock.Unlock()
+
+	if conn != nil {
+		return conn, nil
+	}
+
+	return r.connect()
+}
+
+// reconnectAfter drops the failed connection, the next read connects again.
+func (r *StateHistoryReader) reconnectAfter(conn *websocket.Conn, err error) {
+	zlog.Warn("state history connection lost, reconnecting", zap.String("addr", r.addr), zap.Error(err))
+
+	r.connLock.Lock()
+	if r.conn == conn {
+		r.conn = nil
+	}
+	r.connLock.Unlock()
+
+	conn.Close()
+}
+
+// connect dials the state history endpoint and sends the blocks request.
+// The mindreader creates its readers before `nodeos` is started, so dialing
+// is retried until the endpoint becomes available or the reader is closed.
+func (r *StateHistoryReader) connect() (*websocket.Conn, error) {
+	for {
+		conn, err := r.dial()
+		if err == nil {
+			r.connLock.Lock()
+			defer r.connLock.Unlock()
+
+			if r.isClosed() {
+				conn.Close()
+				return nil, io.EOF
+			}
+
+			r.conn = conn
+			return conn, nil
+		}
+
+		zlog.Info("state history endpoint not available, retrying", zap.String("addr", r.addr), zap.Error(err))
+		select {
+		case <-r.done:
+			return nil, io.EOF
+		case <-time.After(r.connectRetryDelay):
+		}
+	}
+}
+
+func (r *StateHistoryReader) dial() (*websocket.Conn, error) {
+	conn, _, err := websocket.DefaultDialer.Dial(r.addr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The server always starts by sending its ABI (in JSON text), we rely on the
+	// `ship` package types instead, so we simply consume it.
+	if _, _, err := conn.ReadMessage(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("reading state history ABI: %w", err)
+	}
+
+	startBlockNum := r.startBlockNum
+	if len(r.positions) > 0 {
+		startBlockNum = r.positions[len(r.positions)-1].BlockNum + 1
+	}
+
+	request := ship.NewRequest(&ship.GetBlocksRequestV0{
+		StartBlockNum:       startBlockNum,
+		EndBlockNum:         math.MaxUint32,
+		MaxMessagesInFlight: r.maxMessagesInFlight,
+		HavePositions:       r.positions,
+		FetchBlock:          true,
+		FetchTraces:         true,
+		FetchDeltas:         true,
+	})
+
+	if err := conn.WriteMessage(websocket.BinaryMessage, request); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("sending state history blocks request: %w", err)
+	}
+
+	zlog.Info("connected to state history endpoint", zap.String("addr", r.addr), zap.Uint32("start_block_num", startBlockNum), zap.Int("have_positions", len(r.positions)))
+	return conn, nil
+}
+
+// stateHistoryBlocksResult mirrors `ship.GetBlocksResultV0` but keeps the
+// block as raw bytes, decoding `ship.SignedBlockBytes` recurses into itself
+// (reading the length prefix twice) in the `eos-go` version we depend on.
+type stateHistoryBlocksResult struct {
+	Head             *ship.BlockPosition
+	LastIrreversible *ship.BlockPosition
+	ThisBlock        *ship.BlockPosition         `eos:"optional"`
+	PrevBlock        *ship.BlockPosition         `eos:"optional"`
+	Block            []byte                      `eos:"optional"`
+	Traces           *ship.TransactionTraceArray `eos:"optional"`
+	Deltas           *ship.TableDeltaArray       `eos:"optional"`
+}
+
+func decodeStateHistoryBlocksResult(message []byte) (*stateHistoryBlocksResult, error) {
+	decoder := eos.NewDecoder(message)
+	resultType, err := decoder.ReadUvarint32()
+	if err != nil {
+		return nil, fmt.Errorf("reading result type: %w", err)
+	}
+
+	if resultType != ship.GetBlocksResultV0Type {
+		return nil, fmt.Errorf("invalid result type %d", resultType)
+	}
+
+	result := &stateHistoryBlocksResult{}
+	if err := decoder.Decode(result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *StateHistoryReader) readBlock(result *stateHistoryBlocksResult) (*pbcodec.Block, error) {
+	signedBlock := &ship.SignedBlock{}
+	if err := eos.UnmarshalBinary(result.Block, signedBlock); err != nil {
+		return nil, fmt.Errorf("decoding signed block: %w", err)
+	}
+
+	block := &pbcodec.Block{
+		Id:                       result.ThisBlock.BlockID.String(),
+		Number:                   result.ThisBlock.BlockNum,
+		Header:                   BlockHeaderToDEOS(&signedBlock.BlockHeader),
+		ProducerSignature:        signedBlock.ProducerSignature.String(),
+		BlockExtensions:          stateHistoryExtensionsToDEOS(signedBlock.BlockExtensions),
+		DposIrreversibleBlocknum: result.LastIrreversible.BlockNum,
+	}
+	block.MarkPartiallyInstrumented()
+
+	block.TransactionCount = uint32(len(signedBlock.Transactions))
+	for idx, transaction := range signedBlock.Transactions {
+		receipt, err := stateHistoryTransactionReceiptToDEOS(transaction)
+		if err != nil {
+			return nil, fmt.Errorf("transaction receipt #%d: %w", idx, err)
+		}
+
+		receipt.Index = uint64(idx)
+		block.Transactions = append(block.Transactions, receipt)
+	}
+
+	var traces []*ship.TransactionTraceV0
+	if result.Traces != nil {
+		traces = result.Traces.AsTransactionTracesV0()
+	}
+
+	for _, trace := range traces {
+		// Like in deep-mind, the failed deferred transaction is recorded before the `onerror` handler
+		// trace holding it, since it was ultimately ran first.
+		if trace.FailedDtrxTrace != nil {
+			failedTrx, ok := trace.FailedDtrxTrace.Impl.(*ship.TransactionTraceV0)
+			if !ok {
+				return nil, fmt.Errorf("transaction trace %s: unknown failed deferred transaction trace variant %T", trace.ID, trace.FailedDtrxTrace.Impl)
+			}
+
+			failedTrace, err := stateHistoryTransactionTraceToDEOS(failedTrx)
+			if err != nil {
+				return nil, fmt.Errorf("transaction trace %s: %w", failedTrx.ID, err)
+			}
+
+			block.TransactionTraces = append(block.TransactionTraces, failedTrace)
+		}
+
+		transactionTrace, err := stateHistoryTransactionTraceToDEOS(trace)
+		if err != nil {
+			return nil, fmt.Errorf("transaction trace %s: %w", trace.ID, err)
+		}
+
+		block.TransactionTraces = append(block.TransactionTraces, transactionTrace)
+	}
+
+	var deltas []*ship.TableDeltaV0
+	if result.Deltas != nil {
+		deltas = result.Deltas.AsTableDeltasV0()
+	}
+
+	if err := readStateHistoryDeltas(block, deltas); err != nil {
+		return nil, fmt.Errorf("table deltas: %w", err)
+	}
+
+	block.TransactionTraceCount = uint32(len(block.TransactionTraces))
+	for idx, t := range block.TransactionTraces {
+		t.Index = uint64(idx)
+		t.BlockTime = block.Header.Timestamp
+		t.ProducerBlockId = block.Id
+		t.BlockNum = uint64(block.Number)
+
+		for _, actionTrace := range t.ActionTraces {
+			actionTrace.BlockTime = block.Header.Timestamp
+			actionTrace.ProducerBlockId = block.Id
+			actionTrace.BlockNum = uint64(block.Number)
+
+			block.ExecutedTotalActionCount++
+			if actionTrace.IsInput() {
+				block.ExecuteInputActionCount++
+			}
+		}
+	}
+
+	if err := r.abiDecoder.DecodeBlock(context.Background(), block); err != nil {
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// readStateHistoryDeltas converts the block's table deltas into ops. State
+// history deltas are per block, not per transaction, so DB ops and permission
+// ops are all attached to the block's first transaction trace (the implicit
+// `onblock` one) while resource ops go to the block level `RlimitOps`.
+//
+// Deltas only convey the row's final state within the block, so this data is
+// partial: a present row is reported with an unknown operation holding only
+// its new value (whether it was inserted or updated, and its old value, are
+// not known) and a removed row as a removal of its last value. Intermediate
+// changes made within the block are not seen at all.
+func readStateHistoryDeltas(block *pbcodec.Block, deltas []*ship.TableDeltaV0) error {
+	var dbOps []*pbcodec.DBOp
+	var permOps []*pbcodec.PermOp
+
+	for _, delta := range deltas {
+		for _, row := range delta.Rows {
+			var err error
+			switch delta.Name {
+			case "contract_row":
+				var op *pbcodec.DBOp
+				if op, err = stateHistoryContractRowToDBOp(row); err == nil {
+					dbOps = append(dbOps, op)
+				}
+
+			case "permission":
+				var op *pbcodec.PermOp
+				if op, err = stateHistoryPermissionToPermOp(row); err == nil {
+					permOps = append(permOps, op)
+				}
+
+			case "resource_limits":
+				var op *pbcodec.RlimitOp
+				if op, err = stateHistoryResourceLimitsToRlimitOp(row); err == nil {
+					block.RlimitOps = append(block.RlimitOps, op)
+				}
+
+			case "resource_usage":
+				var op *pbcodec.RlimitOp
+				if op, err = stateHistoryResourceUsageToRlimitOp(row); err == nil {
+					block.RlimitOps = append(block.RlimitOps, op)
+				}
+			}
+
+			if err != nil {
+				return fmt.Errorf("%s row: %w", delta.Name, err)
+			}
+		}
+	}
+
+	if len(dbOps) == 0 && len(permOps) == 0 {
+		return nil
+	}
+
+	if len(block.TransactionTraces) == 0 {
+		return fmt.Errorf("got %d db ops and %d perm ops but block has no transaction trace to attach them to", len(dbOps), len(permOps))
+	}
+
+	trace := block.TransactionTraces[0]
+	trace.DbOps = append(trace.DbOps, dbOps...)
+	trace.PermOps = append(trace.PermOps, permOps...)
+
+	return nil
+}
+
+type stateHistoryContractRow struct {
+	Code       eos.Name
+	Scope      eos.Name
+	Table      eos.Name
+	PrimaryKey uint64
+	Payer      eos.Name
+	Value      []byte
+}
+
+type stateHistoryPermission struct {
+	Owner       eos.Name
+	Name        eos.Name
+	Parent      eos.Name
+	LastUpdated uint64
+	Auth        eos.Authority
+}
+
+type stateHistoryResourceLimits struct {
+	Owner     eos.Name
+	NetWeight int64
+	CPUWeight int64
+	RAMBytes  int64
+}
+
+type stateHistoryUsageAccumulator struct {
+	LastOrdinal uint32
+	ValueEx     uint64
+	Consumed    uint64
+}
+
+type stateHistoryResourceUsage struct {
+	Owner    eos.Name
+	NetUsage stateHistoryUsageAccumulator
+	CPUUsage stateHistoryUsageAccumulator
+	RAMUsage uint64
+}
+
+// newStateHistoryRowDecoder returns a decoder positioned on the row's content,
+// every row is a variant of which only the first version is known so far.
+func newStateHistoryRowDecoder(data []byte) (*eos.Decoder, error) {
+	decoder := eos.NewDecoder(data)
+	if err := readStateHistoryVersion(decoder); err != nil {
+		return nil, err
+	}
+
+	return decoder, nil
+}
+
+func readStateHistoryVersion(decoder *eos.Decoder) error {
+	version, err := decoder.ReadUvarint32()
+	if err != nil {
+		return fmt.Errorf("reading version: %w", err)
+	}
+
+	if version != 0 {
+		return fmt.Errorf("unsupported version %d", version)
+	}
+
+	return nil
+}
+
+// decodeStateHistoryResourceUsage decodes a resource usage row by hand, its
+// usage accumulators are variants too, which the decoder cannot infer.
+func decodeStateHistoryResourceUsage(decoder *eos.Decoder, out *stateHistoryResourceUsage) (err error) {
+	if err = decoder.Decode(&out.Owner); err != nil {
+		return err
+	}
+
+	for _, accumulator := range []*stateHistoryUsageAccumulator{&out.NetUsage, &out.CPUUsage} {
+		if err = readStateHistoryVersion(decoder); err != nil {
+			return fmt.Errorf("usage accumulator: %w", err)
+		}
+
+		if err = decoder.Decode(accumulator); err != nil {
+			return err
+		}
+	}
+
+	out.RAMUsage, err = decoder.ReadUint64()
+	return err
+}
+
+func stateHistoryContractRowToDBOp(row ship.Row) (*pbcodec.DBOp, error) {
+	decoder, err := newStateHistoryRowDecoder(row.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	contractRow := &stateHistoryContractRow{}
+	if err := decoder.Decode(contractRow); err != nil {
+		return nil, err
+	}
+
+	op := &pbcodec.DBOp{
+		Code:       string(contractRow.Code),
+		Scope:      string(contractRow.Scope),
+		TableName:  string(contractRow.Table),
+		PrimaryKey: eos.NameToString(contractRow.PrimaryKey),
+	}
+
+	if row.Present {
+		op.Operation = pbcodec.DBOp_OPERATION_UNKNOWN
+		op.NewPayer = string(contractRow.Payer)
+		op.NewData = contractRow.Value
+	} else {
+		op.Operation = pbcodec.DBOp_OPERATION_REMOVE
+		op.OldPayer = string(contractRow.Payer)
+		op.OldData = contractRow.Value
+	}
+
+	return op, nil
+}
+
+func stateHistoryPermissionToPermOp(row ship.Row) (*pbcodec.PermOp, error) {
+	decoder, err := newStateHistoryRowDecoder(row.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	permission := &stateHistoryPermission{}
+	if err := decoder.Decode(permission); err != nil {
+		return nil, err
+	}
+
+	perm := &pbcodec.PermissionObject{
+		Owner:       string(permission.Owner),
+		Name:        string(permission.Name),
+		LastUpdated: mustProtoTimestamp(time.Unix(0, int64(permission.LastUpdated)*int64(time.Microsecond)).UTC()),
+		Authority:   AuthoritiesToDEOS(&permission.Auth),
+	}
+
+	if row.Present {
+		return &pbcodec.PermOp{Operation: pbcodec.PermOp_OPERATION_UNKNOWN, NewPerm: perm}, nil
+	}
+
+	return &pbcodec.PermOp{Operation: pbcodec.PermOp_OPERATION_REMOVE, OldPerm: perm}, nil
+}
+
+func stateHistoryResourceLimitsToRlimitOp(row ship.Row) (*pbcodec.RlimitOp, error) {
+	decoder, err := newStateHistoryRowDecoder(row.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := &stateHistoryResourceLimits{}
+	if err := decoder.Decode(limits); err != nil {
+		return nil, err
+	}
+
+	return &pbcodec.RlimitOp{
+		Operation: stateHistoryRlimitOperation(row),
+		Kind: &pbcodec.RlimitOp_AccountLimits{
+			AccountLimits: &pbcodec.RlimitAccountLimits{
+				Owner:     string(limits.Owner),
+				NetWeight: limits.NetWeight,
+				CpuWeight: limits.CPUWeight,
+				RamBytes:  limits.RAMBytes,
+			},
+		},
+	}, nil
+}
+
+func stateHistoryResourceUsageToRlimitOp(row ship.Row) (*pbcodec.RlimitOp, error) {
+	decoder, err := newStateHistoryRowDecoder(row.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &stateHistoryResourceUsage{}
+	if err := decodeStateHistoryResourceUsage(decoder, usage); err != nil {
+		return nil, err
+	}
+
+	return &pbcodec.RlimitOp{
+		Operation: stateHistoryRlimitOperation(row),
+		Kind: &pbcodec.RlimitOp_AccountUsage{
+			AccountUsage: &pbcodec.RlimitAccountUsage{
+				Owner:    string(usage.Owner),
+				NetUsage: usage.NetUsage.ToProto(),
+				CpuUsage: usage.CPUUsage.ToProto(),
+				RamUsage: usage.RAMUsage,
+			},
+		},
+	}, nil
+}
+
+// stateHistoryRlimitOperation maps a row presence to a resource operation,
+// there is no removal for those so an absent row is reported as unknown.
+func stateHistoryRlimitOperation(row ship.Row) pbcodec.RlimitOp_Operation {
+	if row.Present {
+		return pbcodec.RlimitOp_OPERATION_INSERT
+	}
+
+	return pbcodec.RlimitOp_OPERATION_UNKNOWN
+}
+
+func (a stateHistoryUsageAccumulator) ToProto() *pbcodec.UsageAccumulator {
+	return &pbcodec.UsageAccumulator{
+		LastOrdinal: a.LastOrdinal,
+		ValueEx:     a.ValueEx,
+		Consumed:    a.Consumed,
+	}
+}
+
+func stateHistoryExtensionsToDEOS(in []*ship.Extension) (out []*pbcodec.Extension) {
+	for _, extension := range in {
+		out = append(out, &pbcodec.Extension{
+			Type: uint32(extension.Type),
+			Data: extension.Data,
+		})
+	}
+
+	return
+}
+
+func stateHistoryTransactionReceiptToDEOS(in *ship.TransactionReceipt) (*pbcodec.TransactionReceipt, error) {
+	receipt := &pbcodec.TransactionReceipt{
+		Status:               TransactionStatusToDEOS(in.Status),
+		CpuUsageMicroSeconds: in.CPUUsageMicroSeconds,
+		NetUsageWords:        uint32(in.NetUsageWords),
+	}
+
+	if in.Trx == nil {
+		return nil, fmt.Errorf("receipt has no transaction")
+	}
+
+	switch trx := in.Trx.Impl.(type) {
+	case *eos.Checksum256:
+		receipt.Id = trx.String()
+	case *eos.PackedTransaction:
+		id, err := trx.ID()
+		if err != nil {
+			return nil, fmt.Errorf("computing packed transaction id: %w", err)
+		}
+
+		receipt.Id = id.String()
+		receipt.PackedTransaction = &pbcodec.PackedTransaction{
+			Signatures:            SignaturesToDEOS(trx.Signatures),
+			Compression:           uint32(trx.Compression),
+			PackedContextFreeData: trx.PackedContextFreeData,
+			PackedTransaction:     trx.PackedTransaction,
+		}
+	default:
+		return nil, fmt.Errorf("unknown transaction variant %T", trx)
+	}
+
+	return receipt, nil
+}
+
+func stateHistoryTransactionTraceToDEOS(in *ship.TransactionTraceV0) (*pbcodec.TransactionTrace, error) {
+	actionTraces, err := stateHistoryActionTracesToDEOS(in.ID, in.ActionTraces)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcodec.TransactionTrace{
+		Id: in.ID.String(),
+		Receipt: &pbcodec.TransactionReceiptHeader{
+			Status:               TransactionStatusToDEOS(in.Status),
+			CpuUsageMicroSeconds: in.CPUUsageUS,
+			NetUsageWords:        uint32(in.NetUsageWords),
+		},
+		Elapsed:      int64(in.Elapsed),
+		NetUsage:     in.NetUsage,
+		Scheduled:    in.Scheduled,
+		ActionTraces: actionTraces,
+		Exception:    stateHistoryExceptionToDEOS(in.Except),
+		ErrorCode:    in.ErrorCode,
+	}, nil
+}
+
+// stateHistoryActionTrace is an action trace along with its receipt, if
+// any, both unwrapped from their variants.
+type stateHistoryActionTrace struct {
+	*ship.ActionTraceV0
+	receipt *ship.ActionReceiptV0
+}
+
+func stateHistoryActionTracesToDEOS(trxID eos.Checksum256, in []*ship.ActionTrace) (out []*pbcodec.ActionTrace, err error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	actionTraces := make([]*stateHistoryActionTrace, len(in))
+	for i, actionTrace := range in {
+		trace, ok := actionTrace.Impl.(*ship.ActionTraceV0)
+		if !ok {
+			return nil, fmt.Errorf("action trace #%d: unknown action trace variant %T", i, actionTrace.Impl)
+		}
+
+		actionTraces[i] = &stateHistoryActionTrace{ActionTraceV0: trace}
+		if trace.Receipt != nil {
+			if actionTraces[i].receipt, ok = trace.Receipt.Impl.(*ship.ActionReceiptV0); !ok {
+				return nil, fmt.Errorf("action trace #%d: unknown action receipt variant %T", i, trace.Receipt.Impl)
+			}
+		}
+	}
+
+	// The closest unnotified ancestor is not part of state history traces, it's derived from the
+	// creation chain before the traces are re-ordered: an action created by a notification shares
+	// its creator's closest unnotified ancestor, otherwise its creator is the closest one.
+	closestUnnotifiedAncestors := make(map[uint32]uint32, len(actionTraces))
+	byOrdinal := make(map[uint32]*stateHistoryActionTrace, len(actionTraces))
+	for _, actionTrace := range actionTraces {
+		byOrdinal[uint32(actionTrace.ActionOrdinal)] = actionTrace
+	}
+
+	for _, actionTrace := range actionTraces {
+		creatorOrdinal := uint32(actionTrace.CreatorActionOrdinal)
+		if creator, found := byOrdinal[creatorOrdinal]; found {
+			if creator.Receiver == eos.Name(creator.Act.Account) {
+				closestUnnotifiedAncestors[uint32(actionTrace.ActionOrdinal)] = creatorOrdinal
+			} else {
+				closestUnnotifiedAncestors[uint32(actionTrace.ActionOrdinal)] = closestUnnotifiedAncestors[creatorOrdinal]
+			}
+		}
+	}
+
+	// Same ordering as deep-mind's traces, execution order, which is the global sequence one
+	sort.SliceStable(actionTraces, func(i, j int) bool {
+		return actionTraces[i].globalSequence() < actionTraces[j].globalSequence()
+	})
+
+	out = make([]*pbcodec.ActionTrace, len(actionTraces))
+	for idx, actionTrace := range actionTraces {
+		out[idx] = stateHistoryActionTraceToDEOS(trxID, actionTrace, uint32(idx))
+		out[idx].ClosestUnnotifiedAncestorActionOrdinal = closestUnnotifiedAncestors[uint32(actionTrace.ActionOrdinal)]
+	}
+
+	return out, nil
+}
+
+func (t *stateHistoryActionTrace) globalSequence() uint64 {
+	if t.receipt != nil && t.receipt.GlobalSequence != 0 {
+		return t.receipt.GlobalSequence
+	}
+
+	return math.MaxUint64
+}
+
+func stateHistoryActionTraceToDEOS(trxID eos.Checksum256, in *stateHistoryActionTrace, execIndex uint32) *pbcodec.ActionTrace {
+	out := &pbcodec.ActionTrace{
+		Receiver: string(in.Receiver),
+		Action: &pbcodec.Action{
+			Account:       string(in.Act.Account),
+			Name:          string(in.Act.Name),
+			Authorization: AuthorizationToDEOS(in.Act.Authorization),
+			RawData:       in.Act.Data,
+		},
+		ContextFree:          in.ContextFree,
+		Elapsed:              in.Elapsed,
+		Console:              string(in.Console),
+		TransactionId:        trxID.String(),
+		AccountRamDeltas:     AccountRAMDeltasToDEOS(in.AccountRamDeltas),
+		Exception:            stateHistoryExceptionToDEOS(in.Except),
+		ErrorCode:            in.ErrorCode,
+		ActionOrdinal:        uint32(in.ActionOrdinal),
+		CreatorActionOrdinal: uint32(in.CreatorActionOrdinal),
+		ExecutionIndex:       execIndex,
+	}
+
+	if receipt := in.receipt; receipt != nil {
+		var authSequences []*pbcodec.AuthSequence
+		for _, seq := range receipt.AuthSequence {
+			authSequences = append(authSequences, &pbcodec.AuthSequence{
+				AccountName: string(seq.Account),
+				Sequence:    seq.Sequence,
+			})
+		}
+
+		out.Receipt = &pbcodec.ActionReceipt{
+			Receiver:       string(receipt.Receiver),
+			Digest:         receipt.ActDigest.String(),
+			GlobalSequence: receipt.GlobalSequence,
+			AuthSequence:   authSequences,
+			RecvSequence:   receipt.RecvSequence,
+			CodeSequence:   uint64(receipt.CodeSequence),
+			AbiSequence:    uint64(receipt.ABISequence),
+		}
+	}
+
+	return out
+}
+
+// stateHistoryExceptionToDEOS converts the state history exception, which
+// is only its formatted message, to our exception representation.
+func stateHistoryExceptionToDEOS(except string) *pbcodec.Exception {
+	if except == "" {
+		return nil
+	}
+
+	return &pbcodec.Exception{Message: except}
+}
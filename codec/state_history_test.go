@@ -0,0 +1,279 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ship"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateHistoryReader(t *testing.T) {
+	framesFile := "testdata/state-history.frames"
+	server, requests := newStateHistoryStandIn(t, framesFile, 0)
+	defer server.Close()
+
+	reader, err := NewStateHistoryReader("ws"+strings.TrimPrefix(server.URL, "http"), 2)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	buf := &bytes.Buffer{}
+	var blockNums []uint32
+	for {
+		out, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		block := out.(*pbcodec.Block)
+		assert.True(t, block.IsPartiallyInstrumented())
+		blockNums = append(blockNums, block.Number)
+
+		if len(buf.Bytes()) != 0 {
+			buf.Write([]byte("\n"))
+		}
+		buf.Write([]byte(protoJSONMarshalIndent(t, block)))
+	}
+
+	assert.Equal(t, []uint32{2, 3, 4}, blockNums)
+
+	select {
+	case <-reader.Done():
+	default:
+		t.Error("reader should be done once the server closed the connection")
+	}
+
+	received := <-requests
+	require.Len(t, received, 4)
+	assert.Equal(t, &ship.GetBlocksRequestV0{
+		StartBlockNum:       2,
+		EndBlockNum:         4294967295,
+		MaxMessagesInFlight: 10,
+		HavePositions:       []*ship.BlockPosition{},
+		FetchBlock:          true,
+		FetchTraces:         true,
+		FetchDeltas:         true,
+	}, received[0].Impl)
+	for _, ack := range received[1:] {
+		assert.Equal(t, &ship.GetBlocksAckRequestV0{NumMessages: 1}, ack.Impl)
+	}
+
+	goldenFile := framesFile + ".golden.json"
+	if os.Getenv("GOLDEN_UPDATE") == "true" {
+		ioutil.WriteFile(goldenFile, buf.Bytes(), os.ModePerm)
+	}
+
+	cnt, err := ioutil.ReadFile(goldenFile)
+	require.NoError(t, err)
+
+	if !assert.Equal(t, string(cnt), buf.String()) {
+		t.Error("previous diff:\n" + unifiedDiff(t, cnt, buf.Bytes()))
+	}
+}
+
+func TestStateHistoryActionTracesToDEOS(t *testing.T) {
+	trace := func(ordinal, creator uint32, receiver, account string, globalSequence uint64) *ship.ActionTrace {
+		return &ship.ActionTrace{BaseVariant: eos.BaseVariant{Impl: &ship.ActionTraceV0{
+			ActionOrdinal:        eos.Varuint32(ordinal),
+			CreatorActionOrdinal: eos.Varuint32(creator),
+			Receipt:              &ship.ActionReceipt{BaseVariant: eos.BaseVariant{Impl: &ship.ActionReceiptV0{GlobalSequence: globalSequence}}},
+			Receiver:             eos.Name(receiver),
+			Act:                  &ship.Action{Account: eos.AccountName(account), Name: "transfer"},
+		}}}
+	}
+
+	// Ordinals as produced by nodeos: the notification (2) of the root action (1) is scheduled before
+	// the inline (3) of the root action, while the inline (4) is sent by the notification.
+	actionTraces, err := stateHistoryActionTracesToDEOS(nil, []*ship.ActionTrace{
+		trace(1, 0, "eosio.token", "eosio.token", 10),
+		trace(2, 1, "alice", "eosio.token", 11),
+		trace(3, 1, "eosio.token", "eosio.token", 13),
+		trace(4, 2, "bob", "bob", 12),
+	})
+	require.NoError(t, err)
+
+	type actionTraceSummary struct {
+		ordinal                uint32
+		closestUnnotified      uint32
+		executionIndex         uint32
+		receiverGlobalSequence uint64
+	}
+
+	var summaries []actionTraceSummary
+	for _, actionTrace := range actionTraces {
+		summaries = append(summaries, actionTraceSummary{
+			actionTrace.ActionOrdinal,
+			actionTrace.ClosestUnnotifiedAncestorActionOrdinal,
+			actionTrace.ExecutionIndex,
+			actionTrace.Receipt.GlobalSequence,
+		})
+	}
+
+	assert.Equal(t, []actionTraceSummary{
+		{1, 0, 0, 10},
+		{2, 1, 1, 11},
+		{4, 1, 2, 12},
+		{3, 1, 3, 13},
+	}, summaries)
+}
+
+func TestStateHistoryActionTracesToDEOS_UnknownVariant(t *testing.T) {
+	_, err := stateHistoryActionTracesToDEOS(nil, []*ship.ActionTrace{
+		{BaseVariant: eos.BaseVariant{Impl: &ship.ActionReceiptV0{}}},
+	})
+	assert.EqualError(t, err, "action trace #0: unknown action trace variant *ship.ActionReceiptV0")
+}
+
+func TestStateHistoryReader_Reconnect(t *testing.T) {
+	server, requests := newStateHistoryStandIn(t, "testdata/state-history.frames", 1)
+	defer server.Close()
+
+	reader, err := NewStateHistoryReader("ws"+strings.TrimPrefix(server.URL, "http"), 2)
+	require.NoError(t, err)
+	reader.connectRetryDelay = 10 * time.Millisecond
+	defer reader.Close()
+
+	var blockNums []uint32
+	for {
+		out, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		blockNums = append(blockNums, out.(*pbcodec.Block).Number)
+	}
+
+	assert.Equal(t, []uint32{2, 3, 4}, blockNums)
+
+	first := <-requests
+	require.Len(t, first, 2)
+	assert.Equal(t, uint32(2), first[0].Impl.(*ship.GetBlocksRequestV0).StartBlockNum)
+
+	second := <-requests
+	require.Len(t, second, 3)
+	request := second[0].Impl.(*ship.GetBlocksRequestV0)
+	assert.Equal(t, uint32(3), request.StartBlockNum)
+	require.Len(t, request.HavePositions, 1)
+	assert.Equal(t, uint32(2), request.HavePositions[0].BlockNum)
+	assert.Equal(t, "00000002abababababababababababababababababababababababababababab", request.HavePositions[0].BlockID.String())
+}
+
+// newStateHistoryStandIn starts a local stand-in for the `state_history_plugin`
+// websocket endpoint, replaying the recorded frames of `framesFile` (the ABI
+// line followed by one hex encoded binary result per line). Once done, it
+// closes the connection and sends the requests it received on the channel.
+//
+// When `dropAfter` is not 0, the first connection is abruptly dropped once
+// that many frames were sent, the next connection sending the remaining ones.
+func newStateHistoryStandIn(t *testing.T, framesFile string, dropAfter int) (*httptest.Server, <-chan []*ship.Request) {
+	t.Helper()
+
+	content, err := ioutil.ReadFile(framesFile)
+	require.NoError(t, err)
+
+	var abi string
+	var frames [][]byte
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "ABI ") {
+			abi = strings.TrimPrefix(line, "ABI ")
+			continue
+		}
+
+		frame, err := hex.DecodeString(line)
+		require.NoError(t, err)
+		frames = append(frames, frame)
+	}
+	require.NoError(t, scanner.Err())
+
+	requests := make(chan []*ship.Request, 2)
+	upgrader := websocket.Upgrader{}
+	var connections int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var received []*ship.Request
+		defer func() { requests <- received }()
+
+		readRequest := func() bool {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return false
+			}
+
+			request := &ship.Request{}
+			if err := eos.UnmarshalBinary(message, request); err != nil {
+				return false
+			}
+
+			received = append(received, request)
+			return true
+		}
+
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(abi)); err != nil || !readRequest() {
+			return
+		}
+
+		first := atomic.AddInt32(&connections, 1) == 1
+		toSend := frames
+		if dropAfter != 0 {
+			if first {
+				toSend = frames[:dropAfter]
+			} else {
+				toSend = frames[dropAfter:]
+			}
+		}
+
+		for _, frame := range toSend {
+			if err := conn.WriteMessage(websocket.BinaryMessage, frame); err != nil || !readRequest() {
+				return
+			}
+		}
+
+		if dropAfter != 0 && first {
+			conn.UnderlyingConn().Close()
+			return
+		}
+
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+
+	return server, requests
+}
@@ -15,23 +15,44 @@
 package dashboard
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/dfuse-io/dfuse-eosio/launcher"
 	"github.com/dfuse-io/dfuse-eosio/metrics"
+	"github.com/dfuse-io/dfuse-eosio/tracing"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	dmeshCli "github.com/dfuse-io/dmesh/client"
 	"github.com/dfuse-io/shutter"
+	"go.uber.org/zap/zapcore"
 )
 
 type Config struct {
 	EosNodeManagerAPIAddr string
 	GRPCListenAddr        string
 	HTTPListenAddr        string
+
+	// The chain explorer is disabled when the addr or dsn is empty, or the
+	// window size is 0
+	BlockStreamAddr    string
+	KVDBDSN            string
+	ExplorerWindowSize int
 }
 
 type Modules struct {
 	Launcher    *launcher.Launcher
 	DmeshClient dmeshCli.SearchClient
+
+	// AppLogs is nil when logs are not buffered
+	AppLogs *launcher.AppLogs
+
+	// SetAppLogLevel changes the level of the logger of an app, returning
+	// false when the app has no logger
+	SetAppLogLevel func(appID string, level zapcore.Level) bool
+
+	// Traces is nil when traces are not kept in memory
+	Traces *tracing.Buffer
 }
 
 type App struct {
@@ -59,6 +80,19 @@ func (a *App) Run() error {
 
 	s := newServer(a.config, a.modules, mgr)
 
+	if a.config.BlockStreamAddr != "" && a.config.KVDBDSN != "" && a.config.ExplorerWindowSize > 0 {
+		db, err := trxdb.New(a.config.KVDBDSN)
+		if err != nil {
+			return fmt.Errorf("unable to create trxdb: %w", err)
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		a.OnTerminating(func(_ error) { cancel() })
+
+		s.explorer = newExplorer(a.config.ExplorerWindowSize, a.config.BlockStreamAddr, db)
+		go s.explorer.Launch(ctx)
+	}
+
 	a.OnTerminating(s.Shutdown)
 
 	go func() {
@@ -0,0 +1,126 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pbdashboard "github.com/dfuse-io/dfuse-eosio/dashboard/pb"
+	"github.com/dfuse-io/dmesh"
+)
+
+func (s *server) SearchCoverage(ctx context.Context, req *pbdashboard.SearchCoverageRequest) (*pbdashboard.SearchCoverageResponse, error) {
+	zlog.Debug("search coverage")
+
+	var peers []*pbdashboard.SearchPeerCoverage
+	for _, peer := range s.modules.DmeshClient.Peers() {
+		peers = append(peers, toPeerCoverage(peer))
+	}
+
+	return computeCoverage(peers), nil
+}
+
+// toPeerCoverage returns the range served by the peer, up to its head block
+// when it serves reversible blocks or its irreversible block otherwise.
+func toPeerCoverage(peer *dmesh.SearchPeer) *pbdashboard.SearchPeerCoverage {
+	out := &pbdashboard.SearchPeerCoverage{
+		Host:         peer.Host,
+		TierLevel:    peer.TierLevel,
+		Ready:        peer.Ready,
+		Live:         peer.ServesReversible,
+		LowBlockNum:  peer.TailBlock,
+		HighBlockNum: peer.IrrBlock,
+		HeadBlockNum: peer.HeadBlock,
+	}
+
+	if peer.ServesReversible {
+		out.HighBlockNum = peer.HeadBlock
+	}
+
+	return out
+}
+
+// computeCoverage splits the blocks served by the ready peers into ranges
+// served by the same peers, flagging the ranges served by none of them.
+func computeCoverage(peers []*pbdashboard.SearchPeerCoverage) *pbdashboard.SearchCoverageResponse {
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].TierLevel == peers[j].TierLevel {
+			return peers[i].LowBlockNum < peers[j].LowBlockNum
+		}
+		return peers[i].TierLevel < peers[j].TierLevel
+	})
+
+	out := &pbdashboard.SearchCoverageResponse{Peers: peers}
+
+	// Each range starts at a boundary, where a peer starts or stops serving
+	var ready []*pbdashboard.SearchPeerCoverage
+	var boundaries []uint64
+	hasLive := false
+	for _, peer := range peers {
+		if !peer.Ready {
+			out.Warnings = append(out.Warnings, fmt.Sprintf("peer %s (tier %d) is not ready", peer.Host, peer.TierLevel))
+			continue
+		}
+
+		if peer.HighBlockNum < peer.LowBlockNum {
+			continue
+		}
+
+		ready = append(ready, peer)
+		boundaries = append(boundaries, peer.LowBlockNum, peer.HighBlockNum+1)
+		hasLive = hasLive || peer.Live
+	}
+
+	if len(ready) == 0 {
+		out.Warnings = append(out.Warnings, "no ready search peer, no block is searchable")
+		return out
+	}
+
+	if !hasLive {
+		out.Warnings = append(out.Warnings, "no ready live search peer, reversible blocks are not searchable")
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i] < boundaries[j] })
+	out.LowBlockNum = boundaries[0]
+	out.HighBlockNum = boundaries[len(boundaries)-1] - 1
+
+	for i := 0; i < len(boundaries)-1; i++ {
+		low, high := boundaries[i], boundaries[i+1]-1
+		if boundaries[i+1] == low {
+			continue
+		}
+
+		coverageRange := &pbdashboard.SearchCoverageRange{LowBlockNum: low, HighBlockNum: high}
+		tiers := map[uint32]bool{}
+		for _, peer := range ready {
+			if peer.LowBlockNum <= low && peer.HighBlockNum >= high {
+				coverageRange.Hosts = append(coverageRange.Hosts, peer.Host)
+				coverageRange.Overlap = coverageRange.Overlap || tiers[peer.TierLevel]
+				tiers[peer.TierLevel] = true
+			}
+		}
+
+		if len(coverageRange.Hosts) == 0 {
+			coverageRange.Gap = true
+			out.Warnings = append(out.Warnings, fmt.Sprintf("blocks %d to %d are not served by any ready search peer", low, high))
+		}
+
+		out.Ranges = append(out.Ranges, coverageRange)
+	}
+
+	return out
+}
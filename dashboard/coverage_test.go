@@ -0,0 +1,47 @@
+package dashboard
+
+import (
+	"testing"
+
+	pbdashboard "github.com/dfuse-io/dfuse-eosio/dashboard/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeCoverage(t *testing.T) {
+	out := computeCoverage([]*pbdashboard.SearchPeerCoverage{
+		{Host: "live", TierLevel: 100, Ready: true, Live: true, LowBlockNum: 250, HighBlockNum: 320, HeadBlockNum: 320},
+		{Host: "archive-1", TierLevel: 10, Ready: true, LowBlockNum: 0, HighBlockNum: 99, HeadBlockNum: 99},
+		{Host: "archive-2", TierLevel: 10, Ready: true, LowBlockNum: 50, HighBlockNum: 199, HeadBlockNum: 199},
+		{Host: "archive-3", TierLevel: 20, Ready: false, LowBlockNum: 200, HighBlockNum: 299, HeadBlockNum: 299},
+	})
+
+	assert.Equal(t, []string{"archive-1", "archive-2", "archive-3", "live"}, []string{out.Peers[0].Host, out.Peers[1].Host, out.Peers[2].Host, out.Peers[3].Host})
+	assert.Equal(t, uint64(0), out.LowBlockNum)
+	assert.Equal(t, uint64(320), out.HighBlockNum)
+
+	require.Len(t, out.Ranges, 5)
+	assert.Equal(t, &pbdashboard.SearchCoverageRange{LowBlockNum: 0, HighBlockNum: 49, Hosts: []string{"archive-1"}}, out.Ranges[0])
+	assert.Equal(t, &pbdashboard.SearchCoverageRange{LowBlockNum: 50, HighBlockNum: 99, Hosts: []string{"archive-1", "archive-2"}, Overlap: true}, out.Ranges[1])
+	assert.Equal(t, &pbdashboard.SearchCoverageRange{LowBlockNum: 100, HighBlockNum: 199, Hosts: []string{"archive-2"}}, out.Ranges[2])
+	assert.Equal(t, &pbdashboard.SearchCoverageRange{LowBlockNum: 200, HighBlockNum: 249, Gap: true}, out.Ranges[3])
+	assert.Equal(t, &pbdashboard.SearchCoverageRange{LowBlockNum: 250, HighBlockNum: 320, Hosts: []string{"live"}}, out.Ranges[4])
+
+	assert.Equal(t, []string{
+		"peer archive-3 (tier 20) is not ready",
+		"blocks 200 to 249 are not served by any ready search peer",
+	}, out.Warnings)
+}
+
+func TestComputeCoverage_NoLivePeer(t *testing.T) {
+	out := computeCoverage([]*pbdashboard.SearchPeerCoverage{
+		{Host: "archive-1", TierLevel: 10, Ready: true, LowBlockNum: 0, HighBlockNum: 99},
+	})
+
+	require.Len(t, out.Ranges, 1)
+	assert.Equal(t, []string{"no ready live search peer, reversible blocks are not searchable"}, out.Warnings)
+
+	out = computeCoverage(nil)
+	assert.Len(t, out.Ranges, 0)
+	assert.Equal(t, []string{"no ready search peer, no block is searchable"}, out.Warnings)
+}
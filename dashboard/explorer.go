@@ -0,0 +1,361 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/blockstream"
+	pbdashboard "github.com/dfuse-io/dfuse-eosio/dashboard/pb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errExplorerUnavailable = status.Error(codes.Unavailable, "chain explorer is disabled, it requires a trxdb dsn and a blockstream address")
+
+// explorer keeps a summary of the last blocks of the chain, backfilled from
+// trxdb at startup then followed live from the relayer, to answer the chain
+// explorer calls of the dashboard.
+type explorer struct {
+	windowSize      int
+	blockStreamAddr string
+	db              trxdb.DBReader
+
+	lock   sync.RWMutex
+	blocks []*blockSummary // ordered by block num, the last one being the head
+}
+
+type blockSummary struct {
+	num       uint64
+	id        string
+	producer  string
+	timestamp time.Time
+
+	transactionCount int
+	actionCount      int
+
+	// actionCountByContract counts the actions executed by each contract,
+	// notifications excluded
+	actionCountByContract map[string]uint64
+	failedTransactions    []*pbdashboard.FailedTransaction
+	abiChanges            []*pbdashboard.ABIChange
+}
+
+func newExplorer(windowSize int, blockStreamAddr string, db trxdb.DBReader) *explorer {
+	return &explorer{
+		windowSize:      windowSize,
+		blockStreamAddr: blockStreamAddr,
+		db:              db,
+	}
+}
+
+// Launch backfills the window from trxdb then follows the live blocks until
+// `ctx` is canceled.
+func (e *explorer) Launch(ctx context.Context) {
+	if err := e.backfill(ctx); err != nil {
+		zlog.Warn("unable to backfill chain explorer from trxdb, only showing live blocks", zap.Error(err))
+	}
+
+	handler := bstream.HandlerFunc(func(blk *bstream.Block, obj interface{}) error {
+		e.add(summarizeBlock(blk.ToNative().(*pbcodec.Block)))
+		return nil
+	})
+
+	// The relayer might not be up yet, or restart, retrying until terminated
+	for {
+		src := blockstream.NewSource(ctx, e.blockStreamAddr, 300, handler, blockstream.WithName("dashboard"))
+		terminated := make(chan struct{})
+		src.OnTerminated(func(err error) {
+			zlog.Info("chain explorer live source terminated", zap.Error(err))
+			close(terminated)
+		})
+
+		go src.Run()
+
+		select {
+		case <-ctx.Done():
+			src.Shutdown(nil)
+			return
+		case <-terminated:
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (e *explorer) backfill(ctx context.Context) error {
+	blocks, err := e.db.ListBlocks(ctx, math.MaxUint32, e.windowSize)
+	if err != nil {
+		return fmt.Errorf("list blocks: %w", err)
+	}
+
+	// Blocks are listed from the highest one
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block, err := e.hydrateBlock(ctx, blocks[i])
+		if err != nil {
+			return fmt.Errorf("hydrate block %q: %w", blocks[i].Id, err)
+		}
+
+		e.add(summarizeBlock(block))
+	}
+
+	zlog.Info("backfilled chain explorer from trxdb", zap.Int("block_count", len(blocks)))
+	return nil
+}
+
+// hydrateBlock fills the transaction traces of the block, trxdb only keeping
+// references to them.
+func (e *explorer) hydrateBlock(ctx context.Context, blockWithRefs *pbcodec.BlockWithRefs) (*pbcodec.Block, error) {
+	block := blockWithRefs.Block
+	if blockWithRefs.TransactionTraceRefs == nil || len(blockWithRefs.TransactionTraceRefs.Hashes) == 0 {
+		return block, nil
+	}
+
+	var prefixes []string
+	for _, hash := range blockWithRefs.TransactionTraceRefs.Hashes {
+		prefixes = append(prefixes, hex.EncodeToString(hash))
+	}
+
+	responses, err := e.db.GetTransactionTracesBatch(ctx, prefixes)
+	if err != nil {
+		return nil, err
+	}
+
+	block.TransactionTraces = nil
+	for _, events := range responses {
+		for _, event := range events {
+			execution, ok := event.Event.(*pbcodec.TransactionEvent_Execution)
+			if ok && event.BlockId == blockWithRefs.Id {
+				block.TransactionTraces = append(block.TransactionTraces, execution.Execution.Trace)
+				break
+			}
+		}
+	}
+
+	return block, nil
+}
+
+// add appends the summary at the head of the window, replacing the blocks at
+// or above its num when switching to a fork.
+func (e *explorer) add(summary *blockSummary) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	cut := sort.Search(len(e.blocks), func(i int) bool { return e.blocks[i].num >= summary.num })
+	e.blocks = append(e.blocks[:cut], summary)
+
+	if len(e.blocks) > e.windowSize {
+		e.blocks = append([]*blockSummary(nil), e.blocks[len(e.blocks)-e.windowSize:]...)
+	}
+}
+
+// lastBlocks returns the last `count` summaries, or all of them when 0, from
+// the lowest block.
+func (e *explorer) lastBlocks(count uint32) []*blockSummary {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	blocks := e.blocks
+	if count != 0 && int(count) < len(blocks) {
+		blocks = blocks[len(blocks)-int(count):]
+	}
+
+	return append([]*blockSummary(nil), blocks...)
+}
+
+func summarizeBlock(block *pbcodec.Block) *blockSummary {
+	summary := &blockSummary{
+		num:                   block.Num(),
+		id:                    block.ID(),
+		actionCountByContract: map[string]uint64{},
+	}
+
+	if block.Header != nil {
+		summary.producer = block.Header.Producer
+		summary.timestamp = block.MustTime()
+	}
+
+	for _, trace := range block.TransactionTraces {
+		summary.transactionCount++
+
+		if trace.HasBeenReverted() {
+			summary.failedTransactions = append(summary.failedTransactions, toProtoFailedTransaction(block, trace))
+			continue
+		}
+
+		for _, actionTrace := range trace.ActionTraces {
+			if actionTrace.Action == nil {
+				continue
+			}
+
+			summary.actionCount++
+			if actionTrace.Receiver != actionTrace.Account() {
+				continue
+			}
+			summary.actionCountByContract[actionTrace.Account()]++
+
+			if actionTrace.Account() == "eosio" && actionTrace.Name() == "setabi" {
+				summary.abiChanges = append(summary.abiChanges, &pbdashboard.ABIChange{
+					Account:       actionTrace.GetData("account").String(),
+					BlockNum:      summary.num,
+					BlockId:       summary.id,
+					TransactionId: trace.Id,
+					Timestamp:     timeToProtoTimestamp(&summary.timestamp),
+				})
+			}
+		}
+	}
+
+	return summary
+}
+
+func toProtoFailedTransaction(block *pbcodec.Block, trace *pbcodec.TransactionTrace) *pbdashboard.FailedTransaction {
+	out := &pbdashboard.FailedTransaction{
+		Id:       trace.Id,
+		BlockNum: block.Num(),
+		BlockId:  block.ID(),
+		Status:   "unknown",
+	}
+
+	if trace.Receipt != nil {
+		out.Status = trace.Receipt.Status.String()
+	}
+
+	if trace.Exception != nil {
+		out.Error = fmt.Sprintf("%s (%d): %s", trace.Exception.Name, trace.Exception.Code, trace.Exception.Message)
+	}
+
+	return out
+}
+
+func (s *server) RecentBlocks(ctx context.Context, req *pbdashboard.RecentBlocksRequest) (*pbdashboard.RecentBlocksResponse, error) {
+	if s.explorer == nil {
+		return nil, errExplorerUnavailable
+	}
+
+	blocks := s.explorer.lastBlocks(req.Limit)
+	out := &pbdashboard.RecentBlocksResponse{}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		block := blocks[i]
+		out.Blocks = append(out.Blocks, &pbdashboard.BlockSummary{
+			Num:                    block.num,
+			Id:                     block.id,
+			Producer:               block.producer,
+			Timestamp:              timeToProtoTimestamp(&block.timestamp),
+			TransactionCount:       uint32(block.transactionCount),
+			ActionCount:            uint32(block.actionCount),
+			FailedTransactionCount: uint32(len(block.failedTransactions)),
+		})
+	}
+
+	return out, nil
+}
+
+func (s *server) TopContracts(ctx context.Context, req *pbdashboard.TopContractsRequest) (*pbdashboard.TopContractsResponse, error) {
+	if s.explorer == nil {
+		return nil, errExplorerUnavailable
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	blocks := s.explorer.lastBlocks(req.LastBlocks)
+	out := &pbdashboard.TopContractsResponse{}
+	if len(blocks) == 0 {
+		return out, nil
+	}
+	out.LowBlockNum = blocks[0].num
+	out.HighBlockNum = blocks[len(blocks)-1].num
+
+	actionCounts := map[string]uint64{}
+	for _, block := range blocks {
+		for contract, count := range block.actionCountByContract {
+			actionCounts[contract] += count
+		}
+	}
+
+	for contract, count := range actionCounts {
+		out.Contracts = append(out.Contracts, &pbdashboard.ContractActivity{Account: contract, ActionCount: count})
+	}
+
+	sort.Slice(out.Contracts, func(i, j int) bool {
+		if out.Contracts[i].ActionCount == out.Contracts[j].ActionCount {
+			return out.Contracts[i].Account < out.Contracts[j].Account
+		}
+		return out.Contracts[i].ActionCount > out.Contracts[j].ActionCount
+	})
+
+	if len(out.Contracts) > limit {
+		out.Contracts = out.Contracts[:limit]
+	}
+
+	return out, nil
+}
+
+func (s *server) FailedTransactions(ctx context.Context, req *pbdashboard.FailedTransactionsRequest) (*pbdashboard.FailedTransactionsResponse, error) {
+	if s.explorer == nil {
+		return nil, errExplorerUnavailable
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	// Most recent first
+	blocks := s.explorer.lastBlocks(req.LastBlocks)
+	out := &pbdashboard.FailedTransactionsResponse{}
+	for i := len(blocks) - 1; i >= 0 && len(out.Transactions) < limit; i-- {
+		for _, trx := range blocks[i].failedTransactions {
+			if len(out.Transactions) == limit {
+				break
+			}
+			out.Transactions = append(out.Transactions, trx)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *server) ABIChanges(ctx context.Context, req *pbdashboard.ABIChangesRequest) (*pbdashboard.ABIChangesResponse, error) {
+	if s.explorer == nil {
+		return nil, errExplorerUnavailable
+	}
+
+	// Most recent first
+	blocks := s.explorer.lastBlocks(req.LastBlocks)
+	out := &pbdashboard.ABIChangesResponse{}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		out.Changes = append(out.Changes, blocks[i].abiChanges...)
+	}
+
+	return out, nil
+}
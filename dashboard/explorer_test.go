@@ -0,0 +1,112 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	pbdashboard "github.com/dfuse-io/dfuse-eosio/dashboard/pb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplorer(t *testing.T) {
+	e := newExplorer(3, "", nil)
+	s := &server{explorer: e}
+
+	e.add(summarizeBlock(testBlock(1, "a", executedTrx("trx1", "eosio.token:eosio.token:transfer", "alice:eosio.token:transfer"))))
+	e.add(summarizeBlock(testBlock(2, "a", executedTrx("trx2", "eosio:eosio:setabi"), failedTrx("trx3"))))
+	e.add(summarizeBlock(testBlock(3, "a", executedTrx("trx4", "eosio.token:eosio.token:transfer"))))
+	e.add(summarizeBlock(testBlock(4, "a")))
+
+	recent, err := s.RecentBlocks(context.Background(), &pbdashboard.RecentBlocksRequest{})
+	require.NoError(t, err)
+	require.Len(t, recent.Blocks, 3, "window keeps the last 3 blocks")
+	assert.Equal(t, []uint64{4, 3, 2}, blockNums(recent.Blocks))
+	assert.Equal(t, uint32(2), recent.Blocks[2].TransactionCount)
+	assert.Equal(t, uint32(1), recent.Blocks[2].FailedTransactionCount)
+
+	// Switching to a fork replaces the blocks at or above the forked one
+	e.add(summarizeBlock(testBlock(3, "b")))
+	recent, err = s.RecentBlocks(context.Background(), &pbdashboard.RecentBlocksRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, recent.Blocks, 1)
+	assert.Equal(t, "00000003b", recent.Blocks[0].Id)
+
+	e.add(summarizeBlock(testBlock(4, "b", executedTrx("trx5", "eosio.token:eosio.token:transfer", "eosio:eosio:setabi"))))
+
+	top, err := s.TopContracts(context.Background(), &pbdashboard.TopContractsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), top.LowBlockNum)
+	assert.Equal(t, uint64(4), top.HighBlockNum)
+	require.Len(t, top.Contracts, 2)
+	assert.Equal(t, &pbdashboard.ContractActivity{Account: "eosio", ActionCount: 2}, top.Contracts[0])
+	assert.Equal(t, &pbdashboard.ContractActivity{Account: "eosio.token", ActionCount: 1}, top.Contracts[1])
+
+	failed, err := s.FailedTransactions(context.Background(), &pbdashboard.FailedTransactionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, failed.Transactions, 1)
+	assert.Equal(t, "trx3", failed.Transactions[0].Id)
+	assert.Equal(t, "TRANSACTIONSTATUS_HARDFAIL", failed.Transactions[0].Status)
+	assert.Equal(t, "eosio_assert_message_exception (3050003): overdrawn balance", failed.Transactions[0].Error)
+
+	changes, err := s.ABIChanges(context.Background(), &pbdashboard.ABIChangesRequest{LastBlocks: 2})
+	require.NoError(t, err)
+	require.Len(t, changes.Changes, 1)
+	assert.Equal(t, "trx5", changes.Changes[0].TransactionId)
+	assert.Equal(t, "eosio.token", changes.Changes[0].Account)
+}
+
+func TestExplorer_Disabled(t *testing.T) {
+	_, err := (&server{}).RecentBlocks(context.Background(), &pbdashboard.RecentBlocksRequest{})
+	assert.Equal(t, errExplorerUnavailable, err)
+}
+
+func testBlock(num uint32, fork string, traces ...*pbcodec.TransactionTrace) *pbcodec.Block {
+	return &pbcodec.Block{
+		Id:                fmt.Sprintf("%08d%s", num, fork),
+		Number:            num,
+		Header:            &pbcodec.BlockHeader{Producer: "eosio", Timestamp: ptypes.TimestampNow()},
+		TransactionTraces: traces,
+	}
+}
+
+// executedTrx creates a transaction with an action for each `receiver:account:name`
+func executedTrx(id string, actions ...string) *pbcodec.TransactionTrace {
+	trace := &pbcodec.TransactionTrace{
+		Id:      id,
+		Receipt: &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED},
+	}
+
+	for _, action := range actions {
+		parts := strings.Split(action, ":")
+		trace.ActionTraces = append(trace.ActionTraces, &pbcodec.ActionTrace{
+			Receiver: parts[0],
+			Action: &pbcodec.Action{
+				Account:  parts[1],
+				Name:     parts[2],
+				JsonData: `{"account":"eosio.token"}`,
+			},
+		})
+	}
+
+	return trace
+}
+
+func failedTrx(id string) *pbcodec.TransactionTrace {
+	trace := executedTrx(id, "eosio.token:eosio.token:transfer")
+	trace.Receipt.Status = pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL
+	trace.Exception = &pbcodec.Exception{Code: 3050003, Name: "eosio_assert_message_exception", Message: "overdrawn balance"}
+
+	return trace
+}
+
+func blockNums(blocks []*pbdashboard.BlockSummary) (out []uint64) {
+	for _, block := range blocks {
+		out = append(out, block.Num)
+	}
+	return
+}
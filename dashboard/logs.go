@@ -0,0 +1,169 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	pbdashboard "github.com/dfuse-io/dfuse-eosio/dashboard/pb"
+	core "github.com/dfuse-io/dfuse-eosio/launcher"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var logLevelToZap = map[pbdashboard.LogLevel]zapcore.Level{
+	pbdashboard.LogLevel_DEBUG: zap.DebugLevel,
+	pbdashboard.LogLevel_INFO:  zap.InfoLevel,
+	pbdashboard.LogLevel_WARN:  zap.WarnLevel,
+	pbdashboard.LogLevel_ERROR: zap.ErrorLevel,
+}
+
+type logFilter struct {
+	appID    string
+	minLevel zapcore.Level
+	regex    *regexp.Regexp
+	since    time.Time
+}
+
+func newLogFilter(req *pbdashboard.AppLogsRequest) (*logFilter, error) {
+	minLevel, found := logLevelToZap[req.MinLevel]
+	if !found {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid min level %d", req.MinLevel)
+	}
+
+	filter := &logFilter{appID: req.FilterAppId, minLevel: minLevel}
+	if req.Regex != "" {
+		regex, err := regexp.Compile(req.Regex)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid regex %q: %s", req.Regex, err)
+		}
+		filter.regex = regex
+	}
+
+	if req.Since != nil {
+		since, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since timestamp: %s", err)
+		}
+		filter.since = since
+	}
+
+	return filter, nil
+}
+
+func (f *logFilter) matches(entry *core.LogEntry) bool {
+	if f.appID != "" && f.appID != entry.AppID {
+		return false
+	}
+
+	if entry.Level < f.minLevel || entry.Time.Before(f.since) {
+		return false
+	}
+
+	if f.regex != nil && !f.regex.MatchString(entry.Message) && !f.regex.MatchString(entry.Fields) {
+		return false
+	}
+
+	return true
+}
+
+func (s *server) AppLogs(req *pbdashboard.AppLogsRequest, stream pbdashboard.Dashboard_AppLogsServer) error {
+	zlog.Debug("app logs", zap.String("app_id", req.FilterAppId), zap.Stringer("min_level", req.MinLevel), zap.String("regex", req.Regex))
+	if s.modules.AppLogs == nil {
+		return status.Error(codes.Unavailable, "logs are not buffered, the log buffer size is 0")
+	}
+
+	filter, err := newLogFilter(req)
+	if err != nil {
+		return err
+	}
+
+	// Subscribing before reading the buffered entries so none are missed, the
+	// ones received while replaying are skipped since they are in the buffer
+	sub := s.modules.AppLogs.Subscribe(1000)
+	defer s.modules.AppLogs.Unsubscribe(sub)
+
+	var lastSeq uint64
+	for _, entry := range s.modules.AppLogs.Entries(req.FilterAppId) {
+		if !filter.matches(entry) {
+			continue
+		}
+
+		if err := stream.Send(toProtoLogEntry(entry)); err != nil {
+			zlog.Info("failed writing to socket, shutting down subscription", zap.Error(err))
+			return err
+		}
+		lastSeq = entry.Seq
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case entry := <-sub.Entries:
+			if entry.Seq <= lastSeq || !filter.matches(entry) {
+				continue
+			}
+
+			if err := stream.Send(toProtoLogEntry(entry)); err != nil {
+				zlog.Info("failed writing to socket, shutting down subscription", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) SetAppLogLevel(ctx context.Context, req *pbdashboard.SetAppLogLevelRequest) (*pbdashboard.SetAppLogLevelResponse, error) {
+	level, found := logLevelToZap[req.Level]
+	if !found {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid level %d", req.Level)
+	}
+
+	if s.modules.SetAppLogLevel == nil || !s.modules.SetAppLogLevel(req.AppId, level) {
+		return nil, status.Errorf(codes.NotFound, "no logger for app %q", req.AppId)
+	}
+
+	zlog.Info("changed app log level", zap.String("app_id", req.AppId), zap.Stringer("level", level))
+	return &pbdashboard.SetAppLogLevelResponse{}, nil
+}
+
+func toProtoLogEntry(entry *core.LogEntry) *pbdashboard.AppLogEntry {
+	out := &pbdashboard.AppLogEntry{
+		AppId:      entry.AppID,
+		Timestamp:  timeToProtoTimestamp(&entry.Time),
+		Logger:     entry.Logger,
+		Caller:     entry.Caller,
+		Message:    entry.Message,
+		FieldsJson: entry.Fields,
+	}
+
+	switch {
+	case entry.Level >= zap.ErrorLevel:
+		out.Level = pbdashboard.LogLevel_ERROR
+	case entry.Level == zap.WarnLevel:
+		out.Level = pbdashboard.LogLevel_WARN
+	case entry.Level == zap.InfoLevel:
+		out.Level = pbdashboard.LogLevel_INFO
+	default:
+		out.Level = pbdashboard.LogLevel_DEBUG
+	}
+
+	return out
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -84,6 +85,37 @@ func (MetricType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9b97678da3a35dfb, []int{1}
 }
 
+type LogLevel int32
+
+const (
+	LogLevel_DEBUG LogLevel = 0
+	LogLevel_INFO  LogLevel = 1
+	LogLevel_WARN  LogLevel = 2
+	LogLevel_ERROR LogLevel = 3
+)
+
+var LogLevel_name = map[int32]string{
+	0: "DEBUG",
+	1: "INFO",
+	2: "WARN",
+	3: "ERROR",
+}
+
+var LogLevel_value = map[string]int32{
+	"DEBUG": 0,
+	"INFO":  1,
+	"WARN":  2,
+	"ERROR": 3,
+}
+
+func (x LogLevel) String() string {
+	return proto.EnumName(LogLevel_name, int32(x))
+}
+
+func (LogLevel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{2}
+}
+
 type AppsListRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -805,317 +837,2037 @@ func (m *DmeshClient) GetHeadBlockId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("dashboard.AppStatus", AppStatus_name, AppStatus_value)
-	proto.RegisterEnum("dashboard.MetricType", MetricType_name, MetricType_value)
-	proto.RegisterType((*AppsListRequest)(nil), "dashboard.AppsListRequest")
-	proto.RegisterType((*AppsListResponse)(nil), "dashboard.AppsListResponse")
-	proto.RegisterType((*AppsInfoRequest)(nil), "dashboard.AppsInfoRequest")
-	proto.RegisterType((*AppsInfoResponse)(nil), "dashboard.AppsInfoResponse")
-	proto.RegisterType((*AppInfo)(nil), "dashboard.AppInfo")
-	proto.RegisterType((*AppsMetricsRequest)(nil), "dashboard.AppsMetricsRequest")
-	proto.RegisterType((*AppMetricsResponse)(nil), "dashboard.AppMetricsResponse")
-	proto.RegisterType((*Metric)(nil), "dashboard.Metric")
-	proto.RegisterType((*StartAppRequest)(nil), "dashboard.StartAppRequest")
-	proto.RegisterType((*StartAppResponse)(nil), "dashboard.StartAppResponse")
-	proto.RegisterType((*StopAppRequest)(nil), "dashboard.StopAppRequest")
-	proto.RegisterType((*StopAppResponse)(nil), "dashboard.StopAppResponse")
-	proto.RegisterType((*DmeshRequest)(nil), "dashboard.DmeshRequest")
-	proto.RegisterType((*DmeshResponse)(nil), "dashboard.DmeshResponse")
-	proto.RegisterType((*DmeshClient)(nil), "dashboard.DmeshClient")
+// AppLogsRequest streams the buffered log entries matching the filters then
+// follows the new ones until the stream is closed.
+type AppLogsRequest struct {
+	FilterAppId          string               `protobuf:"bytes,1,opt,name=filter_app_id,json=filterAppId,proto3" json:"filter_app_id,omitempty"`
+	MinLevel             LogLevel             `protobuf:"varint,2,opt,name=min_level,json=minLevel,proto3,enum=dashboard.LogLevel" json:"min_level,omitempty"`
+	Regex                string               `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	Since                *timestamp.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
-
-var fileDescriptor_9b97678da3a35dfb = []byte{
-	// 899 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5b, 0x8f, 0xda, 0x46,
-	0x14, 0x8e, 0x81, 0xe5, 0x72, 0xcc, 0xc5, 0x4c, 0xb3, 0xad, 0x43, 0x15, 0x15, 0x59, 0x55, 0x4a,
-	0x93, 0x8a, 0x5d, 0x51, 0x55, 0x8a, 0xf2, 0x50, 0x95, 0x05, 0xb6, 0x41, 0x5d, 0xd8, 0x68, 0x60,
-	0x55, 0xa9, 0x2f, 0x96, 0x59, 0xcf, 0x2e, 0xa3, 0x18, 0xec, 0xce, 0x0c, 0x48, 0x9b, 0x97, 0x3e,
-	0xf4, 0xd7, 0xf4, 0xa1, 0xff, 0xb1, 0x9a, 0x19, 0xdb, 0x18, 0x6f, 0xd4, 0x28, 0x6f, 0x3e, 0xdf,
-	0xf9, 0xce, 0x77, 0x2e, 0x3a, 0x73, 0x0c, 0x2d, 0xdf, 0xe3, 0xeb, 0x55, 0xe8, 0x31, 0xbf, 0x1f,
-	0xb1, 0x50, 0x84, 0xa8, 0x96, 0x02, 0x9d, 0x6f, 0xee, 0xc3, 0xf0, 0x3e, 0x20, 0x67, 0xca, 0xb1,
-	0xda, 0xdd, 0x9d, 0x09, 0xba, 0x21, 0x5c, 0x78, 0x9b, 0x48, 0x73, 0x9d, 0x36, 0xb4, 0x86, 0x51,
-	0xc4, 0xaf, 0x28, 0x17, 0x98, 0xfc, 0xb9, 0x23, 0x5c, 0x38, 0x6f, 0xc0, 0x3a, 0x40, 0x3c, 0x0a,
-	0xb7, 0x9c, 0xa0, 0x17, 0x50, 0x92, 0x98, 0x6d, 0x74, 0x8b, 0x3d, 0x73, 0x80, 0xfa, 0x87, 0x94,
-	0xc3, 0x28, 0x9a, 0x6e, 0xef, 0x42, 0xac, 0xfc, 0xce, 0x4f, 0x5a, 0x4e, 0x21, 0x5a, 0x0e, 0x39,
-	0xd0, 0xb8, 0xa3, 0x81, 0x20, 0xcc, 0xf5, 0xa2, 0xc8, 0xa5, 0xbe, 0x6d, 0x74, 0x8d, 0x5e, 0x0d,
-	0x9b, 0x1a, 0x94, 0xe1, 0x7e, 0x92, 0x52, 0x87, 0x7d, 0x66, 0xca, 0xbf, 0xa0, 0x12, 0x03, 0xa8,
-	0x09, 0x85, 0x54, 0xbf, 0x40, 0x7d, 0xf4, 0x14, 0x4e, 0x04, 0x15, 0x01, 0xb1, 0x0b, 0x0a, 0xd2,
-	0x06, 0xea, 0x82, 0xe9, 0x13, 0x7e, 0xcb, 0x68, 0x24, 0x68, 0xb8, 0xb5, 0x8b, 0xba, 0x9c, 0x0c,
-	0x84, 0x7e, 0x80, 0x32, 0x17, 0x9e, 0xd8, 0x71, 0xbb, 0xd4, 0x35, 0x7a, 0xcd, 0xc1, 0xd3, 0xe3,
-	0xe4, 0x0b, 0xe5, 0xc3, 0x31, 0xc7, 0x79, 0x0d, 0x48, 0x16, 0x32, 0x23, 0x82, 0xd1, 0x5b, 0xfe,
-	0x39, 0x6d, 0xdf, 0xab, 0xc8, 0x34, 0x30, 0x6e, 0x3c, 0xdf, 0xc5, 0x2b, 0xa8, 0x6c, 0x34, 0xc5,
-	0x2e, 0xa8, 0x59, 0xb4, 0x33, 0xe5, 0xe8, 0x60, 0x9c, 0x30, 0x0e, 0x2d, 0x17, 0x33, 0x2d, 0x3b,
-	0x7f, 0x1b, 0x50, 0xd6, 0x4c, 0xf4, 0x1a, 0x6a, 0xe9, 0x0e, 0xa8, 0x24, 0xe6, 0xa0, 0xd3, 0xd7,
-	0x5b, 0xd2, 0x4f, 0xb6, 0xa4, 0xbf, 0x4c, 0x18, 0xf8, 0x40, 0x96, 0xd2, 0x7b, 0x2f, 0xd8, 0xe9,
-	0x69, 0x16, 0xb0, 0x36, 0xd0, 0xf7, 0x50, 0x12, 0x0f, 0x91, 0xce, 0xd7, 0x1c, 0x9c, 0x3e, 0x2a,
-	0x6d, 0xf9, 0x10, 0x11, 0xac, 0x28, 0x4e, 0x0f, 0x5a, 0x0b, 0xe1, 0x31, 0x31, 0x8c, 0xa2, 0x64,
-	0x4a, 0xa7, 0x50, 0x3e, 0x1a, 0xcf, 0x89, 0xa7, 0x06, 0x83, 0xc0, 0x3a, 0x30, 0xf5, 0x58, 0x9c,
-	0xef, 0xa0, 0xb9, 0x10, 0x61, 0xf4, 0xe9, 0xe0, 0xb6, 0x4c, 0x13, 0x13, 0xe3, 0xd8, 0x26, 0xd4,
-	0xc7, 0x1b, 0xc2, 0xd7, 0xc9, 0x8a, 0x0f, 0xa1, 0x11, 0xdb, 0xf1, 0xcc, 0xcf, 0xa1, 0x72, 0x1b,
-	0x50, 0xb2, 0x15, 0xc9, 0xbe, 0x7d, 0x99, 0x69, 0x44, 0x51, 0x47, 0xca, 0x8d, 0x13, 0x9a, 0xf3,
-	0x6f, 0x09, 0xcc, 0x8c, 0x03, 0x21, 0x28, 0xad, 0x43, 0x2e, 0xe2, 0x52, 0xd4, 0xb7, 0x9c, 0x18,
-	0x23, 0x9e, 0xff, 0xa0, 0x26, 0x56, 0xc5, 0xda, 0x40, 0x7d, 0x28, 0xad, 0xc2, 0x50, 0xa8, 0x89,
-	0xfd, 0xff, 0xf0, 0x15, 0x0f, 0x9d, 0xc1, 0x17, 0x9c, 0xb0, 0x3d, 0xe1, 0x2e, 0x23, 0x3c, 0x0c,
-	0xf6, 0xe4, 0x32, 0x64, 0xef, 0xf5, 0x6a, 0x56, 0x31, 0xd2, 0x2e, 0x9c, 0xf1, 0xa0, 0x57, 0xd0,
-	0x4e, 0x03, 0xf6, 0x84, 0x71, 0xba, 0x0a, 0x88, 0x7d, 0xa2, 0xe8, 0x56, 0x42, 0x4f, 0x70, 0xf4,
-	0x02, 0x5a, 0x6b, 0x8f, 0xbb, 0x9b, 0x70, 0x4f, 0xb7, 0xf7, 0xee, 0x9a, 0x78, 0xbe, 0x5d, 0x56,
-	0xd4, 0xc6, 0xda, 0xe3, 0x33, 0x85, 0xbe, 0x25, 0x9e, 0x9f, 0xe3, 0x09, 0x8f, 0x06, 0x76, 0x25,
-	0xc7, 0x5b, 0x7a, 0x34, 0x40, 0xcf, 0x01, 0xf8, 0xda, 0x63, 0xbe, 0xcb, 0xe9, 0x07, 0x62, 0x57,
-	0xbb, 0x46, 0xaf, 0x84, 0x6b, 0x0a, 0x59, 0xd0, 0x0f, 0x44, 0xba, 0x05, 0x25, 0xcc, 0x0d, 0xc8,
-	0x9e, 0x04, 0x76, 0xad, 0x6b, 0xf4, 0x1a, 0x72, 0xc7, 0x08, 0xbb, 0x92, 0x00, 0xfa, 0x16, 0x9a,
-	0x52, 0xda, 0x5d, 0x05, 0xe1, 0xed, 0x7b, 0x77, 0xbb, 0xdb, 0xd8, 0xa0, 0x14, 0xea, 0x12, 0xbd,
-	0x90, 0xe0, 0x7c, 0xb7, 0x91, 0x6f, 0x2b, 0xc3, 0xa2, 0xbe, 0x6d, 0xea, 0xb7, 0x95, 0x92, 0xa6,
-	0xbe, 0xe4, 0x50, 0xc6, 0x32, 0x42, 0x75, 0x25, 0x64, 0x52, 0xc6, 0x52, 0x9d, 0x2e, 0xd4, 0x0f,
-	0x1c, 0xea, 0xdb, 0x0d, 0x25, 0x03, 0x09, 0x65, 0xea, 0xcb, 0x7a, 0xe4, 0x48, 0x32, 0x32, 0x4d,
-	0x5d, 0x8f, 0x44, 0xb3, 0xf5, 0x64, 0x58, 0xd4, 0xb7, 0x5b, 0xba, 0x9e, 0x94, 0x34, 0xf5, 0x5f,
-	0xce, 0xa0, 0x96, 0x9e, 0x0e, 0x54, 0x87, 0xea, 0xfc, 0x7a, 0x79, 0x79, 0x7d, 0x33, 0x1f, 0x5b,
-	0x4f, 0x90, 0x09, 0x95, 0x11, 0x9e, 0x0c, 0x97, 0x93, 0xb1, 0x65, 0x48, 0x03, 0xdf, 0xcc, 0xe7,
-	0xd3, 0xf9, 0xaf, 0x56, 0x41, 0x1a, 0xbf, 0x0f, 0xb1, 0x32, 0x8a, 0xd2, 0x58, 0x2c, 0xaf, 0xdf,
-	0xbd, 0x9b, 0x8c, 0xad, 0xd2, 0xcb, 0x9f, 0x01, 0x0e, 0xef, 0x0b, 0x3d, 0x83, 0xd3, 0xb7, 0x93,
-	0xe1, 0xd8, 0xbd, 0xb8, 0xba, 0x1e, 0xfd, 0xe6, 0x2e, 0xa7, 0xb3, 0x89, 0x3b, 0xc6, 0xd3, 0xcb,
-	0xa5, 0xf5, 0x04, 0x9d, 0x42, 0x3b, 0xe3, 0x9a, 0xdf, 0xcc, 0x2e, 0x26, 0xd8, 0x32, 0x06, 0xff,
-	0x14, 0xa1, 0x36, 0x4e, 0x36, 0x1c, 0x8d, 0xa0, 0x9a, 0x9c, 0x7c, 0xd4, 0x39, 0x3e, 0x76, 0xd9,
-	0x5f, 0x43, 0xe7, 0xeb, 0x8f, 0xfa, 0xe2, 0x37, 0x34, 0xd1, 0x22, 0xea, 0x12, 0xe7, 0x45, 0x32,
-	0x3f, 0x84, 0x47, 0x22, 0xd9, 0xab, 0x7f, 0x6e, 0xa0, 0x19, 0x98, 0x99, 0x73, 0x8a, 0x9e, 0xe7,
-	0xd8, 0xc7, 0x67, 0xb6, 0x93, 0x73, 0xe7, 0x6e, 0xe9, 0xb9, 0x81, 0xde, 0xc0, 0x89, 0x7a, 0xa6,
-	0xe8, 0xab, 0xfc, 0x8b, 0x4e, 0x24, 0xec, 0xc7, 0x8e, 0xb8, 0xa3, 0x11, 0x54, 0x93, 0x33, 0x74,
-	0xd4, 0x51, 0xee, 0x8a, 0x1d, 0x75, 0x94, 0xbf, 0x5b, 0xe8, 0x17, 0xa8, 0xc4, 0xe7, 0x08, 0x3d,
-	0x3b, 0xe2, 0x65, 0x6f, 0x59, 0xa7, 0xf3, 0x31, 0x97, 0x56, 0xb8, 0x30, 0xff, 0x38, 0xfc, 0xd1,
-	0x57, 0x65, 0x75, 0x27, 0x7e, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x94, 0x02, 0x01, 0x49, 0xf6,
-	0x07, 0x00, 0x00,
+func (m *AppLogsRequest) Reset()         { *m = AppLogsRequest{} }
+func (m *AppLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*AppLogsRequest) ProtoMessage()    {}
+func (*AppLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{15}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DashboardClient is the client API for Dashboard service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DashboardClient interface {
-	AppsList(ctx context.Context, in *AppsListRequest, opts ...grpc.CallOption) (*AppsListResponse, error)
-	AppsInfo(ctx context.Context, in *AppsInfoRequest, opts ...grpc.CallOption) (Dashboard_AppsInfoClient, error)
-	AppsMetrics(ctx context.Context, in *AppsMetricsRequest, opts ...grpc.CallOption) (Dashboard_AppsMetricsClient, error)
-	Dmesh(ctx context.Context, in *DmeshRequest, opts ...grpc.CallOption) (*DmeshResponse, error)
-	StartApp(ctx context.Context, in *StartAppRequest, opts ...grpc.CallOption) (*StartAppResponse, error)
-	StopApp(ctx context.Context, in *StopAppRequest, opts ...grpc.CallOption) (*StopAppResponse, error)
+func (m *AppLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppLogsRequest.Unmarshal(m, b)
 }
-
-type dashboardClient struct {
-	cc *grpc.ClientConn
+func (m *AppLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppLogsRequest.Marshal(b, m, deterministic)
 }
-
-func NewDashboardClient(cc *grpc.ClientConn) DashboardClient {
-	return &dashboardClient{cc}
+func (m *AppLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLogsRequest.Merge(m, src)
+}
+func (m *AppLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_AppLogsRequest.Size(m)
+}
+func (m *AppLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLogsRequest.DiscardUnknown(m)
 }
 
-func (c *dashboardClient) AppsList(ctx context.Context, in *AppsListRequest, opts ...grpc.CallOption) (*AppsListResponse, error) {
-	out := new(AppsListResponse)
-	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/AppsList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AppLogsRequest proto.InternalMessageInfo
+
+func (m *AppLogsRequest) GetFilterAppId() string {
+	if m != nil {
+		return m.FilterAppId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dashboardClient) AppsInfo(ctx context.Context, in *AppsInfoRequest, opts ...grpc.CallOption) (Dashboard_AppsInfoClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[0], "/dashboard.Dashboard/AppsInfo", opts...)
-	if err != nil {
-		return nil, err
+func (m *AppLogsRequest) GetMinLevel() LogLevel {
+	if m != nil {
+		return m.MinLevel
 	}
-	x := &dashboardAppsInfoClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return LogLevel_DEBUG
+}
+
+func (m *AppLogsRequest) GetRegex() string {
+	if m != nil {
+		return m.Regex
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *AppLogsRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
 	}
-	return x, nil
+	return nil
 }
 
-type Dashboard_AppsInfoClient interface {
-	Recv() (*AppsInfoResponse, error)
-	grpc.ClientStream
+type AppLogEntry struct {
+	AppId                string               `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level                LogLevel             `protobuf:"varint,3,opt,name=level,proto3,enum=dashboard.LogLevel" json:"level,omitempty"`
+	Logger               string               `protobuf:"bytes,4,opt,name=logger,proto3" json:"logger,omitempty"`
+	Caller               string               `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	Message              string               `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	FieldsJson           string               `protobuf:"bytes,7,opt,name=fields_json,json=fieldsJson,proto3" json:"fields_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-type dashboardAppsInfoClient struct {
-	grpc.ClientStream
+func (m *AppLogEntry) Reset()         { *m = AppLogEntry{} }
+func (m *AppLogEntry) String() string { return proto.CompactTextString(m) }
+func (*AppLogEntry) ProtoMessage()    {}
+func (*AppLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{16}
 }
 
-func (x *dashboardAppsInfoClient) Recv() (*AppsInfoResponse, error) {
-	m := new(AppsInfoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *AppLogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppLogEntry.Unmarshal(m, b)
+}
+func (m *AppLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppLogEntry.Marshal(b, m, deterministic)
+}
+func (m *AppLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppLogEntry.Merge(m, src)
+}
+func (m *AppLogEntry) XXX_Size() int {
+	return xxx_messageInfo_AppLogEntry.Size(m)
+}
+func (m *AppLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppLogEntry.DiscardUnknown(m)
 }
 
-func (c *dashboardClient) AppsMetrics(ctx context.Context, in *AppsMetricsRequest, opts ...grpc.CallOption) (Dashboard_AppsMetricsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[1], "/dashboard.Dashboard/AppsMetrics", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &dashboardAppsMetricsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_AppLogEntry proto.InternalMessageInfo
+
+func (m *AppLogEntry) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return x, nil
+	return ""
 }
 
-type Dashboard_AppsMetricsClient interface {
-	Recv() (*AppMetricsResponse, error)
-	grpc.ClientStream
+func (m *AppLogEntry) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
 }
 
-type dashboardAppsMetricsClient struct {
-	grpc.ClientStream
+func (m *AppLogEntry) GetLevel() LogLevel {
+	if m != nil {
+		return m.Level
+	}
+	return LogLevel_DEBUG
 }
 
-func (x *dashboardAppsMetricsClient) Recv() (*AppMetricsResponse, error) {
-	m := new(AppMetricsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *AppLogEntry) GetLogger() string {
+	if m != nil {
+		return m.Logger
 	}
-	return m, nil
+	return ""
 }
 
-func (c *dashboardClient) Dmesh(ctx context.Context, in *DmeshRequest, opts ...grpc.CallOption) (*DmeshResponse, error) {
-	out := new(DmeshResponse)
-	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/Dmesh", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AppLogEntry) GetCaller() string {
+	if m != nil {
+		return m.Caller
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dashboardClient) StartApp(ctx context.Context, in *StartAppRequest, opts ...grpc.CallOption) (*StartAppResponse, error) {
-	out := new(StartAppResponse)
-	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/StartApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AppLogEntry) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dashboardClient) StopApp(ctx context.Context, in *StopAppRequest, opts ...grpc.CallOption) (*StopAppResponse, error) {
-	out := new(StopAppResponse)
-	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/StopApp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *AppLogEntry) GetFieldsJson() string {
+	if m != nil {
+		return m.FieldsJson
 	}
-	return out, nil
+	return ""
 }
 
-// DashboardServer is the server API for Dashboard service.
-type DashboardServer interface {
-	AppsList(context.Context, *AppsListRequest) (*AppsListResponse, error)
-	AppsInfo(*AppsInfoRequest, Dashboard_AppsInfoServer) error
-	AppsMetrics(*AppsMetricsRequest, Dashboard_AppsMetricsServer) error
-	Dmesh(context.Context, *DmeshRequest) (*DmeshResponse, error)
-	StartApp(context.Context, *StartAppRequest) (*StartAppResponse, error)
-	StopApp(context.Context, *StopAppRequest) (*StopAppResponse, error)
+type SetAppLogLevelRequest struct {
+	AppId                string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Level                LogLevel `protobuf:"varint,2,opt,name=level,proto3,enum=dashboard.LogLevel" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedDashboardServer can be embedded to have forward compatible implementations.
-type UnimplementedDashboardServer struct {
+func (m *SetAppLogLevelRequest) Reset()         { *m = SetAppLogLevelRequest{} }
+func (m *SetAppLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAppLogLevelRequest) ProtoMessage()    {}
+func (*SetAppLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{17}
 }
 
-func (*UnimplementedDashboardServer) AppsList(ctx context.Context, req *AppsListRequest) (*AppsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AppsList not implemented")
+func (m *SetAppLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAppLogLevelRequest.Unmarshal(m, b)
 }
-func (*UnimplementedDashboardServer) AppsInfo(req *AppsInfoRequest, srv Dashboard_AppsInfoServer) error {
-	return status.Errorf(codes.Unimplemented, "method AppsInfo not implemented")
-}
-func (*UnimplementedDashboardServer) AppsMetrics(req *AppsMetricsRequest, srv Dashboard_AppsMetricsServer) error {
-	return status.Errorf(codes.Unimplemented, "method AppsMetrics not implemented")
+func (m *SetAppLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAppLogLevelRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedDashboardServer) Dmesh(ctx context.Context, req *DmeshRequest) (*DmeshResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Dmesh not implemented")
+func (m *SetAppLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAppLogLevelRequest.Merge(m, src)
 }
-func (*UnimplementedDashboardServer) StartApp(ctx context.Context, req *StartAppRequest) (*StartAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartApp not implemented")
+func (m *SetAppLogLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAppLogLevelRequest.Size(m)
 }
-func (*UnimplementedDashboardServer) StopApp(ctx context.Context, req *StopAppRequest) (*StopAppResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StopApp not implemented")
+func (m *SetAppLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAppLogLevelRequest.DiscardUnknown(m)
 }
 
-func RegisterDashboardServer(s *grpc.Server, srv DashboardServer) {
-	s.RegisterService(&_Dashboard_serviceDesc, srv)
-}
+var xxx_messageInfo_SetAppLogLevelRequest proto.InternalMessageInfo
 
-func _Dashboard_AppsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AppsListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(DashboardServer).AppsList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dashboard.Dashboard/AppsList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DashboardServer).AppsList(ctx, req.(*AppsListRequest))
+func (m *SetAppLogLevelRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Dashboard_AppsInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AppsInfoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *SetAppLogLevelRequest) GetLevel() LogLevel {
+	if m != nil {
+		return m.Level
 	}
-	return srv.(DashboardServer).AppsInfo(m, &dashboardAppsInfoServer{stream})
+	return LogLevel_DEBUG
 }
 
-type Dashboard_AppsInfoServer interface {
-	Send(*AppsInfoResponse) error
-	grpc.ServerStream
+type SetAppLogLevelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type dashboardAppsInfoServer struct {
-	grpc.ServerStream
+func (m *SetAppLogLevelResponse) Reset()         { *m = SetAppLogLevelResponse{} }
+func (m *SetAppLogLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAppLogLevelResponse) ProtoMessage()    {}
+func (*SetAppLogLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{18}
 }
 
-func (x *dashboardAppsInfoServer) Send(m *AppsInfoResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *SetAppLogLevelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAppLogLevelResponse.Unmarshal(m, b)
 }
-
-func _Dashboard_AppsMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AppsMetricsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(DashboardServer).AppsMetrics(m, &dashboardAppsMetricsServer{stream})
+func (m *SetAppLogLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAppLogLevelResponse.Marshal(b, m, deterministic)
 }
-
-type Dashboard_AppsMetricsServer interface {
-	Send(*AppMetricsResponse) error
-	grpc.ServerStream
+func (m *SetAppLogLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAppLogLevelResponse.Merge(m, src)
+}
+func (m *SetAppLogLevelResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAppLogLevelResponse.Size(m)
+}
+func (m *SetAppLogLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAppLogLevelResponse.DiscardUnknown(m)
 }
 
-type dashboardAppsMetricsServer struct {
-	grpc.ServerStream
+var xxx_messageInfo_SetAppLogLevelResponse proto.InternalMessageInfo
+
+type RecentBlocksRequest struct {
+	Limit                uint32   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (x *dashboardAppsMetricsServer) Send(m *AppMetricsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *RecentBlocksRequest) Reset()         { *m = RecentBlocksRequest{} }
+func (m *RecentBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*RecentBlocksRequest) ProtoMessage()    {}
+func (*RecentBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{19}
 }
 
-func _Dashboard_Dmesh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DmeshRequest)
-	if err := dec(in); err != nil {
+func (m *RecentBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentBlocksRequest.Unmarshal(m, b)
+}
+func (m *RecentBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *RecentBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentBlocksRequest.Merge(m, src)
+}
+func (m *RecentBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_RecentBlocksRequest.Size(m)
+}
+func (m *RecentBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentBlocksRequest proto.InternalMessageInfo
+
+func (m *RecentBlocksRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type RecentBlocksResponse struct {
+	Blocks               []*BlockSummary `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RecentBlocksResponse) Reset()         { *m = RecentBlocksResponse{} }
+func (m *RecentBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentBlocksResponse) ProtoMessage()    {}
+func (*RecentBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{20}
+}
+
+func (m *RecentBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentBlocksResponse.Unmarshal(m, b)
+}
+func (m *RecentBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *RecentBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentBlocksResponse.Merge(m, src)
+}
+func (m *RecentBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_RecentBlocksResponse.Size(m)
+}
+func (m *RecentBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentBlocksResponse proto.InternalMessageInfo
+
+func (m *RecentBlocksResponse) GetBlocks() []*BlockSummary {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type BlockSummary struct {
+	Num                    uint64               `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Id                     string               `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Producer               string               `protobuf:"bytes,3,opt,name=producer,proto3" json:"producer,omitempty"`
+	Timestamp              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TransactionCount       uint32               `protobuf:"varint,5,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	ActionCount            uint32               `protobuf:"varint,6,opt,name=action_count,json=actionCount,proto3" json:"action_count,omitempty"`
+	FailedTransactionCount uint32               `protobuf:"varint,7,opt,name=failed_transaction_count,json=failedTransactionCount,proto3" json:"failed_transaction_count,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
+}
+
+func (m *BlockSummary) Reset()         { *m = BlockSummary{} }
+func (m *BlockSummary) String() string { return proto.CompactTextString(m) }
+func (*BlockSummary) ProtoMessage()    {}
+func (*BlockSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{21}
+}
+
+func (m *BlockSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockSummary.Unmarshal(m, b)
+}
+func (m *BlockSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockSummary.Marshal(b, m, deterministic)
+}
+func (m *BlockSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockSummary.Merge(m, src)
+}
+func (m *BlockSummary) XXX_Size() int {
+	return xxx_messageInfo_BlockSummary.Size(m)
+}
+func (m *BlockSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockSummary proto.InternalMessageInfo
+
+func (m *BlockSummary) GetNum() uint64 {
+	if m != nil {
+		return m.Num
+	}
+	return 0
+}
+
+func (m *BlockSummary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BlockSummary) GetProducer() string {
+	if m != nil {
+		return m.Producer
+	}
+	return ""
+}
+
+func (m *BlockSummary) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *BlockSummary) GetTransactionCount() uint32 {
+	if m != nil {
+		return m.TransactionCount
+	}
+	return 0
+}
+
+func (m *BlockSummary) GetActionCount() uint32 {
+	if m != nil {
+		return m.ActionCount
+	}
+	return 0
+}
+
+func (m *BlockSummary) GetFailedTransactionCount() uint32 {
+	if m != nil {
+		return m.FailedTransactionCount
+	}
+	return 0
+}
+
+// TopContractsRequest ranks the contracts by the number of actions they
+// executed in the last blocks, notifications excluded.
+type TopContractsRequest struct {
+	LastBlocks           uint32   `protobuf:"varint,1,opt,name=last_blocks,json=lastBlocks,proto3" json:"last_blocks,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopContractsRequest) Reset()         { *m = TopContractsRequest{} }
+func (m *TopContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*TopContractsRequest) ProtoMessage()    {}
+func (*TopContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{22}
+}
+
+func (m *TopContractsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopContractsRequest.Unmarshal(m, b)
+}
+func (m *TopContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopContractsRequest.Marshal(b, m, deterministic)
+}
+func (m *TopContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopContractsRequest.Merge(m, src)
+}
+func (m *TopContractsRequest) XXX_Size() int {
+	return xxx_messageInfo_TopContractsRequest.Size(m)
+}
+func (m *TopContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopContractsRequest proto.InternalMessageInfo
+
+func (m *TopContractsRequest) GetLastBlocks() uint32 {
+	if m != nil {
+		return m.LastBlocks
+	}
+	return 0
+}
+
+func (m *TopContractsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TopContractsResponse struct {
+	LowBlockNum          uint64              `protobuf:"varint,1,opt,name=low_block_num,json=lowBlockNum,proto3" json:"low_block_num,omitempty"`
+	HighBlockNum         uint64              `protobuf:"varint,2,opt,name=high_block_num,json=highBlockNum,proto3" json:"high_block_num,omitempty"`
+	Contracts            []*ContractActivity `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *TopContractsResponse) Reset()         { *m = TopContractsResponse{} }
+func (m *TopContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*TopContractsResponse) ProtoMessage()    {}
+func (*TopContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{23}
+}
+
+func (m *TopContractsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopContractsResponse.Unmarshal(m, b)
+}
+func (m *TopContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopContractsResponse.Marshal(b, m, deterministic)
+}
+func (m *TopContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopContractsResponse.Merge(m, src)
+}
+func (m *TopContractsResponse) XXX_Size() int {
+	return xxx_messageInfo_TopContractsResponse.Size(m)
+}
+func (m *TopContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopContractsResponse proto.InternalMessageInfo
+
+func (m *TopContractsResponse) GetLowBlockNum() uint64 {
+	if m != nil {
+		return m.LowBlockNum
+	}
+	return 0
+}
+
+func (m *TopContractsResponse) GetHighBlockNum() uint64 {
+	if m != nil {
+		return m.HighBlockNum
+	}
+	return 0
+}
+
+func (m *TopContractsResponse) GetContracts() []*ContractActivity {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type ContractActivity struct {
+	Account              string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	ActionCount          uint64   `protobuf:"varint,2,opt,name=action_count,json=actionCount,proto3" json:"action_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractActivity) Reset()         { *m = ContractActivity{} }
+func (m *ContractActivity) String() string { return proto.CompactTextString(m) }
+func (*ContractActivity) ProtoMessage()    {}
+func (*ContractActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{24}
+}
+
+func (m *ContractActivity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractActivity.Unmarshal(m, b)
+}
+func (m *ContractActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractActivity.Marshal(b, m, deterministic)
+}
+func (m *ContractActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractActivity.Merge(m, src)
+}
+func (m *ContractActivity) XXX_Size() int {
+	return xxx_messageInfo_ContractActivity.Size(m)
+}
+func (m *ContractActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractActivity proto.InternalMessageInfo
+
+func (m *ContractActivity) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *ContractActivity) GetActionCount() uint64 {
+	if m != nil {
+		return m.ActionCount
+	}
+	return 0
+}
+
+type FailedTransactionsRequest struct {
+	LastBlocks           uint32   `protobuf:"varint,1,opt,name=last_blocks,json=lastBlocks,proto3" json:"last_blocks,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailedTransactionsRequest) Reset()         { *m = FailedTransactionsRequest{} }
+func (m *FailedTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*FailedTransactionsRequest) ProtoMessage()    {}
+func (*FailedTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{25}
+}
+
+func (m *FailedTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailedTransactionsRequest.Unmarshal(m, b)
+}
+func (m *FailedTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailedTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *FailedTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTransactionsRequest.Merge(m, src)
+}
+func (m *FailedTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_FailedTransactionsRequest.Size(m)
+}
+func (m *FailedTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTransactionsRequest proto.InternalMessageInfo
+
+func (m *FailedTransactionsRequest) GetLastBlocks() uint32 {
+	if m != nil {
+		return m.LastBlocks
+	}
+	return 0
+}
+
+func (m *FailedTransactionsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type FailedTransactionsResponse struct {
+	Transactions         []*FailedTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *FailedTransactionsResponse) Reset()         { *m = FailedTransactionsResponse{} }
+func (m *FailedTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*FailedTransactionsResponse) ProtoMessage()    {}
+func (*FailedTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{26}
+}
+
+func (m *FailedTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailedTransactionsResponse.Unmarshal(m, b)
+}
+func (m *FailedTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailedTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *FailedTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTransactionsResponse.Merge(m, src)
+}
+func (m *FailedTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_FailedTransactionsResponse.Size(m)
+}
+func (m *FailedTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTransactionsResponse proto.InternalMessageInfo
+
+func (m *FailedTransactionsResponse) GetTransactions() []*FailedTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type FailedTransaction struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BlockNum             uint64   `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockId              string   `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FailedTransaction) Reset()         { *m = FailedTransaction{} }
+func (m *FailedTransaction) String() string { return proto.CompactTextString(m) }
+func (*FailedTransaction) ProtoMessage()    {}
+func (*FailedTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{27}
+}
+
+func (m *FailedTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FailedTransaction.Unmarshal(m, b)
+}
+func (m *FailedTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FailedTransaction.Marshal(b, m, deterministic)
+}
+func (m *FailedTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedTransaction.Merge(m, src)
+}
+func (m *FailedTransaction) XXX_Size() int {
+	return xxx_messageInfo_FailedTransaction.Size(m)
+}
+func (m *FailedTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedTransaction proto.InternalMessageInfo
+
+func (m *FailedTransaction) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *FailedTransaction) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *FailedTransaction) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *FailedTransaction) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *FailedTransaction) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ABIChangesRequest struct {
+	LastBlocks           uint32   `protobuf:"varint,1,opt,name=last_blocks,json=lastBlocks,proto3" json:"last_blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ABIChangesRequest) Reset()         { *m = ABIChangesRequest{} }
+func (m *ABIChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*ABIChangesRequest) ProtoMessage()    {}
+func (*ABIChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{28}
+}
+
+func (m *ABIChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ABIChangesRequest.Unmarshal(m, b)
+}
+func (m *ABIChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ABIChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *ABIChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABIChangesRequest.Merge(m, src)
+}
+func (m *ABIChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_ABIChangesRequest.Size(m)
+}
+func (m *ABIChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABIChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABIChangesRequest proto.InternalMessageInfo
+
+func (m *ABIChangesRequest) GetLastBlocks() uint32 {
+	if m != nil {
+		return m.LastBlocks
+	}
+	return 0
+}
+
+type ABIChangesResponse struct {
+	Changes              []*ABIChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ABIChangesResponse) Reset()         { *m = ABIChangesResponse{} }
+func (m *ABIChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*ABIChangesResponse) ProtoMessage()    {}
+func (*ABIChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{29}
+}
+
+func (m *ABIChangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ABIChangesResponse.Unmarshal(m, b)
+}
+func (m *ABIChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ABIChangesResponse.Marshal(b, m, deterministic)
+}
+func (m *ABIChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABIChangesResponse.Merge(m, src)
+}
+func (m *ABIChangesResponse) XXX_Size() int {
+	return xxx_messageInfo_ABIChangesResponse.Size(m)
+}
+func (m *ABIChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABIChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABIChangesResponse proto.InternalMessageInfo
+
+func (m *ABIChangesResponse) GetChanges() []*ABIChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type ABIChange struct {
+	Account              string               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	BlockNum             uint64               `protobuf:"varint,2,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	BlockId              string               `protobuf:"bytes,3,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	TransactionId        string               `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ABIChange) Reset()         { *m = ABIChange{} }
+func (m *ABIChange) String() string { return proto.CompactTextString(m) }
+func (*ABIChange) ProtoMessage()    {}
+func (*ABIChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{30}
+}
+
+func (m *ABIChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ABIChange.Unmarshal(m, b)
+}
+func (m *ABIChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ABIChange.Marshal(b, m, deterministic)
+}
+func (m *ABIChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABIChange.Merge(m, src)
+}
+func (m *ABIChange) XXX_Size() int {
+	return xxx_messageInfo_ABIChange.Size(m)
+}
+func (m *ABIChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABIChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABIChange proto.InternalMessageInfo
+
+func (m *ABIChange) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *ABIChange) GetBlockNum() uint64 {
+	if m != nil {
+		return m.BlockNum
+	}
+	return 0
+}
+
+func (m *ABIChange) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *ABIChange) GetTransactionId() string {
+	if m != nil {
+		return m.TransactionId
+	}
+	return ""
+}
+
+func (m *ABIChange) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type SearchCoverageRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchCoverageRequest) Reset()         { *m = SearchCoverageRequest{} }
+func (m *SearchCoverageRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchCoverageRequest) ProtoMessage()    {}
+func (*SearchCoverageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{31}
+}
+
+func (m *SearchCoverageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCoverageRequest.Unmarshal(m, b)
+}
+func (m *SearchCoverageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCoverageRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchCoverageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCoverageRequest.Merge(m, src)
+}
+func (m *SearchCoverageRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchCoverageRequest.Size(m)
+}
+func (m *SearchCoverageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCoverageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCoverageRequest proto.InternalMessageInfo
+
+type SearchCoverageResponse struct {
+	Peers []*SearchPeerCoverage `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// Contiguous ranges from the lowest to the highest block served by the ready peers
+	Ranges               []*SearchCoverageRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	LowBlockNum          uint64                 `protobuf:"varint,3,opt,name=low_block_num,json=lowBlockNum,proto3" json:"low_block_num,omitempty"`
+	HighBlockNum         uint64                 `protobuf:"varint,4,opt,name=high_block_num,json=highBlockNum,proto3" json:"high_block_num,omitempty"`
+	Warnings             []string               `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SearchCoverageResponse) Reset()         { *m = SearchCoverageResponse{} }
+func (m *SearchCoverageResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchCoverageResponse) ProtoMessage()    {}
+func (*SearchCoverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{32}
+}
+
+func (m *SearchCoverageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCoverageResponse.Unmarshal(m, b)
+}
+func (m *SearchCoverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCoverageResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchCoverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCoverageResponse.Merge(m, src)
+}
+func (m *SearchCoverageResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchCoverageResponse.Size(m)
+}
+func (m *SearchCoverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCoverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCoverageResponse proto.InternalMessageInfo
+
+func (m *SearchCoverageResponse) GetPeers() []*SearchPeerCoverage {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *SearchCoverageResponse) GetRanges() []*SearchCoverageRange {
+	if m != nil {
+		return m.Ranges
+	}
+	return nil
+}
+
+func (m *SearchCoverageResponse) GetLowBlockNum() uint64 {
+	if m != nil {
+		return m.LowBlockNum
+	}
+	return 0
+}
+
+func (m *SearchCoverageResponse) GetHighBlockNum() uint64 {
+	if m != nil {
+		return m.HighBlockNum
+	}
+	return 0
+}
+
+func (m *SearchCoverageResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type SearchPeerCoverage struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	TierLevel            uint32   `protobuf:"varint,2,opt,name=tier_level,json=tierLevel,proto3" json:"tier_level,omitempty"`
+	Ready                bool     `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	Live                 bool     `protobuf:"varint,4,opt,name=live,proto3" json:"live,omitempty"`
+	LowBlockNum          uint64   `protobuf:"varint,5,opt,name=low_block_num,json=lowBlockNum,proto3" json:"low_block_num,omitempty"`
+	HighBlockNum         uint64   `protobuf:"varint,6,opt,name=high_block_num,json=highBlockNum,proto3" json:"high_block_num,omitempty"`
+	HeadBlockNum         uint64   `protobuf:"varint,7,opt,name=head_block_num,json=headBlockNum,proto3" json:"head_block_num,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchPeerCoverage) Reset()         { *m = SearchPeerCoverage{} }
+func (m *SearchPeerCoverage) String() string { return proto.CompactTextString(m) }
+func (*SearchPeerCoverage) ProtoMessage()    {}
+func (*SearchPeerCoverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{33}
+}
+
+func (m *SearchPeerCoverage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPeerCoverage.Unmarshal(m, b)
+}
+func (m *SearchPeerCoverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPeerCoverage.Marshal(b, m, deterministic)
+}
+func (m *SearchPeerCoverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPeerCoverage.Merge(m, src)
+}
+func (m *SearchPeerCoverage) XXX_Size() int {
+	return xxx_messageInfo_SearchPeerCoverage.Size(m)
+}
+func (m *SearchPeerCoverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPeerCoverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPeerCoverage proto.InternalMessageInfo
+
+func (m *SearchPeerCoverage) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *SearchPeerCoverage) GetTierLevel() uint32 {
+	if m != nil {
+		return m.TierLevel
+	}
+	return 0
+}
+
+func (m *SearchPeerCoverage) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *SearchPeerCoverage) GetLive() bool {
+	if m != nil {
+		return m.Live
+	}
+	return false
+}
+
+func (m *SearchPeerCoverage) GetLowBlockNum() uint64 {
+	if m != nil {
+		return m.LowBlockNum
+	}
+	return 0
+}
+
+func (m *SearchPeerCoverage) GetHighBlockNum() uint64 {
+	if m != nil {
+		return m.HighBlockNum
+	}
+	return 0
+}
+
+func (m *SearchPeerCoverage) GetHeadBlockNum() uint64 {
+	if m != nil {
+		return m.HeadBlockNum
+	}
+	return 0
+}
+
+type SearchCoverageRange struct {
+	LowBlockNum          uint64   `protobuf:"varint,1,opt,name=low_block_num,json=lowBlockNum,proto3" json:"low_block_num,omitempty"`
+	HighBlockNum         uint64   `protobuf:"varint,2,opt,name=high_block_num,json=highBlockNum,proto3" json:"high_block_num,omitempty"`
+	Hosts                []string `protobuf:"bytes,3,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Gap                  bool     `protobuf:"varint,4,opt,name=gap,proto3" json:"gap,omitempty"`
+	Overlap              bool     `protobuf:"varint,5,opt,name=overlap,proto3" json:"overlap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchCoverageRange) Reset()         { *m = SearchCoverageRange{} }
+func (m *SearchCoverageRange) String() string { return proto.CompactTextString(m) }
+func (*SearchCoverageRange) ProtoMessage()    {}
+func (*SearchCoverageRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{34}
+}
+
+func (m *SearchCoverageRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchCoverageRange.Unmarshal(m, b)
+}
+func (m *SearchCoverageRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchCoverageRange.Marshal(b, m, deterministic)
+}
+func (m *SearchCoverageRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchCoverageRange.Merge(m, src)
+}
+func (m *SearchCoverageRange) XXX_Size() int {
+	return xxx_messageInfo_SearchCoverageRange.Size(m)
+}
+func (m *SearchCoverageRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchCoverageRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchCoverageRange proto.InternalMessageInfo
+
+func (m *SearchCoverageRange) GetLowBlockNum() uint64 {
+	if m != nil {
+		return m.LowBlockNum
+	}
+	return 0
+}
+
+func (m *SearchCoverageRange) GetHighBlockNum() uint64 {
+	if m != nil {
+		return m.HighBlockNum
+	}
+	return 0
+}
+
+func (m *SearchCoverageRange) GetHosts() []string {
+	if m != nil {
+		return m.Hosts
+	}
+	return nil
+}
+
+func (m *SearchCoverageRange) GetGap() bool {
+	if m != nil {
+		return m.Gap
+	}
+	return false
+}
+
+func (m *SearchCoverageRange) GetOverlap() bool {
+	if m != nil {
+		return m.Overlap
+	}
+	return false
+}
+
+type RecentTracesRequest struct {
+	Limit                uint32   `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	RootNameFilter       string   `protobuf:"bytes,2,opt,name=root_name_filter,json=rootNameFilter,proto3" json:"root_name_filter,omitempty"`
+	ErrorsOnly           bool     `protobuf:"varint,3,opt,name=errors_only,json=errorsOnly,proto3" json:"errors_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecentTracesRequest) Reset()         { *m = RecentTracesRequest{} }
+func (m *RecentTracesRequest) String() string { return proto.CompactTextString(m) }
+func (*RecentTracesRequest) ProtoMessage()    {}
+func (*RecentTracesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{35}
+}
+
+func (m *RecentTracesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentTracesRequest.Unmarshal(m, b)
+}
+func (m *RecentTracesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentTracesRequest.Marshal(b, m, deterministic)
+}
+func (m *RecentTracesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentTracesRequest.Merge(m, src)
+}
+func (m *RecentTracesRequest) XXX_Size() int {
+	return xxx_messageInfo_RecentTracesRequest.Size(m)
+}
+func (m *RecentTracesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentTracesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentTracesRequest proto.InternalMessageInfo
+
+func (m *RecentTracesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RecentTracesRequest) GetRootNameFilter() string {
+	if m != nil {
+		return m.RootNameFilter
+	}
+	return ""
+}
+
+func (m *RecentTracesRequest) GetErrorsOnly() bool {
+	if m != nil {
+		return m.ErrorsOnly
+	}
+	return false
+}
+
+type RecentTracesResponse struct {
+	Traces               []*TraceSummary `protobuf:"bytes,1,rep,name=traces,proto3" json:"traces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RecentTracesResponse) Reset()         { *m = RecentTracesResponse{} }
+func (m *RecentTracesResponse) String() string { return proto.CompactTextString(m) }
+func (*RecentTracesResponse) ProtoMessage()    {}
+func (*RecentTracesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{36}
+}
+
+func (m *RecentTracesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecentTracesResponse.Unmarshal(m, b)
+}
+func (m *RecentTracesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecentTracesResponse.Marshal(b, m, deterministic)
+}
+func (m *RecentTracesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecentTracesResponse.Merge(m, src)
+}
+func (m *RecentTracesResponse) XXX_Size() int {
+	return xxx_messageInfo_RecentTracesResponse.Size(m)
+}
+func (m *RecentTracesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecentTracesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecentTracesResponse proto.InternalMessageInfo
+
+func (m *RecentTracesResponse) GetTraces() []*TraceSummary {
+	if m != nil {
+		return m.Traces
+	}
+	return nil
+}
+
+type TraceSummary struct {
+	TraceId              string               `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	RootSpanName         string               `protobuf:"bytes,2,opt,name=root_span_name,json=rootSpanName,proto3" json:"root_span_name,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration             *duration.Duration   `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	SpanCount            uint32               `protobuf:"varint,5,opt,name=span_count,json=spanCount,proto3" json:"span_count,omitempty"`
+	HasError             bool                 `protobuf:"varint,6,opt,name=has_error,json=hasError,proto3" json:"has_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TraceSummary) Reset()         { *m = TraceSummary{} }
+func (m *TraceSummary) String() string { return proto.CompactTextString(m) }
+func (*TraceSummary) ProtoMessage()    {}
+func (*TraceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{37}
+}
+
+func (m *TraceSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceSummary.Unmarshal(m, b)
+}
+func (m *TraceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceSummary.Marshal(b, m, deterministic)
+}
+func (m *TraceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceSummary.Merge(m, src)
+}
+func (m *TraceSummary) XXX_Size() int {
+	return xxx_messageInfo_TraceSummary.Size(m)
+}
+func (m *TraceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceSummary proto.InternalMessageInfo
+
+func (m *TraceSummary) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *TraceSummary) GetRootSpanName() string {
+	if m != nil {
+		return m.RootSpanName
+	}
+	return ""
+}
+
+func (m *TraceSummary) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *TraceSummary) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *TraceSummary) GetSpanCount() uint32 {
+	if m != nil {
+		return m.SpanCount
+	}
+	return 0
+}
+
+func (m *TraceSummary) GetHasError() bool {
+	if m != nil {
+		return m.HasError
+	}
+	return false
+}
+
+type GetTraceRequest struct {
+	TraceId              string   `protobuf:"bytes,1,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTraceRequest) Reset()         { *m = GetTraceRequest{} }
+func (m *GetTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTraceRequest) ProtoMessage()    {}
+func (*GetTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{38}
+}
+
+func (m *GetTraceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTraceRequest.Unmarshal(m, b)
+}
+func (m *GetTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTraceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTraceRequest.Merge(m, src)
+}
+func (m *GetTraceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTraceRequest.Size(m)
+}
+func (m *GetTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTraceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTraceRequest proto.InternalMessageInfo
+
+func (m *GetTraceRequest) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+type GetTraceResponse struct {
+	Summary              *TraceSummary `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	Spans                []*TraceSpan  `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTraceResponse) Reset()         { *m = GetTraceResponse{} }
+func (m *GetTraceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTraceResponse) ProtoMessage()    {}
+func (*GetTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{39}
+}
+
+func (m *GetTraceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTraceResponse.Unmarshal(m, b)
+}
+func (m *GetTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTraceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTraceResponse.Merge(m, src)
+}
+func (m *GetTraceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTraceResponse.Size(m)
+}
+func (m *GetTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTraceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTraceResponse proto.InternalMessageInfo
+
+func (m *GetTraceResponse) GetSummary() *TraceSummary {
+	if m != nil {
+		return m.Summary
+	}
+	return nil
+}
+
+func (m *GetTraceResponse) GetSpans() []*TraceSpan {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
+type TraceSpan struct {
+	SpanId               string               `protobuf:"bytes,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	ParentSpanId         string               `protobuf:"bytes,2,opt,name=parent_span_id,json=parentSpanId,proto3" json:"parent_span_id,omitempty"`
+	Name                 string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration             *duration.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	StatusCode           int32                `protobuf:"varint,6,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	StatusMessage        string               `protobuf:"bytes,7,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	AttributesJson       string               `protobuf:"bytes,8,opt,name=attributes_json,json=attributesJson,proto3" json:"attributes_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TraceSpan) Reset()         { *m = TraceSpan{} }
+func (m *TraceSpan) String() string { return proto.CompactTextString(m) }
+func (*TraceSpan) ProtoMessage()    {}
+func (*TraceSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b97678da3a35dfb, []int{40}
+}
+
+func (m *TraceSpan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceSpan.Unmarshal(m, b)
+}
+func (m *TraceSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceSpan.Marshal(b, m, deterministic)
+}
+func (m *TraceSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceSpan.Merge(m, src)
+}
+func (m *TraceSpan) XXX_Size() int {
+	return xxx_messageInfo_TraceSpan.Size(m)
+}
+func (m *TraceSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceSpan proto.InternalMessageInfo
+
+func (m *TraceSpan) GetSpanId() string {
+	if m != nil {
+		return m.SpanId
+	}
+	return ""
+}
+
+func (m *TraceSpan) GetParentSpanId() string {
+	if m != nil {
+		return m.ParentSpanId
+	}
+	return ""
+}
+
+func (m *TraceSpan) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TraceSpan) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *TraceSpan) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *TraceSpan) GetStatusCode() int32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *TraceSpan) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *TraceSpan) GetAttributesJson() string {
+	if m != nil {
+		return m.AttributesJson
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("dashboard.AppStatus", AppStatus_name, AppStatus_value)
+	proto.RegisterEnum("dashboard.MetricType", MetricType_name, MetricType_value)
+	proto.RegisterEnum("dashboard.LogLevel", LogLevel_name, LogLevel_value)
+	proto.RegisterType((*AppsListRequest)(nil), "dashboard.AppsListRequest")
+	proto.RegisterType((*AppsListResponse)(nil), "dashboard.AppsListResponse")
+	proto.RegisterType((*AppsInfoRequest)(nil), "dashboard.AppsInfoRequest")
+	proto.RegisterType((*AppsInfoResponse)(nil), "dashboard.AppsInfoResponse")
+	proto.RegisterType((*AppInfo)(nil), "dashboard.AppInfo")
+	proto.RegisterType((*AppsMetricsRequest)(nil), "dashboard.AppsMetricsRequest")
+	proto.RegisterType((*AppMetricsResponse)(nil), "dashboard.AppMetricsResponse")
+	proto.RegisterType((*Metric)(nil), "dashboard.Metric")
+	proto.RegisterType((*StartAppRequest)(nil), "dashboard.StartAppRequest")
+	proto.RegisterType((*StartAppResponse)(nil), "dashboard.StartAppResponse")
+	proto.RegisterType((*StopAppRequest)(nil), "dashboard.StopAppRequest")
+	proto.RegisterType((*StopAppResponse)(nil), "dashboard.StopAppResponse")
+	proto.RegisterType((*DmeshRequest)(nil), "dashboard.DmeshRequest")
+	proto.RegisterType((*DmeshResponse)(nil), "dashboard.DmeshResponse")
+	proto.RegisterType((*DmeshClient)(nil), "dashboard.DmeshClient")
+	proto.RegisterType((*AppLogsRequest)(nil), "dashboard.AppLogsRequest")
+	proto.RegisterType((*AppLogEntry)(nil), "dashboard.AppLogEntry")
+	proto.RegisterType((*SetAppLogLevelRequest)(nil), "dashboard.SetAppLogLevelRequest")
+	proto.RegisterType((*SetAppLogLevelResponse)(nil), "dashboard.SetAppLogLevelResponse")
+	proto.RegisterType((*RecentBlocksRequest)(nil), "dashboard.RecentBlocksRequest")
+	proto.RegisterType((*RecentBlocksResponse)(nil), "dashboard.RecentBlocksResponse")
+	proto.RegisterType((*BlockSummary)(nil), "dashboard.BlockSummary")
+	proto.RegisterType((*TopContractsRequest)(nil), "dashboard.TopContractsRequest")
+	proto.RegisterType((*TopContractsResponse)(nil), "dashboard.TopContractsResponse")
+	proto.RegisterType((*ContractActivity)(nil), "dashboard.ContractActivity")
+	proto.RegisterType((*FailedTransactionsRequest)(nil), "dashboard.FailedTransactionsRequest")
+	proto.RegisterType((*FailedTransactionsResponse)(nil), "dashboard.FailedTransactionsResponse")
+	proto.RegisterType((*FailedTransaction)(nil), "dashboard.FailedTransaction")
+	proto.RegisterType((*ABIChangesRequest)(nil), "dashboard.ABIChangesRequest")
+	proto.RegisterType((*ABIChangesResponse)(nil), "dashboard.ABIChangesResponse")
+	proto.RegisterType((*ABIChange)(nil), "dashboard.ABIChange")
+	proto.RegisterType((*SearchCoverageRequest)(nil), "dashboard.SearchCoverageRequest")
+	proto.RegisterType((*SearchCoverageResponse)(nil), "dashboard.SearchCoverageResponse")
+	proto.RegisterType((*SearchPeerCoverage)(nil), "dashboard.SearchPeerCoverage")
+	proto.RegisterType((*SearchCoverageRange)(nil), "dashboard.SearchCoverageRange")
+	proto.RegisterType((*RecentTracesRequest)(nil), "dashboard.RecentTracesRequest")
+	proto.RegisterType((*RecentTracesResponse)(nil), "dashboard.RecentTracesResponse")
+	proto.RegisterType((*TraceSummary)(nil), "dashboard.TraceSummary")
+	proto.RegisterType((*GetTraceRequest)(nil), "dashboard.GetTraceRequest")
+	proto.RegisterType((*GetTraceResponse)(nil), "dashboard.GetTraceResponse")
+	proto.RegisterType((*TraceSpan)(nil), "dashboard.TraceSpan")
+}
+
+func init() { proto.RegisterFile("dashboard.proto", fileDescriptor_9b97678da3a35dfb) }
+
+var fileDescriptor_9b97678da3a35dfb = []byte{
+	// 2134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x59, 0x4b, 0x73, 0xe3, 0xc6,
+	0x11, 0x0e, 0xdf, 0x64, 0xf3, 0x21, 0x6a, 0xf4, 0x58, 0x2e, 0xd7, 0xeb, 0x5d, 0xa3, 0xfc, 0x58,
+	0xaf, 0x5d, 0x5a, 0x47, 0xb6, 0x53, 0x1b, 0x1f, 0x5c, 0x91, 0x28, 0x6a, 0x2d, 0x47, 0x22, 0xb7,
+	0x20, 0xaa, 0x5c, 0xf6, 0xc1, 0x28, 0x90, 0xc4, 0x92, 0x88, 0x41, 0x82, 0x06, 0x40, 0x39, 0xca,
+	0x25, 0x87, 0x9c, 0x72, 0xcb, 0x3d, 0xa7, 0xfc, 0x80, 0xe4, 0x27, 0xa4, 0x2a, 0x3f, 0x25, 0xe7,
+	0x5c, 0x73, 0x75, 0x55, 0x7a, 0x5e, 0xc0, 0xe0, 0x21, 0xad, 0xe4, 0xf8, 0xc6, 0xe9, 0xfe, 0xa6,
+	0xd1, 0xf3, 0x75, 0x4f, 0x4f, 0xcf, 0x10, 0x36, 0xa6, 0xa6, 0x3f, 0x1f, 0xbb, 0xa6, 0x37, 0xdd,
+	0x5b, 0x79, 0x6e, 0xe0, 0x92, 0x5a, 0x28, 0xe8, 0xbe, 0x39, 0x73, 0xdd, 0x99, 0x63, 0x3d, 0x63,
+	0x8a, 0xf1, 0xfa, 0xd5, 0xb3, 0xe9, 0xda, 0x33, 0x03, 0xdb, 0x5d, 0x72, 0x68, 0xf7, 0x51, 0x52,
+	0x1f, 0xd8, 0x0b, 0xcb, 0x0f, 0xcc, 0xc5, 0x8a, 0x03, 0xb4, 0x4d, 0xd8, 0x38, 0x58, 0xad, 0xfc,
+	0x53, 0xdb, 0x0f, 0x74, 0xeb, 0xfb, 0x35, 0xea, 0xb4, 0xcf, 0xa0, 0x1d, 0x89, 0xfc, 0x95, 0xbb,
+	0xf4, 0x2d, 0xf2, 0x2e, 0x14, 0xa9, 0xac, 0x93, 0x7b, 0x5c, 0x78, 0x52, 0xdf, 0x27, 0x7b, 0x91,
+	0x4b, 0x28, 0x3e, 0x59, 0xbe, 0x72, 0x75, 0xa6, 0xd7, 0x3e, 0xe5, 0xe6, 0x98, 0x84, 0x9b, 0x23,
+	0x1a, 0x34, 0x5f, 0xd9, 0x4e, 0x60, 0x79, 0x86, 0xb9, 0x5a, 0x19, 0xf6, 0x14, 0x6d, 0xe4, 0x9e,
+	0xd4, 0xf4, 0x3a, 0x17, 0xd2, 0xe9, 0x53, 0xf9, 0x49, 0x3e, 0xed, 0x8e, 0x9f, 0xfc, 0x23, 0x54,
+	0x84, 0x80, 0xb4, 0x20, 0x1f, 0xda, 0xc7, 0x5f, 0x64, 0x1b, 0x4a, 0x81, 0x1d, 0x38, 0x56, 0x27,
+	0xcf, 0x44, 0x7c, 0x40, 0x1e, 0x43, 0x7d, 0x6a, 0xf9, 0x13, 0xcf, 0x5e, 0x51, 0xa2, 0x3a, 0x05,
+	0xee, 0x8e, 0x22, 0x22, 0x1f, 0x42, 0x19, 0x39, 0x0a, 0xd6, 0x7e, 0xa7, 0x88, 0xca, 0xd6, 0xfe,
+	0x76, 0xfc, 0xe3, 0xe7, 0x4c, 0xa7, 0x0b, 0x8c, 0xf6, 0x1c, 0x08, 0x75, 0xe4, 0xcc, 0x0a, 0x3c,
+	0x7b, 0xe2, 0xdf, 0x65, 0xd9, 0x33, 0x36, 0x33, 0x9c, 0x28, 0x16, 0x9e, 0x5c, 0xc5, 0x07, 0x50,
+	0x59, 0x70, 0x08, 0xae, 0x83, 0x72, 0xb1, 0xa9, 0xb8, 0xc3, 0x27, 0xeb, 0x12, 0x11, 0x2d, 0xb9,
+	0xa0, 0x2c, 0x59, 0xfb, 0x53, 0x0e, 0xca, 0x1c, 0x49, 0x9e, 0x43, 0x2d, 0xcc, 0x01, 0xf6, 0x91,
+	0xfa, 0x7e, 0x77, 0x8f, 0x67, 0xc9, 0x9e, 0xcc, 0x92, 0xbd, 0x91, 0x44, 0xe8, 0x11, 0x98, 0x9a,
+	0xbe, 0x34, 0x9d, 0x35, 0x67, 0x33, 0xaf, 0xf3, 0x01, 0x79, 0x1f, 0x8a, 0xc1, 0xd5, 0x8a, 0x7f,
+	0xaf, 0xb5, 0xbf, 0x93, 0x72, 0x6d, 0x84, 0x4a, 0x9d, 0x41, 0xb4, 0x27, 0xb0, 0x81, 0xd4, 0x79,
+	0x01, 0xae, 0x59, 0xb2, 0xb4, 0x03, 0xe5, 0x18, 0x3d, 0x25, 0x93, 0x11, 0x43, 0xa0, 0x1d, 0x21,
+	0x39, 0x2d, 0xda, 0x7b, 0xd0, 0x3a, 0x0f, 0xdc, 0xd5, 0xeb, 0x27, 0x6f, 0xd2, 0xcf, 0x08, 0xa0,
+	0x98, 0xdb, 0x82, 0xc6, 0x11, 0x2e, 0x63, 0x2e, 0x53, 0xfc, 0x00, 0x9a, 0x62, 0x2c, 0x38, 0xff,
+	0x08, 0x2a, 0x13, 0xc7, 0xb6, 0x96, 0x81, 0xcc, 0xb7, 0x5d, 0x65, 0x21, 0x0c, 0xda, 0x63, 0x6a,
+	0x5d, 0xc2, 0xb4, 0xbf, 0x17, 0xa1, 0xae, 0x28, 0x08, 0x81, 0xe2, 0xdc, 0xf5, 0x03, 0xe1, 0x0a,
+	0xfb, 0x4d, 0x19, 0xf3, 0x2c, 0x73, 0x7a, 0xc5, 0x18, 0xab, 0xea, 0x7c, 0x40, 0xf6, 0xa0, 0x38,
+	0x76, 0xdd, 0x80, 0x31, 0x76, 0x33, 0xf9, 0x0c, 0x47, 0x9e, 0xc1, 0x96, 0x6f, 0x79, 0x97, 0x96,
+	0x6f, 0x78, 0x96, 0xef, 0x3a, 0x97, 0xd6, 0xb1, 0xeb, 0x7d, 0xc7, 0x53, 0xb3, 0xaa, 0x13, 0xae,
+	0xd2, 0x15, 0x0d, 0x26, 0xcc, 0x66, 0x38, 0xe1, 0xd2, 0xf2, 0x7c, 0x7b, 0x8c, 0xf9, 0x50, 0x62,
+	0xf0, 0xb6, 0x84, 0x4b, 0x39, 0x6e, 0xb3, 0x8d, 0xb9, 0xe9, 0x1b, 0x0b, 0xf7, 0xd2, 0x5e, 0xce,
+	0x8c, 0x39, 0x7a, 0xd8, 0x29, 0x33, 0x68, 0x13, 0xc5, 0x67, 0x4c, 0xfa, 0x05, 0x0a, 0x13, 0xb8,
+	0xc0, 0xb4, 0x9d, 0x4e, 0x25, 0x81, 0x1b, 0xa1, 0x90, 0x3c, 0x04, 0xf0, 0xe7, 0xc8, 0x9a, 0xe1,
+	0xdb, 0x7f, 0xb0, 0x3a, 0x55, 0x84, 0x14, 0xf5, 0x1a, 0x93, 0x9c, 0xa3, 0x80, 0xaa, 0x03, 0x1b,
+	0x37, 0x85, 0x83, 0x1e, 0x38, 0x9d, 0x1a, 0xaa, 0x9b, 0x34, 0xc7, 0x2c, 0xef, 0x94, 0x0a, 0xc8,
+	0xdb, 0xd0, 0xa2, 0xa6, 0x8d, 0xb1, 0xe3, 0x4e, 0xbe, 0x33, 0x96, 0xeb, 0x45, 0x07, 0x98, 0x85,
+	0x06, 0x95, 0x1e, 0x52, 0xe1, 0x60, 0xbd, 0xa0, 0x7b, 0x4b, 0x41, 0x61, 0xfc, 0xeb, 0x7c, 0x6f,
+	0x85, 0xa0, 0x93, 0x29, 0xc5, 0xd8, 0x9e, 0xa7, 0x18, 0x6a, 0x30, 0x43, 0x75, 0x14, 0x86, 0x76,
+	0x1e, 0x43, 0x23, 0xc2, 0xa0, 0x99, 0x26, 0x33, 0x03, 0x12, 0x82, 0x56, 0xd0, 0x1f, 0x4a, 0x89,
+	0x62, 0xa6, 0xc5, 0xfd, 0xa1, 0x52, 0xd5, 0x1f, 0x05, 0x85, 0x86, 0x36, 0xb8, 0x3f, 0x21, 0x08,
+	0xb3, 0xf2, 0x1f, 0x39, 0x68, 0x61, 0x4a, 0x9e, 0xba, 0xb3, 0xbb, 0x94, 0x08, 0x4c, 0xcc, 0xda,
+	0xc2, 0x5e, 0x0a, 0xba, 0xf2, 0x6c, 0x8f, 0x6d, 0x29, 0xa9, 0x89, 0xe6, 0x18, 0x71, 0x7a, 0x15,
+	0x51, 0x9c, 0x42, 0x96, 0x74, 0x33, 0xeb, 0xf7, 0xb2, 0x02, 0xb0, 0x01, 0xda, 0x29, 0xf9, 0xf6,
+	0x72, 0x62, 0xb1, 0xb4, 0xb9, 0x39, 0xeb, 0x38, 0x50, 0xfb, 0x6f, 0x0e, 0xea, 0xdc, 0xe1, 0xfe,
+	0x32, 0xf0, 0xae, 0xae, 0xd9, 0x6d, 0xf1, 0x7a, 0x92, 0xbf, 0x4b, 0x3d, 0x79, 0x1f, 0x4a, 0x7c,
+	0x59, 0x85, 0xeb, 0x97, 0xc5, 0x11, 0x64, 0x17, 0xca, 0x8e, 0x3b, 0x9b, 0x59, 0x1e, 0x73, 0xbf,
+	0xa6, 0x8b, 0x11, 0x95, 0x4f, 0x4c, 0xc7, 0x41, 0x79, 0x89, 0xcb, 0xf9, 0x88, 0x74, 0x68, 0xc9,
+	0xf4, 0x7d, 0x73, 0x66, 0xb1, 0x64, 0xae, 0xe9, 0x72, 0x48, 0x1e, 0x01, 0xd2, 0x6b, 0x39, 0x53,
+	0xdf, 0xf8, 0x9d, 0x8f, 0xc5, 0xbf, 0xc2, 0x23, 0xce, 0x45, 0x5f, 0xa2, 0x44, 0xfb, 0x1a, 0x76,
+	0xce, 0xad, 0x80, 0x2f, 0x9c, 0xfb, 0x70, 0x63, 0xb5, 0x89, 0x56, 0x91, 0x7f, 0xdd, 0x2a, 0xb4,
+	0x0e, 0xec, 0x26, 0x4d, 0x8b, 0xfa, 0xf4, 0x01, 0x6c, 0xe9, 0xd6, 0x04, 0xcb, 0x08, 0xcb, 0x96,
+	0x30, 0x41, 0x30, 0x94, 0x8e, 0xbd, 0xb0, 0x79, 0x51, 0x69, 0xea, 0x7c, 0xa0, 0xbd, 0x80, 0xed,
+	0x38, 0x58, 0xd4, 0xb0, 0x67, 0x50, 0x66, 0x09, 0x28, 0x4b, 0xd8, 0x3d, 0xc5, 0x15, 0x06, 0x3d,
+	0x5f, 0x2f, 0x16, 0xa6, 0x77, 0xa5, 0x0b, 0x98, 0xf6, 0x97, 0x3c, 0x34, 0x54, 0x05, 0x69, 0x43,
+	0x81, 0xa6, 0x78, 0x8e, 0xa5, 0x38, 0xfd, 0x29, 0xce, 0xa2, 0x7c, 0x78, 0x16, 0x75, 0xa1, 0x8a,
+	0x41, 0x9d, 0xae, 0x27, 0x48, 0x39, 0xcf, 0xaf, 0x70, 0x1c, 0xcf, 0x84, 0xe2, 0x5d, 0x32, 0x01,
+	0x0b, 0x56, 0xe0, 0x99, 0x4b, 0xdf, 0x9c, 0xd0, 0xe3, 0xd7, 0x98, 0xb8, 0xeb, 0x65, 0xc0, 0x22,
+	0xda, 0xd4, 0xdb, 0x8a, 0xa2, 0x47, 0xe5, 0xe4, 0x2d, 0x68, 0xc4, 0x70, 0x65, 0x86, 0xab, 0xab,
+	0x90, 0xe7, 0xd0, 0x79, 0x85, 0xa5, 0xc0, 0x9a, 0x1a, 0x69, 0xb3, 0x15, 0x06, 0xdf, 0xe5, 0xfa,
+	0x51, 0xc2, 0xb8, 0x76, 0x0a, 0x5b, 0x23, 0x77, 0xd5, 0x73, 0x31, 0xe3, 0x51, 0x1c, 0x06, 0x02,
+	0xb3, 0xc6, 0x31, 0xfd, 0xc0, 0x08, 0xf9, 0xa5, 0x36, 0x80, 0x8a, 0x78, 0x0c, 0xa2, 0x48, 0xe5,
+	0xd5, 0x48, 0xfd, 0x35, 0x07, 0xdb, 0x71, 0x73, 0x22, 0x54, 0xb8, 0xf3, 0x1d, 0xf7, 0x07, 0xa5,
+	0xaa, 0x70, 0xca, 0xeb, 0x28, 0x0c, 0x8b, 0x0a, 0x2d, 0x3d, 0xf6, 0x6c, 0xae, 0x80, 0xf2, 0xa2,
+	0xf4, 0xa0, 0x34, 0x44, 0xfd, 0x1a, 0x6a, 0x13, 0x69, 0x1e, 0x23, 0x42, 0xe3, 0xfe, 0x40, 0x89,
+	0xbb, 0xfc, 0xf4, 0x01, 0xae, 0xf1, 0xd2, 0x0e, 0xae, 0xf4, 0x08, 0xad, 0x0d, 0xa1, 0x9d, 0x54,
+	0xd3, 0x8d, 0x63, 0x4e, 0x38, 0x51, 0x3c, 0xcb, 0xe5, 0x30, 0x45, 0x3b, 0x77, 0x46, 0xa5, 0x5d,
+	0xd3, 0xe1, 0xfe, 0x71, 0x92, 0xd6, 0xff, 0x97, 0xc2, 0x6f, 0xa1, 0x9b, 0x65, 0x53, 0xf0, 0xf8,
+	0x1b, 0x68, 0x28, 0x11, 0x96, 0x89, 0xff, 0x86, 0x42, 0x40, 0x6a, 0xb2, 0x1e, 0x9b, 0xa1, 0xfd,
+	0x39, 0x07, 0x9b, 0x29, 0x4c, 0xaa, 0x05, 0x7b, 0x00, 0xb5, 0x64, 0x18, 0xaa, 0x63, 0x19, 0x82,
+	0xfb, 0x50, 0x0d, 0x0b, 0x3f, 0xdf, 0x13, 0x95, 0xb1, 0x38, 0x3e, 0x76, 0x63, 0x8d, 0x64, 0x4d,
+	0xb6, 0x8c, 0x74, 0xad, 0x96, 0xe7, 0xb9, 0xb2, 0x6c, 0xf1, 0x81, 0xf6, 0x09, 0x6c, 0x1e, 0x1c,
+	0x9e, 0xf4, 0xe6, 0xe6, 0x72, 0x66, 0xdd, 0x9a, 0x37, 0xed, 0x08, 0x9b, 0x48, 0x65, 0x96, 0x60,
+	0x66, 0x0f, 0x1b, 0x1a, 0x2e, 0x12, 0xa4, 0xc4, 0x7a, 0x58, 0x89, 0xd7, 0x25, 0x48, 0xfb, 0x67,
+	0x0e, 0x6a, 0xa1, 0xf8, 0x86, 0x34, 0xf8, 0xa9, 0x4c, 0xbc, 0x83, 0x07, 0xbb, 0xb2, 0x17, 0x11,
+	0xc0, 0x19, 0x69, 0x2a, 0xd2, 0xe4, 0x69, 0x52, 0xba, 0x43, 0x0d, 0xd1, 0xee, 0xd1, 0xba, 0x6d,
+	0x7a, 0x93, 0x79, 0xcf, 0xc5, 0xde, 0x06, 0x4b, 0xbd, 0xec, 0xf5, 0xfe, 0x93, 0xa3, 0x65, 0x37,
+	0xae, 0x11, 0x24, 0x7d, 0x0c, 0xa5, 0x95, 0x85, 0x8d, 0x90, 0xa0, 0xe8, 0xa1, 0x42, 0x11, 0x9f,
+	0xf1, 0x12, 0xb5, 0xe1, 0x2c, 0x8e, 0x25, 0xbf, 0x82, 0xb2, 0xc7, 0x89, 0xe5, 0xdd, 0xf8, 0x9b,
+	0xa9, 0x59, 0xe1, 0x0c, 0x46, 0xb1, 0x40, 0xa7, 0xf7, 0x7c, 0xe1, 0x36, 0x7b, 0xbe, 0x98, 0xb1,
+	0xe7, 0xb1, 0x08, 0xff, 0x60, 0x7a, 0x4b, 0xec, 0xb8, 0x7c, 0xe4, 0xa8, 0x40, 0x8b, 0xb0, 0x1c,
+	0x6b, 0xff, 0xce, 0x01, 0x49, 0xfb, 0x9e, 0xd9, 0x9d, 0xc6, 0x5b, 0xb1, 0x7c, 0xb2, 0x15, 0x0b,
+	0x9b, 0xd7, 0x82, 0xda, 0xbc, 0xa2, 0x21, 0xc7, 0xbe, 0xb4, 0x44, 0xf7, 0xc9, 0x7e, 0xa7, 0x57,
+	0x56, 0xba, 0xcd, 0xca, 0xca, 0x19, 0x2b, 0x4b, 0xb7, 0x5b, 0x95, 0x74, 0xbb, 0xa5, 0xfd, 0x2d,
+	0x07, 0x5b, 0x19, 0x4c, 0xff, 0x8c, 0x55, 0x15, 0xd7, 0x4e, 0x29, 0xe2, 0x15, 0x15, 0xf7, 0x27,
+	0x1b, 0xd0, 0xe3, 0x71, 0x66, 0xae, 0xc4, 0xd2, 0xe9, 0x4f, 0xba, 0x4f, 0xa8, 0x07, 0x8e, 0xb9,
+	0x12, 0xfd, 0xb5, 0x1c, 0x6a, 0x97, 0xf2, 0x44, 0xc7, 0xb2, 0x32, 0xb1, 0x6e, 0x3e, 0xd1, 0xc9,
+	0x13, 0x68, 0x7b, 0xd8, 0xe9, 0x1b, 0x4b, 0x73, 0x61, 0x19, 0xbc, 0xfb, 0x13, 0x67, 0x6e, 0x8b,
+	0xca, 0x07, 0x28, 0x3e, 0x66, 0x52, 0x5a, 0x0d, 0x58, 0xad, 0xf0, 0x0d, 0x77, 0xe9, 0xc8, 0xd0,
+	0x00, 0x17, 0x0d, 0x51, 0x12, 0x35, 0x07, 0xf2, 0xbb, 0x51, 0x73, 0x10, 0x30, 0x49, 0x46, 0x73,
+	0xc0, 0xa0, 0x61, 0x73, 0xc0, 0x61, 0xda, 0x8f, 0x39, 0x68, 0xa8, 0x0a, 0xba, 0xb9, 0x99, 0x2a,
+	0xea, 0x80, 0x2a, 0x6c, 0xcc, 0xbb, 0x64, 0xe6, 0xbf, 0xbf, 0x32, 0x97, 0x6c, 0x11, 0xc2, 0xfb,
+	0x06, 0x95, 0x9e, 0xa3, 0x90, 0xae, 0x00, 0x8f, 0x2a, 0xf0, 0xe9, 0xa5, 0xce, 0xa0, 0x9b, 0xf6,
+	0x16, 0xb7, 0x9f, 0x1a, 0x43, 0xd3, 0x31, 0xf9, 0x14, 0xaa, 0xf2, 0x61, 0x43, 0x74, 0x16, 0xf7,
+	0x53, 0x13, 0x8f, 0x04, 0x40, 0x0f, 0xa1, 0xec, 0x2e, 0x42, 0x5d, 0x52, 0x1b, 0x8a, 0x1a, 0x95,
+	0xf4, 0x64, 0x2d, 0xa3, 0x57, 0x1a, 0x5e, 0x89, 0xf9, 0xa5, 0xa7, 0x8a, 0x82, 0x3e, 0x2b, 0xc6,
+	0x1f, 0xc2, 0xc6, 0x0b, 0x8b, 0xb3, 0x28, 0x83, 0x77, 0x3d, 0x03, 0xda, 0xf7, 0xd0, 0x8e, 0xd0,
+	0x82, 0xf2, 0x5f, 0x42, 0xc5, 0xe7, 0xdc, 0x89, 0x7b, 0xf6, 0xb5, 0x9c, 0x4b, 0x1c, 0x79, 0x8a,
+	0x5d, 0x3a, 0xba, 0x27, 0x4b, 0xcb, 0x76, 0x6a, 0x02, 0x2a, 0x75, 0x0e, 0xd1, 0xfe, 0x95, 0x87,
+	0x5a, 0x28, 0x24, 0xf7, 0xf0, 0x63, 0x74, 0xa9, 0xa1, 0x6b, 0x65, 0x3a, 0xe4, 0xb1, 0x59, 0x99,
+	0x1e, 0x26, 0x84, 0x21, 0xf5, 0x22, 0x36, 0x5c, 0x7a, 0xce, 0x51, 0xb8, 0xad, 0x59, 0xdc, 0x78,
+	0xd5, 0x66, 0xbf, 0x13, 0xf1, 0x2a, 0xfe, 0xd4, 0x78, 0x95, 0x6e, 0x1f, 0x2f, 0xcc, 0x6e, 0x7e,
+	0x40, 0x62, 0xc4, 0xa6, 0xbc, 0x75, 0x2f, 0xe9, 0xc0, 0x45, 0x3d, 0x94, 0xd0, 0x53, 0x44, 0x00,
+	0x64, 0x7b, 0xcf, 0x1b, 0xf8, 0x26, 0x97, 0x9e, 0x89, 0x26, 0xff, 0x3d, 0xd8, 0x30, 0x83, 0xc0,
+	0xb3, 0xc7, 0xeb, 0xc0, 0x12, 0x8d, 0x7e, 0x95, 0x6f, 0xa7, 0x48, 0x4c, 0x9b, 0xfd, 0xa7, 0x67,
+	0x78, 0xe8, 0xc9, 0xf7, 0x1c, 0xd2, 0x80, 0xea, 0x60, 0x38, 0x3a, 0x1e, 0x5e, 0x0c, 0x8e, 0xda,
+	0xbf, 0x20, 0x75, 0xa8, 0xf4, 0xf4, 0xfe, 0xc1, 0xa8, 0x7f, 0xd4, 0xce, 0xd1, 0x81, 0x7e, 0x31,
+	0x18, 0x9c, 0x0c, 0x5e, 0xb4, 0xf3, 0x74, 0xf0, 0xd5, 0x81, 0xce, 0x06, 0x05, 0x3a, 0x38, 0x1f,
+	0x0d, 0x5f, 0xbe, 0x44, 0x58, 0xf1, 0xe9, 0xe7, 0x00, 0xd1, 0xa3, 0x07, 0xa6, 0xcb, 0xce, 0x17,
+	0xfd, 0x83, 0x23, 0xe3, 0xf0, 0x74, 0xd8, 0xfb, 0xad, 0x31, 0x3a, 0x39, 0xeb, 0x1b, 0x47, 0xfa,
+	0xc9, 0xf1, 0x08, 0x8d, 0xef, 0xc0, 0xa6, 0xa2, 0x1a, 0x5c, 0x9c, 0x1d, 0xf6, 0xf5, 0x76, 0xee,
+	0xe9, 0x27, 0x50, 0x95, 0x57, 0x03, 0x52, 0x83, 0xd2, 0x51, 0xff, 0xf0, 0xe2, 0x05, 0xa2, 0xab,
+	0x50, 0x3c, 0x19, 0x1c, 0x0f, 0xd1, 0x0f, 0xfc, 0x45, 0x3f, 0x8d, 0x4e, 0xa0, 0xba, 0xaf, 0xeb,
+	0x43, 0xbd, 0x5d, 0xd8, 0xff, 0xb1, 0x0a, 0xb5, 0x23, 0x99, 0x27, 0xa4, 0x07, 0x55, 0xf9, 0x7a,
+	0x47, 0xba, 0xf1, 0x77, 0x2b, 0xf5, 0x95, 0xaf, 0xfb, 0x20, 0x53, 0x27, 0x52, 0xb7, 0xcf, 0x8d,
+	0xb0, 0x47, 0xb5, 0xa4, 0x11, 0xe5, 0x6d, 0x2f, 0x65, 0x44, 0x7d, 0xc0, 0xfb, 0x28, 0x47, 0xce,
+	0xd8, 0x0d, 0x52, 0xbe, 0x8c, 0x91, 0x87, 0x09, 0x74, 0xfc, 0xc5, 0xac, 0x9b, 0x50, 0x27, 0x9e,
+	0xc5, 0xd0, 0xdc, 0x67, 0x48, 0x09, 0x7d, 0x71, 0x21, 0xf7, 0x92, 0x8f, 0x33, 0xd2, 0x44, 0x27,
+	0xad, 0x10, 0x2b, 0x42, 0x5a, 0xe4, 0x8b, 0x52, 0x6c, 0x45, 0x89, 0x07, 0xa9, 0xd8, 0x8a, 0x92,
+	0x4f, 0x50, 0xd8, 0x6e, 0x56, 0xc4, 0xcb, 0x12, 0xb9, 0x1f, 0xc3, 0xa9, 0xcf, 0x52, 0xdd, 0x6e,
+	0x96, 0x4a, 0x58, 0xf8, 0x9c, 0x3d, 0x56, 0xd2, 0x47, 0x80, 0x98, 0x85, 0xf8, 0xc3, 0x40, 0x77,
+	0x37, 0xa5, 0x62, 0x57, 0x70, 0xa4, 0xe0, 0x02, 0x5a, 0xf1, 0x2b, 0x24, 0x79, 0x1c, 0x6b, 0x3f,
+	0x32, 0x2e, 0xae, 0xdd, 0xb7, 0x6e, 0x40, 0x08, 0xb7, 0x86, 0xd0, 0x50, 0xaf, 0x94, 0x44, 0xed,
+	0x69, 0x32, 0x2e, 0xa6, 0xdd, 0x47, 0xd7, 0xea, 0x23, 0x83, 0xea, 0xc5, 0x27, 0x66, 0x30, 0xe3,
+	0x82, 0x15, 0x33, 0x98, 0x79, 0x63, 0x32, 0x81, 0xa4, 0xef, 0x01, 0xe4, 0xed, 0x9b, 0x3a, 0xfd,
+	0xd0, 0xf8, 0x3b, 0xaf, 0x41, 0x89, 0x4f, 0x9c, 0x00, 0x44, 0x8d, 0x34, 0x79, 0x23, 0xab, 0x5f,
+	0xce, 0xce, 0xd5, 0x74, 0xf7, 0xcd, 0xc2, 0xa4, 0x36, 0x28, 0x89, 0x30, 0x65, 0xf4, 0xa9, 0x89,
+	0x30, 0x65, 0xf6, 0xab, 0x61, 0x98, 0xf8, 0xe1, 0x9e, 0x11, 0xa6, 0x58, 0xb7, 0x91, 0x11, 0xa6,
+	0x44, 0x57, 0x80, 0xbb, 0x42, 0x1e, 0x5b, 0xb1, 0x5d, 0x91, 0x38, 0xf9, 0x62, 0xbb, 0x22, 0x79,
+	0xce, 0x1d, 0xd6, 0xbf, 0x89, 0xfe, 0x90, 0x18, 0x97, 0x59, 0x7d, 0xff, 0xf8, 0x7f, 0x24, 0xb3,
+	0x1a, 0xe9, 0xb5, 0x18, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DashboardClient is the client API for Dashboard service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DashboardClient interface {
+	AppsList(ctx context.Context, in *AppsListRequest, opts ...grpc.CallOption) (*AppsListResponse, error)
+	AppsInfo(ctx context.Context, in *AppsInfoRequest, opts ...grpc.CallOption) (Dashboard_AppsInfoClient, error)
+	AppsMetrics(ctx context.Context, in *AppsMetricsRequest, opts ...grpc.CallOption) (Dashboard_AppsMetricsClient, error)
+	Dmesh(ctx context.Context, in *DmeshRequest, opts ...grpc.CallOption) (*DmeshResponse, error)
+	StartApp(ctx context.Context, in *StartAppRequest, opts ...grpc.CallOption) (*StartAppResponse, error)
+	StopApp(ctx context.Context, in *StopAppRequest, opts ...grpc.CallOption) (*StopAppResponse, error)
+	AppLogs(ctx context.Context, in *AppLogsRequest, opts ...grpc.CallOption) (Dashboard_AppLogsClient, error)
+	SetAppLogLevel(ctx context.Context, in *SetAppLogLevelRequest, opts ...grpc.CallOption) (*SetAppLogLevelResponse, error)
+	RecentBlocks(ctx context.Context, in *RecentBlocksRequest, opts ...grpc.CallOption) (*RecentBlocksResponse, error)
+	TopContracts(ctx context.Context, in *TopContractsRequest, opts ...grpc.CallOption) (*TopContractsResponse, error)
+	FailedTransactions(ctx context.Context, in *FailedTransactionsRequest, opts ...grpc.CallOption) (*FailedTransactionsResponse, error)
+	ABIChanges(ctx context.Context, in *ABIChangesRequest, opts ...grpc.CallOption) (*ABIChangesResponse, error)
+	SearchCoverage(ctx context.Context, in *SearchCoverageRequest, opts ...grpc.CallOption) (*SearchCoverageResponse, error)
+	RecentTraces(ctx context.Context, in *RecentTracesRequest, opts ...grpc.CallOption) (*RecentTracesResponse, error)
+	GetTrace(ctx context.Context, in *GetTraceRequest, opts ...grpc.CallOption) (*GetTraceResponse, error)
+}
+
+type dashboardClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDashboardClient(cc *grpc.ClientConn) DashboardClient {
+	return &dashboardClient{cc}
+}
+
+func (c *dashboardClient) AppsList(ctx context.Context, in *AppsListRequest, opts ...grpc.CallOption) (*AppsListResponse, error) {
+	out := new(AppsListResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/AppsList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) AppsInfo(ctx context.Context, in *AppsInfoRequest, opts ...grpc.CallOption) (Dashboard_AppsInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[0], "/dashboard.Dashboard/AppsInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dashboardAppsInfoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dashboard_AppsInfoClient interface {
+	Recv() (*AppsInfoResponse, error)
+	grpc.ClientStream
+}
+
+type dashboardAppsInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *dashboardAppsInfoClient) Recv() (*AppsInfoResponse, error) {
+	m := new(AppsInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dashboardClient) AppsMetrics(ctx context.Context, in *AppsMetricsRequest, opts ...grpc.CallOption) (Dashboard_AppsMetricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[1], "/dashboard.Dashboard/AppsMetrics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dashboardAppsMetricsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dashboard_AppsMetricsClient interface {
+	Recv() (*AppMetricsResponse, error)
+	grpc.ClientStream
+}
+
+type dashboardAppsMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dashboardAppsMetricsClient) Recv() (*AppMetricsResponse, error) {
+	m := new(AppMetricsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dashboardClient) Dmesh(ctx context.Context, in *DmeshRequest, opts ...grpc.CallOption) (*DmeshResponse, error) {
+	out := new(DmeshResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/Dmesh", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) StartApp(ctx context.Context, in *StartAppRequest, opts ...grpc.CallOption) (*StartAppResponse, error) {
+	out := new(StartAppResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/StartApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) StopApp(ctx context.Context, in *StopAppRequest, opts ...grpc.CallOption) (*StopAppResponse, error) {
+	out := new(StopAppResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/StopApp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) AppLogs(ctx context.Context, in *AppLogsRequest, opts ...grpc.CallOption) (Dashboard_AppLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Dashboard_serviceDesc.Streams[2], "/dashboard.Dashboard/AppLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dashboardAppLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Dashboard_AppLogsClient interface {
+	Recv() (*AppLogEntry, error)
+	grpc.ClientStream
+}
+
+type dashboardAppLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dashboardAppLogsClient) Recv() (*AppLogEntry, error) {
+	m := new(AppLogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dashboardClient) SetAppLogLevel(ctx context.Context, in *SetAppLogLevelRequest, opts ...grpc.CallOption) (*SetAppLogLevelResponse, error) {
+	out := new(SetAppLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/SetAppLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) RecentBlocks(ctx context.Context, in *RecentBlocksRequest, opts ...grpc.CallOption) (*RecentBlocksResponse, error) {
+	out := new(RecentBlocksResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/RecentBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) TopContracts(ctx context.Context, in *TopContractsRequest, opts ...grpc.CallOption) (*TopContractsResponse, error) {
+	out := new(TopContractsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/TopContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) FailedTransactions(ctx context.Context, in *FailedTransactionsRequest, opts ...grpc.CallOption) (*FailedTransactionsResponse, error) {
+	out := new(FailedTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/FailedTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) ABIChanges(ctx context.Context, in *ABIChangesRequest, opts ...grpc.CallOption) (*ABIChangesResponse, error) {
+	out := new(ABIChangesResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/ABIChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) SearchCoverage(ctx context.Context, in *SearchCoverageRequest, opts ...grpc.CallOption) (*SearchCoverageResponse, error) {
+	out := new(SearchCoverageResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/SearchCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) RecentTraces(ctx context.Context, in *RecentTracesRequest, opts ...grpc.CallOption) (*RecentTracesResponse, error) {
+	out := new(RecentTracesResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/RecentTraces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardClient) GetTrace(ctx context.Context, in *GetTraceRequest, opts ...grpc.CallOption) (*GetTraceResponse, error) {
+	out := new(GetTraceResponse)
+	err := c.cc.Invoke(ctx, "/dashboard.Dashboard/GetTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DashboardServer is the server API for Dashboard service.
+type DashboardServer interface {
+	AppsList(context.Context, *AppsListRequest) (*AppsListResponse, error)
+	AppsInfo(*AppsInfoRequest, Dashboard_AppsInfoServer) error
+	AppsMetrics(*AppsMetricsRequest, Dashboard_AppsMetricsServer) error
+	Dmesh(context.Context, *DmeshRequest) (*DmeshResponse, error)
+	StartApp(context.Context, *StartAppRequest) (*StartAppResponse, error)
+	StopApp(context.Context, *StopAppRequest) (*StopAppResponse, error)
+	AppLogs(*AppLogsRequest, Dashboard_AppLogsServer) error
+	SetAppLogLevel(context.Context, *SetAppLogLevelRequest) (*SetAppLogLevelResponse, error)
+	RecentBlocks(context.Context, *RecentBlocksRequest) (*RecentBlocksResponse, error)
+	TopContracts(context.Context, *TopContractsRequest) (*TopContractsResponse, error)
+	FailedTransactions(context.Context, *FailedTransactionsRequest) (*FailedTransactionsResponse, error)
+	ABIChanges(context.Context, *ABIChangesRequest) (*ABIChangesResponse, error)
+	SearchCoverage(context.Context, *SearchCoverageRequest) (*SearchCoverageResponse, error)
+	RecentTraces(context.Context, *RecentTracesRequest) (*RecentTracesResponse, error)
+	GetTrace(context.Context, *GetTraceRequest) (*GetTraceResponse, error)
+}
+
+// UnimplementedDashboardServer can be embedded to have forward compatible implementations.
+type UnimplementedDashboardServer struct {
+}
+
+func (*UnimplementedDashboardServer) AppsList(ctx context.Context, req *AppsListRequest) (*AppsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppsList not implemented")
+}
+func (*UnimplementedDashboardServer) AppsInfo(req *AppsInfoRequest, srv Dashboard_AppsInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method AppsInfo not implemented")
+}
+func (*UnimplementedDashboardServer) AppsMetrics(req *AppsMetricsRequest, srv Dashboard_AppsMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AppsMetrics not implemented")
+}
+func (*UnimplementedDashboardServer) Dmesh(ctx context.Context, req *DmeshRequest) (*DmeshResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dmesh not implemented")
+}
+func (*UnimplementedDashboardServer) StartApp(ctx context.Context, req *StartAppRequest) (*StartAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartApp not implemented")
+}
+func (*UnimplementedDashboardServer) StopApp(ctx context.Context, req *StopAppRequest) (*StopAppResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopApp not implemented")
+}
+func (*UnimplementedDashboardServer) AppLogs(req *AppLogsRequest, srv Dashboard_AppLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AppLogs not implemented")
+}
+func (*UnimplementedDashboardServer) SetAppLogLevel(ctx context.Context, req *SetAppLogLevelRequest) (*SetAppLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppLogLevel not implemented")
+}
+func (*UnimplementedDashboardServer) RecentBlocks(ctx context.Context, req *RecentBlocksRequest) (*RecentBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentBlocks not implemented")
+}
+func (*UnimplementedDashboardServer) TopContracts(ctx context.Context, req *TopContractsRequest) (*TopContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopContracts not implemented")
+}
+func (*UnimplementedDashboardServer) FailedTransactions(ctx context.Context, req *FailedTransactionsRequest) (*FailedTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedTransactions not implemented")
+}
+func (*UnimplementedDashboardServer) ABIChanges(ctx context.Context, req *ABIChangesRequest) (*ABIChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ABIChanges not implemented")
+}
+func (*UnimplementedDashboardServer) SearchCoverage(ctx context.Context, req *SearchCoverageRequest) (*SearchCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCoverage not implemented")
+}
+func (*UnimplementedDashboardServer) RecentTraces(ctx context.Context, req *RecentTracesRequest) (*RecentTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentTraces not implemented")
+}
+func (*UnimplementedDashboardServer) GetTrace(ctx context.Context, req *GetTraceRequest) (*GetTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrace not implemented")
+}
+
+func RegisterDashboardServer(s *grpc.Server, srv DashboardServer) {
+	s.RegisterService(&_Dashboard_serviceDesc, srv)
+}
+
+func _Dashboard_AppsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).AppsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/AppsList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).AppsList(ctx, req.(*AppsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_AppsInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AppsInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServer).AppsInfo(m, &dashboardAppsInfoServer{stream})
+}
+
+type Dashboard_AppsInfoServer interface {
+	Send(*AppsInfoResponse) error
+	grpc.ServerStream
+}
+
+type dashboardAppsInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *dashboardAppsInfoServer) Send(m *AppsInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dashboard_AppsMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AppsMetricsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServer).AppsMetrics(m, &dashboardAppsMetricsServer{stream})
+}
+
+type Dashboard_AppsMetricsServer interface {
+	Send(*AppMetricsResponse) error
+	grpc.ServerStream
+}
+
+type dashboardAppsMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dashboardAppsMetricsServer) Send(m *AppMetricsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dashboard_Dmesh_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DmeshRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -1167,6 +2919,171 @@ func _Dashboard_StopApp_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dashboard_AppLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AppLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServer).AppLogs(m, &dashboardAppLogsServer{stream})
+}
+
+type Dashboard_AppLogsServer interface {
+	Send(*AppLogEntry) error
+	grpc.ServerStream
+}
+
+type dashboardAppLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dashboardAppLogsServer) Send(m *AppLogEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Dashboard_SetAppLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).SetAppLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/SetAppLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).SetAppLogLevel(ctx, req.(*SetAppLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_RecentBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecentBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).RecentBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/RecentBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).RecentBlocks(ctx, req.(*RecentBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_TopContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).TopContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/TopContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).TopContracts(ctx, req.(*TopContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_FailedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailedTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).FailedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/FailedTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).FailedTransactions(ctx, req.(*FailedTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_ABIChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ABIChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).ABIChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/ABIChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).ABIChanges(ctx, req.(*ABIChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_SearchCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).SearchCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/SearchCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).SearchCoverage(ctx, req.(*SearchCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_RecentTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecentTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).RecentTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/RecentTraces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).RecentTraces(ctx, req.(*RecentTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboard_GetTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardServer).GetTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dashboard.Dashboard/GetTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardServer).GetTrace(ctx, req.(*GetTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Dashboard_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dashboard.Dashboard",
 	HandlerType: (*DashboardServer)(nil),
@@ -1187,6 +3104,38 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopApp",
 			Handler:    _Dashboard_StopApp_Handler,
 		},
+		{
+			MethodName: "SetAppLogLevel",
+			Handler:    _Dashboard_SetAppLogLevel_Handler,
+		},
+		{
+			MethodName: "RecentBlocks",
+			Handler:    _Dashboard_RecentBlocks_Handler,
+		},
+		{
+			MethodName: "TopContracts",
+			Handler:    _Dashboard_TopContracts_Handler,
+		},
+		{
+			MethodName: "FailedTransactions",
+			Handler:    _Dashboard_FailedTransactions_Handler,
+		},
+		{
+			MethodName: "ABIChanges",
+			Handler:    _Dashboard_ABIChanges_Handler,
+		},
+		{
+			MethodName: "SearchCoverage",
+			Handler:    _Dashboard_SearchCoverage_Handler,
+		},
+		{
+			MethodName: "RecentTraces",
+			Handler:    _Dashboard_RecentTraces_Handler,
+		},
+		{
+			MethodName: "GetTrace",
+			Handler:    _Dashboard_GetTrace_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1199,6 +3148,11 @@ var _Dashboard_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Dashboard_AppsMetrics_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AppLogs",
+			Handler:       _Dashboard_AppLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "dashboard.proto",
 }
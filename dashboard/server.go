@@ -47,6 +47,9 @@ type server struct {
 	managerController *core.Controller
 	metricsManager    *metrics.Manager
 	box               *rice.HTTPBox
+
+	// explorer is nil when the chain explorer is disabled
+	explorer *explorer
 }
 
 func newServer(config *Config, modules *Modules, metricsManager *metrics.Manager) *server {
@@ -0,0 +1,120 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	pbdashboard "github.com/dfuse-io/dfuse-eosio/dashboard/pb"
+	"github.com/dfuse-io/dfuse-eosio/tracing"
+	"github.com/golang/protobuf/ptypes"
+	"go.opencensus.io/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errTracesUnavailable = status.Error(codes.Unavailable, "traces are not kept in memory, the trace buffer size is 0")
+
+func (s *server) RecentTraces(ctx context.Context, req *pbdashboard.RecentTracesRequest) (*pbdashboard.RecentTracesResponse, error) {
+	if s.modules.Traces == nil {
+		return nil, errTracesUnavailable
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 50
+	}
+
+	out := &pbdashboard.RecentTracesResponse{}
+	for _, t := range s.modules.Traces.Traces(0) {
+		if len(out.Traces) >= limit {
+			break
+		}
+
+		if req.ErrorsOnly && !t.HasError() {
+			continue
+		}
+
+		if req.RootNameFilter != "" && !strings.Contains(t.Root().Name, req.RootNameFilter) {
+			continue
+		}
+
+		out.Traces = append(out.Traces, toProtoTraceSummary(t))
+	}
+
+	return out, nil
+}
+
+func (s *server) GetTrace(ctx context.Context, req *pbdashboard.GetTraceRequest) (*pbdashboard.GetTraceResponse, error) {
+	if s.modules.Traces == nil {
+		return nil, errTracesUnavailable
+	}
+
+	t, found := s.modules.Traces.Trace(req.TraceId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "trace %q not found, it might have been evicted", req.TraceId)
+	}
+
+	spans := t.Spans
+	sort.Slice(spans, func(i, j int) bool { return spans[i].StartTime.Before(spans[j].StartTime) })
+
+	out := &pbdashboard.GetTraceResponse{Summary: toProtoTraceSummary(t)}
+	for _, span := range spans {
+		out.Spans = append(out.Spans, toProtoTraceSpan(span))
+	}
+
+	return out, nil
+}
+
+func toProtoTraceSummary(t *tracing.Trace) *pbdashboard.TraceSummary {
+	root := t.Root()
+	return &pbdashboard.TraceSummary{
+		TraceId:      t.ID.String(),
+		RootSpanName: root.Name,
+		StartTime:    timeToProtoTimestamp(&root.StartTime),
+		Duration:     ptypes.DurationProto(t.Duration()),
+		SpanCount:    uint32(len(t.Spans)),
+		HasError:     t.HasError(),
+	}
+}
+
+func toProtoTraceSpan(span *trace.SpanData) *pbdashboard.TraceSpan {
+	out := &pbdashboard.TraceSpan{
+		SpanId:        span.SpanID.String(),
+		Name:          span.Name,
+		StartTime:     timeToProtoTimestamp(&span.StartTime),
+		Duration:      ptypes.DurationProto(span.EndTime.Sub(span.StartTime)),
+		StatusCode:    span.Code,
+		StatusMessage: span.Message,
+	}
+
+	if span.ParentSpanID != (trace.SpanID{}) {
+		out.ParentSpanId = span.ParentSpanID.String()
+	}
+
+	if len(span.Attributes) > 0 {
+		data, err := json.Marshal(span.Attributes)
+		if err != nil {
+			zlog.Debug("unable to marshal span attributes", zap.String("span_id", out.SpanId), zap.Error(err))
+		}
+		out.AttributesJson = string(data)
+	}
+
+	return out
+}
@@ -0,0 +1,349 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphqlws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dfuse-io/dauth/authenticator"
+	"github.com/dfuse-io/dfuse-eosio/metering"
+	"github.com/dfuse-io/dgraphql/analytics"
+	"github.com/dfuse-io/dtracing"
+	"github.com/dfuse-io/logging"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	"go.uber.org/zap"
+)
+
+const analyticsProtocol = "graphql-transport-ws"
+
+type connection struct {
+	logger  *zap.Logger
+	ws      *websocket.Conn
+	request *http.Request
+	handler *Handler
+
+	// ctx lives as long as the connection, operations derive from it
+	ctx       context.Context
+	cancel    func()
+	out       chan *message
+	closeOnce sync.Once
+
+	lock         sync.Mutex
+	initReceived bool
+	credentials  authenticator.Credentials
+	operations   map[string]func()
+}
+
+func newConnection(connectionID string, ws *websocket.Conn, r *http.Request, handler *Handler) *connection {
+	logger := zlog.With(zap.String("connection_id", connectionID))
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background(), logger))
+
+	return &connection{
+		logger:     logger,
+		ws:         ws,
+		request:    r,
+		handler:    handler,
+		ctx:        ctx,
+		cancel:     cancel,
+		out:        make(chan *message),
+		operations: map[string]func(){},
+	}
+}
+
+func (c *connection) run() {
+	go c.writeLoop()
+	c.readLoop()
+}
+
+func (c *connection) writeLoop() {
+	defer c.terminate()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case msg := <-c.out:
+			if err := c.ws.SetWriteDeadline(time.Now().Add(c.handler.writeTimeout)); err != nil {
+				return
+			}
+
+			if err := c.ws.WriteJSON(msg); err != nil {
+				c.logger.Debug("unable to write message, terminating connection", zap.Error(err))
+				return
+			}
+
+			//////////////////////////////////////////////////////////////////////
+			// Billable event on GraphQL Subscriptions
+			// WARNING : Here we only track Egress bytes
+			//////////////////////////////////////////////////////////////////////
+			if msg.Type == typeNext {
+				metering.EmitWithCredentials(metering.Event{
+					Source:      "dgraphql",
+					Kind:        "GraphQL Subscription",
+					EgressBytes: int64(len(msg.Payload)),
+				}, c.getCredentials())
+			}
+			//////////////////////////////////////////////////////////////////////
+		}
+	}
+}
+
+func (c *connection) send(msg *message) {
+	select {
+	case <-c.ctx.Done():
+	case c.out <- msg:
+	}
+}
+
+// closeWith terminates the connection with one of the protocol's close codes.
+func (c *connection) closeWith(code int, reason string) {
+	c.logger.Debug("closing connection", zap.Int("code", code), zap.String("reason", reason))
+	c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(c.handler.writeTimeout))
+	c.terminate()
+}
+
+// terminate cancels all operations of the connection and closes it.
+func (c *connection) terminate() {
+	c.closeOnce.Do(func() {
+		c.logger.Debug("terminating websocket connection")
+		c.cancel()
+		c.ws.Close()
+	})
+}
+
+func (c *connection) readLoop() {
+	defer c.terminate()
+
+	initTimer := time.AfterFunc(c.handler.initTimeout, func() {
+		c.lock.Lock()
+		initReceived := c.initReceived
+		c.lock.Unlock()
+
+		if !initReceived {
+			c.closeWith(closeInitTimeout, "Connection initialisation timeout")
+		}
+	})
+	defer initTimer.Stop()
+
+	for {
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			c.logger.Debug("unable to read message, terminating connection", zap.Error(err))
+			return
+		}
+
+		var msg message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			c.closeWith(closeBadRequest, "Invalid message received")
+			return
+		}
+
+		switch msg.Type {
+		case typeConnectionInit:
+			if !c.onConnectionInit(&msg) {
+				return
+			}
+
+		case typePing:
+			c.send(&message{Type: typePong})
+
+		case typePong:
+			// Answers to our pings (if we ever send some), nothing to do
+
+		case typeSubscribe:
+			if !c.onSubscribe(&msg) {
+				return
+			}
+
+		case typeComplete:
+			if cancel, found := c.removeOperation(msg.ID); found {
+				c.logger.Debug("stopping operation due to complete message received from client", zap.String("id", msg.ID))
+				cancel()
+			}
+
+		default:
+			c.closeWith(closeBadRequest, fmt.Sprintf("Unexpected message of type %q received", msg.Type))
+			return
+		}
+	}
+}
+
+func (c *connection) onConnectionInit(msg *message) bool {
+	c.lock.Lock()
+	alreadyReceived := c.initReceived
+	c.initReceived = true
+	c.lock.Unlock()
+
+	if alreadyReceived {
+		c.closeWith(closeTooManyInitialization, "Too many initialisation requests")
+		return false
+	}
+
+	payload := map[string]interface{}{}
+	if len(msg.Payload) > 0 && string(msg.Payload) != "null" {
+		if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+			c.closeWith(closeBadRequest, "Invalid connection_init payload")
+			return false
+		}
+	}
+
+	ctx, err := c.handler.authenticate(c.ctx, c.request, payload)
+	if err != nil {
+		c.logger.Debug("connection authentication failed", zap.Error(err))
+		c.closeWith(closeForbidden, "Forbidden")
+		return false
+	}
+
+	c.lock.Lock()
+	c.credentials = authenticator.GetCredentials(ctx)
+	c.lock.Unlock()
+
+	c.send(&message{Type: typeConnectionAck})
+	return true
+}
+
+func (c *connection) onSubscribe(msg *message) bool {
+	credentials := c.getCredentials()
+	if credentials == nil {
+		c.closeWith(closeUnauthorized, "Unauthorized")
+		return false
+	}
+
+	var payload subscribePayload
+	if msg.ID == "" || json.Unmarshal(msg.Payload, &payload) != nil || payload.Query == "" {
+		c.closeWith(closeBadRequest, "Invalid subscribe message")
+		return false
+	}
+
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on GraphQL Subscriptions
+	// WARNING : Here we only track Ingress bytes
+	//////////////////////////////////////////////////////////////////////
+	metering.EmitWithCredentials(metering.Event{
+		Source:       "dgraphql",
+		Kind:         "GraphQL Subscription",
+		IngressBytes: int64(len(msg.Payload)),
+	}, credentials)
+	//////////////////////////////////////////////////////////////////////
+
+	opCtx, cancel := context.WithCancel(authenticator.WithCredentials(c.ctx, credentials))
+	if !c.addOperation(msg.ID, cancel) {
+		cancel()
+		c.closeWith(closeSubscriberExists, fmt.Sprintf("Subscriber for %s already exists", msg.ID))
+		return false
+	}
+
+	// We create a brand new span (and trace) per GraphQL subscription
+	opCtx, span := dtracing.StartFreshSpan(opCtx, "stream/"+payload.OperationName)
+	opLogger := c.logger.With(zap.String("stream_id", msg.ID), zap.Stringer("trace_id", span.SpanContext().TraceID))
+	opCtx = logging.WithLogger(opCtx, opLogger)
+
+	opLogger.Debug("starting operation due to subscribe message received from client")
+	analytics.TrackSubscriptionStart(opCtx, analyticsProtocol)
+
+	results, err := c.handler.service.Subscribe(opCtx, payload.Query, payload.OperationName, payload.Variables)
+	if err != nil {
+		c.removeOperation(msg.ID)
+		cancel()
+		c.send(&message{ID: msg.ID, Type: typeError, Payload: errorsPayload(err)})
+		return true
+	}
+
+	go c.relay(opCtx, msg.ID, results, opLogger)
+	return true
+}
+
+// relay forwards the results of operation `id` to the client until they are
+// exhausted, or until the operation is cancelled (client `complete` message
+// or connection termination), which in turns cancels the resolvers through
+// the operation's context.
+func (c *connection) relay(ctx context.Context, id string, results <-chan interface{}, logger *zap.Logger) {
+	for {
+		select {
+		case <-ctx.Done():
+			analytics.TrackSubscriptionContextDone(ctx, analyticsProtocol)
+			return
+
+		case result, more := <-results:
+			if !more {
+				if cancel, found := c.removeOperation(id); found {
+					analytics.TrackSubscriptionComplete(ctx, analyticsProtocol)
+					logger.Debug("notifying completion of operation due to no more data")
+					c.send(&message{ID: id, Type: typeComplete})
+					cancel()
+				}
+				return
+			}
+
+			// A response without data is an operation failure (validation
+			// errors, resolver refusing the operation), it ends the operation
+			if resp, ok := result.(*graphql.Response); ok && len(resp.Errors) > 0 && len(resp.Data) == 0 {
+				if cancel, found := c.removeOperation(id); found {
+					payload, _ := json.Marshal(resp.Errors)
+					logger.Debug("notifying failure of operation", zap.Reflect("errors", resp.Errors))
+					analytics.TrackSubscriptionError(ctx, analyticsProtocol, resp.Errors[0])
+					c.send(&message{ID: id, Type: typeError, Payload: payload})
+					cancel()
+				}
+				return
+			}
+
+			payload, err := json.Marshal(result)
+			if err != nil {
+				if cancel, found := c.removeOperation(id); found {
+					c.send(&message{ID: id, Type: typeError, Payload: errorsPayload(err)})
+					cancel()
+				}
+				return
+			}
+
+			c.send(&message{ID: id, Type: typeNext, Payload: payload})
+		}
+	}
+}
+
+func (c *connection) getCredentials() authenticator.Credentials {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.credentials
+}
+
+func (c *connection) addOperation(id string, cancel func()) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, found := c.operations[id]; found {
+		return false
+	}
+
+	c.operations[id] = cancel
+	return true
+}
+
+func (c *connection) removeOperation(id string) (cancel func(), found bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cancel, found = c.operations[id]
+	delete(c.operations, id)
+	return
+}
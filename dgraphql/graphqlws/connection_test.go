@@ -0,0 +1,235 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphqlws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/dauth/authenticator"
+	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `
+	schema {
+		query: Query
+		subscription: Subscription
+	}
+
+	type Query {
+		hello: String!
+	}
+
+	type Subscription {
+		counter(count: Int!): Int!
+	}
+`
+
+type testResolver struct {
+	cancelled chan struct{}
+}
+
+func (r *testResolver) QueryHello() string {
+	return "world"
+}
+
+// SubscriptionCounter streams 0 up to `count` (exclusive), endlessly when
+// `count` is negative in which case `cancelled` is closed once the
+// subscription context is done.
+func (r *testResolver) SubscriptionCounter(ctx context.Context, args struct{ Count int32 }) (<-chan int32, error) {
+	out := make(chan int32)
+	go func() {
+		defer close(out)
+		for i := int32(0); args.Count < 0 || i < args.Count; i++ {
+			select {
+			case <-ctx.Done():
+				close(r.cancelled)
+				return
+			case out <- i:
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func TestConnection_Subscribe(t *testing.T) {
+	conn, _, cleanup := newTestConnection(t)
+	defer cleanup()
+	initConnection(t, conn)
+
+	require.NoError(t, conn.WriteJSON(&message{Type: typePing}))
+	assert.Equal(t, &message{Type: typePong}, readMessage(t, conn))
+
+	subscribe(t, conn, "1", `subscription { counter(count: 2) }`)
+	assert.Equal(t, &message{ID: "1", Type: typeNext, Payload: json.RawMessage(`{"data":{"counter":0}}`)}, readMessage(t, conn))
+	assert.Equal(t, &message{ID: "1", Type: typeNext, Payload: json.RawMessage(`{"data":{"counter":1}}`)}, readMessage(t, conn))
+	assert.Equal(t, &message{ID: "1", Type: typeComplete}, readMessage(t, conn))
+
+	// Completed operation IDs can be reused
+	subscribe(t, conn, "1", `subscription { counter(count: 1) }`)
+	assert.Equal(t, typeNext, readMessage(t, conn).Type)
+	assert.Equal(t, typeComplete, readMessage(t, conn).Type)
+}
+
+func TestConnection_ClientCompleteCancelsOperation(t *testing.T) {
+	conn, resolver, cleanup := newTestConnection(t)
+	defer cleanup()
+	initConnection(t, conn)
+
+	subscribe(t, conn, "1", `subscription { counter(count: -1) }`)
+	assert.Equal(t, typeNext, readMessage(t, conn).Type)
+
+	require.NoError(t, conn.WriteJSON(&message{ID: "1", Type: typeComplete}))
+
+	select {
+	case <-resolver.cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("resolver context was not cancelled after client completed the operation")
+	}
+}
+
+func TestConnection_ValidationError(t *testing.T) {
+	conn, _, cleanup := newTestConnection(t)
+	defer cleanup()
+	initConnection(t, conn)
+
+	subscribe(t, conn, "1", `subscription { unknown }`)
+
+	msg := readMessage(t, conn)
+	assert.Equal(t, typeError, msg.Type)
+	assert.Equal(t, "1", msg.ID)
+	assert.Contains(t, string(msg.Payload), `Cannot query field \"unknown\"`)
+}
+
+func TestConnection_ProtocolErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		messages     []string
+		expectedCode int
+	}{
+		{"subscribe before init", []string{`{"type":"subscribe","id":"1","payload":{"query":"subscription { counter(count: 1) }"}}`}, closeUnauthorized},
+		{"forbidden", []string{`{"type":"connection_init","payload":{"Authorization":"bad"}}`}, closeForbidden},
+		{"double init", []string{`{"type":"connection_init","payload":{"Authorization":"Bearer good"}}`, `{"type":"connection_init"}`}, closeTooManyInitialization},
+		{"duplicate id", []string{
+			`{"type":"connection_init","payload":{"Authorization":"good"}}`,
+			`{"type":"subscribe","id":"1","payload":{"query":"subscription { counter(count: -1) }"}}`,
+			`{"type":"subscribe","id":"1","payload":{"query":"subscription { counter(count: -1) }"}}`,
+		}, closeSubscriberExists},
+		{"invalid json", []string{`{`}, closeBadRequest},
+		{"unknown type", []string{`{"type":"start"}`}, closeBadRequest},
+		{"missing id", []string{`{"type":"connection_init","payload":{"Authorization":"good"}}`, `{"type":"subscribe","payload":{"query":"{ hello }"}}`}, closeBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conn, _, cleanup := newTestConnection(t)
+			defer cleanup()
+			for _, msg := range test.messages {
+				require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+			}
+
+			assert.Equal(t, test.expectedCode, readCloseCode(t, conn))
+		})
+	}
+}
+
+func TestConnection_InitTimeout(t *testing.T) {
+	conn, _, cleanup := newTestConnection(t, WithInitTimeout(50*time.Millisecond))
+	defer cleanup()
+	assert.Equal(t, closeInitTimeout, readCloseCode(t, conn))
+}
+
+func TestHandler_RejectsOtherProtocols(t *testing.T) {
+	server := httptest.NewServer(NewHandler(nil, nil))
+	defer server.Close()
+
+	_, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), http.Header{"Sec-WebSocket-Protocol": []string{"graphql-ws"}})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func newTestConnection(t *testing.T, opts ...Option) (*websocket.Conn, *testResolver, func()) {
+	resolver := &testResolver{cancelled: make(chan struct{})}
+	schema, err := graphql.ParseSchema(testSchema, resolver, graphql.PrefixRootFunctions())
+	require.NoError(t, err)
+
+	authenticate := func(ctx context.Context, r *http.Request, payload map[string]interface{}) (context.Context, error) {
+		if token, _ := payload["Authorization"].(string); strings.TrimPrefix(token, "Bearer ") != "good" {
+			return nil, fmt.Errorf("invalid token")
+		}
+
+		return authenticator.WithCredentials(ctx, &authenticator.AnonymousCredentials{}), nil
+	}
+
+	server := httptest.NewServer(NewHandler(schema, authenticate, opts...))
+
+	dialer := &websocket.Dialer{Subprotocols: []string{Subprotocol}}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		server.Close()
+	}
+	require.NoError(t, err)
+
+	assert.Equal(t, Subprotocol, conn.Subprotocol())
+	return conn, resolver, func() {
+		conn.Close()
+		server.Close()
+	}
+}
+
+func initConnection(t *testing.T, conn *websocket.Conn) {
+	require.NoError(t, conn.WriteJSON(&message{Type: typeConnectionInit, Payload: json.RawMessage(`{"Authorization":"good"}`)}))
+	assert.Equal(t, &message{Type: typeConnectionAck}, readMessage(t, conn))
+}
+
+func subscribe(t *testing.T, conn *websocket.Conn, id string, query string) {
+	payload, err := json.Marshal(&subscribePayload{Query: query})
+	require.NoError(t, err)
+
+	require.NoError(t, conn.WriteJSON(&message{ID: id, Type: typeSubscribe, Payload: payload}))
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) *message {
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	msg := &message{}
+	require.NoError(t, conn.ReadJSON(msg))
+	return msg
+}
+
+func readCloseCode(t *testing.T, conn *websocket.Conn) int {
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	for {
+		_, _, err := conn.ReadMessage()
+		if err == nil {
+			continue
+		}
+
+		closeErr, ok := err.(*websocket.CloseError)
+		require.True(t, ok, "expected a close error, got %s", err)
+		return closeErr.Code
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphqlws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dfuse-io/dauth/authenticator"
+	"github.com/dfuse-io/dtracing"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// Service is what subscriptions are executed against, a `*graphql.Schema`
+// satisfies it.
+type Service interface {
+	Subscribe(ctx context.Context, document string, operationName string, variableValues map[string]interface{}) (payloads <-chan interface{}, err error)
+}
+
+// AuthenticateFunc validates the `connection_init` payload of a connection,
+// returning the context its subscriptions run within.
+type AuthenticateFunc func(ctx context.Context, r *http.Request, payload map[string]interface{}) (context.Context, error)
+
+// NewAuthenticateFunc checks the `Authorization` entry of the
+// `connection_init` payload (with or without the `Bearer ` prefix) against
+// `auth`. A missing entry is checked as an empty token, which only
+// authenticators not requiring authentication accept.
+func NewAuthenticateFunc(auth authenticator.Authenticator) AuthenticateFunc {
+	return func(ctx context.Context, r *http.Request, payload map[string]interface{}) (context.Context, error) {
+		var token string
+		if tokenObject, found := payload["Authorization"]; found {
+			tokenString, ok := tokenObject.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected 'Authorization' to be of string type")
+			}
+
+			token = strings.TrimPrefix(tokenString, "Bearer ")
+		}
+
+		return auth.Check(ctx, token, authenticator.RealIPFromRequest(r))
+	}
+}
+
+type Handler struct {
+	service      Service
+	authenticate AuthenticateFunc
+	upgrader     websocket.Upgrader
+
+	initTimeout  time.Duration
+	writeTimeout time.Duration
+	readLimit    int64
+}
+
+type Option func(h *Handler)
+
+// WithInitTimeout sets how long a client has to send `connection_init`
+// after connecting.
+func WithInitTimeout(timeout time.Duration) Option {
+	return func(h *Handler) {
+		h.initTimeout = timeout
+	}
+}
+
+// WithWriteTimeout sets a timeout for outgoing messages.
+func WithWriteTimeout(timeout time.Duration) Option {
+	return func(h *Handler) {
+		h.writeTimeout = timeout
+	}
+}
+
+func NewHandler(service Service, authenticate AuthenticateFunc, opts ...Option) *Handler {
+	h := &Handler{
+		service:      service,
+		authenticate: authenticate,
+		upgrader: websocket.Upgrader{
+			CheckOrigin:  func(r *http.Request) bool { return true },
+			Subprotocols: []string{Subprotocol},
+		},
+		initTimeout:  10 * time.Second,
+		writeTimeout: 10 * time.Second,
+		readLimit:    40 * 1024,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// IsRequest returns whether `r` is a websocket upgrade asking for the
+// GraphQL over WebSocket subprotocol.
+func IsRequest(r *http.Request) bool {
+	if !websocket.IsWebSocketUpgrade(r) {
+		return false
+	}
+
+	for _, protocol := range websocket.Subprotocols(r) {
+		if protocol == Subprotocol {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !IsRequest(r) {
+		http.Error(w, fmt.Sprintf("expecting a websocket connection using the %q subprotocol", Subprotocol), http.StatusBadRequest)
+		return
+	}
+
+	ws, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		zlog.Debug("unable to upgrade HTTP connection", zap.Error(err))
+		return
+	}
+
+	ws.SetReadLimit(h.readLimit)
+
+	conn := newConnection(dtracing.GetTraceID(r.Context()).String(), ws, r, h)
+	go conn.run()
+}
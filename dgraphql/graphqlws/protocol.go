@@ -0,0 +1,70 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphqlws
+
+import (
+	"encoding/json"
+)
+
+// Subprotocol is the websocket subprotocol negotiated by clients speaking the
+// GraphQL over WebSocket protocol (`graphql-ws` library, Apollo Client's
+// `GraphQLWsLink`, urql, ...), not to be confused with the legacy
+// `subscriptions-transport-ws` protocol that uses the `graphql-ws` name.
+const Subprotocol = "graphql-transport-ws"
+
+type messageType string
+
+// https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md
+const (
+	typeConnectionInit messageType = "connection_init"
+	typeConnectionAck  messageType = "connection_ack"
+	typePing           messageType = "ping"
+	typePong           messageType = "pong"
+	typeSubscribe      messageType = "subscribe"
+	typeNext           messageType = "next"
+	typeError          messageType = "error"
+	typeComplete       messageType = "complete"
+)
+
+// Close codes the protocol uses to terminate a connection on errors.
+const (
+	closeBadRequest            = 4400
+	closeUnauthorized          = 4401
+	closeForbidden             = 4403
+	closeInitTimeout           = 4408
+	closeSubscriberExists      = 4409
+	closeTooManyInitialization = 4429
+)
+
+type message struct {
+	ID      string          `json:"id,omitempty"`
+	Type    messageType     `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type subscribePayload struct {
+	OperationName string                 `json:"operationName"`
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type errorPayload struct {
+	Message string `json:"message"`
+}
+
+func errorsPayload(err error) json.RawMessage {
+	payload, _ := json.Marshal([]*errorPayload{{Message: err.Error()}})
+	return payload
+}
@@ -16,17 +16,25 @@ package dgraphql
 
 import (
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
 
 	"go.uber.org/zap"
 
+	"github.com/dfuse-io/dauth/authenticator"
 	drateLimiter "github.com/dfuse-io/dauth/ratelimiter"
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/dgraphql/graphqlws"
 	eosResolver "github.com/dfuse-io/dfuse-eosio/dgraphql/resolvers"
-	"github.com/dfuse-io/dfuse-eosio/trxdb"
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
 	pbabicodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/abicodec/v1"
+	"github.com/dfuse-io/dfuse-eosio/tracing"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	"github.com/dfuse-io/dgraphql"
 	dgraphqlApp "github.com/dfuse-io/dgraphql/app/dgraphql"
-	"github.com/dfuse-io/dgrpc"
 	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
 	pbsearch "github.com/dfuse-io/pbgo/dfuse/search/v1"
 )
@@ -36,11 +44,25 @@ type Config struct {
 	RatelimiterPlugin string
 	SearchAddr        string
 	ABICodecAddr      string
+	FluxDBAddr        string
 	BlockMetaAddr     string
 	KVDBDSN           string
 }
 
-func NewApp(config *Config) (*dgraphqlApp.App, error) {
+// App is the base dgraphql app, extended with the standard GraphQL over
+// WebSocket transport. The app listens on `HTTPListenAddr` itself, serving
+// websocket upgrades negotiating the `graphql-transport-ws` subprotocol and
+// proxying everything else (the dfuse transport included) to the base app,
+// moved to a loopback address.
+type App struct {
+	*dgraphqlApp.App
+
+	config       *Config
+	schemas      *dgraphql.Schemas
+	baseHTTPAddr string
+}
+
+func NewApp(config *Config) (*App, error) {
 	zlog.Info("new dgraphql eosio app", zap.Reflect("config", config))
 
 	schemas, err := SetupSchemas(config)
@@ -48,10 +70,78 @@ func NewApp(config *Config) (*dgraphqlApp.App, error) {
 		return nil, err
 	}
 
+	baseHTTPAddr, err := freeLoopbackAddr()
+	if err != nil {
+		return nil, fmt.Errorf("unable to find an address for the base dgraphql http server: %w", err)
+	}
+
 	dgraphqlBaseConfig := config.Config
 	dgraphqlBaseConfig.Schemas = schemas
+	dgraphqlBaseConfig.HTTPListenAddr = baseHTTPAddr
+
+	return &App{
+		App:          dgraphqlApp.New(&dgraphqlBaseConfig),
+		config:       config,
+		schemas:      schemas,
+		baseHTTPAddr: baseHTTPAddr,
+	}, nil
+}
 
-	return dgraphqlApp.New(&dgraphqlBaseConfig), nil
+func (a *App) Run() error {
+	if err := a.serveHTTP(); err != nil {
+		return err
+	}
+
+	return a.App.Run()
+}
+
+func (a *App) serveHTTP() error {
+	auth, err := authenticator.New(a.config.AuthPlugin)
+	if err != nil {
+		return fmt.Errorf("unable to initialize dauth: %w", err)
+	}
+
+	graphqlWSHandler := tracing.NewHTTPHandler(graphqlws.NewHandler(a.schemas.PublicSchema, graphqlws.NewAuthenticateFunc(auth)))
+
+	// Tracing and websocket upgrades of the proxied requests are handled by the base app
+	baseProxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: a.baseHTTPAddr})
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if graphqlws.IsRequest(r) {
+			graphqlWSHandler.ServeHTTP(w, r)
+			return
+		}
+
+		baseProxy.ServeHTTP(w, r)
+	})
+
+	server := &http.Server{Addr: a.config.HTTPListenAddr, Handler: handler}
+
+	go func() {
+		zlog.Info("serving http", zap.String("listen_addr", a.config.HTTPListenAddr), zap.String("base_listen_addr", a.baseHTTPAddr))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			a.Shutdown(fmt.Errorf("http server failed: %w", err))
+		}
+	}()
+
+	go func() {
+		<-a.Terminating()
+		server.Close()
+	}()
+
+	return nil
+}
+
+// freeLoopbackAddr returns a loopback address with a port free at the time
+// of the call.
+func freeLoopbackAddr() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer listener.Close()
+
+	return listener.Addr().String(), nil
 }
 
 var RootResolverFactory = eosResolver.NewRoot
@@ -64,7 +154,7 @@ func SetupSchemas(config *Config) (*dgraphql.Schemas, error) {
 	}
 
 	zlog.Info("creating abicodec grpc client")
-	abiConn, err := dgrpc.NewInternalClient(config.ABICodecAddr)
+	abiConn, err := tracing.NewInternalGRPCClient(config.ABICodecAddr)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting abi grpc client: %w", err)
 	}
@@ -76,9 +166,16 @@ func SetupSchemas(config *Config) (*dgraphql.Schemas, error) {
 		return nil, fmt.Errorf("failed creating blockmeta client: %w", err)
 	}
 
+	zlog.Info("creating fluxdb client")
+	fluxURL := config.FluxDBAddr
+	if !strings.HasPrefix(fluxURL, "http") {
+		fluxURL = "http://" + fluxURL
+	}
+	fluxClient := fluxdb.NewClient(fluxURL, nil)
+
 	zlog.Info("creating search grpc client")
 
-	searchConn, err := dgrpc.NewInternalClient(config.SearchAddr)
+	searchConn, err := tracing.NewInternalGRPCClient(config.SearchAddr)
 	if err != nil {
 		return nil, fmt.Errorf("failed getting search grpc client: %w", err)
 	}
@@ -88,7 +185,7 @@ func SetupSchemas(config *Config) (*dgraphql.Schemas, error) {
 	derr.Check("unable to initialize rate limiter", err)
 
 	zlog.Info("configuring resolver and parsing schemas")
-	resolver, err := RootResolverFactory(searchRouterClient, dbReader, blockMetaClient, abiClient, rateLimiter)
+	resolver, err := RootResolverFactory(searchRouterClient, dbReader, blockMetaClient, abiClient, fluxClient, rateLimiter)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create root resolver: %w", err)
 	}
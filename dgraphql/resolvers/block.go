@@ -20,10 +20,10 @@ import (
 	"strings"
 
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 	"github.com/dfuse-io/dgraphql"
 	"github.com/dfuse-io/dgraphql/analytics"
 	commonTypes "github.com/dfuse-io/dgraphql/types"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/kvdb"
 	"github.com/dfuse-io/logging"
 	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
@@ -50,7 +50,7 @@ func (r *Root) QueryBlock(ctx context.Context, req QueryBlockRequest) (*Block, e
 	// WARNING: Ingress / Egress bytess is taken care by the middleware
 	//////////////////////////////////////////////////////////////////////
 	// TODO: How could we include the total outbound docs count since it's composed?
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:         "dgraphql",
 		Kind:           "GraphQL Query",
 		Method:         "Block",
@@ -229,7 +229,7 @@ func (b *Block) TransactionTraces(ctx context.Context, req *TransactionTracesReq
 	//////////////////////////////////////////////////////////////////////
 	// TODO: maybe we should find a way to link this event with the one under trxTrace..
 	//       then again, maybe not.
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:         "dgraphql",
 		Kind:           "GraphQL Query",
 		Method:         "TransactionTraces",
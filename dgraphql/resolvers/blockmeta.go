@@ -20,10 +20,10 @@ import (
 	"time"
 
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 	"github.com/dfuse-io/dgraphql"
 	"github.com/dfuse-io/dgraphql/analytics"
 	commonTypes "github.com/dfuse-io/dgraphql/types"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/kvdb"
 	"github.com/dfuse-io/logging"
 	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
@@ -55,7 +55,7 @@ func (r *Root) QueryBlockIDAtAccountCreation(ctx context.Context, args BlockIDAt
 			// Billable event on GraphQL Query - One Request, Many Oubound Documents
 			// WARNING: Ingress / Egress bytess is taken care by the middleware
 			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
+			metering.EmitWithContext(metering.Event{
 				Source:         "dgraphql",
 				Kind:           "GraphQL Query",
 				Method:         "BlockIDAtAccountCreation",
@@ -76,7 +76,7 @@ func (r *Root) QueryBlockIDAtAccountCreation(ctx context.Context, args BlockIDAt
 	// Billable event on GraphQL Query - One Request, Many Oubound Documents
 	// WARNING: Ingress / Egress bytess is taken care by the middleware
 	//////////////////////////////////////////////////////////////////////
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:         "dgraphql",
 		Kind:           "GraphQL Query",
 		Method:         "BlockIDAtAccountCreation",
@@ -127,7 +127,7 @@ func (r *Root) QueryBlockIDByTime(ctx context.Context, args BlockIDByTimeArgs) (
 		// Billable event on GraphQL Query - One Request, Many Oubound Documents
 		// WARNING: Ingress / Egress bytess is taken care by the middleware
 		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
+		metering.EmitWithContext(metering.Event{
 			Source:         "dgraphql",
 			Kind:           "GraphQL Query",
 			Method:         "BlockIDByTime",
@@ -145,7 +145,7 @@ func (r *Root) QueryBlockIDByTime(ctx context.Context, args BlockIDByTimeArgs) (
 		// Billable event on GraphQL Query - One Request, Many Oubound Documents
 		// WARNING: Ingress / Egress bytess is taken care by the middleware
 		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
+		metering.EmitWithContext(metering.Event{
 			Source:         "dgraphql",
 			Kind:           "GraphQL Query",
 			Method:         "BlockIDByTime",
@@ -165,7 +165,7 @@ func (r *Root) QueryBlockIDByTime(ctx context.Context, args BlockIDByTimeArgs) (
 	// Billable event on GraphQL Query - One Request, Many Oubound Documents
 	// WARNING: Ingress / Egress bytess is taken care by the middleware
 	//////////////////////////////////////////////////////////////////////
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:         "dgraphql",
 		Kind:           "GraphQL Query",
 		Method:         "BlockIDByTime",
@@ -28,6 +28,8 @@ import (
 	rateLimiter "github.com/dfuse-io/dauth/ratelimiter"
 	"github.com/dfuse-io/dfuse-eosio/codec"
 	"github.com/dfuse-io/dfuse-eosio/dgraphql/types"
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	pbabicodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/abicodec/v1"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
@@ -37,7 +39,6 @@ import (
 	"github.com/dfuse-io/dgraphql/metrics"
 	commonTypes "github.com/dfuse-io/dgraphql/types"
 	"github.com/dfuse-io/dhammer"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/logging"
 	"github.com/dfuse-io/opaque"
 	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
@@ -57,11 +58,12 @@ type Root struct {
 	blockmetaClient               *pbblockmeta.Client
 	chainDiscriminatorClient      *pbblockmeta.ChainDiscriminatorClient
 	abiCodecClient                pbabicodec.DecoderClient
+	fluxClient                    fluxdb.Client
 	requestRateLimiter            rateLimiter.RateLimiter
 	requestRateLimiterLastLogTime time.Time
 }
 
-func NewRoot(searchClient pbsearch.RouterClient, dbReader trxdb.DBReader, blockMetaClient *pbblockmeta.Client, abiCodecClient pbabicodec.DecoderClient, requestRateLimiter rateLimiter.RateLimiter) (interface{}, error) {
+func NewRoot(searchClient pbsearch.RouterClient, dbReader trxdb.DBReader, blockMetaClient *pbblockmeta.Client, abiCodecClient pbabicodec.DecoderClient, fluxClient fluxdb.Client, requestRateLimiter rateLimiter.RateLimiter) (interface{}, error) {
 	return &Root{
 		searchClient:       searchClient,
 		trxsReader:         dbReader,
@@ -69,6 +71,7 @@ func NewRoot(searchClient pbsearch.RouterClient, dbReader trxdb.DBReader, blockM
 		accountsReader:     dbReader,
 		blockmetaClient:    blockMetaClient,
 		abiCodecClient:     abiCodecClient,
+		fluxClient:         fluxClient,
 		requestRateLimiter: requestRateLimiter,
 	}, nil
 }
@@ -82,6 +85,13 @@ type SearchArgs struct {
 	Limit            types.Int64
 	Cursor           *string
 	IrreversibleOnly bool
+	WithStateAfter   *[]TableRefInput
+}
+
+type TableRefInput struct {
+	Account string
+	Table   string
+	Scope   string
 }
 
 func (r *Root) QuerySearchTransactionsForward(ctx context.Context, args SearchArgs) (*SearchTransactionsForwardResponse, error) {
@@ -116,7 +126,7 @@ func (r *Root) QuerySearchTransactionsForward(ctx context.Context, args SearchAr
 	// Billable event on GraphQL Query - One Request, Many Oubound Documents
 	// WARNING: Ingress / Egress bytess is taken care by the middleware
 	//////////////////////////////////////////////////////////////////////
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:         "dgraphql",
 		Kind:           "GraphQL Query",
 		Method:         "SearchTransactionsForward",
@@ -171,7 +181,7 @@ func (r *Root) QuerySearchTransactionsBackward(ctx context.Context, args SearchA
 	// Billable event on GraphQL Query - One Request, Many Oubound Documents
 	// WARNING: Ingress / Egress bytess is taken care by the middleware
 	//////////////////////////////////////////////////////////////////////
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:         "dgraphql",
 		Kind:           "GraphQL Query",
 		Method:         "SearchTransactionsBackward",
@@ -193,6 +203,11 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 		return nil, dgraphql.Errorf(ctx, "Invalid limit for this query: max 1000")
 	}
 
+	snapshotter, err := r.newStateSnapshotter(ctx, args.WithStateAfter)
+	if err != nil {
+		return nil, err
+	}
+
 	zlogger := logging.Logger(ctx, zlog)
 	zlogger.Info("executing search query",
 		zap.String("query", args.Query),
@@ -300,6 +315,15 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 			out.trxTrace = lifecycle.ExecutionTrace
 		}
 
+		if snapshotter != nil {
+			stateAfter, err := snapshotter.SnapshotsAt(ctx, eos.BlockNum(out.blockID))
+			if err != nil {
+				return nil, err
+			}
+
+			out.stateAfter = newTableSnapshots(stateAfter)
+		}
+
 		zlogger.Debug("sending message", zap.String("trx_id", match.TrxIdPrefix))
 		res = append(res, out)
 	}
@@ -307,6 +331,30 @@ func (r *Root) querySearchTransactionsBoth(ctx context.Context, forward bool, ar
 	return res, nil
 }
 
+// newStateSnapshotter returns the snapshotter resolving the `withStateAfter`
+// table scopes of a query, nil when there are none. It is scoped to the query,
+// its results being shared by the matches of a same block.
+func (r *Root) newStateSnapshotter(ctx context.Context, withStateAfter *[]TableRefInput) (*fluxdb.StateSnapshotter, error) {
+	if withStateAfter == nil || len(*withStateAfter) == 0 {
+		return nil, nil
+	}
+
+	if len(*withStateAfter) > fluxdb.MaxTableRefs {
+		return nil, dgraphql.Errorf(ctx, "Invalid withStateAfter for this query: max %d table scopes", fluxdb.MaxTableRefs)
+	}
+
+	var refs []*fluxdb.TableRef
+	for _, in := range *withStateAfter {
+		if in.Account == "" || in.Table == "" || in.Scope == "" {
+			return nil, dgraphql.Errorf(ctx, "Invalid withStateAfter for this query: account, table and scope are all required")
+		}
+
+		refs = append(refs, &fluxdb.TableRef{Account: eos.AccountName(in.Account), Table: eos.TableName(in.Table), Scope: eos.Name(in.Scope)})
+	}
+
+	return fluxdb.NewStateSnapshotter(r.fluxClient, refs), nil
+}
+
 // CAREFUL - this mirrored in the BigQuery schema - if you change this, make sure to be backwards compatible
 type StreamSearchArgs struct {
 	Query              string
@@ -472,7 +520,7 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 	} else {
 		billingEventDirectionSuffix = "Backward"
 	}
-	dmetering.EmitWithContext(dmetering.Event{
+	metering.EmitWithContext(metering.Event{
 		Source:        "dgraphql",
 		Kind:          "GraphQL Subscription",
 		Method:        "SearchTransactions" + billingEventDirectionSuffix,
@@ -578,7 +626,7 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 				// Billable event on GraphQL Subscriptions
 				// WARNING : Here we only track outbound documents
 				//////////////////////////////////////////////////////////////////////
-				dmetering.EmitWithContext(dmetering.Event{
+				metering.EmitWithContext(metering.Event{
 					Source:         "dgraphql",
 					Kind:           "GraphQL Subscription",
 					Method:         "SearchTransactions" + billingEventDirectionSuffix,
@@ -611,7 +659,7 @@ func (r *Root) streamSearchTracesBoth(forward bool, ctx context.Context, args St
 						// WARNING : Here we only track outbound documents
 						//////////////////////////////////////////////////////////////////////
 						documentCount++
-						dmetering.EmitWithContext(dmetering.Event{
+						metering.EmitWithContext(metering.Event{
 							Source:         "dgraphql",
 							Kind:           "GraphQL Subscription",
 							Method:         "SearchTransactions" + billingEventDirectionSuffix,
@@ -673,6 +721,7 @@ type SearchTransactionBackwardResponse struct {
 
 	irreversibleBlockNum  uint32
 	matchingActionIndexes []uint32
+	stateAfter            []*TableSnapshot
 
 	//FIXME: shouldn't this be shared betweeen the two Single search responses?
 	ResolverError error
@@ -708,6 +757,14 @@ func (t *SearchTransactionBackwardResponse) Block() *BlockHeader {
 	}
 }
 
+func (t *SearchTransactionBackwardResponse) StateAfter() *[]*TableSnapshot {
+	if t.stateAfter == nil {
+		return nil
+	}
+
+	return &t.stateAfter
+}
+
 func (t *SearchTransactionBackwardResponse) Trace() *TransactionTrace {
 	if t.trxIDPrefix == "" {
 		return nil
@@ -20,10 +20,13 @@ import (
 	"os"
 	"testing"
 
+	"github.com/dfuse-io/dfuse-eosio/dgraphql/types"
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbsearcheos "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/search/v1"
 	"github.com/dfuse-io/dgraphql"
+	commonTypes "github.com/dfuse-io/dgraphql/types"
 	"github.com/dfuse-io/dtracing"
 	"github.com/dfuse-io/logging"
 	pbsearch "github.com/dfuse-io/pbgo/dfuse/search/v1"
@@ -175,6 +178,55 @@ func TestSubscriptionSearchForward(t *testing.T) {
 	}
 }
 
+func TestQuerySearchWithStateAfter(t *testing.T) {
+	ctx := dtracing.NewFixedTraceIDInContext(context.Background(), "00000000000000000000000000000000")
+
+	newMatch := func(trxID string, blockID string) *pbsearch.SearchMatch {
+		cs, err := ptypes.MarshalAny(&pbsearcheos.Match{
+			Block: &pbsearcheos.BlockTrxPayload{BlockID: blockID, Trace: &pbcodec.TransactionTrace{}},
+		})
+		require.NoError(t, err)
+
+		return &pbsearch.SearchMatch{TrxIdPrefix: trxID, ChainSpecific: cs}
+	}
+
+	root := &Root{
+		searchClient: pbsearch.NewTestRouterClient([]interface{}{
+			newMatch("trx000", "00000005aa"),
+			newMatch("trx001", "00000005aa"),
+		}),
+		fluxClient: fluxdb.NewTestFluxClient().SetGetTablesMultiScopesResponse(`{"tables":[{"scope":"alice","rows":[{"balance":"1.0000 EOS"}]}]}`, nil),
+	}
+
+	res, err := root.querySearchTransactionsBoth(ctx, true, SearchArgs{
+		Limit:          types.Int64(10),
+		WithStateAfter: &[]TableRefInput{{Account: "eosio.token", Table: "accounts", Scope: "alice"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	for _, response := range res {
+		stateAfter := response.StateAfter()
+		require.NotNil(t, stateAfter)
+		require.Len(t, *stateAfter, 1)
+
+		snapshot := (*stateAfter)[0]
+		assert.Equal(t, "eosio.token", snapshot.Account())
+		assert.Equal(t, "alice", snapshot.Scope())
+		assert.Equal(t, commonTypes.Uint32(5), snapshot.BlockNum())
+		assert.JSONEq(t, `[{"balance":"1.0000 EOS"}]`, string(*snapshot.Rows()))
+		assert.Nil(t, snapshot.Error())
+	}
+
+	tooMany := make([]TableRefInput, fluxdb.MaxTableRefs+1)
+	for i := range tooMany {
+		tooMany[i] = TableRefInput{Account: "eosio.token", Table: "accounts", Scope: "alice"}
+	}
+
+	_, err = root.querySearchTransactionsBoth(ctx, true, SearchArgs{Limit: types.Int64(10), WithStateAfter: &tooMany})
+	require.Error(t, err)
+}
+
 //
 //import (
 //	"context"
@@ -0,0 +1,57 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resolvers
+
+import (
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	commonTypes "github.com/dfuse-io/dgraphql/types"
+)
+
+// TableSnapshot resolves the rows of a table scope requested through the
+// `withStateAfter` argument of search queries.
+type TableSnapshot struct {
+	s *fluxdb.TableSnapshot
+}
+
+func newTableSnapshots(in []*fluxdb.TableSnapshot) (out []*TableSnapshot) {
+	out = make([]*TableSnapshot, len(in))
+	for i, snapshot := range in {
+		out[i] = &TableSnapshot{s: snapshot}
+	}
+
+	return out
+}
+
+func (t *TableSnapshot) Account() string              { return t.s.Account }
+func (t *TableSnapshot) Table() string                { return t.s.Table }
+func (t *TableSnapshot) Scope() string                { return t.s.Scope }
+func (t *TableSnapshot) BlockNum() commonTypes.Uint32 { return commonTypes.Uint32(t.s.BlockNum) }
+
+func (t *TableSnapshot) Rows() *commonTypes.JSON {
+	if t.s.Rows == nil {
+		return nil
+	}
+
+	rows := commonTypes.JSON(t.s.Rows)
+	return &rows
+}
+
+func (t *TableSnapshot) Error() *string {
+	if t.s.Error == "" {
+		return nil
+	}
+
+	return &t.s.Error
+}
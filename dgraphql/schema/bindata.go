@@ -115,7 +115,7 @@ func blockmetaGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x4f\x6f\x1b\xb7\x13\xbd\xfb\x53\xcc\xcf\xbf\x8b\x13\x28\x82\xec\x26\x39\x08\xf0\x41\xb2\x5d\xc4\xa8\x6d\xb5\xb2\xda\x02\x39\xed\x88\x9c\xdd\x25\xc2\x1d\x6e\xf8\x47\xea\xa6\xe8\x77\x2f\x48\xae\xa4\x75\x60\x17\x45\x9b\x22\x2d\xe0\x5c\x76\xb5\x24\x67\xde\xcc\x7b\xf3\x18\xfb\xae\x25\xf8\x21\x90\xed\xe0\xd7\x23\x00\x80\xe3\xe3\xe3\xf4\xbc\x27\xb4\xa2\x06\x5f\x13\xac\xb5\x11\x1f\x44\x8d\x8a\xa1\x34\x76\x8b\x56\xc6\x27\x78\x8b\xec\x50\x78\x65\x18\x5e\xd2\x2f\x24\x42\x7a\xf5\x16\x05\xb9\x97\xb0\x46\x47\x12\x0c\x43\xf1\x31\x86\x2f\xc6\x47\x29\xee\xcf\x35\x71\x8a\x6a\xc9\x07\xcb\x24\xa1\x10\xc1\x3a\x63\x0b\x50\x0e\xa8\x69\x7d\x37\x02\xe5\xa1\x21\x64\x07\x9d\x09\x50\xe3\x26\xee\x46\x51\x93\x4c\x27\x89\x25\x98\x32\xbd\xba\x96\x84\x2a\x15\xc9\x0c\x32\x65\xb0\xc8\x15\xed\xb2\xcd\x96\x77\x53\x40\xbd\xc5\xce\x81\x30\xec\x94\x24\x9b\x4e\x16\x81\xa5\x29\xa0\x54\xa4\x25\x0c\x2a\x73\xa9\x6e\x72\x23\xd8\xd6\x4a\xd4\xe0\x54\xc5\xa8\xc1\xd7\xe8\xd3\xb9\x06\xbd\xa8\x15\x57\x40\x9a\x1a\x62\x9f\xd2\x6c\xd1\xa5\x18\x28\x3c\x2c\xaf\x6e\x17\x3f\x5d\x5d\x42\x69\x4d\x93\x4e\xe4\xce\xad\x49\x60\x70\x14\x81\x27\xa8\x0e\x2c\x19\x5b\x21\xab\x4f\x18\xfb\xd6\x03\xbe\x27\x02\xd4\xce\xe4\xea\xbc\x25\x6c\x62\xb2\x0d\x59\x17\x9b\x1b\x38\xe2\x2f\xee\xc3\xda\x09\xab\xda\x78\xb0\x78\x40\x5b\x86\xbf\x3a\x50\xe3\xbe\xcd\x85\x9d\xa4\xe5\xb4\x55\x96\x11\x48\x4f\x70\xe6\xfe\x06\xb9\x0a\x58\xa5\x94\x8a\xab\xe3\xfd\xe6\xc4\xdd\x14\xee\xd3\xe7\xff\x1d\x1d\x82\xdc\x98\x2d\xd9\x5c\x0a\x70\x68\x60\x6d\x02\x4b\xb4\x91\x3c\x16\x3a\x38\xb5\x21\xdd\x8d\x61\x06\x4c\x15\x7a\xb5\x21\xd8\xa0\x0e\xd4\xf3\x8a\xfd\x49\x4b\x3a\x2f\xfa\x5c\x71\x4d\x28\xc1\x58\xd0\xe8\x3c\x28\x6b\x29\x15\xbe\xd6\xbd\x08\xe1\x44\x52\x4b\x2c\x63\x4b\xa2\xb2\x86\x3b\x16\xac\xbb\xe2\xc5\xf8\x00\x5d\x9b\xed\x3c\x1e\xba\x0b\xcd\x14\xae\xd9\xbf\x7d\x3d\x80\xff\x4e\x55\xf5\x9f\xc3\xff\x89\xac\x89\x90\xbe\x5a\x1d\xb5\xaa\xea\xa7\x0b\x59\xb4\xf8\x31\x10\x48\xf4\x08\xad\x22\x41\x59\xaa\x71\x70\x04\x32\xb4\xe8\x1c\xac\x51\x7c\x88\xc8\x84\x61\xaf\x38\x50\x5c\xb5\xbd\x54\x40\x95\x71\xdc\x62\x7a\x90\xca\x09\xc3\x4c\xc2\x93\x1c\xc3\x92\xbc\x55\xb4\xa1\xb8\xbc\x17\xf3\x7e\x58\xf7\x83\x93\x67\xd9\xb5\x86\x1d\xb9\x3c\x97\xca\x81\x40\xad\xc7\x70\xed\xe3\x50\x3b\x2c\x53\x63\xa2\xea\x92\xac\xb1\x21\xc8\x71\x62\x80\xf9\x62\xf5\x0e\xa4\xb2\x94\xf5\x0a\x27\xbb\x51\x44\x96\x09\x7a\xfc\x31\x6c\x48\x3e\xba\x13\xe5\x50\x93\xaa\x51\x79\x4a\x39\x34\x6b\xb2\x11\x8d\x25\x17\xb4\x77\xd0\x92\x85\x36\x2a\x7c\xf0\xed\x44\x52\x89\xe9\xcd\x1b\x38\x9d\x4c\x1e\xa8\x27\xc6\xea\xdb\x0d\xe7\x71\x75\x90\x28\x9b\x98\x0d\x34\x02\xc3\xba\xeb\x07\x35\xf7\x79\x17\xdc\x70\xe2\x82\xba\xcc\x41\x44\x75\xa0\x59\x69\xe5\xbb\xbd\xe6\xc6\xb0\xf0\x35\xd9\xad\x72\x34\x02\xd4\xda\x6c\xa1\xa4\xde\x66\x76\xe1\x42\xfb\x40\x5b\x49\x46\x03\xb8\x9f\x2b\x68\x0a\x73\x63\x34\x21\xc3\x39\x94\xa8\x1d\xa5\x9d\x2f\xa6\xfd\xd8\x3f\x62\x10\xcb\x9e\xc3\x7e\xc8\xff\xf8\x1e\xd8\xd1\xf2\x9f\xb9\x08\xbe\xbc\xaf\xce\xfb\x16\x7c\x35\x63\xcd\x3e\x64\x4a\x98\xf4\x3d\x4a\x1c\x51\xa5\x98\x93\xaf\x94\x87\xdb\xe7\xd9\x87\x9f\x7d\xf8\xd9\x87\xff\xe5\x3e\xbc\x33\x94\xcf\x8c\xf8\xff\xf0\xea\x2f\xfd\xeb\x0f\xcf\x6f\x16\x17\xdf\xc1\xed\xd5\x6a\xf6\xf7\xa3\xed\xcc\x70\x99\x1c\xfb\x70\x27\xc0\xf5\x25\x94\xb1\x87\x80\x36\x3d\xe2\x4a\xa5\x36\xc4\x50\x78\xd5\x50\x31\x3a\x5c\x02\x59\xbc\xa6\x69\xd1\xa2\x8f\x02\x6e\xad\xd9\x28\x49\x72\xfc\x20\x45\x8a\x7b\x7d\x39\xef\x56\xaa\xa1\x81\xc5\xc6\x9f\xce\x63\xd3\xa6\x9b\x27\xc7\x51\xce\xf0\xa8\xff\x3f\x7c\x83\x1e\xce\x26\x93\xb7\xaf\x26\xa7\xaf\x26\x67\xab\xd3\x37\xd3\xc9\xeb\xe9\xe4\xcd\xfb\x68\x02\x8f\x7c\x1f\x9f\x9e\x7d\xf3\xfe\xc0\x5e\x04\x3b\x85\x98\x63\xe8\xc8\x03\xc5\xef\x71\x4f\xe1\x62\x71\xfb\xfd\x6c\x39\x5b\x2d\x96\x70\x0e\x37\xab\xab\x1d\xb1\xf3\x8c\xfc\xcb\xb2\x38\xbb\xb8\x58\xfc\x78\xb7\xfa\xa7\x79\xbc\xcb\xe3\xba\xad\xc9\xee\x09\x44\x21\x4c\x60\x5f\xa4\x3f\x72\x84\x25\xf4\x4f\x70\x35\xf3\xb3\xbc\xf5\x22\x6e\x52\x86\x4f\x1e\x6b\x61\x1f\xee\x70\xf3\x3d\xd5\xb6\xdf\x8e\x7e\x0f\x00\x00\xff\xff\xd6\x89\x1a\x9c\x9c\x0e\x00\x00")
+var _queryGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\xdf\x6f\xdb\x36\x10\x7e\xcf\x5f\x71\xcd\x5e\x9c\xc2\x31\xe4\xac\xed\x83\x80\x3d\xd8\x6e\x86\x1a\x4b\xe2\xcd\x76\x37\xa0\xc3\x00\xd1\xd4\xc9\x22\x26\x91\x1a\x49\x59\x55\x87\xfd\xef\x3d\x92\x92\xad\x14\xc9\x30\x6c\xdd\x8a\x01\xf1\x8b\x25\xfe\xb8\xfb\xee\xbe\xef\xee\x20\xdb\x56\x08\x3f\xd4\xa8\x5b\xf8\xfd\x0c\xe8\x77\x7e\x7e\xee\xff\x37\xc8\x34\xcf\xc1\xe6\x08\xbb\x42\xf1\x5f\x79\xce\x84\x84\x4c\xe9\x86\xe9\xd4\xfd\x83\xd5\x4c\x1a\xc6\xad\x50\x12\x9e\xe3\x7b\xe4\xb5\x7f\xa4\x65\x8e\xe6\x39\xec\x98\xc1\x14\x68\x21\xf9\xcd\x99\x4f\x26\x67\xde\xee\x4f\x39\x4a\x6f\x55\xa3\xad\xb5\xa4\x23\x09\xaf\xb5\x51\x3a\x01\x61\x00\xcb\xca\xb6\x63\x10\x16\x4a\x24\xeb\xd0\xaa\x1a\x72\x76\x70\xa7\x19\xcf\xe9\xb0\xbb\x89\x92\xec\x66\xfe\xd1\x54\xc8\x45\x26\x68\xc3\x83\xf4\x1e\x08\xd6\x1e\x7b\x6f\xb3\xf5\x5d\x0c\xac\x68\x58\x6b\x80\x2b\x69\x44\x8a\xda\xdf\x4c\x6a\x99\xaa\x04\xe8\x6e\x91\xc2\x20\x32\xe3\xe3\x46\x33\x86\x26\x17\x94\x00\x23\xf6\x92\x15\x74\x85\x59\x7f\xaf\x64\x96\xe7\x42\xee\x01\x0b\x2c\x51\x5a\xef\xa6\x61\xc6\xdb\xa0\x6c\xc0\xfa\xfa\x76\xf5\xe3\xf5\x6b\xc8\xb4\x2a\xfd\x8d\x90\xb9\x1d\x72\x56\x1b\x74\xc0\x3d\x54\x43\x21\x29\xbd\x67\x52\x7c\x60\x2e\x6f\x1d\xe0\x0d\x22\xc1\x35\x2a\x44\x67\x29\xec\xd2\x39\x3b\xa0\x36\x2e\xb9\x04\x9a\xf0\x27\x9b\x7a\x67\xb8\x16\x95\xbb\x98\xdc\xa3\x2d\xc0\xdf\x9e\xa8\x31\xdf\x86\xc0\x46\x7e\xdb\x1f\x4d\x33\x07\xa4\x23\x38\x70\x7f\x43\x39\xab\xd9\xde\xbb\x24\x7f\xe7\xc7\xc3\x9e\xbb\x18\x36\x7e\xf9\xd9\xd9\xc9\xc8\x8d\x6a\x08\x89\x0f\x05\x64\x5d\xc2\x4e\x11\x36\xa6\x1d\x79\x92\x17\xb5\x11\x07\x2c\xda\x09\xcc\x40\xe2\x9e\x02\x24\x0a\x0f\xac\xa8\xb1\xe3\x95\x75\x37\x35\x16\x61\xd3\x86\x88\x73\x64\xc4\xad\x86\x82\x19\x0b\x42\x6b\xf4\x81\xef\x8a\x4e\x84\x30\x4a\xb1\x22\xfa\x5d\x4a\x9c\xb2\x86\x27\x56\xb2\x68\x93\x8b\xc9\x09\x7a\xa1\x9a\xb9\xbb\x74\x57\x97\x31\x2c\xa5\x7d\xf5\x62\x00\xff\x8d\xd8\xe7\x7f\x0d\xff\x07\xd4\xca\x41\xfa\x62\x71\xe4\x04\xf5\xf1\x40\x56\x15\x23\x8e\x20\x65\x96\x41\x25\x90\x63\x90\xaa\x2b\x1c\xce\x24\x54\xcc\x18\x2a\x45\x72\x49\xc8\xa8\x00\xac\x90\x74\x9a\x76\x75\x27\x15\x10\x99\x2b\x37\xe7\x1e\x52\x61\xe8\x88\x44\x6e\x31\x9d\xc0\x1a\x89\x74\x5a\x77\xdb\x47\x31\x1f\x8b\xf5\x58\x38\xa1\x96\x4d\x45\x52\x43\x13\xea\x92\x0a\x99\xb3\xa2\x98\xc0\xd2\xba\xa2\x36\x2c\xf3\x89\x71\xaa\xf3\xb2\x66\x25\x55\x85\xb7\xe3\x0c\xcc\x57\xdb\x37\xe4\x5a\x63\xd0\x2b\x8c\xfa\x52\x64\x54\xe7\x0e\xba\x7b\x19\x26\x24\x5c\xed\x45\x39\xd4\xa4\x28\x45\xa8\x52\x62\x74\x47\x01\x11\x1a\x82\x56\x17\xd6\x40\x45\xaf\x95\x53\xf8\x60\x8d\x58\xc8\x98\x7f\x22\x74\xd3\x28\xba\xa7\x1e\x67\xab\x4b\x37\x7c\xe3\x76\x07\x8e\x42\x13\xd3\x35\x8e\x89\xbe\xa2\xed\x0a\x35\xe4\xb9\x37\xae\xa4\xe7\x02\xdb\xc0\x81\x43\x75\xa2\x59\x14\xc2\xb6\x47\xcd\x4d\x60\x45\xdb\xba\x11\x86\x0c\x52\xe2\x54\x03\x19\x76\x6d\xa6\x37\x57\x57\xf7\xb4\xe5\x65\x34\x80\xfb\xa9\x82\x62\x98\x2b\x55\x90\x46\x09\x7b\x46\x0d\x05\x07\xe8\xb7\xcc\x09\x91\xa8\xae\x88\xb0\x11\x89\xa5\x54\xa4\xd2\x69\x74\x41\x2d\x4f\x11\x47\x5a\x35\xa4\x6c\x3d\x68\xd1\x1d\xcd\x89\xb1\xcc\xe2\x2c\xb3\x78\x14\x00\x65\xd3\x75\xe6\x0e\x26\xa1\x37\x7d\x6b\x1e\x74\xe9\xa0\x79\xf7\x34\x9c\x19\xae\x69\x86\xb1\xe1\x3d\x0c\x82\x69\x84\xcd\x37\x47\x57\x31\xfc\xec\x11\xaf\x31\x5b\xca\xaa\xb6\xcf\x7e\xf1\x07\x2f\xe2\xae\x85\x3d\xd0\xec\xd6\x9d\x1e\xbb\x86\xf5\xe7\x33\xad\x97\xd8\xff\x66\xa8\x7d\xfe\x19\x31\xef\x52\xf0\xc5\x86\x44\xe8\xa9\x14\x7f\xd4\xe5\xc8\x73\x84\x7b\x21\xa5\xef\x91\xd9\x69\x92\x3e\xcd\x94\xa7\x99\xf2\x34\x53\x9e\x66\xca\x7f\x36\x53\xfa\xe6\xf8\xc9\x50\xf9\x0a\x2e\xff\xd6\xaf\xbb\x3c\xbf\x59\x2d\xbe\x83\xdb\xeb\xed\xec\x9f\x5b\xeb\x1b\xfb\xda\xe7\x76\x90\x9e\x25\x7d\x86\x38\x3d\x50\xe6\xfd\x9f\xdb\xd9\x53\x4b\xa1\xf6\x60\x45\x89\xc9\xf8\x34\xd0\x42\x21\xaa\xb2\x62\x9a\x59\x57\x8c\x95\x56\x07\xfa\x5a\x4a\x27\xf7\x5c\x78\xbb\xcb\xd7\xf3\x76\x4b\xf7\x07\xe3\xc2\xbd\x12\x93\x65\xe5\xa7\x68\xb0\x23\x8c\x92\xe3\xee\xdb\x8a\x3e\x9b\xe0\x2a\x8a\x5e\x5d\x46\xd3\xcb\xe8\x6a\x3b\x7d\x19\x47\x2f\xe2\xe8\xe5\x3b\xd7\xd0\x1e\x58\x9f\x4c\xaf\xbe\x7e\x77\x22\xcf\x81\x8d\xc1\xf9\x18\x4e\x97\x41\xf5\x1e\x71\xc7\xb0\x58\xdd\x7e\x3f\x5b\xcf\xb6\xab\x35\xc9\xf4\x66\x7b\xdd\x13\x3b\x0f\xc8\x3f\x2f\x8b\xb3\xc5\x62\xf5\xf6\x6e\xfb\x6f\xf3\x78\x17\x5a\x4f\x43\x05\x7d\x24\x90\x71\x4e\x9c\xda\xc4\x6b\x9e\x53\xaf\xb0\x8f\x70\x35\xb3\xb3\x70\x74\xe1\x0e\x91\xa2\x47\x0f\xa5\xb0\x33\x77\x9a\xe2\x8f\xa5\xed\x8f\xb3\x8f\x93\x70\x35\x5f\x34\x10\x00\x00")
 
 func queryGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -130,7 +130,7 @@ func queryGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "query.graphql", size: 3740, mode: os.FileMode(420), modTime: time.Unix(1587602721, 0)}
+	info := bindataFileInfo{name: "query.graphql", size: 4148, mode: os.FileMode(420), modTime: time.Unix(1792132951, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -175,7 +175,7 @@ func schemaGraphql() (*asset, error) {
 	return a, nil
 }
 
-var _search_transactionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xcb\x6e\xe4\x36\x10\xbc\xf3\x2b\xda\xe3\x8b\xbd\x70\x74\x48\x6e\x73\x1b\x6f\x9c\xc4\xc0\x62\x8c\xd8\xde\x2c\x82\x20\x80\x5a\x64\x6b\x48\x98\x22\xb5\x7c\x8c\x22\x04\xf9\xf7\xa0\x29\x8d\xe7\x61\x3b\x87\x5c\x92\x05\xf6\x26\x88\xfd\x28\x56\x57\x17\xcf\xc5\x39\xc0\x3d\xc5\xde\xbb\x48\x11\x5a\x1f\xe0\xe7\x4c\x61\x14\xe7\x42\xa4\xb1\x27\x78\x20\x0c\x52\x3f\x06\x74\x11\x65\x32\xde\xc5\x1f\x7c\x18\x30\xa8\x5d\x12\xfc\x29\x16\x8f\xda\x44\x30\x11\x10\xa4\x46\xe3\xbe\x19\x8c\x22\x90\x39\x44\x1f\xae\xc0\x38\x65\x24\x26\xe3\x36\x90\x34\x41\x1f\xfc\x26\x50\x8c\xe0\x5b\x40\x88\xa5\x7c\x05\xbf\xfa\x0c\x12\x1d\xf4\x18\x23\x98\x04\x0d\xca\x27\x48\xbe\x64\x28\xd3\xb6\x14\xc8\xa5\x39\x1a\x3a\x4a\xda\xab\xc8\xe7\xd2\xbb\x64\x5c\x26\x68\x29\x49\xcd\x3d\x3a\x1f\x08\x02\xc5\x6c\x53\xe4\xe6\xf0\x8e\x4c\xd2\x14\x40\x99\x40\xd3\x0d\xde\xc1\x05\x6d\xc9\xf1\x21\xd7\x0f\xb4\xa5\x10\x69\x1f\x70\x59\xc1\x0a\x6a\x97\xad\xad\xe7\x5b\x40\x47\xe8\x62\x89\x26\xa7\x18\x7a\x40\xb7\x21\xd0\x18\xa1\x21\x72\x10\x08\xa5\x26\x55\x2d\xc4\x94\xb0\x84\x87\x14\x8c\xdb\x08\x31\x43\x59\xc2\x6f\x2f\xa8\x3c\x61\xf2\xec\x77\xf1\xd7\x9b\xac\x5f\xa3\x7c\x3a\xa1\xfd\xb8\xd3\xd9\x3f\xb6\x3a\x4d\x9f\x7a\x09\xc1\xf3\x7f\x65\xfc\x80\x4e\xc1\x43\x6e\xa2\x0c\xa6\x2f\xfd\x59\x10\x8b\xc5\x42\xac\x20\x1a\xb7\xb1\x04\x69\x5f\x9c\xbf\x65\x21\xbd\xd4\xb9\x82\x0e\xe7\x61\x20\x97\xe4\xbe\xbb\xd1\x7d\xe6\xea\x95\x10\x9f\x56\xf7\xeb\xdb\xf5\x8f\x4b\x50\x1e\xd6\x77\x8f\x1c\xb6\xa1\xc4\x13\x35\x4e\xda\xac\xa8\x70\x5d\x67\xa7\x7c\x0d\xad\x21\xab\xf8\x4c\x51\xa2\xd0\x19\x47\x60\xda\x12\xd0\x51\x8c\xb8\xa1\xa2\x3d\x99\x32\x5a\x3b\x02\xc2\xfd\xcd\x2f\x37\xf7\x0f\xab\x0f\x3c\x27\x8e\x3a\x80\x5a\x09\xb1\xbe\x7b\xbc\x59\x02\xda\x01\xc7\x08\x52\x13\x0b\x4d\x13\x6c\xd1\x66\xe2\x8c\xba\xdc\xa6\x8a\x09\x53\x8e\x35\xb7\xed\xf0\x89\x20\xe6\x40\xac\x4c\x13\xa1\xa6\x3f\x48\xe6\x44\xaa\x66\x1c\xa3\xcf\x30\xa0\x4b\xc7\x91\x78\x44\x50\x87\xaa\x24\xcf\x8a\x2e\x5b\x52\x4d\x84\xbe\x3e\xef\x57\x96\x6c\xb1\xf8\xa4\xa9\x28\x39\xf1\xba\xed\xd8\x2e\x77\x77\xf0\x71\xfd\xfd\x1d\xf8\x9e\x02\x96\x86\x65\xb9\xfa\x40\x5b\xe3\x73\xb4\x23\x44\x5e\x9f\x5d\x4a\x25\xc4\xad\x7b\x31\x9b\xab\x03\xf4\x63\x59\xc7\x24\xf5\xd4\x8b\xd5\x30\x13\xb6\xa5\x60\xda\x11\x4c\x8a\x13\x63\x55\x05\x58\x36\xd1\xf9\x04\xca\xe7\xc6\x12\xf8\x00\x29\x98\xde\x12\x48\x9f\x99\x98\x03\x1d\x57\xe5\xd2\x3c\xd7\x25\x5c\x7b\x6f\x09\xdd\x59\x21\xe2\xfd\x4b\xe7\x40\x6b\xfd\xc0\x32\x62\x38\x87\xcb\x5e\x54\xc4\x07\x83\x26\xc7\xbf\xdd\xb4\xb8\xcc\x85\x71\x89\x42\xc8\x7d\x22\xc5\x9d\x5e\x2c\xc9\x11\x8f\x04\x8d\xf5\xf2\x09\x02\xf5\x81\x98\x23\x52\xa0\x29\x14\x52\x4d\x98\x8c\xc1\x34\x96\xb8\x92\x89\xb7\x07\x7f\x4e\xd0\x3f\x6a\x02\x8b\x31\xc1\x93\xf3\x83\x3b\xca\x9d\x5b\x14\xa8\x7d\xf0\x92\x62\x9c\xbc\xd0\xc4\x23\x65\x02\x14\x1b\x75\xb9\x6b\x28\x14\x33\x6c\x08\xe8\x73\x46\xfb\x2c\x9b\x1c\x8a\x0d\x4e\x05\x5d\xee\xe0\x22\x3b\x45\x61\x27\xd9\xf2\xbf\x72\xb9\xab\x2f\x8b\xf3\x0d\xda\x48\x0d\x12\x79\x25\xeb\x63\xf8\x35\x94\xee\x99\x2a\x80\xdb\x43\x1b\x2c\x88\x23\x6d\x3a\x6e\x34\xef\x4f\x91\xeb\xd5\x84\x78\x86\x37\x18\x6b\x19\x9f\x0a\xa6\x2d\xd6\x8e\x03\x8e\xd0\x8c\x25\x7e\x8e\xf1\xed\x84\x34\x42\xa4\x1e\xc3\xf4\x04\x68\x42\x35\x5f\x80\x55\xf5\xe1\xf6\xba\x2a\xe4\x1e\x60\xbb\xe6\xd3\x75\xee\x96\xf0\xd1\xb8\xf4\xdd\xb7\x67\x42\x94\x84\x25\x94\x93\x9f\x08\x15\x85\x33\x21\x00\x00\x98\x7a\xbe\x7b\x79\x4e\xa6\xb5\x9c\xe5\x7f\xb2\xf8\x45\x3c\x68\x5c\x79\x22\x9e\xed\x69\xa7\x49\xf1\xbe\xf8\x40\xbd\x3b\x59\x4d\x07\x35\x34\x64\xfd\xc0\xfc\x5b\xd3\xf1\xfe\xea\xbd\xcd\xf1\x5f\xef\xd8\x70\xa6\xe0\x7d\xd9\xd1\xe7\x70\x6c\x78\xb0\xb2\x49\xfb\xbc\xd1\x45\xd4\xcf\x19\x6d\xf0\xdd\x29\xd0\x08\xc8\xf6\xb1\x45\x63\x91\x67\x71\x11\x89\xf6\x8e\xb3\x03\x76\x39\xed\x51\x99\xfb\x12\x0e\x8c\xa3\xb0\xc1\xde\xfe\x6f\xcc\xba\x99\x5f\x89\x53\xb7\xfe\xff\x58\xe6\xdb\x8e\xf9\xca\x03\xf9\x65\xbb\xca\x57\x5b\xf9\x6f\x6c\xe5\xab\xa5\xbc\x61\x29\x7f\x07\x00\x00\xff\xff\xb4\x8d\x8a\xd9\x2c\x0c\x00\x00")
+var _search_transactionGraphql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x57\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x4c\xe4\x43\x93\x40\xd5\xa1\xbd\xe9\x26\xe7\xa3\x55\x11\x28\xa8\xe5\x34\x28\x8a\x02\x5c\x91\x23\x71\x61\x6a\x57\xd9\x5d\x5a\x11\x02\xff\xf7\xbe\x19\x52\x12\x25\xdb\x41\x9b\x43\xd1\x02\x36\x0c\xd8\xe2\xee\xce\xcc\xbe\x79\xef\x8d\x78\x91\x5d\x10\x5d\x71\xdc\x78\x17\x39\xd2\xd2\x07\xfa\xb5\xe1\xb0\xcb\x2e\xb2\x2c\xed\x36\x4c\x73\x36\xa1\xa8\xae\x83\x71\xd1\x14\xc9\x62\xdb\x5b\x1f\xb6\x26\x94\xfb\x43\xf4\x25\x1b\x5c\x57\x36\x12\x7e\x0d\x15\x95\xb1\xee\xfb\xad\x2d\x99\x8a\x26\x44\x1f\x86\x64\x5d\x69\x0b\x93\xac\x5b\x51\xaa\x98\x36\xc1\xaf\x02\xc7\x48\x7e\x89\xfd\x51\xc3\x8f\xe8\x77\xdf\x50\x61\x1c\x6d\x0c\x56\x6c\xa2\x85\x29\x6e\x28\x79\x3d\x51\xda\xe5\x92\x03\xbb\xd4\xed\xa6\x35\xa7\xca\x97\x51\xd6\x0b\xef\x10\xb9\x61\x5a\x72\x2a\x2a\xc9\xb1\xf6\x81\x09\x09\x9a\x3a\x45\x49\x4e\x2f\xd9\x22\x4a\x40\x98\xc0\xed\x0d\x5e\xd2\x73\xbe\x65\x27\x8b\x12\x3f\xe0\x43\x88\x7c\xdc\xf0\x62\x44\x13\xca\x5d\x53\xd7\x79\x77\x0b\xa4\x04\x00\xba\x9b\x5d\x29\xa5\x03\x90\x15\x53\x65\x22\x2d\x18\xa1\x02\x9b\xa2\xe2\x72\x34\xc8\xda\x03\x63\x9a\xa7\x80\x72\xb2\xac\x2b\x65\x4c\x7f\xdc\x83\xf2\x0c\xc9\x67\x7f\x66\x77\x8f\xa2\x7e\x09\x40\xce\x60\x3f\xcd\xf4\xec\xab\xa9\xce\x8f\xb7\xb9\x32\xb4\xf9\xe2\xa1\xf6\x93\xc1\x2d\xe7\xcd\x22\x16\xc1\x6e\x34\xbf\x10\x62\x30\x18\x64\x13\x8a\x48\x56\x33\xa5\x63\x70\xf9\xbf\x50\xd0\x35\xce\x90\xd6\xa6\x6b\x86\x91\x90\x92\x77\xdf\xba\x4f\x12\x7d\x94\x65\x1f\x27\x57\xb3\xe9\xec\xa7\x31\x95\x9e\x66\xef\xaf\x65\xdb\x8a\x93\x74\xd4\xba\xa2\x6e\x40\x1f\xc1\x3a\x6f\x5c\xe9\x73\x5a\x5a\xae\x4b\x59\x2b\x39\x71\x58\x5b\xc7\x64\x97\xba\x61\x0d\x22\x19\xf4\x41\xb8\x57\xa4\xc6\xd4\x35\x4a\xa7\xab\x37\xbf\xbd\xb9\x9a\x4f\xde\x49\x9f\x64\x57\xaf\x54\xa4\x46\xba\x37\x63\x32\xf5\xd6\xec\x22\xf8\xca\x42\x34\x6c\xba\x35\x35\x68\x84\x13\xb9\xde\x66\x14\x93\x49\x4d\xcc\x25\xed\xda\xdc\x30\xc5\x06\xbc\x02\x33\x91\x2a\xe7\xcf\x5c\x34\x89\xcb\x5c\xea\xd8\x81\xba\x5b\xe3\xd2\xe9\x4e\x73\x02\xd0\xda\x94\x7a\xb8\x63\xb4\xaa\x64\xd4\x02\xfa\x70\xbf\x1f\x10\xd9\x60\xf0\xb1\x62\x65\x72\x12\xb9\xed\xd1\xd6\xbb\x3b\xfa\x30\x7b\xfd\x9e\xfc\x86\x83\xd1\x84\x2a\xae\x0d\x98\x6d\x7d\x13\x01\x4a\x14\xf9\xec\x8f\x20\xf3\xd4\xdd\xeb\xcd\xb0\x57\xfd\x4e\xe5\x88\x26\xb6\xb9\x84\x0d\x1d\x60\x90\x8a\x5d\xee\x70\x97\xd8\x22\x36\x1a\x91\x51\x25\x3a\x9f\xd0\xcc\x66\x01\x6a\x80\x45\xa0\xe4\x06\xff\x15\xbe\x11\x60\x7a\x3c\x1e\xe9\xa5\xa5\xaf\x63\xba\xf4\xbe\x86\xae\x9e\x29\x10\xaf\xee\x3b\x07\xda\xe9\xb7\x42\x23\x29\xa7\x2f\x76\x65\x91\x2c\x6c\x2b\x68\x0f\x8f\x5d\x2b\x5c\xc1\xc2\x3a\x70\x24\x34\x1b\xb4\x47\x32\xdd\x13\xc9\x09\x8e\x4c\x8b\xda\x83\x00\x81\x81\x95\x60\xc4\x25\x61\x45\x41\xb5\xa1\x35\x06\x8b\x2b\x49\x24\x1b\xa7\xbd\x27\x67\xd5\x5f\x23\x54\x6d\x62\xa2\x1b\xe7\xb7\xee\xe4\x6c\x97\x42\x4b\x85\xf9\x15\xa0\x6c\xeb\x85\xc8\xd1\x67\x26\x91\xda\xa8\x6b\xd6\x0b\xd4\x26\x66\xb8\x80\xdb\x7c\x02\xa9\x0f\xb4\x69\x82\xda\x60\x1b\x10\x1b\xe9\x39\x80\xc4\xe6\x8e\xb2\xfa\x7c\x84\xe7\xf9\x0b\x75\xbe\x6d\x65\xd1\xc1\xc2\x88\x24\xf3\xd3\xf2\x73\xd2\xec\xe8\x1f\xd1\xb4\x6f\x83\x5a\x71\xe4\xd5\x5a\x12\x75\xfa\x51\xba\x0e\xdb\x8a\xbb\xf2\xb6\xb6\xae\xa5\xbe\x12\x6c\x50\x6b\x37\x60\x07\x2d\x76\xba\xbf\xdb\x83\xd3\x5a\x51\x44\xbc\x8d\x09\xed\x08\xa8\xd8\x94\xdd\x05\x84\x55\xef\xa6\x97\x23\x05\xb7\x57\xdb\xa5\xac\xce\x9a\xf5\x98\x3e\xa0\x99\x3f\xfe\x00\x84\xf5\x00\x20\x97\x3f\x3f\x23\x02\x87\x16\xf6\x2b\xbf\x8d\x07\x95\x1b\x2d\xbd\x80\x08\x22\xd5\x36\x4a\x2f\x3b\x87\xcf\xb7\x18\x01\x73\x08\x9a\x27\x4b\xb0\x23\x27\x13\x56\xcd\xfe\x86\x3d\x67\xb2\x8c\x91\x61\x0e\x11\x3b\xab\x3f\xd2\xe4\xcc\x4c\x20\xfb\x48\x7b\x2f\x20\x81\x28\xee\xfd\x6b\x3f\xed\x00\x1d\xe6\xc4\x21\xa2\x6f\x79\xd7\xd3\xc3\x49\x82\x11\xcd\x30\x74\x5a\xaa\xc8\xc3\x43\x99\x92\x48\x04\xb6\xb2\x98\x5b\x43\x45\x0e\xe9\x63\xdf\xa0\x15\xc6\x78\xb8\x23\x86\xc0\xb5\x00\x32\x77\x66\x13\x2b\x9f\x60\xf8\x19\xe1\x47\xb8\x2a\x64\xd1\xc4\x6d\xed\x9d\x5f\x9c\x5f\x4e\xd4\x86\xbe\xeb\x4c\x3d\xf8\xf9\x5e\xc4\xd9\x2b\x35\xce\x7c\xbf\x32\x69\x17\x72\x70\x02\x9a\x15\xc2\xd6\x76\x2d\x86\x57\x1d\xe7\x82\x3c\xf5\x4e\x1c\xba\xbb\xfa\x21\x2c\xf4\x1d\x4e\x27\x04\x4d\x6a\xcc\xf8\x66\x55\xa9\x0b\x1c\x4e\x2c\x83\x5f\x9f\x17\x0a\x7b\x12\xbf\xbd\x35\xb6\x56\x06\x3c\x8f\xcc\x47\x8b\xde\x17\xf6\xa2\x35\x1e\x15\xca\x98\x7a\x4e\xab\x68\xc8\x30\xfc\x96\xe9\xb6\xe8\xc6\xea\xf9\x78\xfb\xef\xcc\x98\xc7\x47\xcc\x03\xdf\x28\xfe\xdf\x36\xfc\xe4\xc3\x4f\x3e\xfc\x6f\xfa\xf0\xdf\x70\xdc\x27\xb7\xfd\x8a\xdb\x4e\xf4\xd2\x78\x90\xfa\x9c\x19\x7e\x03\x59\x5a\xea\xba\x4d\x93\x48\x5b\x70\xc5\xcb\xa9\x7e\xfa\x92\x99\x42\xbf\x83\x1e\x9d\x47\x53\x1d\x3f\x6a\xce\xe3\xc7\xbb\x83\x8b\x84\x8e\xd2\xe6\xc1\x2a\x3b\x76\x9a\x96\x2f\x43\x79\xff\x2b\x5b\xbc\xde\xd6\xcd\xe7\xd7\xad\x98\xd4\x77\x4f\x38\xf1\xcf\x0b\x5a\xdc\x17\xe1\xe3\x7a\x1b\xe2\x0d\xa9\xf0\x25\x44\x20\xd8\xb5\xc4\xef\x8a\xff\x2e\xd2\xe4\x72\xda\x27\x76\x0e\x7f\xf6\x41\x0d\x28\x72\xd2\x82\xe5\xce\x63\xfa\x65\xfe\x7e\xd6\x19\x74\xeb\x23\xe1\xe1\x6c\xf2\xed\x1e\xef\x65\xa2\x87\x05\x2b\x02\x43\xf0\xf0\x86\x4f\xf2\xe2\x15\xf9\x56\x48\xe7\xbc\xe4\x27\x93\x5a\xfb\x6a\x65\x26\x39\xb5\x8a\xc3\xeb\xf2\x5d\xf6\x17\x2f\x15\x13\x2b\x92\x10\x00\x00")
 
 func search_transactionGraphqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -190,7 +190,7 @@ func search_transactionGraphql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "search_transaction.graphql", size: 3116, mode: os.FileMode(420), modTime: time.Unix(1587602721, 0)}
+	info := bindataFileInfo{name: "search_transaction.graphql", size: 4242, mode: os.FileMode(420), modTime: time.Unix(1792132951, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
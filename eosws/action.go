@@ -77,6 +77,18 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 					continue
 				}
 
+				if ws.binaryFraming {
+					out := wsmsg.NewActionTrace(trx.Id, actIdx, nil)
+					out.Data.BlockNum = blk.Number
+					out.Data.BlockID = blk.Id
+					out.Data.BlockTime, _ = ptypes.Timestamp(blk.Header.Timestamp)
+					out.Proto = protoActionTrace(trx, act, msg)
+
+					metrics.DocumentResponseCounter.Inc()
+					ws.EmitReply(ctx, msg, out)
+					continue
+				}
+
 				rawTrace, err := mdl.ToV1ActionTraceRaw(act, allActions, msg.Data.WithInlineTraces)
 				if err != nil {
 					return err
@@ -156,3 +168,51 @@ func (ws *WSConn) onGetActionTraces(ctx context.Context, msg *wsmsg.GetActionTra
 	ws.EmitReply(ctx, msg, wsmsg.NewListening(authReq.StartBlockNum))
 	go source.Run()
 }
+
+// protoActionTrace returns the transaction trace `trx` stripped down to the
+// action `act` (followed by its inline traces if requested) and the ops
+// requested in `msg`, the binary framing equivalent of the JSON `trace` and
+// ops of an `action_trace` message.
+func protoActionTrace(trx *pbcodec.TransactionTrace, act *pbcodec.ActionTrace, msg *wsmsg.GetActionTraces) *pbcodec.TransactionTrace {
+	out := &pbcodec.TransactionTrace{
+		Id:              trx.Id,
+		BlockNum:        trx.BlockNum,
+		Index:           trx.Index,
+		BlockTime:       trx.BlockTime,
+		ProducerBlockId: trx.ProducerBlockId,
+		Receipt:         trx.Receipt,
+		Elapsed:         trx.Elapsed,
+		NetUsage:        trx.NetUsage,
+		Scheduled:       trx.Scheduled,
+		ActionTraces:    []*pbcodec.ActionTrace{act},
+	}
+
+	if msg.Data.WithInlineTraces {
+		// Like `mdl.ToV1ActionTraceRaw`, only elements directly following the
+		// action on the flat list can be part of its inline traces tree
+		ancestors := map[uint32]bool{act.ActionOrdinal: true}
+		for _, child := range trx.ActionTraces[act.ExecutionIndex+1:] {
+			if !ancestors[child.ClosestUnnotifiedAncestorActionOrdinal] {
+				break
+			}
+
+			ancestors[child.ActionOrdinal] = true
+			out.ActionTraces = append(out.ActionTraces, child)
+		}
+	}
+
+	if msg.Data.WithRAMOps {
+		out.RamOps = trx.RAMOpsForAction(act.ExecutionIndex)
+	}
+	if msg.Data.WithDTrxOps {
+		out.DtrxOps = trx.DtrxOpsForAction(act.ExecutionIndex)
+	}
+	if msg.Data.WithDBOps {
+		out.DbOps = trx.DBOpsForAction(act.ExecutionIndex)
+	}
+	if msg.Data.WithTableOps {
+		out.TableOps = trx.TableOpsForAction(act.ExecutionIndex)
+	}
+
+	return out
+}
@@ -40,7 +40,8 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
 	"github.com/dfuse-io/dfuse-eosio/eosws/rest"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb-client"
-	"github.com/dfuse-io/dgrpc"
+	"github.com/dfuse-io/dfuse-eosio/metering"
+	"github.com/dfuse-io/dfuse-eosio/tracing"
 	"github.com/dfuse-io/dipp"
 	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/dmetrics"
@@ -84,6 +85,17 @@ type Config struct {
 
 	DataIntegrityProofSecret string
 	HealthzSecret            string
+
+	HealthThresholds    []string
+	HealthCheckInterval time.Duration
+	HealthHistorySize   int
+
+	SimpleSearchTokenContracts []string
+
+	WSMaxStreamsPerConnection int
+	WSMaxStreamsPerUser       int
+	WSMaxQueuedMessages       int
+	WSLossyStreams            []string
 }
 
 // Deprecated: The features in the eosws package will be moved to other packages like Dgraphql
@@ -181,7 +193,7 @@ func (a *App) Run() error {
 		return src
 	})
 
-	blockmetaConn, err := dgrpc.NewInternalClient(a.Config.BlockmetaAddr)
+	blockmetaConn, err := tracing.NewInternalGRPCClient(a.Config.BlockmetaAddr)
 	if err != nil {
 		return fmt.Errorf("failed getting blockmeta grpc client: %w", err)
 	}
@@ -281,7 +293,17 @@ func (a *App) Run() error {
 		return fmt.Errorf("blockmeta connection error: %w", err)
 	}
 
-	wsHandler := eosws.NewWebsocketHandler(abiGetter, accountGetter, db, subscriptionHub, fluxClient, voteTallyHub, headInfoHub, priceHub, irrFinder, a.Config.FilesourceRateLimitPerBlock)
+	wsLimits := eosws.WSLimits{
+		MaxStreamsPerConnection: a.Config.WSMaxStreamsPerConnection,
+		MaxStreamsPerUser:       a.Config.WSMaxStreamsPerUser,
+		MaxQueuedMessages:       a.Config.WSMaxQueuedMessages,
+		LossyStreams:            a.Config.WSLossyStreams,
+	}
+	if err := wsLimits.Validate(); err != nil {
+		return fmt.Errorf("invalid websocket limits: %w", err)
+	}
+
+	wsHandler := eosws.NewWebsocketHandler(abiGetter, accountGetter, db, subscriptionHub, fluxClient, voteTallyHub, headInfoHub, priceHub, irrFinder, a.Config.FilesourceRateLimitPerBlock, eosws.WithWSLimits(wsLimits))
 
 	auth, err := authenticator.New(a.Config.AuthPlugin)
 	if err != nil {
@@ -312,7 +334,7 @@ func (a *App) Run() error {
 
 	var searchRouterClient pbsearch.RouterClient
 
-	searchConn, err := dgrpc.NewInternalClient(a.Config.SearchAddr)
+	searchConn, err := tracing.NewInternalGRPCClient(a.Config.SearchAddr)
 	if err != nil {
 		return fmt.Errorf("failed getting abi grpc client: %w", err)
 	}
@@ -320,7 +342,7 @@ func (a *App) Run() error {
 
 	if a.Config.SearchAddrSecondary != "" {
 		zlog.Info("Setting up secondary search router")
-		searchConnv2, err := dgrpc.NewInternalClient(a.Config.SearchAddrSecondary)
+		searchConnv2, err := tracing.NewInternalGRPCClient(a.Config.SearchAddrSecondary)
 		if err != nil {
 			zlog.Warn("failed getting abi grpc client", zap.Error(err))
 		}
@@ -341,7 +363,7 @@ func (a *App) Run() error {
 		searchRouterClient = searchClientV1
 	}
 
-	searchQueryHandler := eosws.NewSearchEngine(db, searchRouterClient)
+	searchQueryHandler := eosws.NewSearchEngine(db, searchRouterClient, fluxClient)
 
 	// Order of router definitions is important, prefix:(/a/b) must be defined before /a
 	router := mux.NewRouter()
@@ -352,7 +374,20 @@ func (a *App) Run() error {
 	})
 
 	// Setup healthz
-	healthzHandler := rest.HealthzHandler(subscriptionHub, api, blocksStore, db, fluxClient, searchQueryHandler, a.Config.HealthzSecret)
+	healthConfig := rest.DefaultHealthConfig()
+	if err := healthConfig.ParseHealthThresholds(a.Config.HealthThresholds); err != nil {
+		return fmt.Errorf("health thresholds: %w", err)
+	}
+	healthConfig.CheckInterval = a.Config.HealthCheckInterval
+	healthConfig.HistorySize = a.Config.HealthHistorySize
+
+	healthMonitor := rest.NewHealthMonitor(healthConfig, rest.NewHealthChecks(subscriptionHub, blocksStore, db, fluxClient, searchQueryHandler))
+	requireStreams := healthMonitor.RequireFeature(rest.HealthFeatureStreams)
+	requireTransactions := healthMonitor.RequireFeature(rest.HealthFeatureTransactions)
+	requireState := healthMonitor.RequireFeature(rest.HealthFeatureState)
+	requireSearch := healthMonitor.RequireFeature(rest.HealthFeatureSearch)
+
+	healthzHandler := rest.HealthzHandler(healthMonitor, a.Config.HealthzSecret)
 	healthzRouter := router.PathPrefix("/").Subrouter()
 	healthzRouter.Path("/healthz").Handler(healthzHandler)
 
@@ -401,27 +436,17 @@ func (a *App) Run() error {
 	}
 
 	dumbAPIProxy := rest.NewReverseProxy(apiURL, true)
-	billedDumbAPIProxy := dmetering.NewMeteringMiddleware(
-		dumbAPIProxy,
-		meter,
-		"eosws", "Chain RPC",
-		true, true,
-	)
+	billedDumbAPIProxy := metering.HTTPMiddleware("eosws", "Chain RPC")(dumbAPIProxy)
 
 	authTxPusher := dauthMiddleware.NewAuthMiddleware(auth, eosws.EOSChainErrorHandler).Handler(
-		dmetering.NewMeteringMiddleware(
-			rest.NewTxPusher(api, subscriptionHub),
-			meter,
-			"eosws", "Push Transaction",
-			true, true,
-		),
+		metering.HTTPMiddleware("eosws", "Push Transaction")(rest.NewTxPusher(api, subscriptionHub)),
 	)
 	txPushRouter := rest.NewTxPushRouter(billedDumbAPIProxy, authTxPusher)
 	chainRouter.PathPrefix("/v1/chain").Handler(txPushRouter)
 
 	/// WebSocket endpoints
 	wsRouter.Use(authMiddleware)
-	wsRouter.Path("/v1/stream").Handler(wsHandler)
+	wsRouter.Path("/v1/stream").Handler(requireStreams(wsHandler))
 
 	/// Primary REST API endpoints
 	restRouter.Use(authMiddleware)
@@ -429,46 +454,49 @@ func (a *App) Run() error {
 	restRouter.Use(dipp.NewProofMiddlewareFunc(a.Config.DataIntegrityProofSecret))
 	//////////////////////////////////////////////////////////////////////
 	// Billable event on REST APIs
-	// WARNING: Middleware is **configured** to ONLY track Query Ingress / Egress bytes.
-	//          This means that the middleware DOES NOT track Query requests / responses.
-	//          Req / Resp (Docs) is counted in the different endpoints
+	// One event per request with its Ingress / Egress bytes, the
+	// endpoints returning many documents set their count through
+	// `metering.SetResponsesCount`
 	//////////////////////////////////////////////////////////////////////
-	restRouter.Use(dmetering.NewMeteringMiddlewareFuncWithOptions(
-		meter,
-		"eosws", "REST API",
-		false, true))
+	restRouter.Use(metering.HTTPMiddleware("eosws", "REST API"))
 	//////////////////////////////////////////////////////////////////////
-	restRouter.Path("/v0/search/transactions").Handler(searchQueryHandler)
+	restRouter.Path("/v0/search/transactions").Handler(requireSearch(searchQueryHandler))
 	restRouter.Path("/v0/block_id/by_time").Handler(rest.BlockTimeHandler(blockmetaClient))
-	restRouter.Path("/v0/transactions/{id}").Handler(rest.GetTransactionHandler(db))
+	restRouter.Path("/v0/transactions/{id}").Handler(requireTransactions(rest.GetTransactionHandler(db)))
+	restRouter.Path("/v0/usage").Handler(metering.UsageHandler())
 
 	// FluxDB (Chain State) REST API endpoints
 	fluxRestRouter.Use(authMiddleware)
 	fluxRestRouter.Use(eosws.RESTTrackingMiddleware)
 	fluxRestRouter.Use(dipp.NewProofMiddlewareFunc(a.Config.DataIntegrityProofSecret))
+	fluxRestRouter.Use(requireState)
 	//////////////////////////////////////////////////////////////////////
 	// Billable event on REST APIs
-	// WARNING: Middleware is **configured** to ONLY track Query Ingress / Egress bytes.
-	//          This means that the middleware DOES NOT track Query requests / responses.
-	//          Req / Resp (Docs) is counted in the different endpoints
+	// One event per request with its Ingress / Egress bytes, the
+	// endpoints returning many documents set their count through
+	// `metering.SetResponsesCount`
 	//////////////////////////////////////////////////////////////////////
-	fluxRestRouter.Use(dmetering.NewMeteringMiddlewareFuncWithOptions(
-		meter,
-		"eosws", "REST API - Chain State",
-		false, true))
+	fluxRestRouter.Use(metering.HTTPMiddleware("eosws", "REST API - Chain State"))
 	//////////////////////////////////////////////////////////////////////
-	fluxRestRouter.Path("/v0/state/abi").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/abi/bin_to_json").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/permission_links").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/key_accounts").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/table").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/table/row").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/table_scopes").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/tables/accounts").Handler(fluxProxy)
-	fluxRestRouter.Path("/v0/state/tables/scopes").Handler(fluxProxy)
+	for _, path := range fluxProxiedPaths {
+		fluxRestRouter.Path(path).Handler(fluxProxy)
+	}
 
+	// History plugin (cleos compatible) REST API endpoints
+	historyRestRouter.Use(authMiddleware)
 	historyRestRouter.Use(eosws.RESTTrackingMiddleware)
-	historyRestRouter.Path("/v1/history/get_key_accounts").Methods("GET", "POST").Handler(rest.GetKeyAccounts(fluxClient))
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on REST APIs
+	// One event per request with its Ingress / Egress bytes, the
+	// endpoints returning many documents set their count through
+	// `metering.SetResponsesCount`
+	//////////////////////////////////////////////////////////////////////
+	historyRestRouter.Use(metering.HTTPMiddleware("eosws", "REST API - History"))
+	//////////////////////////////////////////////////////////////////////
+	historyRestRouter.Path("/v1/history/get_key_accounts").Methods("GET", "POST").Handler(requireState(rest.GetKeyAccounts(fluxClient)))
+	historyRestRouter.Path("/v1/history/get_controlled_accounts").Methods("GET", "POST").Handler(requireState(rest.GetControlledAccounts(fluxClient)))
+	historyRestRouter.Path("/v1/history/get_transaction").Methods("GET", "POST").Handler(requireTransactions(rest.GetHistoryTransaction(db, subscriptionHub)))
+	historyRestRouter.Path("/v1/history/get_actions").Methods("GET", "POST").Handler(requireSearch(rest.GetActions(searchQueryHandler, db, subscriptionHub)))
 
 	/// Rest routes (Eosq accessible only)
 	eosqRestRouter.Use(authMiddleware)
@@ -477,24 +505,33 @@ func (a *App) Run() error {
 
 	//////////////////////////////////////////////////////////////////////
 	// Billable event on EOSQ APIs
-	// WARNING: Middleware is **configured** to ONLY track Query Ingress / Egress bytes.
-	//          This means that the middleware DOES NOT track Query requests / responses.
-	//          Req / Resp (Docs) is counted in the different endpoints
+	// One event per request with its Ingress / Egress bytes, the
+	// endpoints returning many documents set their count through
+	// `metering.SetResponsesCount`
 	//////////////////////////////////////////////////////////////////////
-	eosqRestRouter.Use(dmetering.NewMeteringMiddlewareFuncWithOptions(
-		meter,
-		"eosws", "REST API - eosq",
-		false, true))
+	eosqRestRouter.Use(metering.HTTPMiddleware("eosws", "REST API - eosq"))
 	//////////////////////////////////////////////////////////////////////
 
-	eosqRestRouter.Path("/v0/transactions").Handler(rest.ListTransactionsHandler(db))
+	eosqRestRouter.Path("/v0/transactions").Handler(requireTransactions(rest.ListTransactionsHandler(db)))
+
+	eosqRestRouter.Path("/v0/blocks").Handler(requireTransactions(rest.GetBlocksHandler(db)))
+	eosqRestRouter.Path("/v0/blocks/{blockID}").Handler(requireTransactions(rest.GetBlockHandler(db)))
+	eosqRestRouter.Path("/v0/blocks/{blockID}/transactions").Handler(requireTransactions(rest.GetBlockTransactionsHandler(db)))
+	var tokenContracts []eos.AccountName
+	for _, contract := range a.Config.SimpleSearchTokenContracts {
+		tokenContracts = append(tokenContracts, eos.AccountName(contract))
+	}
 
-	eosqRestRouter.Path("/v0/blocks").Handler(rest.GetBlocksHandler(db))
-	eosqRestRouter.Path("/v0/blocks/{blockID}").Handler(rest.GetBlockHandler(db))
-	eosqRestRouter.Path("/v0/blocks/{blockID}/transactions").Handler(rest.GetBlockTransactionsHandler(db))
-	eosqRestRouter.Path("/v0/simple_search").Handler(rest.SimpleSearchHandler(db, blockmetaClient))
+	simpleSearcher := rest.NewSimpleSearcher(db, blockmetaClient, fluxClient, fluxhelper.NewDefaultFluxHelper(fluxClient), tokenContracts)
+	eosqRestRouter.Path("/v0/simple_search").Handler(rest.SimpleSearchHandler(simpleSearcher))
 	eosqRestRouter.Path("/v0/search/completion").Handler(rest.GetCompletionHandler(completionInstance))
 
+	openAPIDocumentHandler, err := openAPIHandler(router)
+	if err != nil {
+		return fmt.Errorf("openapi document: %w", err)
+	}
+	healthzRouter.Path("/v0/openapi.json").Handler(openAPIDocumentHandler)
+
 	zlog.Info("waiting for subscription hub to reach expected head block")
 	retryDelay := time.Duration(0)
 	for {
@@ -516,6 +553,9 @@ func (a *App) Run() error {
 	go headInfoHub.Launch(context.Background())
 	go completionPipeline.Launch()
 
+	healthMonitor.Check(ctx)
+	go healthMonitor.Run(ctx)
+
 	server := &http.Server{Addr: a.Config.HTTPListenAddr, Handler: handlers.CompressHandlerLevel(corsMiddleware(router), gzip.BestSpeed)}
 
 	go func() {
@@ -0,0 +1,73 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"net/http"
+
+	"github.com/dfuse-io/dfuse-eosio/eosws"
+	"github.com/dfuse-io/dfuse-eosio/eosws/rest"
+	fluxserver "github.com/dfuse-io/dfuse-eosio/fluxdb/server"
+	"github.com/dfuse-io/dfuse-eosio/openapi"
+	"github.com/gorilla/mux"
+)
+
+// fluxProxiedPaths are the FluxDB routes exposed through `eosws`
+var fluxProxiedPaths = []string{
+	"/v0/state/abi",
+	"/v0/state/abi/bin_to_json",
+	"/v0/state/code",
+	"/v0/state/code/deployments",
+	"/v0/state/permission_links",
+	"/v0/state/key_accounts",
+	"/v0/state/table",
+	"/v0/state/table/row",
+	"/v0/state/table_scopes",
+	"/v0/state/tables/accounts",
+	"/v0/state/tables/scopes",
+}
+
+// openAPIIgnoredPaths are the registered routes not part of the REST API
+var openAPIIgnoredPaths = []string{"/", "/healthz", "/search_not_stuck", "/v1/stream"}
+
+// openAPIRoutes describes the REST routes served by `eosws`, the FluxDB ones
+// being described by FluxDB itself.
+func openAPIRoutes() []*openapi.Route {
+	routes := append(rest.OpenAPIRoutes(), eosws.SearchTransactionsOpenAPIRoute())
+
+	proxied := map[string]bool{}
+	for _, path := range fluxProxiedPaths {
+		proxied[path] = true
+	}
+
+	for _, route := range fluxserver.OpenAPIRoutes() {
+		if proxied[route.Path] {
+			routes = append(routes, route)
+		}
+	}
+
+	return routes
+}
+
+// openAPIHandler serves the OpenAPI document of the routes registered on
+// `router`, failing when a registered route is not described (or the inverse).
+func openAPIHandler(router *mux.Router) (http.Handler, error) {
+	document := openapi.NewDocument("dfuse for EOSIO - REST API", "v0")
+	if err := document.AddRouterRoutes(router, openAPIRoutes(), openAPIIgnoredPaths...); err != nil {
+		return nil, err
+	}
+
+	return document.Handler()
+}
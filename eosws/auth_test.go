@@ -28,11 +28,12 @@ import (
 )
 
 type testCredentials struct {
+	userID     string
 	startBlock int64
 }
 
 func (c *testCredentials) GetUserID() string {
-	return ""
+	return c.userID
 }
 
 func (c *testCredentials) GetLogFields() []zap.Field {
@@ -45,6 +45,8 @@ type DB interface {
 	//ListBlocks(ctx context.Context, startBlockNum uint32, limit int) ([]*mdl.BlockRow, error)
 	//ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) ([]*mdl.BlockRow, error)
 	GetTransaction(ctx context.Context, id string) (*pbcodec.TransactionLifecycle, error)
+	// GetTransactions returns the transactions found among `ids`, in order, the missing
+	// ones being skipped.
 	GetTransactions(ctx context.Context, ids []string) ([]*pbcodec.TransactionLifecycle, error)
 	ListTransactionsForBlockID(ctx context.Context, blockId string, startKey string, limit int) (*mdl.TransactionList, error)
 	ListMostRecentTransactions(ctx context.Context, startKey string, limit int) (*mdl.TransactionList, error)
@@ -92,10 +94,9 @@ func (db *TRXDB) GetTransactions(ctx context.Context, ids []string) (out []*pbco
 		return nil, err
 	}
 
-	for idx, ev := range evs {
-		trxID := ids[idx]
+	for _, ev := range evs {
 		if len(ev) == 0 {
-			return nil, DBTrxNotFoundError(ctx, trxID)
+			continue
 		}
 
 		out = append(out, pbcodec.MergeTransactionEvents(ev, db.chainDiscriminator))
@@ -405,7 +406,13 @@ func (db *MockDB) GetTransactions(ctx context.Context, ids []string) (out []*pbc
 		filename := filepath.Join(db.path, "transactions", fmt.Sprintf("%s.json", id))
 		var el *pbcodec.TransactionLifecycle
 		err = readFromFile(filename, el)
-		out = append(out, el)
+		if err != nil {
+			return nil, err
+		}
+
+		if el != nil {
+			out = append(out, el)
+		}
 	}
 	return
 }
@@ -17,6 +17,7 @@ package eosws
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/dfuse-io/derr"
@@ -157,6 +158,14 @@ func AppVoteTallyNotReadyError(ctx context.Context) *derr.ErrorResponse {
 	)
 }
 
+func AppFeatureUnavailableError(ctx context.Context, feature string, reasons []string) *derr.ErrorResponse {
+	return derr.HTTPServiceUnavailableError(ctx, nil, derr.C("app_feature_unavailable_error"),
+		"This feature is temporarily unavailable because one of its dependencies is unhealthy, please try again later.",
+		"feature", feature,
+		"reasons", reasons,
+	)
+}
+
 // WebSocket Errors
 
 func WSBinaryMessageUnsupportedError(ctx context.Context) *derr.ErrorResponse {
@@ -200,6 +209,29 @@ func WSTooMuchStreamError(ctx context.Context, streamCount int, maxStreamCount i
 	)
 }
 
+func WSTooManyUserStreamsError(ctx context.Context, streamCount int, maxStreamCount int) *derr.ErrorResponse {
+	return derr.HTTPLockedError(ctx, nil, derr.C("ws_too_many_user_streams_error"),
+		"No more request listener could be created for this user across its connections.",
+		"actual_listener_count", streamCount,
+		"maximum_listener_count", maxStreamCount,
+	)
+}
+
+func WSStreamDroppedError(ctx context.Context, requestID string, maxQueuedMessages int) *derr.ErrorResponse {
+	return derr.HTTPErrorFromStatus(http.StatusTooManyRequests, ctx, nil, derr.C("ws_stream_dropped_error"),
+		"The request listener was dropped because the connection is not consuming messages fast enough.",
+		"request_id", requestID,
+		"maximum_queued_messages", maxQueuedMessages,
+	)
+}
+
+func WSBackpressureError(ctx context.Context, maxQueuedMessages int) *derr.ErrorResponse {
+	return derr.HTTPErrorFromStatus(http.StatusTooManyRequests, ctx, nil, derr.C("ws_backpressure_error"),
+		"The connection is closed because it is not consuming messages fast enough.",
+		"maximum_queued_messages", maxQueuedMessages,
+	)
+}
+
 func WSStreamAlreadyExistError(ctx context.Context, requestID string) *derr.ErrorResponse {
 	return derr.HTTPConflictError(ctx, nil, derr.C("ws_stream_already_exist_error"),
 		"A request listener with this id already exists.",
@@ -25,6 +25,11 @@ var ConnectionsCounter = Metricset.NewCounter("ws_connections_counter", "Counter
 var DocumentResponseCounter = Metricset.NewCounter("document_response_counter", "Number of documents sent as response in websocket")
 var CurrentListeners = Metricset.NewGaugeVec("current_listeners", []string{"req_type"}, "Number of WS streams active (listening)")
 var ListenersCount = Metricset.NewGaugeVec("listeners_count", []string{"req_type"}, "Counter of WS streams requests")
+var QueuedMessages = Metricset.NewGauge("eosws_ws_queued_messages", "Number of WS messages waiting to be written to slow clients, across all connections")
+var DroppedMessageCount = Metricset.NewCounterVec("eosws_ws_dropped_message_count", []string{"reason"}, "Number of WS messages never written to the client because its outbound queue was full (or coalesced in lossy streams)")
+var DroppedStreamCount = Metricset.NewCounter("eosws_ws_dropped_stream_count", "Number of WS streams dropped because the client was not consuming messages fast enough")
+var BackpressureDisconnectCount = Metricset.NewCounter("eosws_ws_backpressure_disconnect_count", "Number of WS connections closed because the client was not consuming messages fast enough")
+var StreamLimitReachedCount = Metricset.NewCounterVec("eosws_ws_stream_limit_reached_count", []string{"scope"}, "Number of WS streams refused because the connection or user reached its maximum of concurrent streams")
 var PushTrxCount = Metricset.NewCounterVec("push_transaction_count", []string{"guarantee"}, "Number of request for push_transaction")
 var TimedOutPushTrxCount = Metricset.NewCounterVec("timed_out_pushing_transaction_count", []string{"guarantee"}, "Number of requests for push_transaction timed out waiting for inclusion in block")
 var TimedOutPushingTrxCount = Metricset.NewCounterVec("timed_out_push_transaction_count", []string{"guarantee"}, "Number of requests for push_transaction timed out while submitting")
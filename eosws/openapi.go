@@ -0,0 +1,44 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"github.com/dfuse-io/dfuse-eosio/openapi"
+)
+
+// searchTransactionsQuery lists the parameters read by
+// `extractSearchQueryFromRequest`, see `validateSearchTransactionsRequest`.
+type searchTransactionsQuery struct {
+	Query          string `json:"q"`
+	StartBlock     uint32 `json:"start_block"`
+	BlockCount     uint32 `json:"block_count"`
+	Limit          uint64 `json:"limit"`
+	Cursor         string `json:"cursor"`
+	Sort           string `json:"sort"`
+	WithReversible bool   `json:"with_reversible"`
+	Format         string `json:"format"`
+}
+
+// SearchTransactionsOpenAPIRoute describes the route served by `SearchEngine`.
+func SearchTransactionsOpenAPIRoute() *openapi.Route {
+	return &openapi.Route{
+		Path:     "/v0/search/transactions",
+		Tags:     []string{"Search"},
+		Summary:  "Search transactions matching a query",
+		Query:    searchTransactionsQuery{},
+		Required: []string{"q"},
+		Response: &searchClientResponse{},
+	}
+}
@@ -20,9 +20,9 @@ import (
 	"strconv"
 
 	"github.com/dfuse-io/derr"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 	"github.com/dfuse-io/validator"
 	"github.com/gorilla/mux"
 )
@@ -34,19 +34,6 @@ func GetBlocksHandler(db eosws.DB) http.Handler {
 		errors := eosws.ValidateBlocksRequest(r)
 		if len(errors) > 0 {
 			eosws.WriteError(w, r, derr.RequestValidationError(r.Context(), errors))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - eosq",
-				Method:         "/v0/blocks",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
-
 			return
 		}
 
@@ -75,18 +62,7 @@ func GetBlocksHandler(db eosws.DB) http.Handler {
 		if count == 0 {
 			count = 1
 		}
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API - eosq",
-			Method:         "/v0/blocks",
-			RequestsCount:  1,
-			ResponsesCount: count,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
+		metering.SetResponsesCount(ctx, count)
 	})
 }
 
@@ -102,19 +78,6 @@ func GetBlockHandler(db eosws.DB) http.Handler {
 			eosws.WriteError(w, r, derr.RequestValidationError(r.Context(), url.Values{
 				"blockID": []string{"The blockID parameters is not a valid hexadecimal"},
 			}))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - eosq",
-				Method:         "/v0/blocks/{blockID}",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
-
 			return
 		}
 
@@ -147,19 +110,6 @@ func GetBlockHandler(db eosws.DB) http.Handler {
 		}
 
 		eosws.WriteJSON(w, r, blockSummary)
-
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API - eosq",
-			Method:         "/v0/blocks/{blockID}",
-			RequestsCount:  1,
-			ResponsesCount: 1,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
 	})
 }
 
@@ -173,19 +123,6 @@ func GetBlockTransactionsHandler(db eosws.DB) http.Handler {
 		errors := eosws.ValidateListRequest(r)
 		if len(errors) > 0 {
 			eosws.WriteError(w, r, derr.RequestValidationError(r.Context(), errors))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - eosq",
-				Method:         "/v0/blocks/{blockID}/transactions",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
-
 			return
 		}
 
@@ -211,17 +148,6 @@ func GetBlockTransactionsHandler(db eosws.DB) http.Handler {
 			count = 1
 		}
 
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API - eosq",
-			Method:         "/v0/blocks/{blockID}/transactions",
-			RequestsCount:  1,
-			ResponsesCount: count,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
+		metering.SetResponsesCount(ctx, count)
 	})
 }
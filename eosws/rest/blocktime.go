@@ -17,11 +17,11 @@ package rest
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/araddon/dateparse"
 	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
 	"github.com/dfuse-io/derr"
-	"github.com/dfuse-io/dmetering"
 	eos "github.com/eoscanada/eos-go"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
 )
@@ -33,6 +33,16 @@ import (
 /block_id/ ->
 */
 
+type blockTimeResponse struct {
+	Block *blockTimeRef `json:"block"`
+}
+
+type blockTimeRef struct {
+	Num  uint32    `json:"num"`
+	ID   string    `json:"id"`
+	Time time.Time `json:"time"`
+}
+
 func BlockTimeHandler(blockmetaClient *pbblockmeta.Client) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -42,18 +52,6 @@ func BlockTimeHandler(blockmetaClient *pbblockmeta.Client) http.Handler {
 		t, err := dateparse.ParseStrict(blockTime)
 		if err != nil {
 			eosws.WriteError(w, r, derr.RequestValidationError(ctx, url.Values{"time": []string{"timeshould be in a recognizable time format (ex: 2012-11-01T22:08:41+00:00)"}}))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API",
-				Method:         "/v0/block_id/by_time",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
 			return
 		}
 
@@ -73,58 +71,20 @@ func BlockTimeHandler(blockmetaClient *pbblockmeta.Client) http.Handler {
 			btResp, err = blockmetaClient.BlockBefore(ctx, t, false)
 		default:
 			eosws.WriteError(w, r, derr.RequestValidationError(ctx, url.Values{"comparator": []string{"should be one of 'gt', 'gte', 'lt', 'lte' or 'eq'"}}))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API",
-				Method:         "/v0/block_id/by_time",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
-
 			return
 		}
 
 		if err != nil {
 			eosws.WriteError(w, r, derr.HTTPNotFoundError(ctx, nil, derr.C("block_time_not_found"), "no results found for query"))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API",
-				Method:         "/v0/block_id/by_time",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
 			return
 		}
 
-		eosws.WriteJSON(w, r, map[string]interface{}{
-			"block": map[string]interface{}{
-				"num":  eos.BlockNum(btResp.Id),
-				"id":   btResp.Id,
-				"time": pbblockmeta.Timestamp(btResp.Time),
+		eosws.WriteJSON(w, r, &blockTimeResponse{
+			Block: &blockTimeRef{
+				Num:  eos.BlockNum(btResp.Id),
+				ID:   btResp.Id,
+				Time: pbblockmeta.Timestamp(btResp.Time),
 			},
 		})
-
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API",
-			Method:         "/v0/block_id/by_time",
-			RequestsCount:  1,
-			ResponsesCount: 1,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
 	})
 }
@@ -19,7 +19,6 @@ import (
 	"net/url"
 
 	"github.com/dfuse-io/derr"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
 	"github.com/dfuse-io/validator"
@@ -28,8 +27,6 @@ import (
 
 func GetTransactionHandler(db eosws.DB) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-
 		pathVariables := mux.Vars(r)
 		request := &getTransactionRequest{
 			TransactionID: pathVariables["id"],
@@ -38,18 +35,6 @@ func GetTransactionHandler(db eosws.DB) http.Handler {
 		errors := validateGetTransactionRequest(request)
 		if len(errors) > 0 {
 			eosws.WriteError(w, r, derr.RequestValidationError(r.Context(), errors))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API",
-				Method:         "/v0/transactions/{id}",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
 			return
 		}
 
@@ -66,19 +51,6 @@ func GetTransactionHandler(db eosws.DB) http.Handler {
 		}
 
 		eosws.WriteJSON(w, r, transactionLifecycle)
-
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API",
-			Method:         "/v0/transactions/{id}",
-			RequestsCount:  1,
-			ResponsesCount: 1,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
 	})
 }
 
@@ -0,0 +1,460 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dfuse-io/dfuse-eosio/eosws"
+	"go.uber.org/zap"
+)
+
+type HealthLevel string
+
+const (
+	HealthLevelHealthy   HealthLevel = "healthy"
+	HealthLevelDegraded  HealthLevel = "degraded"
+	HealthLevelUnhealthy HealthLevel = "unhealthy"
+)
+
+func (l HealthLevel) rank() int {
+	switch l {
+	case HealthLevelHealthy:
+		return 0
+	case HealthLevelDegraded:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func worstHealthLevel(a, b HealthLevel) HealthLevel {
+	if b.rank() > a.rank() {
+		return b
+	}
+	return a
+}
+
+// HealthSubsystem is a dependency of eosws whose lag is monitored.
+type HealthSubsystem string
+
+const (
+	HealthSubsystemHub    HealthSubsystem = "hub"
+	HealthSubsystemTRXDB  HealthSubsystem = "trxdb"
+	HealthSubsystemFlux   HealthSubsystem = "flux"
+	HealthSubsystemSearch HealthSubsystem = "search"
+	HealthSubsystemMerger HealthSubsystem = "merger"
+)
+
+// HealthFeature is a group of endpoints served by eosws, its readiness is
+// the worst level of the subsystems it depends on.
+type HealthFeature string
+
+const (
+	HealthFeatureStreams      HealthFeature = "streams"
+	HealthFeatureTransactions HealthFeature = "transactions"
+	HealthFeatureState        HealthFeature = "state"
+	HealthFeatureSearch       HealthFeature = "search"
+	HealthFeatureArchives     HealthFeature = "archives"
+)
+
+var healthFeatureDependencies = map[HealthFeature][]HealthSubsystem{
+	HealthFeatureStreams:      {HealthSubsystemHub},
+	HealthFeatureTransactions: {HealthSubsystemTRXDB},
+	HealthFeatureState:        {HealthSubsystemFlux},
+	HealthFeatureSearch:       {HealthSubsystemSearch, HealthSubsystemTRXDB},
+	HealthFeatureArchives:     {HealthSubsystemMerger},
+}
+
+// HealthThresholds are the latencies at which a subsystem becomes degraded
+// then unhealthy. The hub latency is in seconds behind wall clock, all the
+// others are in blocks behind the hub head block. A check not answering
+// within `Timeout` marks the subsystem unhealthy.
+type HealthThresholds struct {
+	DegradedLatency  int
+	UnhealthyLatency int
+	Timeout          time.Duration
+}
+
+func (t *HealthThresholds) level(latency int) HealthLevel {
+	switch {
+	case latency >= t.UnhealthyLatency:
+		return HealthLevelUnhealthy
+	case latency >= t.DegradedLatency:
+		return HealthLevelDegraded
+	default:
+		return HealthLevelHealthy
+	}
+}
+
+type HealthConfig struct {
+	Thresholds    map[HealthSubsystem]*HealthThresholds
+	CheckInterval time.Duration
+	HistorySize   int
+}
+
+func DefaultHealthConfig() *HealthConfig {
+	return &HealthConfig{
+		Thresholds: map[HealthSubsystem]*HealthThresholds{
+			HealthSubsystemHub:    {DegradedLatency: 12, UnhealthyLatency: 120, Timeout: 2 * time.Second},
+			HealthSubsystemTRXDB:  {DegradedLatency: 24, UnhealthyLatency: 1200, Timeout: 2 * time.Second},
+			HealthSubsystemFlux:   {DegradedLatency: 24, UnhealthyLatency: 1200, Timeout: 2 * time.Second},
+			HealthSubsystemSearch: {DegradedLatency: 24, UnhealthyLatency: 1200, Timeout: 2 * time.Second},
+			HealthSubsystemMerger: {DegradedLatency: 500, UnhealthyLatency: 5000, Timeout: 2 * time.Second},
+		},
+		CheckInterval: 5 * time.Second,
+		HistorySize:   100,
+	}
+}
+
+// ParseHealthThresholds overrides the thresholds of `config` with `specs`
+// of the form `<subsystem>:<degraded>:<unhealthy>[:<timeout>]`, for example
+// `search:50:500:1s`.
+func (c *HealthConfig) ParseHealthThresholds(specs []string) error {
+	for _, spec := range specs {
+		parts := strings.Split(spec, ":")
+		if len(parts) < 3 || len(parts) > 4 {
+			return fmt.Errorf("invalid health threshold %q, expecting <subsystem>:<degraded>:<unhealthy>[:<timeout>]", spec)
+		}
+
+		current, found := c.Thresholds[HealthSubsystem(parts[0])]
+		if !found {
+			return fmt.Errorf("invalid health threshold %q, unknown subsystem %q", spec, parts[0])
+		}
+
+		thresholds := *current
+		var err error
+		if thresholds.DegradedLatency, err = strconv.Atoi(parts[1]); err != nil {
+			return fmt.Errorf("invalid health threshold %q, degraded latency: %w", spec, err)
+		}
+
+		if thresholds.UnhealthyLatency, err = strconv.Atoi(parts[2]); err != nil {
+			return fmt.Errorf("invalid health threshold %q, unhealthy latency: %w", spec, err)
+		}
+
+		if thresholds.UnhealthyLatency < thresholds.DegradedLatency {
+			return fmt.Errorf("invalid health threshold %q, unhealthy latency must be greater or equal to degraded latency", spec)
+		}
+
+		if len(parts) == 4 {
+			if thresholds.Timeout, err = time.ParseDuration(parts[3]); err != nil {
+				return fmt.Errorf("invalid health threshold %q, timeout: %w", spec, err)
+			}
+		}
+
+		c.Thresholds[HealthSubsystem(parts[0])] = &thresholds
+	}
+
+	return nil
+}
+
+// HealthCheck measures a subsystem. It returns the subsystem's head block
+// and its latency relative to `refHeadBlockNum`, the hub head block (the
+// hub check receives 0 and returns its own head block and its latency in
+// seconds).
+type HealthCheck func(ctx context.Context, refHeadBlockNum uint32) (headBlockNum uint32, latency int, err error)
+
+type SubsystemHealth struct {
+	Level     HealthLevel `json:"level"`
+	HeadBlock uint32      `json:"head_block"`
+	Latency   int         `json:"latency"`
+	Error     string      `json:"error,omitempty"`
+	CheckedAt time.Time   `json:"checked_at"`
+}
+
+type FeatureReadiness struct {
+	Level   HealthLevel `json:"level"`
+	Reasons []string    `json:"reasons,omitempty"`
+}
+
+type HealthReport struct {
+	Status     HealthLevel                          `json:"status"`
+	CheckedAt  time.Time                            `json:"checked_at"`
+	Subsystems map[HealthSubsystem]*SubsystemHealth `json:"subsystems"`
+	Features   map[HealthFeature]*FeatureReadiness  `json:"features"`
+}
+
+type HealthTransition struct {
+	Time      time.Time       `json:"time"`
+	Subsystem HealthSubsystem `json:"subsystem"`
+	From      HealthLevel     `json:"from,omitempty"`
+	To        HealthLevel     `json:"to"`
+	Reason    string          `json:"reason,omitempty"`
+}
+
+// HealthMonitor periodically runs the health checks of the eosws
+// dependencies, keeping the last report and the history of level
+// transitions. Requests hitting a feature whose dependencies are unhealthy
+// are rejected through `RequireFeature`.
+type HealthMonitor struct {
+	config *HealthConfig
+	hub    HealthCheck
+	checks map[HealthSubsystem]HealthCheck
+
+	lock        sync.RWMutex
+	report      *HealthReport
+	transitions []*HealthTransition
+}
+
+// NewHealthMonitor creates a monitor over `checks`, the hub check must be
+// present as all latencies are computed relative to its head block.
+// Subsystems without a check (not configured) are not part of the report.
+func NewHealthMonitor(config *HealthConfig, checks map[HealthSubsystem]HealthCheck) *HealthMonitor {
+	others := map[HealthSubsystem]HealthCheck{}
+	for subsystem, check := range checks {
+		if subsystem != HealthSubsystemHub {
+			others[subsystem] = check
+		}
+	}
+
+	return &HealthMonitor{
+		config: config,
+		hub:    checks[HealthSubsystemHub],
+		checks: others,
+	}
+}
+
+// Run checks the subsystems every `CheckInterval` until `ctx` is done, call
+// `Check` beforehand to have a report right away.
+func (m *HealthMonitor) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.config.CheckInterval):
+		}
+
+		m.Check(ctx)
+	}
+}
+
+// Check runs all health checks once and updates the current report.
+func (m *HealthMonitor) Check(ctx context.Context) *HealthReport {
+	report := &HealthReport{
+		CheckedAt:  time.Now(),
+		Subsystems: map[HealthSubsystem]*SubsystemHealth{},
+		Features:   map[HealthFeature]*FeatureReadiness{},
+	}
+
+	hubHealth := m.runCheck(ctx, HealthSubsystemHub, m.hub, 0)
+	report.Subsystems[HealthSubsystemHub] = hubHealth
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	for subsystem, check := range m.checks {
+		wg.Add(1)
+		go func(subsystem HealthSubsystem, check HealthCheck) {
+			defer wg.Done()
+
+			var health *SubsystemHealth
+			if hubHealth.Error != "" {
+				// Without a reference head block, the latency is meaningless
+				health = &SubsystemHealth{Level: HealthLevelUnhealthy, Error: "hub head block unknown", CheckedAt: time.Now()}
+			} else {
+				health = m.runCheck(ctx, subsystem, check, hubHealth.HeadBlock)
+			}
+
+			lock.Lock()
+			report.Subsystems[subsystem] = health
+			lock.Unlock()
+		}(subsystem, check)
+	}
+	wg.Wait()
+
+	report.Status = HealthLevelHealthy
+	for _, health := range report.Subsystems {
+		report.Status = worstHealthLevel(report.Status, health.Level)
+	}
+
+	for feature, dependencies := range healthFeatureDependencies {
+		readiness := &FeatureReadiness{Level: HealthLevelHealthy}
+		for _, subsystem := range dependencies {
+			health, found := report.Subsystems[subsystem]
+			if !found || health.Level == HealthLevelHealthy {
+				continue
+			}
+
+			readiness.Level = worstHealthLevel(readiness.Level, health.Level)
+			readiness.Reasons = append(readiness.Reasons, health.reason(subsystem))
+		}
+		report.Features[feature] = readiness
+	}
+
+	m.update(report)
+	return report
+}
+
+func (m *HealthMonitor) runCheck(ctx context.Context, subsystem HealthSubsystem, check HealthCheck, refHeadBlockNum uint32) *SubsystemHealth {
+	thresholds, found := m.config.Thresholds[subsystem]
+	if !found {
+		thresholds = DefaultHealthConfig().Thresholds[subsystem]
+	}
+
+	if check == nil {
+		return &SubsystemHealth{Level: HealthLevelUnhealthy, Error: "no health check configured", CheckedAt: time.Now()}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, thresholds.Timeout)
+	defer cancel()
+
+	type result struct {
+		headBlockNum uint32
+		latency      int
+		err          error
+	}
+
+	done := make(chan *result, 1)
+	go func() {
+		headBlockNum, latency, err := check(ctx, refHeadBlockNum)
+		done <- &result{headBlockNum, latency, err}
+	}()
+
+	health := &SubsystemHealth{}
+	select {
+	case res := <-done:
+		health.HeadBlock = res.headBlockNum
+		health.Latency = res.latency
+		if res.err != nil {
+			health.Error = res.err.Error()
+		}
+	case <-ctx.Done():
+		health.Error = fmt.Sprintf("%s health check time out", subsystem)
+	}
+
+	health.CheckedAt = time.Now()
+	health.Level = thresholds.level(health.Latency)
+	if health.Error != "" {
+		health.Level = HealthLevelUnhealthy
+	}
+
+	return health
+}
+
+func (h *SubsystemHealth) reason(subsystem HealthSubsystem) string {
+	if h.Error != "" {
+		return fmt.Sprintf("%s is %s: %s", subsystem, h.Level, h.Error)
+	}
+
+	unit := "blocks"
+	if subsystem == HealthSubsystemHub {
+		unit = "seconds"
+	}
+
+	return fmt.Sprintf("%s is %s: %d %s behind", subsystem, h.Level, h.Latency, unit)
+}
+
+func (m *HealthMonitor) update(report *HealthReport) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var subsystems []string
+	for subsystem := range report.Subsystems {
+		subsystems = append(subsystems, string(subsystem))
+	}
+	sort.Strings(subsystems)
+
+	for _, name := range subsystems {
+		subsystem := HealthSubsystem(name)
+		health := report.Subsystems[subsystem]
+
+		var previous HealthLevel
+		if m.report != nil {
+			if previousHealth, found := m.report.Subsystems[subsystem]; found {
+				previous = previousHealth.Level
+			}
+		}
+
+		if previous == health.Level {
+			continue
+		}
+
+		transition := &HealthTransition{
+			Time:      report.CheckedAt,
+			Subsystem: subsystem,
+			From:      previous,
+			To:        health.Level,
+		}
+		if health.Level != HealthLevelHealthy {
+			transition.Reason = health.reason(subsystem)
+		}
+
+		zlog.Info("health transition", zap.String("subsystem", name), zap.String("from", string(previous)), zap.String("to", string(health.Level)), zap.String("reason", transition.Reason))
+		m.transitions = append(m.transitions, transition)
+	}
+
+	if overflow := len(m.transitions) - m.config.HistorySize; overflow > 0 {
+		m.transitions = append([]*HealthTransition(nil), m.transitions[overflow:]...)
+	}
+
+	m.report = report
+}
+
+// Report returns the last health report, nil if no check ran yet.
+func (m *HealthMonitor) Report() *HealthReport {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.report
+}
+
+// Transitions returns the recorded level transitions, oldest first.
+func (m *HealthMonitor) Transitions() []*HealthTransition {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return append([]*HealthTransition(nil), m.transitions...)
+}
+
+// Feature returns the readiness of `feature` in the last report, healthy
+// when no check ran yet.
+func (m *HealthMonitor) Feature(feature HealthFeature) *FeatureReadiness {
+	report := m.Report()
+	if report == nil {
+		return &FeatureReadiness{Level: HealthLevelHealthy}
+	}
+
+	if readiness, found := report.Features[feature]; found {
+		return readiness
+	}
+
+	return &FeatureReadiness{Level: HealthLevelHealthy}
+}
+
+// RequireFeature is a middleware rejecting requests with a `503` when
+// `feature` is unhealthy. Requests on a degraded feature are served, the
+// `X-Dfuse-Health` response header letting callers know data may lag.
+func (m *HealthMonitor) RequireFeature(feature HealthFeature) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			readiness := m.Feature(feature)
+			switch readiness.Level {
+			case HealthLevelUnhealthy:
+				eosws.WriteError(w, r, eosws.AppFeatureUnavailableError(r.Context(), string(feature), readiness.Reasons))
+				return
+			case HealthLevelDegraded:
+				w.Header().Set("X-Dfuse-Health", string(HealthLevelDegraded))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testHealthLatencies map[HealthSubsystem]int
+
+func (l testHealthLatencies) checks() map[HealthSubsystem]HealthCheck {
+	checks := map[HealthSubsystem]HealthCheck{}
+	for subsystem := range l {
+		subsystem := subsystem
+		checks[subsystem] = func(ctx context.Context, refHeadBlockNum uint32) (uint32, int, error) {
+			latency := l[subsystem]
+			switch latency {
+			case -1:
+				return 0, 0, errors.New("unreachable")
+			case -2:
+				time.Sleep(time.Second)
+				return 0, 0, nil
+			}
+
+			if subsystem == HealthSubsystemHub {
+				return 1000, latency, nil
+			}
+			return refHeadBlockNum - uint32(latency), latency, nil
+		}
+	}
+
+	return checks
+}
+
+func newTestHealthConfig() *HealthConfig {
+	config := DefaultHealthConfig()
+	for _, thresholds := range config.Thresholds {
+		thresholds.Timeout = 50 * time.Millisecond
+	}
+
+	return config
+}
+
+func TestHealthMonitor_Check(t *testing.T) {
+	latencies := testHealthLatencies{
+		HealthSubsystemHub:    1,
+		HealthSubsystemTRXDB:  2,
+		HealthSubsystemFlux:   30,
+		HealthSubsystemSearch: -1,
+		HealthSubsystemMerger: -2,
+	}
+	monitor := NewHealthMonitor(newTestHealthConfig(), latencies.checks())
+
+	report := monitor.Check(context.Background())
+	assert.Equal(t, HealthLevelUnhealthy, report.Status)
+
+	assert.Equal(t, HealthLevelHealthy, report.Subsystems[HealthSubsystemHub].Level)
+	assert.Equal(t, HealthLevelHealthy, report.Subsystems[HealthSubsystemTRXDB].Level)
+	assert.Equal(t, uint32(998), report.Subsystems[HealthSubsystemTRXDB].HeadBlock)
+	assert.Equal(t, HealthLevelDegraded, report.Subsystems[HealthSubsystemFlux].Level)
+	assert.Equal(t, HealthLevelUnhealthy, report.Subsystems[HealthSubsystemSearch].Level)
+	assert.Equal(t, "unreachable", report.Subsystems[HealthSubsystemSearch].Error)
+	assert.Equal(t, "merger health check time out", report.Subsystems[HealthSubsystemMerger].Error)
+
+	assert.Equal(t, &FeatureReadiness{Level: HealthLevelHealthy}, report.Features[HealthFeatureStreams])
+	assert.Equal(t, &FeatureReadiness{Level: HealthLevelHealthy}, report.Features[HealthFeatureTransactions])
+	assert.Equal(t, &FeatureReadiness{Level: HealthLevelDegraded, Reasons: []string{"flux is degraded: 30 blocks behind"}}, report.Features[HealthFeatureState])
+	assert.Equal(t, &FeatureReadiness{Level: HealthLevelUnhealthy, Reasons: []string{"search is unhealthy: unreachable"}}, report.Features[HealthFeatureSearch])
+	assert.Equal(t, HealthLevelUnhealthy, report.Features[HealthFeatureArchives].Level)
+}
+
+func TestHealthMonitor_UnknownHubHead(t *testing.T) {
+	latencies := testHealthLatencies{HealthSubsystemHub: -1, HealthSubsystemTRXDB: 0}
+	monitor := NewHealthMonitor(newTestHealthConfig(), latencies.checks())
+
+	report := monitor.Check(context.Background())
+	assert.Equal(t, HealthLevelUnhealthy, report.Subsystems[HealthSubsystemTRXDB].Level)
+	assert.Equal(t, "hub head block unknown", report.Subsystems[HealthSubsystemTRXDB].Error)
+	assert.NotContains(t, report.Subsystems, HealthSubsystemFlux)
+	assert.Equal(t, HealthLevelHealthy, report.Features[HealthFeatureState].Level)
+}
+
+func TestHealthMonitor_Transitions(t *testing.T) {
+	config := newTestHealthConfig()
+	config.HistorySize = 3
+
+	latencies := testHealthLatencies{HealthSubsystemHub: 0, HealthSubsystemSearch: 0}
+	monitor := NewHealthMonitor(config, latencies.checks())
+
+	monitor.Check(context.Background())
+	monitor.Check(context.Background())
+
+	latencies[HealthSubsystemSearch] = 50
+	monitor.Check(context.Background())
+
+	latencies[HealthSubsystemSearch] = 5000
+	monitor.Check(context.Background())
+
+	var actual [][]string
+	for _, transition := range monitor.Transitions() {
+		actual = append(actual, []string{string(transition.Subsystem), string(transition.From), string(transition.To), transition.Reason})
+	}
+
+	assert.Equal(t, [][]string{
+		{"search", "", "healthy", ""},
+		{"search", "healthy", "degraded", "search is degraded: 50 blocks behind"},
+		{"search", "degraded", "unhealthy", "search is unhealthy: 5000 blocks behind"},
+	}, actual)
+}
+
+func TestHealthMonitor_RequireFeature(t *testing.T) {
+	latencies := testHealthLatencies{HealthSubsystemHub: 0, HealthSubsystemFlux: 50, HealthSubsystemSearch: 5000}
+	monitor := NewHealthMonitor(newTestHealthConfig(), latencies.checks())
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) })
+	serve := func(feature HealthFeature) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		monitor.RequireFeature(feature)(handler).ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+		return recorder
+	}
+
+	// No report yet, everything is served
+	assert.Equal(t, http.StatusOK, serve(HealthFeatureSearch).Code)
+
+	monitor.Check(context.Background())
+
+	recorder := serve(HealthFeatureStreams)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, recorder.Header().Get("X-Dfuse-Health"))
+
+	recorder = serve(HealthFeatureState)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "degraded", recorder.Header().Get("X-Dfuse-Health"))
+
+	recorder = serve(HealthFeatureSearch)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "app_feature_unavailable_error")
+	assert.Contains(t, recorder.Body.String(), "search is unhealthy: 5000 blocks behind")
+}
+
+func TestHealthConfig_ParseHealthThresholds(t *testing.T) {
+	config := DefaultHealthConfig()
+	require.NoError(t, config.ParseHealthThresholds([]string{"search:50:500:1s", "hub:5:60"}))
+
+	assert.Equal(t, &HealthThresholds{DegradedLatency: 50, UnhealthyLatency: 500, Timeout: time.Second}, config.Thresholds[HealthSubsystemSearch])
+	assert.Equal(t, &HealthThresholds{DegradedLatency: 5, UnhealthyLatency: 60, Timeout: 2 * time.Second}, config.Thresholds[HealthSubsystemHub])
+
+	assert.EqualError(t, config.ParseHealthThresholds([]string{"search:50"}), `invalid health threshold "search:50", expecting <subsystem>:<degraded>:<unhealthy>[:<timeout>]`)
+	assert.EqualError(t, config.ParseHealthThresholds([]string{"other:1:2"}), `invalid health threshold "other:1:2", unknown subsystem "other"`)
+	assert.EqualError(t, config.ParseHealthThresholds([]string{"flux:20:10"}), `invalid health threshold "flux:20:10", unhealthy latency must be greater or equal to degraded latency`)
+}
+
+func TestHealthzHandler(t *testing.T) {
+	latencies := testHealthLatencies{HealthSubsystemHub: 200, HealthSubsystemFlux: -1}
+	monitor := NewHealthMonitor(newTestHealthConfig(), latencies.checks())
+	monitor.Check(context.Background())
+
+	handler := HealthzHandler(monitor, "secret")
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/healthz?secret=wrong", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/healthz?secret=secret", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var probe map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &probe))
+	assert.JSONEq(t, `"unhealthy"`, string(probe["status"]))
+	assert.JSONEq(t, `{"hub":false}`, string(probe["healthy"]))
+	assert.NotContains(t, probe, "transitions")
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/healthz?secret=secret&full=true&history=true", nil))
+	require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+
+	var full map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &full))
+	assert.JSONEq(t, `{"hub":false,"flux":false}`, string(full["healthy"]))
+	assert.JSONEq(t, `["unreachable"]`, string(full["errors"]))
+	assert.Contains(t, string(full["transitions"]), `"subsystem":"flux"`)
+}
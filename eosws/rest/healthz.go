@@ -17,11 +17,12 @@ package rest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
+	"sort"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/dfuse-io/bstream"
@@ -36,40 +37,13 @@ import (
 	"go.uber.org/zap"
 )
 
-var maxSecondsLatencyHubStatus = 120
-var maxBlockLatencyStreams = 24
-var maxBlockLatencyArchives = 500
-var maxSubsystemResponseTime = 350 * time.Millisecond
-
-type Hub struct {
-	HeadBlockNum   uint32    `json:"head_block_num"`
-	HeadBlockTime  time.Time `json:"head_block_time"`
-	TimeLatencySec int64     `json:"time_latency_sec"`
-}
-type TRXDB struct {
-	HeadBlock    uint32 `json:"head_block"`
-	BlockLatency int    `json:"block_latency"`
-}
-type Flux struct {
-	HeadBlock    uint32 `json:"head_block"`
-	BlockLatency int    `json:"block_latency"`
-}
-type Search struct {
-	HeadBlock    uint32 `json:"head_block"`
-	BlockLatency int    `json:"block_latency"`
-}
-type Merger struct {
-	LastMergedBlock uint32 `json:"last_merged_block"`
-	BlockLatency    int    `json:"block_latency"`
-}
-
+// Healthz is the `/healthz` response, the `errors` and `healthy` fields are
+// kept for existing monitoring, the levels and features come from the
+// last `HealthReport`.
 type Healthz struct {
+	*HealthReport
+
 	Errors  []string `json:"errors"`
-	Hub     *Hub     `json:"hub,omitempty"`
-	TRXDB   *TRXDB   `json:"trxdb,omitempty"`
-	Flux    *Flux    `json:"flux,omitempty"`
-	Search  *Search  `json:"search,omitempty"`
-	Merger  *Merger  `json:"merger,omitempty"`
 	Healthy struct {
 		Hub    *bool `json:"hub,omitempty"`
 		Merger *bool `json:"merger,omitempty"`
@@ -77,6 +51,8 @@ type Healthz struct {
 		Flux   *bool `json:"flux,omitempty"`
 		Search *bool `json:"search,omitempty"`
 	} `json:"healthy"`
+
+	Transitions []*HealthTransition `json:"transitions,omitempty"`
 }
 
 func SearchNotStuckHandler(searchEngine *eosws.SearchEngine) http.Handler {
@@ -100,13 +76,12 @@ func SearchNotStuckHandler(searchEngine *eosws.SearchEngine) http.Handler {
 	})
 }
 
-func HealthzHandler(hub *hub.SubscriptionHub, api *eos.API, blocksStore dstore.Store, db eosws.DB, fluxClient fluxdb.Client, searchEngine *eosws.SearchEngine, expectedSecret string) http.Handler {
+// HealthzHandler serves the last report of `monitor`. Without `full`, only
+// the hub is reported (k8s probes), with it every subsystem and feature is,
+// the response being a `503` when the hub is unhealthy. The level
+// transitions are added with `history=true`.
+func HealthzHandler(monitor *HealthMonitor, expectedSecret string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		zlogger := logging.Logger(r.Context(), zlog)
-		h := &Healthz{
-			Errors: []string{},
-		}
-
 		keys := r.URL.Query()
 		secret := keys.Get("secret")
 		if secret != expectedSecret {
@@ -120,51 +95,81 @@ func HealthzHandler(hub *hub.SubscriptionHub, api *eos.API, blocksStore dstore.S
 			return
 		}
 		w.Header().Set("Content-Type", "application/json")
-		customMaxSecondsLatencyHubStatus, err := strconv.ParseInt(keys.Get("max_seconds_latency_hub_status"), 10, 64)
-		if err == nil && customMaxSecondsLatencyHubStatus > 0 {
-			maxSecondsLatencyHubStatus = int(customMaxSecondsLatencyHubStatus)
-		}
-		customMaxBlockLatencyStreams, err := strconv.ParseInt(keys.Get("max_block_latency_streams"), 10, 64)
-		if err == nil && customMaxBlockLatencyStreams > 0 {
-			maxBlockLatencyStreams = int(customMaxBlockLatencyStreams)
-		}
-		customMaxBlockLatencyArchives, err := strconv.ParseInt(keys.Get("max_block_latency_archives"), 10, 64)
-		if err == nil && customMaxBlockLatencyArchives > 0 {
-			maxBlockLatencyArchives = int(customMaxBlockLatencyArchives)
+
+		h := newHealthz(monitor.Report(), full)
+		if history, _ := strconv.ParseBool(keys.Get("history")); history {
+			h.Transitions = monitor.Transitions()
 		}
-		customMaxSubsystemResponseTimeMS, err := strconv.ParseInt(keys.Get("max_subsystem_response_time_ms"), 10, 64)
-		if err == nil && customMaxSubsystemResponseTimeMS > 0 {
-			maxSubsystemResponseTime = time.Duration(customMaxSubsystemResponseTimeMS) * time.Millisecond
+
+		if full && h.HealthReport != nil && h.Subsystems[HealthSubsystemHub].Level == HealthLevelUnhealthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
 		}
 
-		var wg sync.WaitGroup
+		json.NewEncoder(w).Encode(h)
+	})
+}
+
+func newHealthz(report *HealthReport, full bool) *Healthz {
+	h := &Healthz{Errors: []string{}}
+	if report == nil {
+		return h
+	}
 
-		refHeadBlockNum := healthCheckHub(h, hub, zlogger)
+	if !full {
+		hubHealth := report.Subsystems[HealthSubsystemHub]
+		report = &HealthReport{
+			Status:     hubHealth.Level,
+			CheckedAt:  report.CheckedAt,
+			Subsystems: map[HealthSubsystem]*SubsystemHealth{HealthSubsystemHub: hubHealth},
+			Features:   map[HealthFeature]*FeatureReadiness{HealthFeatureStreams: report.Features[HealthFeatureStreams]},
+		}
+	}
 
-		if full {
-			wg.Add(4)
-			go healthCheckBigTable(h, &wg, db, r, refHeadBlockNum)
-			go healthCheckFlux(r.Context(), h, &wg, fluxClient, refHeadBlockNum)
-			go healthCheckSearch(r.Context(), h, &wg, searchEngine, refHeadBlockNum, zlogger)
-			go healthCheckMerger(h, &wg, refHeadBlockNum, blocksStore, zlogger)
-			if h.Hub.TimeLatencySec > int64(maxSecondsLatencyHubStatus) {
-				w.WriteHeader(http.StatusServiceUnavailable)
-			}
+	h.HealthReport = report
+	healthy := map[HealthSubsystem]**bool{
+		HealthSubsystemHub:    &h.Healthy.Hub,
+		HealthSubsystemMerger: &h.Healthy.Merger,
+		HealthSubsystemTRXDB:  &h.Healthy.TRXDB,
+		HealthSubsystemFlux:   &h.Healthy.Flux,
+		HealthSubsystemSearch: &h.Healthy.Search,
+	}
+
+	for subsystem, health := range report.Subsystems {
+		if health.Error != "" {
+			h.Errors = append(h.Errors, health.Error)
 		}
 
-		wg.Wait()
-		zlog.Debug("Healthz wait group done")
-		json.NewEncoder(w).Encode(h)
+		if field, found := healthy[subsystem]; found {
+			*field = newBool(health.Level == HealthLevelHealthy)
+		}
+	}
+	sort.Strings(h.Errors)
 
-	})
+	return h
 }
 
-func healthCheckSearch(ctx context.Context, h *Healthz, wg *sync.WaitGroup, searchEngine *eosws.SearchEngine, headBlockNum uint32, zlogger *zap.Logger) {
-	defer wg.Done()
-	done := make(chan struct{})
+// NewHealthChecks returns the health checks of the eosws dependencies,
+// `fluxClient` and `searchEngine` being optional.
+func NewHealthChecks(hub *hub.SubscriptionHub, blocksStore dstore.Store, db eosws.DB, fluxClient fluxdb.Client, searchEngine *eosws.SearchEngine) map[HealthSubsystem]HealthCheck {
+	checks := map[HealthSubsystem]HealthCheck{
+		HealthSubsystemHub:    healthCheckHub(hub),
+		HealthSubsystemTRXDB:  healthCheckTRXDB(db),
+		HealthSubsystemMerger: healthCheckMerger(blocksStore),
+	}
+
+	if fluxClient != nil {
+		checks[HealthSubsystemFlux] = healthCheckFlux(fluxClient)
+	}
+
+	if searchEngine != nil {
+		checks[HealthSubsystemSearch] = healthCheckSearch(searchEngine)
+	}
+
+	return checks
+}
 
-	go func() {
-		h.Search = &Search{}
+func healthCheckSearch(searchEngine *eosws.SearchEngine) HealthCheck {
+	return func(ctx context.Context, headBlockNum uint32) (uint32, int, error) {
 		searchRequest := &pbsearch.RouterRequest{
 			Query:               "action:onblock",
 			Limit:               1,
@@ -176,198 +181,105 @@ func healthCheckSearch(ctx context.Context, h *Healthz, wg *sync.WaitGroup, sear
 
 		traces, _, err := searchEngine.DoRequest(ctx, searchRequest)
 		if err != nil {
-			h.Errors = append(h.Errors, err.Error())
-			close(done)
-			return
+			return 0, 0, err
 		}
 
 		if len(traces) == 0 {
-			h.Errors = append(h.Errors, "no traces returned from search")
-			close(done)
-			return
+			return 0, 0, errors.New("no traces returned from search")
 		}
 
 		blockNum := uint32(traces[len(traces)-1].GetBlockNum())
-
-		h.Search.BlockLatency = int(headBlockNum - blockNum)
-		if blockNum > headBlockNum {
-			h.Search.BlockLatency = 0
-		}
-		h.Search.HeadBlock = blockNum
-		isHealthy := h.Search.BlockLatency < maxBlockLatencyStreams
-		h.Healthy.Search = newBool(isHealthy)
-		close(done)
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(maxSubsystemResponseTime):
-		h.Errors = append(h.Errors, "Search health check time out")
-		h.Healthy.Search = newBool(false)
+		return blockNum, blockLatency(headBlockNum, blockNum), nil
 	}
-
 }
 
-func healthCheckHub(h *Healthz, hub *hub.SubscriptionHub, zlogger *zap.Logger) (headBlockNum uint32) {
-	done := make(chan struct{})
-
-	go func() {
+func healthCheckHub(hub *hub.SubscriptionHub) HealthCheck {
+	return func(ctx context.Context, _ uint32) (uint32, int, error) {
 		hubHeadBlock := hub.HeadBlock()
-
-		h.Hub = &Hub{}
-		h.Hub.HeadBlockNum = uint32(hubHeadBlock.Num())
-		h.Healthy.Hub = newBool(true)
+		if hubHeadBlock == nil {
+			return 0, 0, errors.New("hub has no head block yet")
+		}
 
 		logOptions := []zap.Field{zap.Stringer("head_block", hubHeadBlock)}
 
+		latency := 0
 		if block, ok := hubHeadBlock.(*bstream.PreprocessedBlock); ok {
 			headBlockTime := block.Block.Time()
-			h.Hub.HeadBlockTime = headBlockTime
-			h.Hub.TimeLatencySec = int64(time.Now().Sub(headBlockTime) / time.Second)
-			h.Healthy.Hub = newBool(h.Hub.TimeLatencySec*2 < int64(maxBlockLatencyStreams))
+			if elapsed := time.Since(headBlockTime); elapsed > 0 {
+				latency = int(elapsed / time.Second)
+			}
 
 			logOptions = append(logOptions, zap.Time("head_block_time", headBlockTime))
 		}
 
-		zlogger.Debug("hub health check", logOptions...)
-		close(done)
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(maxSubsystemResponseTime):
-		h.Errors = append(h.Errors, "Hub health check time out")
-		h.Healthy.Hub = newBool(false)
+		logging.Logger(ctx, zlog).Debug("hub health check", logOptions...)
+		return uint32(hubHeadBlock.Num()), latency, nil
 	}
-
-	return h.Hub.HeadBlockNum
 }
 
-func healthCheckBigTable(h *Healthz, wg *sync.WaitGroup, db eosws.DB, r *http.Request, headBlockNum uint32) {
-	defer wg.Done()
-	done := make(chan struct{})
-
-	go func() {
-		bigTableHeadBlocks, err := db.ListBlocks(r.Context(), math.MaxUint32, 1)
+func healthCheckTRXDB(db eosws.DB) HealthCheck {
+	return func(ctx context.Context, headBlockNum uint32) (uint32, int, error) {
+		headBlocks, err := db.ListBlocks(ctx, math.MaxUint32, 1)
 		if err != nil {
-			h.Errors = append(h.Errors, err.Error())
-			close(done)
-			return
+			return 0, 0, err
 		}
-		h.TRXDB = &TRXDB{}
-		if len(bigTableHeadBlocks) == 1 {
-			bgHeadBlock := bigTableHeadBlocks[0]
-			blockNum := eos.BlockNum(bgHeadBlock.Id)
-			h.TRXDB.HeadBlock = blockNum
-			h.TRXDB.BlockLatency = int(headBlockNum - blockNum)
-			if blockNum > headBlockNum {
-				h.TRXDB.BlockLatency = 0
-			}
+
+		if len(headBlocks) == 0 {
+			return 0, 0, errors.New("no blocks in trxdb")
 		}
-		isHealthy := h.TRXDB.BlockLatency < maxBlockLatencyStreams
-		h.Healthy.TRXDB = newBool(isHealthy)
-		close(done)
-	}()
-
-	select {
-	case <-done:
-	case <-time.After(maxSubsystemResponseTime):
-		h.Errors = append(h.Errors, "Big Table health check time out")
-		h.Healthy.TRXDB = newBool(false)
-	}
 
+		blockNum := eos.BlockNum(headBlocks[0].Id)
+		return blockNum, blockLatency(headBlockNum, blockNum), nil
+	}
 }
 
-func healthCheckFlux(context context.Context, h *Healthz, wg *sync.WaitGroup, fluxClient fluxdb.Client, headBlockNum uint32) {
-	defer wg.Done()
-	done := make(chan struct{})
-
-	go func() {
+func healthCheckFlux(fluxClient fluxdb.Client) HealthCheck {
+	return func(ctx context.Context, headBlockNum uint32) (uint32, int, error) {
 		req := fluxdb.NewGetTableRequest(eos.AccountName("eosio"), eos.Name("eosio"), eos.TableName("global"), "name")
-		h.Flux = &Flux{}
-		res, err := fluxClient.GetTable(context, 0, req)
+		res, err := fluxClient.GetTable(ctx, 0, req)
 		if err != nil {
-			h.Errors = append(h.Errors, err.Error())
-		} else {
-			fluxHeadBlock := res.UpToBlockNum
-			h.Flux.HeadBlock = fluxHeadBlock
-			h.Flux.BlockLatency = int(headBlockNum - fluxHeadBlock)
-			if fluxHeadBlock > headBlockNum {
-				h.Flux.BlockLatency = 0
-			}
+			return 0, 0, err
 		}
-		isHealthy := h.Flux.BlockLatency < maxBlockLatencyStreams
-		h.Healthy.Flux = newBool(isHealthy)
-
-		close(done)
-	}()
 
-	select {
-	case <-done:
-	case <-time.After(maxSubsystemResponseTime):
-		h.Errors = append(h.Errors, "Flux health check time out")
-		h.Healthy.Flux = newBool(false)
+		return res.UpToBlockNum, blockLatency(headBlockNum, res.UpToBlockNum), nil
 	}
-
 }
 
-func healthCheckMerger(h *Healthz, wg *sync.WaitGroup, headBlockNum uint32, blockStore dstore.Store, zlogger *zap.Logger) {
-	defer wg.Done()
-	done := make(chan struct{})
+func healthCheckMerger(blockStore dstore.Store) HealthCheck {
+	return func(ctx context.Context, headBlockNum uint32) (uint32, int, error) {
+		zlogger := logging.Logger(ctx, zlog)
 
-	go func() {
-		h.Merger = &Merger{}
 		baseBlockNum := headBlockNum - (headBlockNum % 100)
-		baseBlockNum -= 100
-		baseFilename := fmt.Sprintf("%010d", baseBlockNum)
-		zlogger.Debug("lastBlockMergedFile called", zap.Uint32("head_block_num", headBlockNum), zap.Uint32("base_block_num", baseBlockNum), zap.String("base_filename", baseFilename))
-
-		var exists bool
-		var err error
-		for !exists && err == nil {
-
-			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-			defer cancel()
-
-			zlogger.Debug("searching for", zap.String("base_filename", baseFilename))
-			exists, err = blockStore.FileExists(ctx, baseFilename)
+		for baseBlockNum >= 100 {
 			baseBlockNum -= 100
-			baseFilename = fmt.Sprintf("%010d", baseBlockNum)
-		}
+			baseFilename := fmt.Sprintf("%010d", baseBlockNum)
 
-		if err != nil {
-			h.Errors = append(h.Errors, err.Error())
-			close(done)
-			return
-		}
+			zlogger.Debug("searching for", zap.String("base_filename", baseFilename))
+			exists, err := blockStore.FileExists(ctx, baseFilename)
+			if err != nil {
+				return 0, 0, err
+			}
 
-		zlogger.Debug("found", zap.String("base_filename", baseFilename))
+			if exists {
+				zlogger.Debug("found", zap.String("base_filename", baseFilename))
 
-		lastMergedBlockNum := baseBlockNum + 99
-		h.Merger.LastMergedBlock = lastMergedBlockNum
-		h.Merger.BlockLatency = int(headBlockNum - lastMergedBlockNum)
-		if lastMergedBlockNum > headBlockNum {
-			h.Merger.BlockLatency = 0
+				lastMergedBlockNum := baseBlockNum + 99
+				return lastMergedBlockNum, blockLatency(headBlockNum, lastMergedBlockNum), nil
+			}
 		}
-		isHealthy := h.Merger.BlockLatency < maxBlockLatencyArchives
-		h.Healthy.Merger = newBool(isHealthy)
 
-		close(done)
-	}()
+		return 0, 0, errors.New("no merged blocks file found")
+	}
+}
 
-	select {
-	case <-done:
-	case <-time.After(maxSubsystemResponseTime):
-		h.Errors = append(h.Errors, "Merger health check time out")
-		h.Healthy.Merger = newBool(false)
+func blockLatency(headBlockNum, blockNum uint32) int {
+	if blockNum > headBlockNum {
+		return 0
 	}
 
+	return int(headBlockNum - blockNum)
 }
 
 func newBool(v bool) *bool {
-	b := false
-	if v {
-		b = true
-	}
-	return &b
+	return &v
 }
@@ -15,36 +15,51 @@
 package rest
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
+	"net/url"
+	"strings"
 
-	"github.com/eoscanada/eos-go"
-
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/hub"
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/codec"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
 	fluxcli "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbsearcheos "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/search/v1"
+	"github.com/dfuse-io/logging"
+	pbsearch "github.com/dfuse-io/pbgo/dfuse/search/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
 )
 
+// maxHistoryActionsWindow is the maximum amount of actions a single `get_actions`
+// call can return, i.e. the absolute value of `offset` is capped to this value.
+const maxHistoryActionsWindow = 1000
+
+// maxHistoryActionsPosition is the highest `pos` accepted by `get_actions`, the
+// actions before the position being searched and skipped.
+const maxHistoryActionsPosition = 10000
+
 func GetKeyAccounts(fluxClient fluxcli.Client) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
-		pubKey := ""
-		if r.Method == "GET" {
-			pubKey = r.FormValue("public_key")
-		} else {
-			body, err := ioutil.ReadAll(r.Body)
-			if err != nil {
-				eosws.WriteError(w, r, derr.Wrap(err, "to read request body"))
-				return
-			}
-			r.Body.Close()
-
-			pubKey = gjson.GetBytes(body, "public_key").String()
+		params, err := readHistoryRequestParams(r)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "to read request body"))
+			return
 		}
 
+		pubKey := params.Get("public_key").String()
+
 		if pubKey == "" {
 			//val := url.Values{}
 			//val.Set("public_key", pubKey)
@@ -67,8 +82,407 @@ func GetKeyAccounts(fluxClient fluxcli.Client) http.Handler {
 				return
 			}
 			eosws.WriteError(w, r, derr.Wrap(err, fmt.Sprintf("failed to retrieve account for key: %s", pubKey)))
+			return
 		}
 
 		eosws.WriteJSON(w, r, resp)
 	})
 }
+
+type getControlledAccountsResponse struct {
+	ControlledAccounts []eos.AccountName `json:"controlled_accounts"`
+}
+
+func GetControlledAccounts(fluxClient fluxcli.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		params, err := readHistoryRequestParams(r)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "to read request body"))
+			return
+		}
+
+		controllingAccount := params.Get("controlling_account").String()
+		if controllingAccount == "" {
+			eosws.WriteError(w, r, derr.RequestValidationError(ctx, url.Values{
+				"controlling_account": []string{"The controlling_account field is required"},
+			}))
+			return
+		}
+
+		resp, err := fluxClient.GetControlledAccounts(ctx, 0, eos.AccountName(controllingAccount))
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, fmt.Sprintf("failed to retrieve controlled accounts for: %s", controllingAccount)))
+			return
+		}
+
+		out := &getControlledAccountsResponse{ControlledAccounts: resp.ControlledAccounts}
+		if out.ControlledAccounts == nil {
+			out.ControlledAccounts = []eos.AccountName{}
+		}
+
+		eosws.WriteJSON(w, r, out)
+	})
+}
+
+type getHistoryTransactionResponse struct {
+	ID                    string              `json:"id"`
+	Trx                   *historyTransaction `json:"trx"`
+	BlockTime             eos.BlockTimestamp  `json:"block_time"`
+	BlockNum              uint32              `json:"block_num"`
+	LastIrreversibleBlock uint32              `json:"last_irreversible_block"`
+	Traces                []eos.ActionTrace   `json:"traces"`
+}
+
+type historyTransaction struct {
+	Receipt *eos.TransactionReceipt `json:"receipt,omitempty"`
+	Trx     *eos.SignedTransaction  `json:"trx,omitempty"`
+}
+
+func GetHistoryTransaction(db eosws.DB, subscriptionHub *hub.SubscriptionHub) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		params, err := readHistoryRequestParams(r)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "to read request body"))
+			return
+		}
+
+		id := params.Get("id").String()
+		if id == "" {
+			eosws.WriteError(w, r, derr.RequestValidationError(ctx, url.Values{
+				"id": []string{"The id field is required"},
+			}))
+			return
+		}
+
+		lifecycle, err := db.GetTransaction(ctx, id)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "failed to get transaction"))
+			return
+		}
+
+		if lifecycle == nil || lifecycle.ExecutionTrace == nil {
+			eosws.WriteError(w, r, eosws.DBTrxNotFoundError(ctx, id))
+			return
+		}
+
+		trx := &historyTransaction{}
+		if lifecycle.TransactionReceipt != nil {
+			trx.Receipt, err = codec.TransactionReceiptToEOS(lifecycle.TransactionReceipt)
+			if err != nil {
+				eosws.WriteError(w, r, derr.Wrap(err, "failed to transform transaction receipt"))
+				return
+			}
+		}
+
+		if lifecycle.Transaction != nil {
+			trx.Trx = codec.SignedTransactionToEOS(lifecycle.Transaction)
+		}
+
+		executionTrace := lifecycle.ExecutionTrace
+		eosws.WriteJSON(w, r, &getHistoryTransactionResponse{
+			ID:                    lifecycle.Id,
+			Trx:                   trx,
+			BlockTime:             codec.TimestampToBlockTimestamp(executionTrace.BlockTime),
+			BlockNum:              uint32(executionTrace.BlockNum),
+			LastIrreversibleBlock: lastIrreversibleBlockNum(subscriptionHub),
+			Traces:                codec.ActionTracesToEOS(executionTrace.ActionTraces),
+		})
+	})
+}
+
+type getActionsResponse struct {
+	Actions               []*historyAction `json:"actions"`
+	LastIrreversibleBlock uint32           `json:"last_irreversible_block"`
+}
+
+type historyAction struct {
+	GlobalActionSeq  uint64             `json:"global_action_seq"`
+	AccountActionSeq int64              `json:"account_action_seq"`
+	BlockNum         uint32             `json:"block_num"`
+	BlockTime        eos.BlockTimestamp `json:"block_time"`
+	ActionTrace      eos.ActionTrace    `json:"action_trace"`
+}
+
+type actionRef struct {
+	trxIDPrefix string
+	actionIndex uint32
+
+	// position is the index of the action in the account's history, -1 when
+	// unknown
+	position int64
+}
+
+// GetActions mimics nodeos history plugin `get_actions` call using search to find
+// all actions received or authorized by the requested account. The `pos` and
+// `offset` parameters have the same semantics as the history plugin, where positions
+// are the 0-based index of the action in the account's history, `pos` being capped
+// to `maxHistoryActionsPosition`.
+//
+// The `account_action_seq` field is the action's position when `pos` is given,
+// which equals the history plugin's one when search covers the full history of the
+// account. For the last actions (`pos` of -1), the position is unknown and the field
+// is instead the action's receive sequence minus one when the account is the
+// receiver, which differs from the position when some actions were only authorized
+// by the account, and -1 otherwise.
+func GetActions(searchEngine *eosws.SearchEngine, db eosws.DB, subscriptionHub *hub.SubscriptionHub) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		zlogger := logging.Logger(ctx, zlog)
+
+		params, err := readHistoryRequestParams(r)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "to read request body"))
+			return
+		}
+
+		accountName := params.Get("account_name").String()
+		pos := int64(-1)
+		if value := params.Get("pos"); value.Exists() {
+			pos = value.Int()
+		}
+
+		offset := int64(-20)
+		if value := params.Get("offset"); value.Exists() {
+			offset = value.Int()
+		}
+
+		errors := url.Values{}
+		if _, err := eos.StringToName(accountName); accountName == "" || err != nil {
+			errors["account_name"] = []string{"The account_name field must be a valid EOS name"}
+		}
+
+		if offset > maxHistoryActionsWindow || offset < -maxHistoryActionsWindow {
+			errors["offset"] = []string{fmt.Sprintf("The offset field must be between -%d and %d", maxHistoryActionsWindow, maxHistoryActionsWindow)}
+		}
+
+		if pos < -1 || pos > maxHistoryActionsPosition {
+			errors["pos"] = []string{fmt.Sprintf("The pos field must be -1 or between 0 and %d", maxHistoryActionsPosition)}
+		}
+
+		if len(errors) > 0 {
+			eosws.WriteError(w, r, derr.RequestValidationError(ctx, errors))
+			return
+		}
+
+		zlogger.Debug("history get actions", zap.String("account_name", accountName), zap.Int64("pos", pos), zap.Int64("offset", offset))
+
+		refs, err := findHistoryActions(ctx, searchEngine, accountName, pos, offset)
+		if err != nil {
+			eosws.WriteError(w, r, derr.Wrap(err, "unable to search account actions"))
+			return
+		}
+
+		actions, err := hydrateHistoryActions(ctx, db, accountName, refs)
+		if err != nil {
+			eosws.WriteError(w, r, err)
+			return
+		}
+
+		eosws.WriteJSON(w, r, &getActionsResponse{
+			Actions:               actions,
+			LastIrreversibleBlock: lastIrreversibleBlockNum(subscriptionHub),
+		})
+	})
+}
+
+// historyActionsWindow turns history plugin's `pos` and `offset` into how the
+// account's actions should be searched. When `descending` is true, the last `count`
+// actions are wanted, otherwise `count` actions after skipping the first `skip` ones.
+func historyActionsWindow(pos, offset int64) (descending bool, skip, count int64) {
+	if pos == -1 {
+		// The history plugin resolves `-1` to the position following the last action, so a
+		// positive offset can never yield any action while a negative one yields the last ones.
+		if offset >= 0 {
+			return true, 0, 0
+		}
+
+		return true, 0, -offset
+	}
+
+	start, end := pos, pos+offset
+	if offset < 0 {
+		start, end = pos+offset, pos
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	return false, start, end - start + 1
+}
+
+// findHistoryActions returns the references, in chronological order, of the actions
+// received or authorized by `account` in the window defined by history plugin's `pos`
+// and `offset`.
+func findHistoryActions(ctx context.Context, searchEngine *eosws.SearchEngine, account string, pos, offset int64) ([]*actionRef, error) {
+	descending, skip, count := historyActionsWindow(pos, offset)
+	if count == 0 {
+		return nil, nil
+	}
+
+	request := &pbsearch.RouterRequest{
+		UseLegacyBoundaries: true,
+		Query:               fmt.Sprintf("(receiver:%s OR auth:%s)", account, account),
+		BlockCount:          math.MaxUint32,
+		WithReversible:      true,
+		Descending:          descending,
+	}
+
+	// Each match holds at least one action, so requesting as many transactions as
+	// the number of actions needed is always enough.
+	request.Limit = skip + count
+
+	matches, _, err := searchEngine.DoRequest(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Descending {
+		for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+			matches[i], matches[j] = matches[j], matches[i]
+		}
+	}
+
+	var refs []*actionRef
+	for _, match := range matches {
+		var eosMatchAny ptypes.DynamicAny
+		if err := ptypes.UnmarshalAny(match.GetChainSpecific(), &eosMatchAny); err != nil {
+			return nil, err
+		}
+
+		eosMatch, ok := eosMatchAny.Message.(*pbsearcheos.Match)
+		if !ok {
+			return nil, fmt.Errorf("unexpected search match type %T", eosMatchAny.Message)
+		}
+
+		for _, actionIndex := range eosMatch.ActionIndexes {
+			refs = append(refs, &actionRef{trxIDPrefix: match.TrxIdPrefix, actionIndex: actionIndex, position: -1})
+		}
+	}
+
+	if request.Descending {
+		if int64(len(refs)) > count {
+			refs = refs[int64(len(refs))-count:]
+		}
+
+		return refs, nil
+	}
+
+	if int64(len(refs)) <= skip {
+		return nil, nil
+	}
+
+	refs = refs[skip:]
+	if int64(len(refs)) > count {
+		refs = refs[:count]
+	}
+
+	for i, ref := range refs {
+		ref.position = skip + int64(i)
+	}
+
+	return refs, nil
+}
+
+// hydrateHistoryActions loads the actions referenced by `refs` from the database,
+// skipping the ones whose transaction is missing, like search matches on blocks not
+// yet processed by trxdb.
+func hydrateHistoryActions(ctx context.Context, db eosws.DB, account string, refs []*actionRef) ([]*historyAction, error) {
+	zlogger := logging.Logger(ctx, zlog)
+
+	actions := []*historyAction{}
+	if len(refs) == 0 {
+		return actions, nil
+	}
+
+	var trxIDPrefixes []string
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		if !seen[ref.trxIDPrefix] {
+			seen[ref.trxIDPrefix] = true
+			trxIDPrefixes = append(trxIDPrefixes, ref.trxIDPrefix)
+		}
+	}
+
+	lifecycles, err := db.GetTransactions(ctx, trxIDPrefixes)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to get transactions from database")
+	}
+
+	executionTraces := map[string]*pbcodec.TransactionTrace{}
+	for _, lifecycle := range lifecycles {
+		for _, trxIDPrefix := range trxIDPrefixes {
+			if strings.HasPrefix(lifecycle.Id, trxIDPrefix) {
+				executionTraces[trxIDPrefix] = lifecycle.ExecutionTrace
+			}
+		}
+	}
+
+	for _, ref := range refs {
+		executionTrace := executionTraces[ref.trxIDPrefix]
+		if executionTrace == nil || int(ref.actionIndex) >= len(executionTrace.ActionTraces) {
+			zlogger.Info("skipping search match referring to an unknown action", zap.String("trx_id_prefix", ref.trxIDPrefix), zap.Uint32("action_index", ref.actionIndex))
+			continue
+		}
+
+		actionTrace := executionTrace.ActionTraces[ref.actionIndex]
+		action := &historyAction{
+			AccountActionSeq: ref.position,
+			BlockNum:         uint32(actionTrace.BlockNum),
+			BlockTime:        codec.TimestampToBlockTimestamp(actionTrace.BlockTime),
+			ActionTrace:      codec.ActionTraceToEOS(actionTrace),
+		}
+
+		if actionTrace.Receipt != nil {
+			action.GlobalActionSeq = actionTrace.Receipt.GlobalSequence
+			if ref.position == -1 && actionTrace.Receiver == account {
+				action.AccountActionSeq = int64(actionTrace.Receipt.RecvSequence) - 1
+			}
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// readHistoryRequestParams reads the parameters of a history plugin call, sent as
+// a JSON body like `cleos` does or as query parameters on `GET`.
+func readHistoryRequestParams(r *http.Request) (gjson.Result, error) {
+	if r.Method == "GET" {
+		params := map[string]string{}
+		for key := range r.URL.Query() {
+			params[key] = r.URL.Query().Get(key)
+		}
+
+		body, err := json.Marshal(params)
+		if err != nil {
+			return gjson.Result{}, err
+		}
+
+		return gjson.ParseBytes(body), nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+	r.Body.Close()
+
+	return gjson.ParseBytes(body), nil
+}
+
+func lastIrreversibleBlockNum(subscriptionHub *hub.SubscriptionHub) uint32 {
+	if subscriptionHub == nil {
+		return 0
+	}
+
+	if headBlock, ok := subscriptionHub.HeadBlock().(*bstream.Block); ok && headBlock != nil {
+		return uint32(headBlock.LibNum)
+	}
+
+	return 0
+}
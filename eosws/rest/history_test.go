@@ -0,0 +1,289 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosws"
+	fluxcli "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	pbsearcheos "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/search/v1"
+	pbsearch "github.com/dfuse-io/pbgo/dfuse/search/v1"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func Test_historyActionsWindow(t *testing.T) {
+	tests := []struct {
+		name               string
+		pos, offset        int64
+		expectedDescending bool
+		expectedSkip       int64
+		expectedCount      int64
+	}{
+		{"last actions", -1, -20, true, 0, 20},
+		{"last position, positive offset", -1, 10, true, 0, 0},
+		{"first action only", 0, 0, false, 0, 1},
+		{"forward from position", 10, 5, false, 10, 6},
+		{"backward from position", 10, -5, false, 5, 6},
+		{"backward past first action", 3, -10, false, 0, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			descending, skip, count := historyActionsWindow(test.pos, test.offset)
+
+			assert.Equal(t, test.expectedDescending, descending)
+			assert.Equal(t, test.expectedSkip, skip)
+			assert.Equal(t, test.expectedCount, count)
+		})
+	}
+}
+
+func TestGetActions(t *testing.T) {
+	// Matches in chronological order, `bbbb` not being in the database yet
+	matches := []*pbsearch.SearchMatch{
+		testSearchMatch(t, "aaaa", 0, 1),
+		testSearchMatch(t, "bbbb", 0),
+		testSearchMatch(t, "cccc", 0),
+	}
+
+	db := &testHistoryDB{lifecycles: map[string]*pbcodec.TransactionLifecycle{
+		"aaaa": testHistoryLifecycle("aaaa", 10, "alice", "bob"),
+		"cccc": testHistoryLifecycle("cccc", 12, "alice"),
+	}}
+
+	tests := []struct {
+		name                   string
+		body                   string
+		expectedStatus         int
+		expectedLimit          int64
+		expectedDescending     bool
+		expectedAccountSeqs    []int64
+		expectedGlobalSeqs     []uint64
+		expectedValidationKeys []string
+	}{
+		{
+			name:                "from first position, skipping missing transaction",
+			body:                `{"account_name":"alice","pos":0,"offset":2}`,
+			expectedStatus:      200,
+			expectedLimit:       3,
+			expectedAccountSeqs: []int64{0, 1},
+			expectedGlobalSeqs:  []uint64{100, 101},
+		},
+		{
+			name:                "from position",
+			body:                `{"account_name":"alice","pos":1,"offset":2}`,
+			expectedStatus:      200,
+			expectedLimit:       4,
+			expectedAccountSeqs: []int64{1, 3},
+			expectedGlobalSeqs:  []uint64{101, 120},
+		},
+		{
+			name:                "last actions",
+			body:                `{"account_name":"alice","pos":-1,"offset":-2}`,
+			expectedStatus:      200,
+			expectedLimit:       2,
+			expectedDescending:  true,
+			expectedAccountSeqs: []int64{118},
+			expectedGlobalSeqs:  []uint64{120},
+		},
+		{
+			name:                   "position too high",
+			body:                   `{"account_name":"alice","pos":10001,"offset":2}`,
+			expectedStatus:         400,
+			expectedValidationKeys: []string{"pos"},
+		},
+		{
+			name:                   "invalid account and offset",
+			body:                   `{"account_name":"","offset":-1001}`,
+			expectedStatus:         400,
+			expectedValidationKeys: []string{"account_name", "offset"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			searchClient := &testSearchRouterClient{matches: matches}
+			handler := GetActions(eosws.NewSearchEngine(db, searchClient, nil), db, nil)
+
+			response := httptest.NewRecorder()
+			handler.ServeHTTP(response, httptest.NewRequest("POST", "/v1/history/get_actions", strings.NewReader(test.body)))
+			require.Equal(t, test.expectedStatus, response.Code, response.Body.String())
+
+			if test.expectedStatus != 200 {
+				var errorResponse struct {
+					Details struct {
+						Errors map[string][]string `json:"errors"`
+					} `json:"details"`
+				}
+				require.NoError(t, json.Unmarshal(response.Body.Bytes(), &errorResponse))
+				for _, key := range test.expectedValidationKeys {
+					assert.Contains(t, errorResponse.Details.Errors, key)
+				}
+				return
+			}
+
+			require.Len(t, searchClient.requests, 1)
+			assert.Equal(t, "(receiver:alice OR auth:alice)", searchClient.requests[0].Query)
+			assert.Equal(t, test.expectedLimit, searchClient.requests[0].Limit)
+			assert.Equal(t, test.expectedDescending, searchClient.requests[0].Descending)
+
+			var actual struct {
+				Actions []struct {
+					GlobalActionSeq  uint64 `json:"global_action_seq"`
+					AccountActionSeq int64  `json:"account_action_seq"`
+				} `json:"actions"`
+			}
+			require.NoError(t, json.Unmarshal(response.Body.Bytes(), &actual))
+
+			var accountSeqs []int64
+			var globalSeqs []uint64
+			for _, action := range actual.Actions {
+				accountSeqs = append(accountSeqs, action.AccountActionSeq)
+				globalSeqs = append(globalSeqs, action.GlobalActionSeq)
+			}
+
+			assert.Equal(t, test.expectedAccountSeqs, accountSeqs)
+			assert.Equal(t, test.expectedGlobalSeqs, globalSeqs)
+		})
+	}
+}
+
+func TestGetKeyAccounts(t *testing.T) {
+	fluxClient := fluxcli.NewTestFluxClient().SetGetAccountByPubKeyResponse(`{"block_num":10,"account_names":["alice","bob"]}`, nil)
+
+	response := httptest.NewRecorder()
+	GetKeyAccounts(fluxClient).ServeHTTP(response, httptest.NewRequest("POST", "/v1/history/get_key_accounts", strings.NewReader(`{"public_key":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV"}`)))
+
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.JSONEq(t, `{"block_num":10,"account_names":["alice","bob"]}`, response.Body.String())
+}
+
+func TestGetControlledAccounts(t *testing.T) {
+	fluxClient := fluxcli.NewTestFluxClient().SetGetControlledAccountsResponse(`{"block_num":10,"controlled_accounts":["bob"]}`, nil)
+
+	response := httptest.NewRecorder()
+	GetControlledAccounts(fluxClient).ServeHTTP(response, httptest.NewRequest("POST", "/v1/history/get_controlled_accounts", strings.NewReader(`{"controlling_account":"alice"}`)))
+
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.JSONEq(t, `{"controlled_accounts":["bob"]}`, response.Body.String())
+}
+
+type testHistoryDB struct {
+	eosws.DB
+	lifecycles map[string]*pbcodec.TransactionLifecycle
+}
+
+func (db *testHistoryDB) GetTransaction(ctx context.Context, id string) (*pbcodec.TransactionLifecycle, error) {
+	if lifecycle, found := db.lifecycles[id]; found {
+		return lifecycle, nil
+	}
+
+	return nil, eosws.DBTrxNotFoundError(ctx, id)
+}
+
+func (db *testHistoryDB) GetTransactions(ctx context.Context, ids []string) (out []*pbcodec.TransactionLifecycle, err error) {
+	for _, id := range ids {
+		if lifecycle, found := db.lifecycles[id]; found {
+			out = append(out, lifecycle)
+		}
+	}
+
+	return out, nil
+}
+
+type testSearchRouterClient struct {
+	pbsearch.RouterClient
+	matches  []*pbsearch.SearchMatch
+	requests []*pbsearch.RouterRequest
+}
+
+// StreamMatches returns the matches in chronological order, or in reverse when the
+// request is descending, up to the request's limit.
+func (c *testSearchRouterClient) StreamMatches(ctx context.Context, in *pbsearch.RouterRequest, opts ...grpc.CallOption) (pbsearch.Router_StreamMatchesClient, error) {
+	c.requests = append(c.requests, in)
+
+	var matches []*pbsearch.SearchMatch
+	for i := range c.matches {
+		match := c.matches[i]
+		if in.Descending {
+			match = c.matches[len(c.matches)-1-i]
+		}
+
+		if in.Limit != 0 && int64(len(matches)) >= in.Limit {
+			break
+		}
+		matches = append(matches, match)
+	}
+
+	return &testSearchMatchesStream{matches: matches}, nil
+}
+
+type testSearchMatchesStream struct {
+	grpc.ClientStream
+	matches []*pbsearch.SearchMatch
+}
+
+func (s *testSearchMatchesStream) Recv() (*pbsearch.SearchMatch, error) {
+	if len(s.matches) == 0 {
+		return nil, io.EOF
+	}
+
+	match := s.matches[0]
+	s.matches = s.matches[1:]
+	return match, nil
+}
+
+func (s *testSearchMatchesStream) Trailer() metadata.MD {
+	return metadata.MD{}
+}
+
+func testSearchMatch(t *testing.T, trxIDPrefix string, actionIndexes ...uint32) *pbsearch.SearchMatch {
+	chainSpecific, err := ptypes.MarshalAny(&pbsearcheos.Match{ActionIndexes: actionIndexes})
+	require.NoError(t, err)
+
+	return &pbsearch.SearchMatch{TrxIdPrefix: trxIDPrefix, ChainSpecific: chainSpecific}
+}
+
+// testHistoryLifecycle creates a transaction with an action for each receiver, the
+// global sequences starting at `10 * seq` and the receive sequences at `10 * seq - 1`
+func testHistoryLifecycle(id string, seq uint64, receivers ...string) *pbcodec.TransactionLifecycle {
+	trace := &pbcodec.TransactionTrace{Id: id, BlockNum: seq}
+	for i, receiver := range receivers {
+		trace.ActionTraces = append(trace.ActionTraces, &pbcodec.ActionTrace{
+			Receiver: receiver,
+			BlockNum: seq,
+			Action:   &pbcodec.Action{Account: "eosio.token", Name: "transfer"},
+			Receipt: &pbcodec.ActionReceipt{
+				Receiver:       receiver,
+				GlobalSequence: 10*seq + uint64(i),
+				RecvSequence:   10*seq - 1 + uint64(i),
+			},
+		})
+	}
+
+	return &pbcodec.TransactionLifecycle{Id: id, ExecutionTrace: trace}
+}
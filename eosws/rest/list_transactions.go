@@ -19,8 +19,8 @@ import (
 	"strconv"
 
 	"github.com/dfuse-io/derr"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 )
 
 func ListTransactionsHandler(db eosws.DB) http.Handler {
@@ -30,18 +30,6 @@ func ListTransactionsHandler(db eosws.DB) http.Handler {
 		errors := eosws.ValidateListRequest(r)
 		if len(errors) > 0 {
 			eosws.WriteError(w, r, derr.RequestValidationError(r.Context(), errors))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - eosq",
-				Method:         "/v0/transactions",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
 			return
 		}
 
@@ -67,17 +55,6 @@ func ListTransactionsHandler(db eosws.DB) http.Handler {
 			count = 1
 		}
 
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API - eosq",
-			Method:         "/v0/transactions",
-			RequestsCount:  1,
-			ResponsesCount: count,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
+		metering.SetResponsesCount(ctx, count)
 	})
 }
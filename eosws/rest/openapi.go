@@ -0,0 +1,112 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	fluxcli "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	"github.com/dfuse-io/dfuse-eosio/metering"
+	"github.com/dfuse-io/dfuse-eosio/openapi"
+	v1 "github.com/dfuse-io/eosws-go/mdl/v1"
+	eos "github.com/eoscanada/eos-go"
+)
+
+type blockTimeQuery struct {
+	Time       string `json:"time"`
+	Comparator string `json:"comparator"`
+}
+
+type listQuery struct {
+	Limit  int    `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+type listBlocksQuery struct {
+	Skip  int `json:"skip"`
+	Limit int `json:"limit"`
+}
+
+type simpleSearchQuery struct {
+	Query string `json:"q"`
+}
+
+type completionQuery struct {
+	Prefix string `json:"prefix"`
+}
+
+type getKeyAccountsRequest struct {
+	PublicKey string `json:"public_key"`
+}
+
+type getControlledAccountsRequest struct {
+	ControllingAccount string `json:"controlling_account"`
+}
+
+type getHistoryTransactionRequest struct {
+	ID string `json:"id"`
+}
+
+type getActionsRequest struct {
+	AccountName string `json:"account_name"`
+	Pos         int64  `json:"pos"`
+	Offset      int64  `json:"offset"`
+}
+
+// OpenAPIRoutes describes the REST endpoints of this package as registered
+// by the `eosws` app.
+func OpenAPIRoutes() []*openapi.Route {
+	routes := []*openapi.Route{
+		{Path: "/v0/block_id/by_time", Tags: []string{"Blocks"}, Summary: "Find the block closest to a time",
+			Query: blockTimeQuery{}, Required: []string{"time", "comparator"}, Response: &blockTimeResponse{}},
+		{Path: "/v0/transactions/{id}", Tags: []string{"Transactions"}, Summary: "Fetch the lifecycle of a transaction",
+			Response: &v1.TransactionLifecycle{}},
+		{Path: "/v0/usage", Methods: []string{"GET"}, Tags: []string{"Usage"}, Summary: "Fetch the consumption of the authenticated user",
+			Query: metering.UsageRequest{}, Response: &metering.UsageResponse{}},
+
+		{Path: "/v0/transactions", Tags: []string{"eosq"}, Summary: "List the most recent transactions",
+			Query: listQuery{}, Required: []string{"limit"}, Response: &mdl.TransactionList{}},
+		{Path: "/v0/blocks", Tags: []string{"eosq"}, Summary: "List the most recent blocks",
+			Query: listBlocksQuery{}, Required: []string{"limit"}, Response: []*mdl.BlockSummary{}},
+		{Path: "/v0/blocks/{blockID}", Tags: []string{"eosq"}, Summary: "Fetch a block summary",
+			Response: &mdl.BlockSummary{}},
+		{Path: "/v0/blocks/{blockID}/transactions", Tags: []string{"eosq"}, Summary: "List the transactions of a block",
+			Query: listQuery{}, Required: []string{"limit"}, Response: &mdl.TransactionList{}},
+		{Path: "/v0/simple_search", Tags: []string{"eosq"}, Summary: "Find the block, transaction or account matching a query",
+			Query: simpleSearchQuery{}, Required: []string{"q"}, Response: &simpleSearchResponse{}},
+		{Path: "/v0/search/completion", Tags: []string{"eosq"}, Summary: "Complete a search query prefix",
+			Query: completionQuery{}, Required: []string{"prefix"}, Response: []*mdl.SuggestionSection{}},
+
+		{Path: "/v1/chain/push_transaction", Methods: []string{"POST"}, Tags: []string{"Chain"}, Summary: "Push a transaction",
+			Description: "Proxied to nodeos unless the `X-Eos-Push-Guarantee` header is set, in which case the response is sent once the guarantee is met.",
+			Body:        &eos.PackedTransaction{}, Response: &PushResponse{}},
+	}
+
+	routes = append(routes, historyRoutes("/v1/history/get_key_accounts", "List the accounts controlled by a public key", &getKeyAccountsRequest{}, &fluxcli.GetAccountByPubKeyResponses{})...)
+	routes = append(routes, historyRoutes("/v1/history/get_controlled_accounts", "List the accounts controlled by an account", &getControlledAccountsRequest{}, &getControlledAccountsResponse{})...)
+	routes = append(routes, historyRoutes("/v1/history/get_transaction", "Fetch a transaction", &getHistoryTransactionRequest{}, &getHistoryTransactionResponse{})...)
+	routes = append(routes, historyRoutes("/v1/history/get_actions", "List the actions of an account", &getActionsRequest{}, &getActionsResponse{})...)
+
+	return routes
+}
+
+// historyRoutes describes a `history_plugin` compatible route, reading its
+// parameters from the query or a JSON body (see `readHistoryRequestParams`).
+func historyRoutes(path, summary string, request, response interface{}) []*openapi.Route {
+	tags := []string{"History"}
+	return []*openapi.Route{
+		{Path: path, Methods: []string{"GET"}, Tags: tags, Summary: summary, Query: request, Response: response},
+		{Path: path, Methods: []string{"POST"}, Tags: tags, Summary: summary, Body: request, Response: response},
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosws/completion"
+	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	"github.com/dfuse-io/dfuse-eosio/openapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCompletion struct {
+	completion.Completion
+	sections []*mdl.SuggestionSection
+}
+
+func (c *testCompletion) Complete(prefix string, limit int) ([]*mdl.SuggestionSection, error) {
+	return c.sections, nil
+}
+
+func newTestOpenAPIDocument(t *testing.T) *openapi.Document {
+	document := openapi.NewDocument("test", "v0")
+	for _, route := range OpenAPIRoutes() {
+		require.NoError(t, document.AddRoute(route))
+	}
+
+	require.NoError(t, document.Validate())
+	return document
+}
+
+func TestOpenAPIRoutes_Responses(t *testing.T) {
+	document := newTestOpenAPIDocument(t)
+
+	completionHandler := GetCompletionHandler(&testCompletion{sections: []*mdl.SuggestionSection{
+		{ID: "accounts", Suggestions: []*mdl.Suggestion{{Key: "eoscanadacom", Label: "eoscanadacom"}}},
+	}})
+
+	tests := []struct {
+		name    string
+		handler http.Handler
+		method  string
+		path    string
+		target  string
+		body    string
+	}{
+		{"completion", completionHandler, "GET", "/v0/search/completion", "/v0/search/completion?prefix=eos", ""},
+		{"key accounts, query", GetKeyAccounts(nil), "GET", "/v1/history/get_key_accounts", "/v1/history/get_key_accounts", ""},
+		{"key accounts, body", GetKeyAccounts(nil), "POST", "/v1/history/get_key_accounts", "/v1/history/get_key_accounts", `{}`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+			recorder := httptest.NewRecorder()
+
+			test.handler.ServeHTTP(recorder, request)
+
+			require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+			assert.NoError(t, document.ValidateResponse(test.path, test.method, recorder.Body.Bytes()))
+		})
+	}
+}
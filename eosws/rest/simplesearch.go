@@ -15,209 +15,388 @@
 package rest
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/araddon/dateparse"
-	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
 	"github.com/dfuse-io/derr"
-	"github.com/dfuse-io/dmetering"
-	"github.com/eoscanada/eos-go"
 	"github.com/dfuse-io/dfuse-eosio/eosws"
+	fluxhelper "github.com/dfuse-io/dfuse-eosio/eosws/fluxdb"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	fluxcli "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	"github.com/dfuse-io/logging"
+	pbblockmeta "github.com/dfuse-io/pbgo/dfuse/blockmeta/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/ecc"
+	"go.uber.org/zap"
+)
+
+// Scores of the simple search results, the most specific matches first
+const (
+	scoreExactID        = 100
+	scorePublicKey      = 95
+	scoreTable          = 95
+	scoreBlockNum       = 80
+	scoreContract       = 75
+	scoreProducer       = 72
+	scoreAccount        = 70
+	scoreToken          = 60
+	scoreBlockTime      = 50
+	scoreTransactionRef = 40
 )
 
-func SimpleSearchHandler(db eosws.DB, blockmetaClient *pbblockmeta.Client) http.Handler {
-	hexRegex := regexp.MustCompile(`^[0-9a-fA-F]+$`)
+var producersCacheTTL = 5 * time.Minute
+
+var (
+	hexRegex          = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	nameRegex         = regexp.MustCompile(`^[a-z1-5.]{1,13}$`)
+	symbolCodeRegex   = regexp.MustCompile(`^[A-Za-z]{1,7}$`)
+	publicKeyPrefixes = []string{"EOS", "PUB_"}
+)
+
+type simpleSearchResponse struct {
+	Query   string                `json:"query"`
+	Results []*simpleSearchResult `json:"results"`
+}
+
+type simpleSearchResult struct {
+	Type  string      `json:"type"`
+	Score int         `json:"score"`
+	Data  interface{} `json:"data"`
+}
+
+type simpleSearchKey struct {
+	PublicKey string            `json:"public_key"`
+	Accounts  []eos.AccountName `json:"accounts"`
+}
+
+type simpleSearchToken struct {
+	Contract  eos.AccountName `json:"contract"`
+	Symbol    string          `json:"symbol"`
+	Supply    string          `json:"supply"`
+	MaxSupply string          `json:"max_supply"`
+	Issuer    eos.AccountName `json:"issuer"`
+}
+
+type simpleSearchContract struct {
+	Account eos.AccountName `json:"account"`
+	Tables  []eos.TableName `json:"tables"`
+}
+
+type simpleSearchTable struct {
+	Account eos.AccountName `json:"account"`
+	Table   eos.TableName   `json:"table"`
+	Scope   string          `json:"scope"`
+	Type    string          `json:"type"`
+}
+
+type simpleSearchLookup func(ctx context.Context, query string) []*simpleSearchResult
+
+// SimpleSearcher resolves a free form query against every kind of chain
+// entity it could name: blocks, transactions, accounts, contracts,
+// producers, public keys, token symbols and `account:table:scope` tables.
+type SimpleSearcher struct {
+	db              eosws.DB
+	blockmetaClient *pbblockmeta.Client
+	fluxClient      fluxcli.Client
+	fluxHelper      fluxhelper.FluxHelper
+	tokenContracts  []eos.AccountName
+
+	producersLock      sync.Mutex
+	producers          map[string]bool
+	producersFetchedAt time.Time
+}
+
+func NewSimpleSearcher(db eosws.DB, blockmetaClient *pbblockmeta.Client, fluxClient fluxcli.Client, fluxHelper fluxhelper.FluxHelper, tokenContracts []eos.AccountName) *SimpleSearcher {
+	return &SimpleSearcher{
+		db:              db,
+		blockmetaClient: blockmetaClient,
+		fluxClient:      fluxClient,
+		fluxHelper:      fluxHelper,
+		tokenContracts:  tokenContracts,
+	}
+}
+
+func SimpleSearchHandler(searcher *SimpleSearcher) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		query := r.FormValue("q")
+		query := strings.TrimSpace(r.FormValue("q"))
 		if query == "" {
 			eosws.WriteError(w, r, derr.RequestValidationError(ctx, url.Values{"q": []string{"query parameter should not be empty"}}))
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - eosq",
-				Method:         "/v0/simple_search",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
 			return
 		}
-		lQuery := strings.ToLower(query)
-
-		if len(query) >= 14 {
-			block, err := db.GetBlock(ctx, lQuery)
-			if err == nil {
-				eosws.WriteJSON(w, r, map[string]interface{}{
-					"type": "block",
-					"data": block,
-				})
-				//////////////////////////////////////////////////////////////////////
-				// Billable event on REST API endpoint
-				// WARNING: Ingress / Egress bytess is taken care by the middleware
-				//////////////////////////////////////////////////////////////////////
-				dmetering.EmitWithContext(dmetering.Event{
-					Source:         "eosws",
-					Kind:           "REST API - eosq",
-					Method:         "/v0/simple_search",
-					RequestsCount:  1,
-					ResponsesCount: 1,
-				}, ctx)
-				//////////////////////////////////////////////////////////////////////
-
-				return
-			}
 
-			txResp, err := db.GetTransaction(ctx, lQuery)
-			if err == nil {
-				eosws.WriteJSON(w, r, map[string]interface{}{
-					"type": "transaction",
-					"data": txResp,
-				})
-				//////////////////////////////////////////////////////////////////////
-				// Billable event on REST API endpoint
-				// WARNING: Ingress / Egress bytess is taken care by the middleware
-				//////////////////////////////////////////////////////////////////////
-				dmetering.EmitWithContext(dmetering.Event{
-					Source:         "eosws",
-					Kind:           "REST API - eosq",
-					Method:         "/v0/simple_search",
-					RequestsCount:  1,
-					ResponsesCount: 1,
-				}, ctx)
-				//////////////////////////////////////////////////////////////////////
-				return
-			}
+		results := searcher.Search(ctx, query)
+		if len(results) == 0 {
+			eosws.WriteError(w, r, derr.HTTPNotFoundError(ctx, nil, derr.C("simple_search_not_found"), "no results found for query"))
+		} else {
+			eosws.WriteJSON(w, r, &simpleSearchResponse{Query: query, Results: results})
 		}
+	})
+}
 
-		if num, err := strconv.Atoi(query); err == nil {
-			blocks, err := db.GetBlockByNum(ctx, uint32(num))
-
-			if err == nil {
-				var block = blocks[0]
-				if len(blocks) > 1 {
-
-					for _, blockRef := range blocks {
-						if blockRef.Irreversible {
-							block = blockRef
-						}
-					}
-				}
-				eosws.WriteJSON(w, r, map[string]interface{}{
-					"type": "block",
-					"data": map[string]string{
-						"id": block.Id,
-					},
-				})
-				//////////////////////////////////////////////////////////////////////
-				// Billable event on REST API endpoint
-				// WARNING: Ingress / Egress bytess is taken care by the middleware
-				//////////////////////////////////////////////////////////////////////
-				dmetering.EmitWithContext(dmetering.Event{
-					Source:         "eosws",
-					Kind:           "REST API - eosq",
-					Method:         "/v0/simple_search",
-					RequestsCount:  1,
-					ResponsesCount: 1,
-				}, ctx)
-				//////////////////////////////////////////////////////////////////////
-				return
-			}
+// Search runs all lookups concurrently and returns their results, highest
+// score first. A failing lookup only means it doesn't contribute results.
+func (s *SimpleSearcher) Search(ctx context.Context, query string) []*simpleSearchResult {
+	lookups := []simpleSearchLookup{
+		s.lookupBlockOrTransactionID,
+		s.lookupBlockNum,
+		s.lookupName,
+		s.lookupPublicKey,
+		s.lookupTokenSymbol,
+		s.lookupTable,
+		s.lookupBlockTime,
+	}
+
+	lookupResults := make([][]*simpleSearchResult, len(lookups))
+
+	wg := sync.WaitGroup{}
+	for i, lookup := range lookups {
+		wg.Add(1)
+		go func(i int, lookup simpleSearchLookup) {
+			defer wg.Done()
+			lookupResults[i] = lookup(ctx, query)
+		}(i, lookup)
+	}
+	wg.Wait()
+
+	// Flattened in lookup order so equal scores keep a stable ranking
+	var results []*simpleSearchResult
+	for _, lookupResult := range lookupResults {
+		results = append(results, lookupResult...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results
+}
+
+func (s *SimpleSearcher) lookupBlockOrTransactionID(ctx context.Context, query string) (out []*simpleSearchResult) {
+	if len(query) < 14 || !hexRegex.MatchString(query) {
+		return nil
+	}
+
+	lQuery := strings.ToLower(query)
+	if block, err := s.db.GetBlock(ctx, lQuery); err == nil && block != nil {
+		out = append(out, &simpleSearchResult{Type: "block", Score: scoreExactID, Data: block})
+	}
+
+	if transaction, err := s.db.GetTransaction(ctx, lQuery); err == nil && transaction != nil {
+		return append(out, &simpleSearchResult{Type: "transaction", Score: scoreExactID, Data: transaction})
+	}
+
+	// A full transaction ID is still worth linking to, it might not be indexed yet
+	if len(query) == 64 && len(out) == 0 {
+		out = append(out, &simpleSearchResult{Type: "transaction", Score: scoreTransactionRef, Data: map[string]interface{}{"id": lQuery}})
+	}
+
+	return out
+}
+
+func (s *SimpleSearcher) lookupBlockNum(ctx context.Context, query string) []*simpleSearchResult {
+	num, err := strconv.ParseUint(query, 10, 32)
+	if err != nil {
+		return nil
+	}
 
+	blocks, err := s.db.GetBlockByNum(ctx, uint32(num))
+	if err != nil || len(blocks) == 0 {
+		return nil
+	}
+
+	block := blocks[0]
+	for _, blockRef := range blocks {
+		if blockRef.Irreversible {
+			block = blockRef
 		}
+	}
+
+	return []*simpleSearchResult{{Type: "block", Score: scoreBlockNum, Data: map[string]string{"id": block.Id}}}
+}
+
+// lookupName resolves a query that is a valid account name to the account,
+// the contract deployed on it and the producer it registered.
+func (s *SimpleSearcher) lookupName(ctx context.Context, query string) (out []*simpleSearchResult) {
+	name := strings.ToLower(query)
+	if !nameRegex.MatchString(name) {
+		return nil
+	}
+
+	if acctResponse, err := s.db.GetAccount(ctx, name); err == nil {
+		account := mdl.ToV1Account(acctResponse)
+		account.AccountResp.AccountName = eos.AccountName(acctResponse.Account)
+
+		out = append(out, &simpleSearchResult{Type: "account", Score: scoreAccount, Data: account})
+	}
 
-		if len(query) <= 13 {
-			acctResponse, err := db.GetAccount(ctx, lQuery)
-			if err == nil {
-
-				account := mdl.ToV1Account(acctResponse)
-				account.AccountResp.AccountName = eos.AccountName(acctResponse.Account)
-
-				eosws.WriteJSON(w, r, map[string]interface{}{
-					"type": "account",
-					"data": account,
-				})
-				//////////////////////////////////////////////////////////////////////
-				// Billable event on REST API endpoint
-				// WARNING: Ingress / Egress bytess is taken care by the middleware
-				//////////////////////////////////////////////////////////////////////
-				dmetering.EmitWithContext(dmetering.Event{
-					Source:         "eosws",
-					Kind:           "REST API - eosq",
-					Method:         "/v0/simple_search",
-					RequestsCount:  1,
-					ResponsesCount: 1,
-				}, ctx)
-				//////////////////////////////////////////////////////////////////////
-				return
+	if s.fluxClient != nil {
+		if abiResp, err := s.fluxClient.GetABI(ctx, 0, eos.AccountName(name)); err == nil && abiResp != nil && abiResp.ABI != nil {
+			contract := &simpleSearchContract{Account: eos.AccountName(name), Tables: []eos.TableName{}}
+			for _, table := range abiResp.ABI.Tables {
+				contract.Tables = append(contract.Tables, table.Name)
 			}
+
+			out = append(out, &simpleSearchResult{Type: "contract", Score: scoreContract, Data: contract})
 		}
+	}
 
-		if len(query) == 64 && hexRegex.MatchString(query) {
-			eosws.WriteJSON(w, r, map[string]interface{}{
-				"type": "transaction",
-				"data": map[string]interface{}{"id": lQuery},
-			})
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - eosq",
-				Method:         "/v0/simple_search",
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
-			return
+	if s.isProducer(ctx, name) {
+		out = append(out, &simpleSearchResult{Type: "producer", Score: scoreProducer, Data: map[string]string{"owner": name}})
+	}
+
+	return out
+}
+
+func (s *SimpleSearcher) isProducer(ctx context.Context, name string) bool {
+	if s.fluxHelper == nil {
+		return false
+	}
+
+	s.producersLock.Lock()
+	defer s.producersLock.Unlock()
+
+	if s.producers == nil || time.Since(s.producersFetchedAt) > producersCacheTTL {
+		producers, _, err := s.fluxHelper.QueryProducers(ctx)
+		if err != nil {
+			logging.Logger(ctx, zlog).Debug("unable to refresh producers list", zap.Error(err))
+			return s.producers[name]
 		}
 
-		t, err := dateparse.ParseStrict(query)
-		if err == nil {
-			btResp, err := blockmetaClient.BlockAfter(ctx, t, true)
-			if err == nil && pbblockmeta.Timestamp(btResp.Time).Sub(t).Seconds() < 5 {
-				eosws.WriteJSON(w, r, map[string]interface{}{
-					"type": "block",
-					"data": map[string]interface{}{"id": btResp.Id},
-				})
-				//////////////////////////////////////////////////////////////////////
-				// Billable event on REST API endpoint
-				// WARNING: Ingress / Egress bytess is taken care by the middleware
-				//////////////////////////////////////////////////////////////////////
-				dmetering.EmitWithContext(dmetering.Event{
-					Source:         "eosws",
-					Kind:           "REST API - eosq",
-					Method:         "/v0/simple_search",
-					RequestsCount:  1,
-					ResponsesCount: 1,
-				}, ctx)
-				//////////////////////////////////////////////////////////////////////
-				return
-			}
+		s.producers = map[string]bool{}
+		for _, producer := range producers {
+			s.producers[producer.Owner] = true
 		}
+		s.producersFetchedAt = time.Now()
+	}
 
-		eosws.WriteError(w, r, derr.HTTPNotFoundError(ctx, nil, derr.C("simple_search_not_found"), "no results found for query"))
-
-		//////////////////////////////////////////////////////////////////////
-		// Billable event on REST API endpoint
-		// WARNING: Ingress / Egress bytess is taken care by the middleware
-		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithContext(dmetering.Event{
-			Source:         "eosws",
-			Kind:           "REST API - eosq",
-			Method:         "/v0/simple_search",
-			RequestsCount:  1,
-			ResponsesCount: 1,
-		}, ctx)
-		//////////////////////////////////////////////////////////////////////
-	})
+	return s.producers[name]
+}
+
+func (s *SimpleSearcher) lookupPublicKey(ctx context.Context, query string) []*simpleSearchResult {
+	if s.fluxClient == nil || !hasAnyPrefix(query, publicKeyPrefixes) {
+		return nil
+	}
+
+	if _, err := ecc.NewPublicKey(query); err != nil {
+		return nil
+	}
+
+	resp, err := s.fluxClient.GetAccountByPubKey(ctx, 0, query)
+	if err != nil {
+		logging.Logger(ctx, zlog).Debug("unable to resolve public key accounts", zap.String("public_key", query), zap.Error(err))
+		resp = &fluxcli.GetAccountByPubKeyResponses{}
+	}
+
+	key := &simpleSearchKey{PublicKey: query, Accounts: resp.AccountNames}
+	if key.Accounts == nil {
+		key.Accounts = []eos.AccountName{}
+	}
+
+	return []*simpleSearchResult{{Type: "key", Score: scorePublicKey, Data: key}}
+}
+
+// lookupTokenSymbol finds the query as a symbol code in the `stat` table of
+// the known token contracts.
+func (s *SimpleSearcher) lookupTokenSymbol(ctx context.Context, query string) (out []*simpleSearchResult) {
+	if s.fluxClient == nil || !symbolCodeRegex.MatchString(query) {
+		return nil
+	}
+
+	symbolCode := strings.ToUpper(query)
+	for _, contract := range s.tokenContracts {
+		request := fluxcli.NewGetTableRequest(contract, eos.Name(symbolCode), eos.TableName("stat"), "")
+		request.ScopeType = "symbol_code"
+
+		resp, err := s.fluxClient.GetTable(ctx, 0, request)
+		if err != nil {
+			continue
+		}
+
+		var rows []struct {
+			JSON struct {
+				Supply    string          `json:"supply"`
+				MaxSupply string          `json:"max_supply"`
+				Issuer    eos.AccountName `json:"issuer"`
+			} `json:"json"`
+		}
+
+		if err := json.Unmarshal(resp.Rows, &rows); err != nil || len(rows) == 0 {
+			continue
+		}
+
+		out = append(out, &simpleSearchResult{Type: "token", Score: scoreToken, Data: &simpleSearchToken{
+			Contract:  contract,
+			Symbol:    symbolCode,
+			Supply:    rows[0].JSON.Supply,
+			MaxSupply: rows[0].JSON.MaxSupply,
+			Issuer:    rows[0].JSON.Issuer,
+		}})
+	}
+
+	return out
+}
+
+// lookupTable resolves `account:table:scope` when the account's ABI defines
+// the table.
+func (s *SimpleSearcher) lookupTable(ctx context.Context, query string) []*simpleSearchResult {
+	parts := strings.Split(query, ":")
+	if s.fluxClient == nil || len(parts) != 3 || !nameRegex.MatchString(parts[0]) || !nameRegex.MatchString(parts[1]) || parts[2] == "" {
+		return nil
+	}
+
+	account, table := eos.AccountName(parts[0]), eos.TableName(parts[1])
+	abiResp, err := s.fluxClient.GetABI(ctx, 0, account)
+	if err != nil || abiResp == nil || abiResp.ABI == nil {
+		return nil
+	}
+
+	tableDef := abiResp.ABI.TableForName(table)
+	if tableDef == nil {
+		return nil
+	}
+
+	return []*simpleSearchResult{{Type: "table", Score: scoreTable, Data: &simpleSearchTable{
+		Account: account,
+		Table:   table,
+		Scope:   parts[2],
+		Type:    tableDef.Type,
+	}}}
+}
+
+func (s *SimpleSearcher) lookupBlockTime(ctx context.Context, query string) []*simpleSearchResult {
+	if s.blockmetaClient == nil {
+		return nil
+	}
+
+	t, err := dateparse.ParseStrict(query)
+	if err != nil {
+		return nil
+	}
+
+	btResp, err := s.blockmetaClient.BlockAfter(ctx, t, true)
+	if err != nil || pbblockmeta.Timestamp(btResp.Time).Sub(t).Seconds() >= 5 {
+		return nil
+	}
+
+	return []*simpleSearchResult{{Type: "block", Score: scoreBlockTime, Data: map[string]interface{}{"id": btResp.Id}}}
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,213 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/dfuse-io/dfuse-eosio/eosws"
+	fluxhelper "github.com/dfuse-io/dfuse-eosio/eosws/fluxdb"
+	fluxcli "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestNotFound = errors.New("not found")
+
+type testSimpleSearchDB struct {
+	eosws.DB
+	accounts map[string]bool
+	blocks   map[uint32][]*pbcodec.BlockWithRefs
+}
+
+func (db *testSimpleSearchDB) GetBlock(ctx context.Context, id string) (*pbcodec.BlockWithRefs, error) {
+	return nil, errTestNotFound
+}
+
+func (db *testSimpleSearchDB) GetTransaction(ctx context.Context, id string) (*pbcodec.TransactionLifecycle, error) {
+	return nil, errTestNotFound
+}
+
+func (db *testSimpleSearchDB) GetBlockByNum(ctx context.Context, num uint32) ([]*pbcodec.BlockWithRefs, error) {
+	if blocks, found := db.blocks[num]; found {
+		return blocks, nil
+	}
+
+	return nil, errTestNotFound
+}
+
+func (db *testSimpleSearchDB) GetAccount(ctx context.Context, name string) (*pbcodec.AccountCreationRef, error) {
+	if db.accounts[name] {
+		return &pbcodec.AccountCreationRef{Account: name, Creator: "eosio"}, nil
+	}
+
+	return nil, errTestNotFound
+}
+
+type testSimpleSearchFluxClient struct {
+	fluxcli.Client
+	abis        map[eos.AccountName]*eos.ABI
+	keyAccounts map[string][]eos.AccountName
+	stats       map[string]string
+}
+
+func (c *testSimpleSearchFluxClient) GetABI(ctx context.Context, startBlock uint32, account eos.AccountName) (*fluxcli.GetABIResponse, error) {
+	if abi, found := c.abis[account]; found {
+		return &fluxcli.GetABIResponse{Account: account, ABI: abi}, nil
+	}
+
+	return nil, errTestNotFound
+}
+
+func (c *testSimpleSearchFluxClient) GetAccountByPubKey(ctx context.Context, startBlock uint32, pubKey string) (*fluxcli.GetAccountByPubKeyResponses, error) {
+	return &fluxcli.GetAccountByPubKeyResponses{AccountNames: c.keyAccounts[pubKey]}, nil
+}
+
+func (c *testSimpleSearchFluxClient) GetTable(ctx context.Context, startBlock uint32, request *fluxcli.GetTableRequest) (*fluxcli.GetTableResponse, error) {
+	rows, found := c.stats[string(request.Account)+"/"+string(request.Scope)]
+	if !found || request.Table != "stat" || request.ScopeType != "symbol_code" {
+		return &fluxcli.GetTableResponse{Rows: json.RawMessage(`[]`)}, nil
+	}
+
+	return &fluxcli.GetTableResponse{Rows: json.RawMessage(rows)}, nil
+}
+
+func newTestSimpleSearcher() *SimpleSearcher {
+	db := &testSimpleSearchDB{
+		accounts: map[string]bool{"eoscanadacom": true, "eosio.token": true},
+		blocks: map[uint32][]*pbcodec.BlockWithRefs{
+			12345: {{Id: "00003039a"}, {Id: "00003039b", Irreversible: true}},
+		},
+	}
+
+	fluxClient := &testSimpleSearchFluxClient{
+		abis: map[eos.AccountName]*eos.ABI{
+			"eosio.token": {Tables: []eos.TableDef{{Name: "accounts", Type: "account"}, {Name: "stat", Type: "currency_stats"}}},
+		},
+		keyAccounts: map[string][]eos.AccountName{
+			"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV": {"eoscanadacom"},
+		},
+		stats: map[string]string{
+			"eosio.token/EOS": `[{"key":"EOS","json":{"supply":"1000.0000 EOS","max_supply":"10000.0000 EOS","issuer":"eosio"}}]`,
+		},
+	}
+
+	fluxHelper := fluxhelper.NewTestFluxHelper()
+	fluxHelper.SetProducersResponse([]fluxhelper.Producer{{Owner: "eoscanadacom", IsActive: true}}, 0, nil)
+
+	return NewSimpleSearcher(db, nil, fluxClient, fluxHelper, []eos.AccountName{"eosio.token"})
+}
+
+func TestSimpleSearchHandler(t *testing.T) {
+	handler := SimpleSearchHandler(newTestSimpleSearcher())
+	document := newTestOpenAPIDocument(t)
+
+	tests := []struct {
+		name            string
+		query           string
+		expectedResults string
+	}{
+		{
+			"account and producer",
+			"eoscanadacom",
+			`[{"type":"producer","score":72,"data":{"owner":"eoscanadacom"}},{"type":"account"}]`,
+		},
+		{
+			"contract ranked before account",
+			"eosio.token",
+			`[{"type":"contract","score":75,"data":{"account":"eosio.token","tables":["accounts","stat"]}},{"type":"account"}]`,
+		},
+		{
+			"token symbol",
+			"eos",
+			`[{"type":"token","score":60,"data":{"contract":"eosio.token","symbol":"EOS","supply":"1000.0000 EOS","max_supply":"10000.0000 EOS","issuer":"eosio"}}]`,
+		},
+		{
+			"public key",
+			"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV",
+			`[{"type":"key","score":95,"data":{"public_key":"EOS6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV","accounts":["eoscanadacom"]}}]`,
+		},
+		{
+			"table triplet",
+			"eosio.token:accounts:eoscanadacom",
+			`[{"type":"table","score":95,"data":{"account":"eosio.token","table":"accounts","scope":"eoscanadacom","type":"account"}}]`,
+		},
+		{
+			"block num, irreversible preferred",
+			"12345",
+			`[{"type":"block","score":80,"data":{"id":"00003039b"}}]`,
+		},
+		{
+			"unindexed transaction id",
+			"aeb3e1c52c6bf0f7a6e5bd8d5c03ba2a0ef3bc76e0cbd1ff3b2c9e0f1b33e1a2",
+			`[{"type":"transaction","score":40,"data":{"id":"aeb3e1c52c6bf0f7a6e5bd8d5c03ba2a0ef3bc76e0cbd1ff3b2c9e0f1b33e1a2"}}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v0/simple_search?q="+url.QueryEscape(test.query), nil))
+
+			require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+			require.NoError(t, document.ValidateResponse("/v0/simple_search", "GET", recorder.Body.Bytes()))
+
+			var response struct {
+				Query   string            `json:"query"`
+				Results []json.RawMessage `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+
+			var expected []json.RawMessage
+			require.NoError(t, json.Unmarshal([]byte(test.expectedResults), &expected))
+
+			assert.Equal(t, test.query, response.Query)
+			require.Len(t, response.Results, len(expected))
+			for i, expectedResult := range expected {
+				assertJSONSubset(t, expectedResult, response.Results[i])
+			}
+		})
+	}
+}
+
+func TestSimpleSearchHandler_NotFound(t *testing.T) {
+	handler := SimpleSearchHandler(newTestSimpleSearcher())
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest("GET", "/v0/simple_search?q=unknown", nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "simple_search_not_found")
+}
+
+// assertJSONSubset checks that every field of `expected` is present with the
+// same value in `actual`.
+func assertJSONSubset(t *testing.T, expected, actual json.RawMessage) {
+	var expectedFields, actualFields map[string]interface{}
+	require.NoError(t, json.Unmarshal(expected, &expectedFields))
+	require.NoError(t, json.Unmarshal(actual, &actualFields))
+
+	for key, value := range expectedFields {
+		assert.Equal(t, value, actualFields[key], "field %q of %s", key, string(actual))
+	}
+}
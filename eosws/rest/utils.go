@@ -20,7 +20,6 @@ import (
 	"net/url"
 
 	stackdriverPropagation "contrib.go.opencensus.io/exporter/stackdriver/propagation"
-	"github.com/dfuse-io/dmetering"
 	"go.opencensus.io/plugin/ochttp"
 )
 
@@ -42,24 +41,7 @@ func NewReverseProxy(target *url.URL, stripQuerystring bool) *httputil.ReversePr
 	return &httputil.ReverseProxy{
 		Director: director,
 		ModifyResponse: func(response *http.Response) error {
-			ctx := response.Request.Context()
-
 			response.Header.Del("X-Trace-ID")
-
-			//////////////////////////////////////////////////////////////////////
-			// Billable event on REST API endpoint
-			// WARNING: Ingress / Egress bytess is taken care by the middleware
-			//////////////////////////////////////////////////////////////////////
-			//TODO: WARNING - /v0/state (flux) bill one document even though they may be very large
-			dmetering.EmitWithContext(dmetering.Event{
-				Source:         "eosws",
-				Kind:           "REST API - Chain State",
-				Method:         response.Request.URL.Path,
-				RequestsCount:  1,
-				ResponsesCount: 1,
-			}, ctx)
-			//////////////////////////////////////////////////////////////////////
-
 			return nil
 		},
 		Transport: &ochttp.Transport{
@@ -27,8 +27,9 @@ import (
 	stackdriverPropagation "contrib.go.opencensus.io/exporter/stackdriver/propagation"
 	"github.com/dfuse-io/derr"
 	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 	pbsearcheos "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/search/v1"
-	"github.com/dfuse-io/dmetering"
 	"github.com/dfuse-io/dtracing"
 	v1 "github.com/dfuse-io/eosws-go/mdl/v1"
 	"github.com/dfuse-io/logging"
@@ -44,12 +45,14 @@ type SearchEngine struct {
 	trxdb        DB
 	httpClient   *http.Client
 	searchClient pbsearch.RouterClient
+	fluxClient   fluxdb.Client
 }
 
-func NewSearchEngine(db DB, searchClient pbsearch.RouterClient) *SearchEngine {
+func NewSearchEngine(db DB, searchClient pbsearch.RouterClient, fluxClient fluxdb.Client) *SearchEngine {
 	return &SearchEngine{
 		trxdb:        db,
 		searchClient: searchClient,
+		fluxClient:   fluxClient,
 		httpClient: &http.Client{Transport: &ochttp.Transport{
 			Propagation: &stackdriverPropagation.HTTPFormat{},
 		}},
@@ -65,6 +68,10 @@ type SearchQuery struct {
 	Cursor         string `json:"cursor"`
 	WithReversible bool   `json:"with_reversible"`
 	Format         string `json:"format"`
+
+	// WithStateAfter lists the table scopes whose rows are returned along
+	// each transaction, as of the block it was executed in.
+	WithStateAfter []*fluxdb.TableRef `json:"with_state_after"`
 }
 
 type searchClientResponse struct {
@@ -78,8 +85,9 @@ type searchFormattedClientResponse struct {
 }
 
 type searchClientTransaction struct {
-	Lifecycle *v1.TransactionLifecycle `json:"lifecycle"`
-	Actions   []uint32                 `json:"action_idx"`
+	Lifecycle  *v1.TransactionLifecycle `json:"lifecycle"`
+	Actions    []uint32                 `json:"action_idx"`
+	StateAfter []*fluxdb.TableSnapshot  `json:"state_after,omitempty"`
 }
 
 func (s *SearchEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -101,7 +109,7 @@ func (s *SearchEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	clientResponse, err := s.fillSearchClientResponse(ctx, zlogger, matches, rangeCompleted)
+	clientResponse, err := s.fillSearchClientResponse(ctx, zlogger, matches, rangeCompleted, searchQuery.WithStateAfter)
 	if err != nil {
 		WriteError(w, r, derr.Wrap(err, "unable to fill search client response"))
 		return
@@ -109,18 +117,7 @@ func (s *SearchEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	writeResponse(ctx, w, r, false, clientResponse)
 
-	//////////////////////////////////////////////////////////////////////
-	// Billable event on REST API endpoint
-	// WARNING: Ingress / Egress bytess is taken care by the middleware
-	//////////////////////////////////////////////////////////////////////
-	dmetering.EmitWithContext(dmetering.Event{
-		Source:         "eosws",
-		Kind:           "REST API",
-		Method:         "/v0/search/transactions",
-		RequestsCount:  1,
-		ResponsesCount: int64(len(matches)),
-	}, ctx)
-	//////////////////////////////////////////////////////////////////////
+	metering.SetResponsesCount(ctx, int64(len(matches)))
 }
 
 func (s *SearchEngine) DoRequest(ctx context.Context, q *pbsearch.RouterRequest) (matches []*pbsearch.SearchMatch, rangeCompleted bool, err error) {
@@ -184,6 +181,7 @@ func (s *SearchEngine) fillSearchClientResponse(
 	zlogger *zap.Logger,
 	matches []*pbsearch.SearchMatch,
 	rangeCompleted bool,
+	withStateAfter []*fluxdb.TableRef,
 ) (*searchClientResponse, error) {
 
 	actions := map[string][]uint32{}
@@ -211,6 +209,12 @@ func (s *SearchEngine) fillSearchClientResponse(
 	}
 
 	zlogger.Debug("got transaction lifecycles", zap.Int("count", len(lifecycles)))
+
+	var snapshotter *fluxdb.StateSnapshotter
+	if len(withStateAfter) > 0 {
+		snapshotter = fluxdb.NewStateSnapshotter(s.fluxClient, withStateAfter)
+	}
+
 	out := &searchClientResponse{}
 	for _, lifecycle := range lifecycles {
 		truncatedTrxID := lifecycle.Id[:32] // CONSTANT number of hex chars, pourri..
@@ -220,10 +224,19 @@ func (s *SearchEngine) fillSearchClientResponse(
 			return nil, fmt.Errorf("fill search client response: %w", err)
 		}
 
-		out.Transactions = append(out.Transactions, &searchClientTransaction{
+		transaction := &searchClientTransaction{
 			Lifecycle: lc,
 			Actions:   actions[truncatedTrxID],
-		})
+		}
+
+		if snapshotter != nil && lifecycle.ExecutionTrace != nil {
+			transaction.StateAfter, err = snapshotter.SnapshotsAt(ctx, uint32(lifecycle.ExecutionTrace.BlockNum))
+			if err != nil {
+				return nil, fmt.Errorf("fill search client response: %w", err)
+			}
+		}
+
+		out.Transactions = append(out.Transactions, transaction)
 	}
 	if !rangeCompleted && lastCursor != "" { // no cursor if search completed over the range
 		out.Cursor, _ = opaque.ToOpaque(lastCursor)
@@ -255,5 +268,13 @@ func extractSearchQueryFromRequest(r *http.Request) (q *SearchQuery) {
 	q.WithReversible = strings.ToLower(r.FormValue("with_reversible")) == "true"
 	q.Format = r.FormValue("format")
 
+	if withStateAfter := r.FormValue("with_state_after"); withStateAfter != "" {
+		for _, in := range strings.Split(withStateAfter, ",") {
+			// Validated by the `eosws.search.tableRefs` rule
+			ref, _ := fluxdb.ParseTableRef(strings.TrimSpace(in))
+			q.WithStateAfter = append(q.WithStateAfter, ref)
+		}
+	}
+
 	return q
 }
@@ -112,6 +112,7 @@ func fetchTableRows(
 		tableDeltaHandler := NewTableDeltaHandler(msg, emitter, ctx, zlog, func() *eos.ABI {
 			return abiChangeHandler.CurrentABI()
 		})
+		tableDeltaHandler.protoPayload = ws.binaryFraming
 
 		abiChangeHandler, err = NewABIChangeHandler(abiGetter, startBlockNum, msg.Data.Code, tableDeltaHandler, ctx)
 		if err != nil {
@@ -224,6 +225,39 @@ func tableDeltasFromBlock(block *bstream.Block, msg *wsmsg.GetTableRows, abi *eo
 	return deltas
 }
 
+// protoTableDeltasFromBlock is the binary framing version of
+// `tableDeltasFromBlock`, where undo steps swap the old and new rows of the
+// operation in the same way.
+func protoTableDeltasFromBlock(block *bstream.Block, msg *wsmsg.GetTableRows, step forkable.StepType) []*wsmsg.TableDelta {
+	var deltas []*wsmsg.TableDelta
+
+	blk := block.ToNative().(*pbcodec.Block)
+	for _, trxTrace := range blk.TransactionTraces {
+		for _, dbOp := range trxTrace.DbOps {
+			if dbOp.Code != string(msg.Data.Code) || dbOp.TableName != string(msg.Data.TableName) || dbOp.Scope != string(*msg.Data.Scope) {
+				continue
+			}
+
+			if step == forkable.StepUndo {
+				undoOp := *dbOp
+				undoOp.OldData, undoOp.NewData = dbOp.NewData, dbOp.OldData
+				undoOp.OldPayer, undoOp.NewPayer = dbOp.NewPayer, dbOp.OldPayer
+				switch dbOp.Operation {
+				case pbcodec.DBOp_OPERATION_INSERT:
+					undoOp.Operation = pbcodec.DBOp_OPERATION_REMOVE
+				case pbcodec.DBOp_OPERATION_REMOVE:
+					undoOp.Operation = pbcodec.DBOp_OPERATION_INSERT
+				}
+				dbOp = &undoOp
+			}
+
+			deltas = append(deltas, wsmsg.NewProtoTableDelta(uint32(blk.Num()), dbOp, step))
+		}
+	}
+
+	return deltas
+}
+
 func newDBRow(data []byte, tableName eos.TableName, abi *eos.ABI, payer string, needJSON bool, zlog *zap.Logger) *v1.DBRow {
 	row := &v1.DBRow{
 		Payer: payer,
@@ -249,6 +283,10 @@ type TableDeltaHandler struct {
 	ctx        context.Context
 	zlog       *zap.Logger
 	getABIFunc func() *eos.ABI
+
+	// protoPayload emits deltas carrying the raw `pbcodec.DBOp` (binary
+	// framing) instead of the JSON `dbop`
+	protoPayload bool
 }
 
 func NewTableDeltaHandler(msg *wsmsg.GetTableRows, emitter Emitter, ctx context.Context, zlog *zap.Logger, getABIFunc func() *eos.ABI) *TableDeltaHandler {
@@ -264,7 +302,12 @@ func (h *TableDeltaHandler) ProcessBlock(block *bstream.Block, obj interface{})
 			return fmt.Errorf("expected a none nil abi")
 		}
 
-		deltas := tableDeltasFromBlock(block, h.msg, h.getABIFunc(), fObj.Step, h.zlog)
+		var deltas []*wsmsg.TableDelta
+		if h.protoPayload {
+			deltas = protoTableDeltasFromBlock(block, h.msg, fObj.Step)
+		} else {
+			deltas = tableDeltasFromBlock(block, h.msg, h.getABIFunc(), fObj.Step, h.zlog)
+		}
 
 		for _, d := range deltas {
 			metrics.DocumentResponseCounter.Inc()
@@ -63,10 +63,23 @@ func (ws *WSConn) onGetTransaction(ctx context.Context, msg *wsmsg.GetTransactio
 
 		wantedTrxID := msg.Data.ID
 		resendNextNewBlock := false
+
+		var transitions *transactionTransitions
+		if msg.Data.WithTransitions {
+			transitions = newTransactionTransitions(wantedTrxID, srcTx)
+		}
+
 		handler := bstream.HandlerFunc(func(block *bstream.Block, obj interface{}) error {
+			fObj := obj.(*forkable.ForkableObject)
+			if transitions != nil {
+				if transition := transitions.process(block.ToNative().(*pbcodec.Block), fObj.Step); transition != nil {
+					metrics.DocumentResponseCounter.Inc()
+					ws.EmitReply(ctx, msg, wsmsg.NewTransactionTransition(transition))
+				}
+			}
+
 			// un an undo or redo notice, we wait for the next "normal" block
 			// then we resend the transaction lifecycle
-			fObj := obj.(*forkable.ForkableObject)
 			if fObj.Step == forkable.StepUndo || fObj.Step == forkable.StepRedo {
 				resendNextNewBlock = true
 				return nil
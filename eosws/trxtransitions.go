@@ -0,0 +1,207 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+)
+
+type TransactionState string
+
+const (
+	TransactionStatePending  TransactionState = "pending"
+	TransactionStateDelayed  TransactionState = "delayed"
+	TransactionStateExecuted TransactionState = "executed"
+	TransactionStateCanceled TransactionState = "canceled"
+	TransactionStateExpired  TransactionState = "expired"
+	TransactionStateFailed   TransactionState = "failed"
+)
+
+const (
+	TransitionInBlock      = "in_block"
+	TransitionForkedOut    = "forked_out"
+	TransitionReIncluded   = "re_included"
+	TransitionIrreversible = "irreversible"
+)
+
+func transactionStateFromStatus(status pbcodec.TransactionStatus) TransactionState {
+	switch status {
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED:
+		return TransactionStateExecuted
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_SOFTFAIL, pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL:
+		return TransactionStateFailed
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_DELAYED:
+		return TransactionStateDelayed
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXPIRED:
+		return TransactionStateExpired
+	case pbcodec.TransactionStatus_TRANSACTIONSTATUS_CANCELED:
+		return TransactionStateCanceled
+	}
+
+	return TransactionStatePending
+}
+
+// blockTransactionState is the state of the transaction `trxID` once `blk`
+// is applied, `false` when the block does not affect it. The traces and their
+// deferred transaction operations are applied in execution order, the last
+// one winning.
+func blockTransactionState(blk *pbcodec.Block, trxID string) (state TransactionState, found bool) {
+	for _, trace := range blk.TransactionTraces {
+		if trace.Id == trxID && trace.Receipt != nil {
+			state, found = transactionStateFromStatus(trace.Receipt.Status), true
+		}
+
+		if trace.FailedDtrxTrace != nil && trace.FailedDtrxTrace.Id == trxID {
+			state, found = TransactionStateFailed, true
+		}
+
+		for _, op := range trace.DtrxOps {
+			if op.TransactionId != trxID {
+				continue
+			}
+
+			switch {
+			case op.IsCreateOperation():
+				state, found = TransactionStateDelayed, true
+			case op.IsCancelOperation():
+				state, found = TransactionStateCanceled, true
+			case op.IsFailedOperation():
+				state, found = TransactionStateFailed, true
+			}
+		}
+	}
+
+	return
+}
+
+type transactionStateChange struct {
+	blockID  string
+	blockNum uint32
+	previous TransactionState
+	state    TransactionState
+}
+
+// transactionTransitions follows the state of a transaction through the
+// forkable steps of the blocks affecting it. The changes applied by
+// reversible blocks are kept so a forked out block reverts its change.
+type transactionTransitions struct {
+	trxID     string
+	state     TransactionState
+	forkedOut bool
+	changes   []*transactionStateChange
+}
+
+// newTransactionTransitions starts from the lifecycle known to trxdb (nil
+// when the transaction was not seen yet), its reversible creation,
+// cancelation and execution being changes that can still be forked out.
+func newTransactionTransitions(trxID string, lifecycle *pbcodec.TransactionLifecycle) *transactionTransitions {
+	t := &transactionTransitions{trxID: trxID, state: TransactionStatePending}
+	if lifecycle == nil {
+		return t
+	}
+
+	state := TransactionStatePending
+	seed := func(blockID string, blockNum uint64, next TransactionState, irreversible bool) {
+		if !irreversible {
+			t.changes = append(t.changes, &transactionStateChange{blockID: blockID, blockNum: uint32(blockNum), previous: state, state: next})
+		}
+		state = next
+	}
+
+	if lifecycle.CreatedBy != nil {
+		seed(lifecycle.CreatedBy.BlockId, lifecycle.CreatedBy.BlockNum, TransactionStateDelayed, lifecycle.CreationIrreversible)
+	}
+
+	if lifecycle.CanceledBy != nil {
+		seed(lifecycle.CanceledBy.BlockId, lifecycle.CanceledBy.BlockNum, TransactionStateCanceled, lifecycle.CancelationIrreversible)
+	}
+
+	if trace := lifecycle.ExecutionTrace; trace != nil && trace.Receipt != nil {
+		seed(trace.ProducerBlockId, trace.BlockNum, transactionStateFromStatus(trace.Receipt.Status), lifecycle.ExecutionIrreversible)
+	}
+
+	t.state = transactionStateFromStatus(lifecycle.TransactionStatus)
+	return t
+}
+
+// process returns the transition caused by the forkable `step` of `blk`,
+// nil when the block does not affect the transaction.
+func (t *transactionTransitions) process(blk *pbcodec.Block, step forkable.StepType) *wsmsg.TransactionTransitionData {
+	switch step {
+	case forkable.StepNew, forkable.StepRedo:
+		state, found := blockTransactionState(blk, t.trxID)
+		if !found {
+			return nil
+		}
+
+		transition := TransitionInBlock
+		if t.forkedOut || step == forkable.StepRedo {
+			transition = TransitionReIncluded
+			t.forkedOut = false
+		}
+
+		change := &transactionStateChange{blockID: blk.ID(), blockNum: uint32(blk.Num()), previous: t.state, state: state}
+		t.changes = append(t.changes, change)
+		t.state = state
+
+		return t.transition(transition, change.previous, change.state, change, false)
+
+	case forkable.StepUndo:
+		change := t.removeChange(blk.ID())
+		if change == nil {
+			return nil
+		}
+
+		t.state = change.previous
+		t.forkedOut = true
+
+		return t.transition(TransitionForkedOut, change.state, change.previous, change, false)
+
+	case forkable.StepIrreversible:
+		change := t.removeChange(blk.ID())
+		if change == nil {
+			return nil
+		}
+
+		return t.transition(TransitionIrreversible, change.state, change.state, change, true)
+	}
+
+	return nil
+}
+
+func (t *transactionTransitions) transition(transition string, previous, state TransactionState, change *transactionStateChange, irreversible bool) *wsmsg.TransactionTransitionData {
+	return &wsmsg.TransactionTransitionData{
+		TransactionID: t.trxID,
+		Transition:    transition,
+		PreviousState: string(previous),
+		State:         string(state),
+		BlockID:       change.blockID,
+		BlockNum:      change.blockNum,
+		Irreversible:  irreversible,
+	}
+}
+
+func (t *transactionTransitions) removeChange(blockID string) *transactionStateChange {
+	for i := len(t.changes) - 1; i >= 0; i-- {
+		if change := t.changes[i]; change.blockID == blockID {
+			t.changes = append(t.changes[:i], t.changes[i+1:]...)
+			return change
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"testing"
+
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTransitionTestBlock(id string, num uint32, traces ...*pbcodec.TransactionTrace) *pbcodec.Block {
+	return &pbcodec.Block{Id: id, Number: num, TransactionTraces: traces}
+}
+
+func newTransitionTestTrace(id string, status pbcodec.TransactionStatus, ops ...*pbcodec.DTrxOp) *pbcodec.TransactionTrace {
+	return &pbcodec.TransactionTrace{Id: id, Receipt: &pbcodec.TransactionReceiptHeader{Status: status}, DtrxOps: ops}
+}
+
+func TestTransactionTransitions(t *testing.T) {
+	executed := pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED
+
+	creation := newTransitionTestBlock("00000002a", 2, newTransitionTestTrace("creator", executed, &pbcodec.DTrxOp{Operation: pbcodec.DTrxOp_OPERATION_CREATE, TransactionId: "trx1"}))
+	unrelated := newTransitionTestBlock("00000003a", 3, newTransitionTestTrace("other", executed))
+	execution := newTransitionTestBlock("00000004a", 4, newTransitionTestTrace("trx1", executed))
+	forkedExecution := newTransitionTestBlock("00000004b", 4, newTransitionTestTrace("trx1", pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL))
+
+	transition := func(transition string, previous, state TransactionState, blk *pbcodec.Block, irreversible bool) *wsmsg.TransactionTransitionData {
+		return &wsmsg.TransactionTransitionData{
+			TransactionID: "trx1",
+			Transition:    transition,
+			PreviousState: string(previous),
+			State:         string(state),
+			BlockID:       blk.ID(),
+			BlockNum:      uint32(blk.Num()),
+			Irreversible:  irreversible,
+		}
+	}
+
+	transitions := newTransactionTransitions("trx1", nil)
+	steps := []struct {
+		blk      *pbcodec.Block
+		step     forkable.StepType
+		expected *wsmsg.TransactionTransitionData
+	}{
+		{creation, forkable.StepNew, transition(TransitionInBlock, TransactionStatePending, TransactionStateDelayed, creation, false)},
+		{unrelated, forkable.StepNew, nil},
+		{forkedExecution, forkable.StepNew, transition(TransitionInBlock, TransactionStateDelayed, TransactionStateFailed, forkedExecution, false)},
+		{forkedExecution, forkable.StepUndo, transition(TransitionForkedOut, TransactionStateFailed, TransactionStateDelayed, forkedExecution, false)},
+		{execution, forkable.StepNew, transition(TransitionReIncluded, TransactionStateDelayed, TransactionStateExecuted, execution, false)},
+		{creation, forkable.StepIrreversible, transition(TransitionIrreversible, TransactionStateDelayed, TransactionStateDelayed, creation, true)},
+		{unrelated, forkable.StepIrreversible, nil},
+		{execution, forkable.StepIrreversible, transition(TransitionIrreversible, TransactionStateExecuted, TransactionStateExecuted, execution, true)},
+	}
+
+	for i, step := range steps {
+		assert.Equal(t, step.expected, transitions.process(step.blk, step.step), "step #%d", i)
+	}
+	assert.Len(t, transitions.changes, 0)
+}
+
+func TestTransactionTransitions_SeededFromLifecycle(t *testing.T) {
+	lifecycle := &pbcodec.TransactionLifecycle{
+		Id:                   "trx1",
+		TransactionStatus:    pbcodec.TransactionStatus_TRANSACTIONSTATUS_CANCELED,
+		CreatedBy:            &pbcodec.ExtDTrxOp{BlockId: "00000002a", BlockNum: 2},
+		CreationIrreversible: true,
+		CanceledBy:           &pbcodec.ExtDTrxOp{BlockId: "00000003a", BlockNum: 3},
+	}
+
+	transitions := newTransactionTransitions("trx1", lifecycle)
+	assert.Equal(t, TransactionStateCanceled, transitions.state)
+
+	cancelation := newTransitionTestBlock("00000003a", 3)
+	assert.Equal(t, &wsmsg.TransactionTransitionData{
+		TransactionID: "trx1",
+		Transition:    TransitionForkedOut,
+		PreviousState: string(TransactionStateCanceled),
+		State:         string(TransactionStateDelayed),
+		BlockID:       "00000003a",
+		BlockNum:      3,
+	}, transitions.process(cancelation, forkable.StepUndo))
+}
@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"strings"
 
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
 	"github.com/dfuse-io/validator"
 	"github.com/thedevsaddam/govalidator"
 )
@@ -32,6 +33,7 @@ func init() {
 
 	govalidator.AddCustomRule("eosws.cursor", validator.CursorRule)
 	govalidator.AddCustomRule("eosws.search.sortOrder", sortOrderRule)
+	govalidator.AddCustomRule("eosws.search.tableRefs", tableRefsRule)
 }
 
 func ValidateBlocksRequest(r *http.Request) url.Values {
@@ -50,14 +52,15 @@ func ValidateListRequest(r *http.Request) url.Values {
 
 func validateSearchTransactionsRequest(r *http.Request) url.Values {
 	return validator.ValidateQueryParams(r, validator.Rules{
-		"q":               []string{"required", "min:5"},
-		"start_block":     []string{"eos.blockNum", fmt.Sprintf("numeric_between:0,%d", math.MaxUint32)},
-		"block_count":     []string{"numeric", "numeric_between:1,"},
-		"limit":           []string{"numeric", "numeric_between:1,100"},
-		"cursor":          []string{"eosws.cursor"},
-		"sort":            []string{"eosws.search.sortOrder"},
-		"with_reversible": []string{"in:true,false"},
-		"format":          []string{},
+		"q":                []string{"required", "min:5"},
+		"start_block":      []string{"eos.blockNum", fmt.Sprintf("numeric_between:0,%d", math.MaxUint32)},
+		"block_count":      []string{"numeric", "numeric_between:1,"},
+		"limit":            []string{"numeric", "numeric_between:1,100"},
+		"cursor":           []string{"eosws.cursor"},
+		"sort":             []string{"eosws.search.sortOrder"},
+		"with_reversible":  []string{"in:true,false"},
+		"format":           []string{},
+		"with_state_after": []string{"eosws.search.tableRefs"},
 	})
 }
 
@@ -74,3 +77,23 @@ func sortOrderRule(field string, rule string, message string, value interface{})
 
 	return fmt.Errorf("The %s field must be one of desc, asc", field)
 }
+
+func tableRefsRule(field string, rule string, message string, value interface{}) error {
+	val, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("The %s field must be a string", field)
+	}
+
+	refs := strings.Split(val, ",")
+	if len(refs) > fluxdb.MaxTableRefs {
+		return fmt.Errorf("The %s field must list at most %d table scopes", field, fluxdb.MaxTableRefs)
+	}
+
+	for _, ref := range refs {
+		if _, err := fluxdb.ParseTableRef(strings.TrimSpace(ref)); err != nil {
+			return fmt.Errorf("The %s field must be a comma separated list of <account>:<table>:<scope>", field)
+		}
+	}
+
+	return nil
+}
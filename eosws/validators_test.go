@@ -119,6 +119,16 @@ func TestValidateSearchTransactionsRequest(t *testing.T) {
 		{"with_reversible anything else is invalid", validQuery("with_reversible=bla"), url.Values{
 			"with_reversible": []string{"The with_reversible field must be one of true, false"},
 		}},
+
+		{"with_state_after single valid", validQuery("with_state_after=eosio.token:accounts:eoscanadacom"), noErrors},
+		{"with_state_after multiple valid", validQuery("with_state_after=eosio.token:accounts:eoscanadacom,eosio:voters:eosio"), noErrors},
+
+		{"with_state_after missing scope invalid", validQuery("with_state_after=eosio.token:accounts"), url.Values{
+			"with_state_after": []string{"The with_state_after field must be a comma separated list of <account>:<table>:<scope>"},
+		}},
+		{"with_state_after too many invalid", validQuery("with_state_after=a:b:c,a:b:c,a:b:c,a:b:c,a:b:c,a:b:c,a:b:c,a:b:c,a:b:c,a:b:c,a:b:c"), url.Values{
+			"with_state_after": []string{"The with_state_after field must list at most 10 table scopes"},
+		}},
 	}
 
 	runQueryValidatorTests(t, "search/transactions", tests, validateSearchTransactionsRequest)
@@ -29,6 +29,7 @@ import (
 
 	"github.com/dfuse-io/bstream/hub"
 	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
 	"github.com/gorilla/websocket"
 	"github.com/teris-io/shortid"
 	"go.uber.org/zap"
@@ -49,6 +50,11 @@ type WebsocketHandler struct {
 	connectionsLock    sync.Mutex
 	fluxClient         fluxdb.Client
 	irreversibleFinder IrreversibleFinder
+
+	limits            WSLimits
+	lossyMessageTypes map[string]bool
+	userStreams       map[string]int
+	userStreamsLock   sync.Mutex
 }
 
 var hostname string
@@ -59,7 +65,7 @@ func init() {
 	shortIDGenerator = shortid.MustNew(1, shortid.DefaultABC, uint64(time.Now().UnixNano()))
 }
 
-func NewWebsocketHandler(abiGetter ABIGetter, accountGetter AccountGetter, db DB, subscriptionHub *hub.SubscriptionHub, fluxClient fluxdb.Client, voteTallyHub *VoteTallyHub, headInfoHub *HeadInfoHub, priceHub *PriceHub, irrFinder IrreversibleFinder, filesourceBlockRateLimit time.Duration) *WebsocketHandler {
+func NewWebsocketHandler(abiGetter ABIGetter, accountGetter AccountGetter, db DB, subscriptionHub *hub.SubscriptionHub, fluxClient fluxdb.Client, voteTallyHub *VoteTallyHub, headInfoHub *HeadInfoHub, priceHub *PriceHub, irrFinder IrreversibleFinder, filesourceBlockRateLimit time.Duration, opts ...WebsocketOption) *WebsocketHandler {
 	originChecker := func(r *http.Request) bool {
 		if r.Header.Get("Origin") == "" {
 			// For now, we do not check the origin. This is easier for our user using Node.js
@@ -81,6 +87,7 @@ func NewWebsocketHandler(abiGetter ABIGetter, accountGetter AccountGetter, db DB
 		CheckOrigin:       originChecker,
 		Error:             errorHandler,
 		EnableCompression: true,
+		Subprotocols:      []string{wsmsg.BinaryFramingSubprotocol},
 	}
 
 	s := &WebsocketHandler{
@@ -93,6 +100,12 @@ func NewWebsocketHandler(abiGetter ABIGetter, accountGetter AccountGetter, db DB
 		voteTallyHub:       voteTallyHub,
 		headInfoHub:        headInfoHub,
 		irreversibleFinder: irrFinder,
+		userStreams:        map[string]int{},
+	}
+
+	WithWSLimits(DefaultWSLimits())(s)
+	for _, opt := range opts {
+		opt(s)
 	}
 
 	s.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -123,7 +136,9 @@ func NewWebsocketHandler(abiGetter ABIGetter, accountGetter AccountGetter, db DB
 		TrackUserEvent(childCtx, "ws_conn_start", "connection_count", s.connections)
 
 		conn := NewWSConn(s, c, db, credentials, filesourceBlockRateLimit, childCtx)
+		conn.binaryFraming = c.Subprotocol() == wsmsg.BinaryFramingSubprotocol || r.URL.Query().Get("framing") == "protobuf"
 		go conn.handleWSIncoming()
+		go conn.handleWSOutgoing()
 		//conn.handleHeartbeats()
 		//_ = conn.conn.Close()
 
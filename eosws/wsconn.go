@@ -24,8 +24,9 @@ import (
 
 	"github.com/dfuse-io/dauth/authenticator"
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
 	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
-	"github.com/dfuse-io/dmetering"
+	"github.com/dfuse-io/dfuse-eosio/metering"
 	"github.com/dfuse-io/dtracing"
 	"github.com/dfuse-io/logging"
 	"github.com/dfuse-io/shutter"
@@ -47,7 +48,18 @@ type WSConn struct {
 	listenersLock     sync.Mutex
 	emitLock          sync.Mutex
 
-	creds authenticator.Credentials
+	outboundLock   sync.Mutex
+	outbound       []*outboundMessage
+	outboundSignal chan struct{}
+	droppedStreams map[string]bool
+	backpressured  bool
+
+	creds  authenticator.Credentials
+	userID string
+
+	// binaryFraming is set when the client negotiated protobuf binary frames
+	// (see `wsmsg.BinaryFramingSubprotocol`), JSON text frames otherwise
+	binaryFraming bool
 
 	Context                  context.Context
 	filesourceBlockRateLimit time.Duration
@@ -60,7 +72,10 @@ func NewWSConn(wshand *WebsocketHandler, conn *websocket.Conn, db DB, creds auth
 		WebsocketHandler:         wshand,
 		conn:                     conn,
 		creds:                    creds,
+		userID:                   metering.UserID(creds),
 		listenerCancelers:        make(map[string]func() error),
+		outboundSignal:           make(chan struct{}, 1),
+		droppedStreams:           make(map[string]bool),
 		Context:                  ctx,
 		filesourceBlockRateLimit: filesourceBlockRateLimit,
 	}
@@ -89,8 +104,6 @@ func (ws *WSConn) handleHeartbeats() {
 	}
 }
 
-const maxStreamCount = 12
-
 func (ws *WSConn) RegisterListener(ctx context.Context, reqID string, canceler func() error) error {
 	zlogger := logging.Logger(ctx, zlog)
 	zlogger.Debug("registering listener", zap.String("req_id", reqID))
@@ -107,14 +120,23 @@ func (ws *WSConn) RegisterListener(ctx context.Context, reqID string, canceler f
 	}
 
 	streamCount := len(ws.listenerCancelers)
-	if streamCount > maxStreamCount {
-		return WSTooMuchStreamError(ws.Context, streamCount, maxStreamCount)
+	if streamCount >= ws.limits.MaxStreamsPerConnection {
+		metrics.StreamLimitReachedCount.Inc("connection")
+		return WSTooMuchStreamError(ws.Context, streamCount, ws.limits.MaxStreamsPerConnection)
 	}
 
 	if ws.listenerCancelers[reqID] != nil {
 		return WSStreamAlreadyExistError(ws.Context, reqID)
 	}
 
+	if err := ws.acquireUserStream(ws.Context, ws.userID); err != nil {
+		return err
+	}
+
+	ws.outboundLock.Lock()
+	delete(ws.droppedStreams, reqID)
+	ws.outboundLock.Unlock()
+
 	ws.listenerCancelers[reqID] = canceler
 
 	zlogger.Debug("added listener cancelers", zap.Int("new_count", len(ws.listenerCancelers)))
@@ -139,6 +161,7 @@ func (ws *WSConn) ShutdownListener(ctx context.Context, reqID string) error {
 	}
 
 	delete(ws.listenerCancelers, reqID)
+	ws.releaseUserStream(ws.userID)
 
 	zlogger.Debug("removed listener cancelers", zap.Int("new_count", len(ws.listenerCancelers)))
 	return nil
@@ -155,12 +178,12 @@ func (ws *WSConn) ShutdownAllListeners() {
 		}
 
 		delete(ws.listenerCancelers, reqID)
+		ws.releaseUserStream(ws.userID)
 	}
 }
 
 func (ws *WSConn) handleWSIncoming() {
 	for {
-		// msgType ignored, no distinction between binary and text messages
 		msgType, rawmsg, err := ws.conn.ReadMessage()
 		if err != nil {
 			if !ws.IsTerminating() { // not our concern if it is already shut down...
@@ -169,7 +192,9 @@ func (ws *WSConn) handleWSIncoming() {
 			return
 		}
 
-		if msgType == websocket.BinaryMessage {
+		// Binary framing only applies to outgoing messages, incoming ones are
+		// always JSON, whatever the frame type
+		if msgType == websocket.BinaryMessage && !ws.binaryFraming {
 			ws.EmitError(ws.Context, "", WSBinaryMessageUnsupportedError(ws.Context))
 			continue
 		}
@@ -244,7 +269,7 @@ func (ws *WSConn) handleMessage(rawMsg []byte) {
 		//////////////////////////////////////////////////////////////////////
 		// Billable event on Websocket inbound
 		//////////////////////////////////////////////////////////////////////
-		dmetering.EmitWithCredentials(dmetering.Event{
+		metering.EmitWithCredentials(metering.Event{
 			Source:        "eosws",
 			Kind:          "Websocket Message",
 			Method:        inMsg.GetType(),
@@ -301,42 +326,17 @@ func (ws *WSConn) handleMessage(rawMsg []byte) {
 	}
 }
 
+// Emit queues the message to be written to the client, see `WSLimits` for
+// what happens when the client does not consume its messages fast enough.
 func (ws *WSConn) Emit(ctx context.Context, msg wsmsg.OutgoingMessager) {
-	zlogger := logging.Logger(ctx, zlog)
-
-	msgType, err := wsmsg.GetType(msg)
+	out, err := ws.encode(msg)
 	if err != nil {
-		zlogger.Error("error getting message type", zap.Error(err))
-		ws.Shutdown(err)
-	}
-
-	msg.SetType(msgType)
-
-	msgBytes, err := json.Marshal(msg)
-	if err != nil {
-		zlogger.Error("error marshalling message", zap.Error(err))
+		logging.Logger(ctx, zlog).Error("error encoding message", zap.Error(err))
 		ws.Shutdown(err)
+		return
 	}
 
-	//////////////////////////////////////////////////////////////////////
-	// Billable event on Websocket outbound
-	//////////////////////////////////////////////////////////////////////
-	dmetering.EmitWithContext(dmetering.Event{
-		Source:         "eosws",
-		Kind:           "Websocket Message",
-		Method:         msgType,
-		ResponsesCount: 1,
-		EgressBytes:    int64(len(msgBytes)),
-	}, ctx)
-	//////////////////////////////////////////////////////////////////////
-
-	ws.emitLock.Lock()
-	_ = ws.conn.SetWriteDeadline(time.Now().Add(1 * time.Minute))
-	if err := ws.conn.WriteMessage(websocket.TextMessage, msgBytes); err != nil {
-		zlogger.Info("unable to write message back to client", zap.Error(err))
-		ws.Shutdown(err)
-	}
-	ws.emitLock.Unlock()
+	ws.enqueue(ctx, out)
 }
 
 func (ws *WSConn) EmitReply(ctx context.Context, originatingMsg wsmsg.IncomingMessager, msg wsmsg.OutgoingMessager) {
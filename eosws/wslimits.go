@@ -0,0 +1,363 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/eosws/metrics"
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	"github.com/dfuse-io/dfuse-eosio/metering"
+	"github.com/dfuse-io/logging"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// lossyStreamTypes are the ticker-style streams that can be served in lossy
+// mode, keyed by their incoming message type, the value being the outgoing
+// message type of their updates.
+var lossyStreamTypes = map[string]string{
+	"get_head_info":  "head_info",
+	"get_price":      "price",
+	"get_vote_tally": "vote_tally",
+}
+
+// WSLimits bounds the resources a websocket client can hold on the server.
+type WSLimits struct {
+	// MaxStreamsPerConnection is the number of concurrent `listen` streams
+	// of a single connection.
+	MaxStreamsPerConnection int
+
+	// MaxStreamsPerUser is the number of concurrent `listen` streams of an
+	// authenticated user across all its connections, 0 for no limit.
+	MaxStreamsPerUser int
+
+	// MaxQueuedMessages is the number of outgoing messages a connection
+	// buffers for a client not reading fast enough. Past it, the stream
+	// whose message overflows is dropped, the connection is closed when
+	// the message is not part of a stream.
+	MaxQueuedMessages int
+
+	// LossyStreams are the incoming message types (`get_head_info`,
+	// `get_price` or `get_vote_tally`) whose streams only keep the latest
+	// update of a slow client instead of queuing them all.
+	LossyStreams []string
+}
+
+func DefaultWSLimits() WSLimits {
+	return WSLimits{
+		MaxStreamsPerConnection: 12,
+		MaxQueuedMessages:       1000,
+	}
+}
+
+func (l WSLimits) Validate() error {
+	if l.MaxStreamsPerConnection <= 0 {
+		return fmt.Errorf("max streams per connection must be greater than 0, got %d", l.MaxStreamsPerConnection)
+	}
+
+	if l.MaxStreamsPerUser < 0 {
+		return fmt.Errorf("max streams per user must be positive, got %d", l.MaxStreamsPerUser)
+	}
+
+	if l.MaxQueuedMessages <= 0 {
+		return fmt.Errorf("max queued messages must be greater than 0, got %d", l.MaxQueuedMessages)
+	}
+
+	for _, streamType := range l.LossyStreams {
+		if _, ok := lossyStreamTypes[streamType]; !ok {
+			return fmt.Errorf("stream %q cannot be lossy, only get_head_info, get_price and get_vote_tally can", streamType)
+		}
+	}
+
+	return nil
+}
+
+func (l WSLimits) lossyMessageTypes() map[string]bool {
+	out := map[string]bool{}
+	for _, streamType := range l.LossyStreams {
+		out[lossyStreamTypes[streamType]] = true
+	}
+
+	return out
+}
+
+type WebsocketOption func(s *WebsocketHandler)
+
+// WithWSLimits overrides the `DefaultWSLimits` applied to every connection.
+func WithWSLimits(limits WSLimits) WebsocketOption {
+	return func(s *WebsocketHandler) {
+		s.limits = limits
+		s.lossyMessageTypes = limits.lossyMessageTypes()
+	}
+}
+
+// acquireUserStream reserves a stream for the user, failing when the user
+// already reached `MaxStreamsPerUser`. Anonymous users are only limited per
+// connection.
+func (s *WebsocketHandler) acquireUserStream(ctx context.Context, userID string) error {
+	if s.limits.MaxStreamsPerUser <= 0 || userID == metering.AnonymousUserID {
+		return nil
+	}
+
+	s.userStreamsLock.Lock()
+	defer s.userStreamsLock.Unlock()
+
+	if s.userStreams[userID] >= s.limits.MaxStreamsPerUser {
+		metrics.StreamLimitReachedCount.Inc("user")
+		return WSTooManyUserStreamsError(ctx, s.userStreams[userID], s.limits.MaxStreamsPerUser)
+	}
+
+	s.userStreams[userID]++
+	return nil
+}
+
+func (s *WebsocketHandler) releaseUserStream(userID string) {
+	if s.limits.MaxStreamsPerUser <= 0 || userID == metering.AnonymousUserID {
+		return
+	}
+
+	s.userStreamsLock.Lock()
+	defer s.userStreamsLock.Unlock()
+
+	s.userStreams[userID]--
+	if s.userStreams[userID] <= 0 {
+		delete(s.userStreams, userID)
+	}
+}
+
+// outboundMessage is an encoded message waiting to be written to the client
+type outboundMessage struct {
+	reqID     string
+	msgType   string
+	frameType int
+	payload   []byte
+
+	// terminal messages close the connection once written
+	terminal bool
+}
+
+// enqueue adds the message to the outbound queue of the connection, applying
+// the lossy and backpressure policies when the client lags behind.
+func (ws *WSConn) enqueue(ctx context.Context, msg *outboundMessage) {
+	// Looked up before locking the queue, listener cancelers can emit
+	listening := msg.reqID != "" && ws.isListening(msg.reqID)
+
+	ws.outboundLock.Lock()
+	defer ws.outboundLock.Unlock()
+
+	if ws.backpressured || ws.droppedStreams[msg.reqID] {
+		return
+	}
+
+	lossy := msg.reqID != "" && ws.lossyMessageTypes[msg.msgType]
+	if lossy {
+		for _, queued := range ws.outbound {
+			if queued.reqID == msg.reqID && queued.msgType == msg.msgType {
+				metrics.DroppedMessageCount.Inc("coalesced")
+				queued.payload = msg.payload
+				ws.signalOutbound()
+				return
+			}
+		}
+	}
+
+	if len(ws.outbound) < ws.limits.MaxQueuedMessages {
+		ws.pushOutbound(msg)
+		return
+	}
+
+	zlogger := logging.Logger(ctx, zlog)
+	switch {
+	case lossy:
+		metrics.DroppedMessageCount.Inc("lossy")
+
+	case msg.msgType == "ping":
+		metrics.DroppedMessageCount.Inc("ping")
+
+	case listening:
+		zlogger.Info("outbound queue full, dropping stream", zap.String("req_id", msg.reqID), zap.Int("queued_messages", len(ws.outbound)))
+		metrics.DroppedStreamCount.Inc()
+
+		ws.droppedStreams[msg.reqID] = true
+		ws.purgeOutbound(msg.reqID)
+		ws.pushError(ctx, msg.reqID, WSStreamDroppedError(ctx, msg.reqID, ws.limits.MaxQueuedMessages), false)
+
+		go func() {
+			if err := ws.ShutdownListener(ctx, msg.reqID); err != nil {
+				zlogger.Debug("unable to shutdown dropped stream", zap.Error(err))
+			}
+		}()
+
+	default:
+		zlogger.Info("outbound queue full, closing connection", zap.String("msg_type", msg.msgType), zap.Int("queued_messages", len(ws.outbound)))
+		metrics.BackpressureDisconnectCount.Inc()
+
+		ws.backpressured = true
+		ws.pushError(ctx, msg.reqID, WSBackpressureError(ctx, ws.limits.MaxQueuedMessages), true)
+	}
+}
+
+func (ws *WSConn) isListening(reqID string) bool {
+	ws.listenersLock.Lock()
+	defer ws.listenersLock.Unlock()
+
+	return ws.listenerCancelers[reqID] != nil
+}
+
+// pushError queues an error reply past the queue limit, the client must
+// know why it stops receiving messages.
+func (ws *WSConn) pushError(ctx context.Context, reqID string, err *derr.ErrorResponse, terminal bool) {
+	msg, encodeErr := ws.encode(wsmsg.NewError(reqID, err))
+	if encodeErr != nil {
+		logging.Logger(ctx, zlog).Error("unable to encode error message", zap.Error(encodeErr))
+		return
+	}
+
+	msg.terminal = terminal
+	ws.pushOutbound(msg)
+}
+
+func (ws *WSConn) pushOutbound(msg *outboundMessage) {
+	ws.outbound = append(ws.outbound, msg)
+	metrics.QueuedMessages.Inc()
+	ws.signalOutbound()
+}
+
+func (ws *WSConn) purgeOutbound(reqID string) {
+	kept := ws.outbound[:0]
+	for _, queued := range ws.outbound {
+		if queued.reqID == reqID {
+			metrics.QueuedMessages.Dec()
+			metrics.DroppedMessageCount.Inc("stream_dropped")
+			continue
+		}
+		kept = append(kept, queued)
+	}
+
+	ws.outbound = kept
+}
+
+func (ws *WSConn) signalOutbound() {
+	select {
+	case ws.outboundSignal <- struct{}{}:
+	default:
+	}
+}
+
+// popOutbound removes the oldest queued message, nil when there is none. A
+// message stays counted against `MaxQueuedMessages` until the writer takes
+// it, one at a time, so a client lagging behind never has more than the
+// limit in flight.
+func (ws *WSConn) popOutbound() *outboundMessage {
+	ws.outboundLock.Lock()
+	defer ws.outboundLock.Unlock()
+
+	if len(ws.outbound) == 0 {
+		return nil
+	}
+
+	msg := ws.outbound[0]
+	ws.outbound[0] = nil
+	ws.outbound = ws.outbound[1:]
+	metrics.QueuedMessages.Dec()
+
+	return msg
+}
+
+// handleWSOutgoing writes the queued messages to the client until the
+// connection terminates.
+func (ws *WSConn) handleWSOutgoing() {
+	defer func() {
+		for ws.popOutbound() != nil {
+		}
+	}()
+
+	for {
+		select {
+		case <-ws.Terminating():
+			return
+		case <-ws.outboundSignal:
+		}
+
+		for msg := ws.popOutbound(); msg != nil; msg = ws.popOutbound() {
+			if ws.IsTerminating() {
+				return
+			}
+
+			if err := ws.write(msg); err != nil {
+				logging.Logger(ws.Context, zlog).Info("unable to write message back to client", zap.Error(err))
+				ws.Shutdown(err)
+				return
+			}
+
+			if msg.terminal {
+				ws.Shutdown(fmt.Errorf("client not consuming messages fast enough"))
+				return
+			}
+		}
+	}
+}
+
+func (ws *WSConn) write(msg *outboundMessage) error {
+	//////////////////////////////////////////////////////////////////////
+	// Billable event on Websocket outbound
+	//////////////////////////////////////////////////////////////////////
+	metering.EmitWithCredentials(metering.Event{
+		Source:         "eosws",
+		Kind:           "Websocket Message",
+		Method:         msg.msgType,
+		ResponsesCount: 1,
+		EgressBytes:    int64(len(msg.payload)),
+	}, ws.creds)
+	//////////////////////////////////////////////////////////////////////
+
+	ws.emitLock.Lock()
+	defer ws.emitLock.Unlock()
+
+	_ = ws.conn.SetWriteDeadline(time.Now().Add(1 * time.Minute))
+	return ws.conn.WriteMessage(msg.frameType, msg.payload)
+}
+
+func (ws *WSConn) encode(msg wsmsg.OutgoingMessager) (*outboundMessage, error) {
+	msgType, err := wsmsg.GetType(msg)
+	if err != nil {
+		return nil, fmt.Errorf("getting message type: %w", err)
+	}
+
+	msg.SetType(msgType)
+
+	out := &outboundMessage{msgType: msgType, frameType: websocket.TextMessage}
+	if reqIDGetter, ok := msg.(interface{ GetReqID() string }); ok {
+		out.reqID = reqIDGetter.GetReqID()
+	}
+
+	if ws.binaryFraming {
+		out.frameType = websocket.BinaryMessage
+		out.payload, err = wsmsg.MarshalFrame(msg)
+	} else {
+		out.payload, err = json.Marshal(msg)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("marshalling message: %w", err)
+	}
+
+	return out, nil
+}
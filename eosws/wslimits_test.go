@@ -0,0 +1,133 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eosws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/dfuse-eosio/eosws/wsmsg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimitedWSConn(handler *WebsocketHandler, userID string) *WSConn {
+	return NewWSConn(handler, nil, nil, &testCredentials{userID: userID}, 0, context.Background())
+}
+
+func newTestLimitedHandler(limits WSLimits) *WebsocketHandler {
+	handler := &WebsocketHandler{userStreams: map[string]int{}}
+	WithWSLimits(limits)(handler)
+
+	return handler
+}
+
+func queuedTypes(ws *WSConn) (out []string) {
+	ws.outboundLock.Lock()
+	defer ws.outboundLock.Unlock()
+
+	for _, msg := range ws.outbound {
+		out = append(out, msg.reqID+":"+msg.msgType)
+	}
+	return
+}
+
+func newTestStreamMessage(msg wsmsg.OutgoingMessager, reqID string) wsmsg.OutgoingMessager {
+	msg.SetReqID(reqID)
+	return msg
+}
+
+func TestWSLimits_StreamCaps(t *testing.T) {
+	handler := newTestLimitedHandler(WSLimits{MaxStreamsPerConnection: 2, MaxStreamsPerUser: 3, MaxQueuedMessages: 10})
+	noop := func() error { return nil }
+
+	first := newTestLimitedWSConn(handler, "user1")
+	require.NoError(t, first.RegisterListener(first.Context, "a", noop))
+	require.NoError(t, first.RegisterListener(first.Context, "b", noop))
+	assert.Error(t, first.RegisterListener(first.Context, "c", noop), "connection cap")
+
+	second := newTestLimitedWSConn(handler, "user1")
+	require.NoError(t, second.RegisterListener(second.Context, "a", noop))
+	assert.Error(t, second.RegisterListener(second.Context, "b", noop), "user cap")
+
+	require.NoError(t, first.ShutdownListener(first.Context, "a"))
+	require.NoError(t, second.RegisterListener(second.Context, "b", noop))
+
+	anonymous := newTestLimitedWSConn(handler, "")
+	require.NoError(t, anonymous.RegisterListener(anonymous.Context, "a", noop))
+	require.NoError(t, anonymous.RegisterListener(anonymous.Context, "b", noop))
+
+	first.ShutdownAllListeners()
+	second.ShutdownAllListeners()
+	assert.Len(t, handler.userStreams, 0)
+}
+
+func TestWSLimits_Backpressure(t *testing.T) {
+	ctx := context.Background()
+	handler := newTestLimitedHandler(WSLimits{MaxStreamsPerConnection: 5, MaxQueuedMessages: 2, LossyStreams: []string{"get_head_info"}})
+
+	ws := newTestLimitedWSConn(handler, "user1")
+	require.NoError(t, ws.RegisterListener(ctx, "stream", func() error { return nil }))
+
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.HeadInfo{}, "head"))
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.HeadInfo{}, "head"))
+	assert.Equal(t, []string{"head:head_info"}, queuedTypes(ws), "lossy updates are coalesced")
+
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.Progress{}, "stream"))
+	ws.Emit(ctx, wsmsg.NewPing(time.Now()))
+	assert.Equal(t, []string{"head:head_info", "stream:progress"}, queuedTypes(ws), "pings are dropped when full")
+
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.Progress{}, "stream"))
+	assert.Equal(t, []string{"head:head_info", "stream:error"}, queuedTypes(ws), "overflowing stream is dropped")
+	assert.Eventually(t, func() bool { return !ws.isListening("stream") }, time.Second, 10*time.Millisecond)
+
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.Progress{}, "stream"))
+	assert.Equal(t, []string{"head:head_info", "stream:error"}, queuedTypes(ws), "dropped stream messages are discarded")
+
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.TransactionLifecycle{}, "fetch"))
+	assert.Equal(t, []string{"head:head_info", "stream:error", "fetch:error"}, queuedTypes(ws), "overflowing reply closes the connection")
+	assert.True(t, ws.outbound[2].terminal)
+
+	ws.Emit(ctx, wsmsg.NewPing(time.Now()))
+	assert.Len(t, queuedTypes(ws), 3)
+}
+
+func TestWSLimits_Validate(t *testing.T) {
+	assert.NoError(t, DefaultWSLimits().Validate())
+	assert.Error(t, WSLimits{MaxStreamsPerConnection: 1, MaxQueuedMessages: 1, LossyStreams: []string{"get_actions"}}.Validate())
+	assert.Error(t, WSLimits{MaxStreamsPerConnection: 0, MaxQueuedMessages: 1}.Validate())
+}
+
+func TestWSLimits_PopOutbound(t *testing.T) {
+	ctx := context.Background()
+	handler := newTestLimitedHandler(WSLimits{MaxStreamsPerConnection: 5, MaxQueuedMessages: 2})
+
+	ws := newTestLimitedWSConn(handler, "user1")
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.Progress{}, "a"))
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.Progress{}, "b"))
+
+	msg := ws.popOutbound()
+	require.NotNil(t, msg)
+	assert.Equal(t, "a", msg.reqID)
+	assert.Equal(t, []string{"b:progress"}, queuedTypes(ws), "the writer takes one message at a time")
+
+	ws.Emit(ctx, newTestStreamMessage(&wsmsg.Progress{}, "c"))
+	assert.Equal(t, []string{"b:progress", "c:progress"}, queuedTypes(ws))
+
+	assert.Equal(t, "b", ws.popOutbound().reqID)
+	assert.Equal(t, "c", ws.popOutbound().reqID)
+	assert.Nil(t, ws.popOutbound())
+}
@@ -18,10 +18,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	v0 "github.com/dfuse-io/eosws-go/mdl/v0"
 	"time"
 
+	pbwsmsg "github.com/dfuse-io/dfuse-eosio/eosws/wsmsg/pb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	v0 "github.com/dfuse-io/eosws-go/mdl/v0"
 	eos "github.com/eoscanada/eos-go"
+	"github.com/golang/protobuf/ptypes"
 )
 
 func init() {
@@ -78,13 +81,39 @@ type ActionTrace struct {
 		TransactionID string    `json:"trx_id"`
 		ActionIndex   int       `json:"idx"`
 		//ActionDepth   int             `json:"depth"`
-		Trace json.RawMessage `json:"trace"`
+		Trace json.RawMessage `json:"trace,omitempty"`
 
 		DBOps    []*v0.DBOp    `json:"dbops,omitempty"`
 		RAMOps   []*v0.RAMOp   `json:"ramops,omitempty"`
 		DTrxOps  []*v0.DTrxOp  `json:"dtrxops,omitempty"`
 		TableOps []*v0.TableOp `json:"tableops,omitempty"`
 	} `json:"data"`
+
+	// Proto replaces `trace` and the ops when the connection uses binary
+	// framing, it holds the matching action (followed by its inline traces
+	// when requested) and its ops.
+	Proto *pbcodec.TransactionTrace `json:"-"`
+}
+
+func (t *ActionTrace) SetFrameMessage(frame *pbwsmsg.Frame) (bool, error) {
+	if t.Proto == nil {
+		return false, nil
+	}
+
+	blockTime, err := ptypes.TimestampProto(t.Data.BlockTime)
+	if err != nil {
+		return false, fmt.Errorf("invalid block time: %w", err)
+	}
+
+	frame.Message = &pbwsmsg.Frame_ActionTrace{ActionTrace: &pbwsmsg.ActionTrace{
+		BlockNum:  t.Data.BlockNum,
+		BlockId:   t.Data.BlockID,
+		BlockTime: blockTime,
+		TrxId:     t.Data.TransactionID,
+		Idx:       uint32(t.Data.ActionIndex),
+		Trace:     t.Proto,
+	}}
+	return true, nil
 }
 
 func NewActionTrace(trxid string, actionIndex int, trace json.RawMessage) *ActionTrace {
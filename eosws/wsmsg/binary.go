@@ -0,0 +1,72 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wsmsg
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pbwsmsg "github.com/dfuse-io/dfuse-eosio/eosws/wsmsg/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// BinaryFramingSubprotocol is the websocket subprotocol a client negotiates
+// to receive binary frames (see `MarshalFrame`) instead of JSON text messages.
+// The `framing=protobuf` query parameter has the same effect for clients
+// unable to set subprotocols.
+const BinaryFramingSubprotocol = "dfuse.eosio.protobuf.v1"
+
+// ProtoFramer is implemented by outgoing messages having a protobuf
+// representation in `pbwsmsg.Frame`. Handlers set the protobuf fields instead
+// of the equivalent JSON ones when the connection uses binary framing.
+type ProtoFramer interface {
+	// SetFrameMessage sets the message of `frame`, returning false when the
+	// message carries no protobuf fields, its JSON being sent instead.
+	SetFrameMessage(frame *pbwsmsg.Frame) (bool, error)
+}
+
+// MarshalFrame encodes `msg` (with its type already set) as a binary frame,
+// see `eosws/wsmsg/pb/frame.proto`. Messages without a protobuf
+// representation are sent as JSON within the frame.
+func MarshalFrame(msg OutgoingMessager) ([]byte, error) {
+	msgType, err := GetType(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	frame := &pbwsmsg.Frame{Type: msgType}
+	if common, ok := msg.(interface{ GetReqID() string }); ok {
+		frame.ReqId = common.GetReqID()
+	}
+
+	if framer, ok := msg.(ProtoFramer); ok {
+		set, err := framer.SetFrameMessage(frame)
+		if err != nil {
+			return nil, fmt.Errorf("set %s frame message: %w", msgType, err)
+		}
+
+		if set {
+			return proto.Marshal(frame)
+		}
+	}
+
+	jsonMsg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s json: %w", msgType, err)
+	}
+
+	frame.Message = &pbwsmsg.Frame_Json{Json: jsonMsg}
+	return proto.Marshal(frame)
+}
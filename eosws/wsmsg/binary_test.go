@@ -0,0 +1,135 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wsmsg
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/eosws/mdl"
+	pbwsmsg "github.com/dfuse-io/dfuse-eosio/eosws/wsmsg/pb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalFrame(t *testing.T) {
+	trx := testTransactionTrace(3)
+
+	msg := NewActionTrace(trx.Id, 0, nil)
+	msg.Data.BlockNum = 10
+	msg.Proto = trx
+	msg.SetType("action_trace")
+	msg.SetReqID("r1")
+
+	data, err := MarshalFrame(msg)
+	require.NoError(t, err)
+
+	frame := &pbwsmsg.Frame{}
+	require.NoError(t, proto.Unmarshal(data, frame))
+
+	assert.Equal(t, "action_trace", frame.Type)
+	assert.Equal(t, "r1", frame.ReqId)
+	assert.Nil(t, frame.GetJson())
+
+	actionTrace := frame.GetActionTrace()
+	require.NotNil(t, actionTrace)
+	assert.Equal(t, uint32(10), actionTrace.BlockNum)
+	assert.Equal(t, "trx.1", actionTrace.TrxId)
+	assert.Equal(t, uint32(0), actionTrace.Idx)
+	assert.True(t, proto.Equal(trx, actionTrace.Trace))
+}
+
+func TestMarshalFrame_WithoutPayload(t *testing.T) {
+	msg := NewTableDelta(10, nil, forkable.StepNew)
+	msg.SetType("table_delta")
+
+	data, err := MarshalFrame(msg)
+	require.NoError(t, err)
+
+	frame := &pbwsmsg.Frame{}
+	require.NoError(t, proto.Unmarshal(data, frame))
+
+	assert.Nil(t, frame.GetTableDelta())
+	assert.JSONEq(t, `{"type":"table_delta","data":{"block_num":10,"step":"new"}}`, string(frame.GetJson()))
+}
+
+// The benchmarks compare the cost of producing an `action_trace` message
+// with inline traces using JSON framing (the default) versus binary framing.
+// Run with `go test ./eosws/wsmsg -run xxx -bench ActionTrace -benchmem`.
+
+func BenchmarkActionTrace_JSON(b *testing.B) {
+	trx := testTransactionTrace(20)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		rawTrace, err := mdl.ToV1ActionTraceRaw(trx.ActionTraces[0], trx.ActionTraces, true)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		msg := NewActionTrace(trx.Id, 0, json.RawMessage(rawTrace))
+		msg.SetType("action_trace")
+		if _, err := json.Marshal(msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkActionTrace_Binary(b *testing.B) {
+	trx := testTransactionTrace(20)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		msg := NewActionTrace(trx.Id, 0, nil)
+		msg.Proto = trx
+		msg.SetType("action_trace")
+		if _, err := MarshalFrame(msg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// testTransactionTrace returns a transaction with a root `transfer` action
+// followed by `inlineCount` inline notifications of it.
+func testTransactionTrace(inlineCount int) *pbcodec.TransactionTrace {
+	trx := &pbcodec.TransactionTrace{Id: "trx.1", BlockNum: 10}
+	for i := 0; i <= inlineCount; i++ {
+		creator := uint32(0)
+		if i > 0 {
+			creator = 1
+		}
+
+		trx.ActionTraces = append(trx.ActionTraces, &pbcodec.ActionTrace{
+			Receiver:                               fmt.Sprintf("account%d", i),
+			ExecutionIndex:                         uint32(i),
+			ActionOrdinal:                          uint32(i + 1),
+			CreatorActionOrdinal:                   creator,
+			ClosestUnnotifiedAncestorActionOrdinal: creator,
+			Action: &pbcodec.Action{
+				Account:  "eosio.token",
+				Name:     "transfer",
+				JsonData: `{"from":"eosio","to":"eosio.token","quantity":"1.0000 EOS","memo":"a memo of some length"}`,
+				RawData:  []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09},
+			},
+			Receipt: &pbcodec.ActionReceipt{Receiver: fmt.Sprintf("account%d", i), GlobalSequence: uint64(1000 + i)},
+		})
+	}
+
+	return trx
+}
@@ -59,6 +59,7 @@ type CommonOut struct {
 
 func (c *CommonOut) SetType(v string)  { c.Type = v }
 func (c *CommonOut) SetReqID(v string) { c.ReqID = v }
+func (c *CommonOut) GetReqID() string  { return c.ReqID }
 
 // GetType retrieves the message `type` on a Common outgoing structure.
 func GetType(msg OutgoingMessager) (string, error) {
@@ -0,0 +1,26 @@
+#!/bin/bash
+# Copyright 2020 dfuse Platform Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+ROOT="$( cd "$( dirname "${BASH_SOURCE[0]}" )" && pwd )"
+
+# Protobuf definitions of the `dfuse.eosio.codec.v1` messages
+PROTO_EOSIO=${1:-"$ROOT/../../../../proto-eosio"}
+
+current_dir="`pwd`"
+trap "cd \"$current_dir\"" EXIT
+pushd "$ROOT" &> /dev/null
+
+# generating .go proto files in the same folder as the .proto definition file
+protoc -I . -I "$PROTO_EOSIO" frame.proto --go_out=plugins=grpc:.
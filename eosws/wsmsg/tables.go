@@ -20,6 +20,8 @@ import (
 	"fmt"
 
 	"github.com/dfuse-io/bstream/forkable"
+	pbwsmsg "github.com/dfuse-io/dfuse-eosio/eosws/wsmsg/pb"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	v1 "github.com/dfuse-io/eosws-go/mdl/v1"
 	eos "github.com/eoscanada/eos-go"
 )
@@ -71,9 +73,13 @@ type TableDelta struct {
 	CommonOut
 	Data struct {
 		BlockNum uint32   `json:"block_num"`
-		DBOp     *v1.DBOp `json:"dbop"`
+		DBOp     *v1.DBOp `json:"dbop,omitempty"`
 		Step     string   `json:"step"`
 	} `json:"data"`
+
+	// Proto replaces `dbop` when the connection uses binary framing, rows
+	// are then sent as raw bytes, never decoded to JSON.
+	Proto *pbcodec.DBOp `json:"-"`
 }
 
 func NewTableDelta(blockNum uint32, dbop *v1.DBOp, stepType forkable.StepType) *TableDelta {
@@ -84,6 +90,26 @@ func NewTableDelta(blockNum uint32, dbop *v1.DBOp, stepType forkable.StepType) *
 	return out
 }
 
+func NewProtoTableDelta(blockNum uint32, dbop *pbcodec.DBOp, stepType forkable.StepType) *TableDelta {
+	out := &TableDelta{Proto: dbop}
+	out.Data.BlockNum = blockNum
+	out.Data.Step = stepType.String()
+	return out
+}
+
+func (d *TableDelta) SetFrameMessage(frame *pbwsmsg.Frame) (bool, error) {
+	if d.Proto == nil {
+		return false, nil
+	}
+
+	frame.Message = &pbwsmsg.Frame_TableDelta{TableDelta: &pbwsmsg.TableDelta{
+		BlockNum: d.Data.BlockNum,
+		Step:     d.Data.Step,
+		Dbop:     d.Proto,
+	}}
+	return true, nil
+}
+
 type TableSnapshot struct {
 	CommonOut
 	Data struct {
@@ -29,6 +29,7 @@ func init() {
 	RegisterIncomingMessage("get_transaction_lifecycle", GetTransaction{})
 
 	RegisterOutgoingMessage("transaction_lifecycle", TransactionLifecycle{})
+	RegisterOutgoingMessage("transaction_transition", TransactionTransition{})
 }
 
 /// GetTransaction, incoming request
@@ -38,6 +39,10 @@ type GetTransaction struct {
 
 	Data struct {
 		ID string `json:"id"`
+
+		// WithTransitions streams a `transaction_transition` message on each
+		// state change of the transaction, in addition to the lifecycle
+		WithTransitions bool `json:"with_transitions,omitempty"`
 	} `json:"data"`
 }
 
@@ -52,6 +57,9 @@ func (m *GetTransaction) Validate(ctx context.Context) error {
 	if m.IrreversibleOnly {
 		return fmt.Errorf("'irreversible_only' is not supported")
 	}
+	if m.Data.WithTransitions && !m.Listen {
+		return fmt.Errorf("'with_transitions' requires 'listen'")
+	}
 	return nil
 }
 
@@ -67,3 +75,27 @@ func NewTransactionLifecycle(transaction *v1.TransactionLifecycle) *TransactionL
 	out.Data.Lifecycle = transaction
 	return out
 }
+
+type TransactionTransition struct {
+	CommonOut
+	Data *TransactionTransitionData `json:"data"`
+}
+
+// TransactionTransitionData is a change of state of a transaction (`pending`,
+// `delayed`, `executed`, `canceled`, `expired` or `failed`), `transition`
+// telling what caused it: the block being applied (`in_block`), undone
+// (`forked_out`) or applied again after a fork (`re_included`), or the block
+// becoming irreversible (`irreversible`, the state staying the same).
+type TransactionTransitionData struct {
+	TransactionID string `json:"transaction_id"`
+	Transition    string `json:"transition"`
+	PreviousState string `json:"previous_state"`
+	State         string `json:"state"`
+	BlockID       string `json:"block_id"`
+	BlockNum      uint32 `json:"block_num"`
+	Irreversible  bool   `json:"irreversible"`
+}
+
+func NewTransactionTransition(data *TransactionTransitionData) *TransactionTransition {
+	return &TransactionTransition{Data: data}
+}
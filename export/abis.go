@@ -0,0 +1,110 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dfuse-io/derr"
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/eoscanada/eos-go"
+)
+
+// ABISource provides the ABI an account had at a given block, used to seed
+// the decoder with the ABIs set before the first block read.
+type ABISource interface {
+	// GetABI returns the ABI of `account` once `blockNum` was applied, nil
+	// when the account had none.
+	GetABI(ctx context.Context, blockNum uint32, account eos.AccountName) (*eos.ABI, error)
+}
+
+type fluxDBABISource struct {
+	client fluxdb.Client
+}
+
+// NewFluxDBABISource returns an `ABISource` reading the ABIs from FluxDB.
+func NewFluxDBABISource(client fluxdb.Client) ABISource {
+	return &fluxDBABISource{client: client}
+}
+
+func (s *fluxDBABISource) GetABI(ctx context.Context, blockNum uint32, account eos.AccountName) (*eos.ABI, error) {
+	response, err := s.client.GetABI(ctx, blockNum, account)
+	if err != nil {
+		var errResponse *derr.ErrorResponse
+		if errors.As(err, &errResponse) && errResponse.Code == "data_abi_not_found_error" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return response.ABI, nil
+}
+
+// seedABIs fetches, for the contracts of the block not seen before, the ABI
+// they had before the first block decoded, so their rows are decoded even
+// when their ABI was set before it.
+func (e *Exporter) seedABIs(ctx context.Context, block *pbcodec.Block) error {
+	if e.seedBlockNum == 0 {
+		e.seedBlockNum = uint64(block.Number)
+	}
+
+	// Nothing was set before the first block of the chain
+	if e.abiSource == nil || e.seedBlockNum <= 1 {
+		return nil
+	}
+
+	for _, trace := range block.TransactionTraces {
+		for _, actionTrace := range trace.ActionTraces {
+			if actionTrace.Action == nil {
+				continue
+			}
+
+			if err := e.seedABI(ctx, actionTrace.Action.Account); err != nil {
+				return err
+			}
+		}
+
+		for _, dbOp := range trace.DbOps {
+			if err := e.seedABI(ctx, dbOp.Code); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) seedABI(ctx context.Context, contract string) error {
+	if e.seededContracts[contract] {
+		return nil
+	}
+
+	// The ABIs in effect before the first block decoded are the ones once its previous block was applied
+	abi, err := e.abiSource.GetABI(ctx, uint32(e.seedBlockNum-1), eos.AccountName(contract))
+	if err != nil {
+		return fmt.Errorf("fetching ABI of %q at block %d: %w", contract, e.seedBlockNum-1, err)
+	}
+
+	if abi != nil {
+		e.decoder.SeedABI(contract, abi)
+	}
+
+	e.seededContracts[contract] = true
+	return nil
+}
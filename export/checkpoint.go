@@ -0,0 +1,75 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/dfuse-io/dstore"
+)
+
+const checkpointFilename = "checkpoint.json"
+
+// Checkpoint is stored alongside the exported files and records up to where
+// the export went.
+type Checkpoint struct {
+	NextBlockNum uint64 `json:"next_block_num"`
+}
+
+func readCheckpoint(ctx context.Context, store dstore.Store) (*Checkpoint, error) {
+	exists, err := store.FileExists(ctx, checkpointFilename)
+	if err != nil {
+		return nil, fmt.Errorf("checking checkpoint existence: %w", err)
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	reader, err := store.OpenObject(ctx, checkpointFilename)
+	if err != nil {
+		return nil, fmt.Errorf("opening checkpoint: %w", err)
+	}
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading checkpoint: %w", err)
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(content, checkpoint); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint: %w", err)
+	}
+
+	return checkpoint, nil
+}
+
+func writeCheckpoint(ctx context.Context, store dstore.Store, checkpoint *Checkpoint) error {
+	content, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("marshalling checkpoint: %w", err)
+	}
+
+	if err := store.WriteObject(ctx, checkpointFilename, bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("writing checkpoint: %w", err)
+	}
+
+	return nil
+}
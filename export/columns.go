@@ -0,0 +1,127 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// column describes a field of a row struct, derived from its `parquet`
+// struct tag so that the Parquet schema, the CSV header and the CEL filter
+// variables always agree on names.
+type column struct {
+	name        string
+	fieldIndex  int
+	kind        reflect.Kind
+	isTimestamp bool
+}
+
+var rowColumns = map[RowKind][]*column{
+	ActionRowKind:      mustColumnsOf(ActionRow{}),
+	DBOpRowKind:        mustColumnsOf(DBOpRow{}),
+	TransactionRowKind: mustColumnsOf(TransactionRow{}),
+}
+
+func mustColumnsOf(row interface{}) (out []*column) {
+	rowType := reflect.TypeOf(row)
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+
+		var name, parquetType string
+		for _, part := range strings.Split(field.Tag.Get("parquet"), ",") {
+			keyValue := strings.SplitN(strings.TrimSpace(part), "=", 2)
+			if len(keyValue) != 2 {
+				continue
+			}
+
+			switch keyValue[0] {
+			case "name":
+				name = keyValue[1]
+			case "type":
+				parquetType = keyValue[1]
+			}
+		}
+
+		if name == "" {
+			panic(fmt.Errorf("field %s of %s has no parquet name", field.Name, rowType))
+		}
+
+		out = append(out, &column{
+			name:        name,
+			fieldIndex:  i,
+			kind:        field.Type.Kind(),
+			isTimestamp: parquetType == "TIMESTAMP_MILLIS",
+		})
+	}
+
+	return
+}
+
+func columnNames(kind RowKind) (out []string) {
+	for _, column := range rowColumns[kind] {
+		out = append(out, column.name)
+	}
+
+	return
+}
+
+// rowValues returns the row's values keyed by column name, timestamps being
+// turned into `time.Time`.
+func rowValues(kind RowKind, row interface{}) map[string]interface{} {
+	rowValue := reflect.Indirect(reflect.ValueOf(row))
+
+	out := make(map[string]interface{}, len(rowColumns[kind]))
+	for _, column := range rowColumns[kind] {
+		value := rowValue.Field(column.fieldIndex).Interface()
+		if column.isTimestamp {
+			value = millisToTime(value.(int64))
+		}
+
+		out[column.name] = value
+	}
+
+	return out
+}
+
+// rowStrings returns the row's values as strings, in column order.
+func rowStrings(kind RowKind, row interface{}) []string {
+	rowValue := reflect.Indirect(reflect.ValueOf(row))
+
+	out := make([]string, len(rowColumns[kind]))
+	for i, column := range rowColumns[kind] {
+		field := rowValue.Field(column.fieldIndex)
+
+		switch {
+		case column.isTimestamp:
+			out[i] = millisToTime(field.Int()).Format(time.RFC3339Nano)
+		case column.kind == reflect.Int64:
+			out[i] = strconv.FormatInt(field.Int(), 10)
+		case column.kind == reflect.Bool:
+			out[i] = strconv.FormatBool(field.Bool())
+		default:
+			out[i] = field.String()
+		}
+	}
+
+	return out
+}
+
+func millisToTime(millis int64) time.Time {
+	return time.Unix(0, millis*int64(time.Millisecond)).UTC()
+}
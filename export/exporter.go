@@ -0,0 +1,387 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dstore"
+	"go.uber.org/zap"
+)
+
+// mergedBlocksFileSize is the amount of blocks in each merged blocks file.
+const mergedBlocksFileSize = 100
+
+type Config struct {
+	StartBlockNum uint64
+	// StopBlockNum is exclusive, 0 meaning until the merged blocks run out
+	StopBlockNum uint64
+	// PartitionSize is the amount of blocks per exported file, partitions
+	// are aligned on multiples of it
+	PartitionSize uint64
+	// LookbackBlockCount is how many blocks before the start block are read
+	// to figure out the irreversible chain and the ABIs in effect
+	LookbackBlockCount uint64
+	Format             Format
+	// Filters maps a row kind to its CEL expression, a kind without one
+	// being exported completely
+	Filters map[RowKind]string
+	// Kinds restricts the row kinds exported, all of them when empty
+	Kinds []RowKind
+	// ABISource, when set, provides the ABIs the contracts had before the
+	// first block read, otherwise only the ABIs set in the blocks read are
+	// known
+	ABISource ABISource
+}
+
+// Exporter flattens the irreversible blocks of a merged blocks store into
+// rows written, one file per row kind and block range partition, to the
+// destination store. A checkpoint is written after each partition so an
+// interrupted export resumes where it left off.
+type Exporter struct {
+	config      *Config
+	blocksStore dstore.Store
+	destStore   dstore.Store
+
+	decoder          *codec.ABIDecoder
+	abiSource        ABISource
+	seedBlockNum     uint64
+	seededContracts  map[string]bool
+	filters          map[RowKind]*Filter
+	partition        *partition
+	reachedStopBlock bool
+
+	// nextBlockNum is the first block not yet covered by a written partition
+	nextBlockNum uint64
+}
+
+func NewExporter(config *Config, blocksStore, destStore dstore.Store) (*Exporter, error) {
+	if config.PartitionSize == 0 {
+		return nil, fmt.Errorf("partition size must be greater than 0")
+	}
+
+	if config.StopBlockNum != 0 && config.StopBlockNum <= config.StartBlockNum {
+		return nil, fmt.Errorf("stop block %d must be greater than start block %d", config.StopBlockNum, config.StartBlockNum)
+	}
+
+	kinds := config.Kinds
+	if len(kinds) == 0 {
+		kinds = RowKinds
+	}
+
+	filters := map[RowKind]*Filter{}
+	for _, kind := range kinds {
+		if _, found := rowColumns[kind]; !found {
+			return nil, fmt.Errorf("unknown row kind %q", kind)
+		}
+
+		filter, err := NewFilter(kind, config.Filters[kind])
+		if err != nil {
+			return nil, err
+		}
+
+		filters[kind] = filter
+	}
+
+	for kind := range config.Filters {
+		if _, found := filters[kind]; !found {
+			return nil, fmt.Errorf("filter defined for row kind %q which is not exported", kind)
+		}
+	}
+
+	return &Exporter{
+		config:          config,
+		blocksStore:     blocksStore,
+		destStore:       destStore,
+		decoder:         codec.NewABIDecoder(),
+		abiSource:       config.ABISource,
+		seededContracts: map[string]bool{},
+		filters:         filters,
+	}, nil
+}
+
+// Run performs the export, resuming from the destination's checkpoint when
+// there is one. It returns once the stop block was exported or when no more
+// merged blocks files are available, in which case blocks that were not yet
+// irreversible in the last file are left for a later run.
+func (e *Exporter) Run(ctx context.Context) error {
+	defer e.discardPartition()
+
+	e.nextBlockNum = e.config.StartBlockNum
+
+	checkpoint, err := readCheckpoint(ctx, e.destStore)
+	if err != nil {
+		return err
+	}
+
+	if checkpoint != nil && checkpoint.NextBlockNum > e.nextBlockNum {
+		zlog.Info("resuming export from checkpoint", zap.Uint64("next_block_num", checkpoint.NextBlockNum))
+		e.nextBlockNum = checkpoint.NextBlockNum
+	}
+
+	if e.config.StopBlockNum != 0 && e.nextBlockNum >= e.config.StopBlockNum {
+		zlog.Info("export already completed up to stop block", zap.Uint64("stop_block_num", e.config.StopBlockNum))
+		return nil
+	}
+
+	readFrom := uint64(0)
+	if e.nextBlockNum > e.config.LookbackBlockCount {
+		readFrom = e.nextBlockNum - e.config.LookbackBlockCount
+	}
+
+	handler := forkable.New(bstream.HandlerFunc(e.processIrreversibleBlock), forkable.WithFilters(forkable.StepIrreversible))
+
+	for baseNum := readFrom - readFrom%mergedBlocksFileSize; ; baseNum += mergedBlocksFileSize {
+		if e.reachedStopBlock {
+			return nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		filename := fmt.Sprintf("%010d", baseNum)
+		exists, err := e.blocksStore.FileExists(ctx, filename)
+		if err != nil {
+			return fmt.Errorf("checking merged blocks file %q: %w", filename, err)
+		}
+
+		if !exists {
+			zlog.Info("no more merged blocks files, stopping export", zap.String("missing_file", filename), zap.Uint64("next_block_num", e.nextBlockNum))
+			return e.flushPartition(ctx)
+		}
+
+		if err := e.readMergedBlocksFile(ctx, filename, handler); err != nil {
+			return err
+		}
+	}
+}
+
+func (e *Exporter) readMergedBlocksFile(ctx context.Context, filename string, handler bstream.Handler) error {
+	zlog.Debug("reading merged blocks file", zap.String("filename", filename))
+	reader, err := e.blocksStore.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("opening merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := codec.NewBlockReader(reader)
+	if err != nil {
+		return fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+	}
+
+	for !e.reachedStopBlock {
+		block, err := blockReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+		}
+
+		if err := handler.ProcessBlock(block, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) processIrreversibleBlock(blk *bstream.Block, obj interface{}) error {
+	if e.reachedStopBlock {
+		return nil
+	}
+
+	block := blk.ToNative().(*pbcodec.Block)
+
+	ctx := context.Background()
+	if err := e.seedABIs(ctx, block); err != nil {
+		return fmt.Errorf("seeding ABIs of block %s: %w", blk, err)
+	}
+
+	// Blocks prior the export range are still decoded to learn about the ABIs they set
+	if err := e.decoder.DecodeBlock(ctx, block); err != nil {
+		return fmt.Errorf("decoding block %s: %w", blk, err)
+	}
+
+	blockNum := blk.Num()
+	if blockNum < e.nextBlockNum {
+		return nil
+	}
+
+	if e.partition != nil && blockNum >= e.partition.stopBlockNum {
+		if err := e.flushPartition(ctx); err != nil {
+			return err
+		}
+	}
+
+	if e.partition == nil {
+		partition, err := e.newPartition(blockNum)
+		if err != nil {
+			return err
+		}
+
+		e.partition = partition
+	}
+
+	rows, err := FlattenBlock(block, e.decoder)
+	if err != nil {
+		return fmt.Errorf("flattening block %s: %w", blk, err)
+	}
+
+	if err := e.partition.write(rows, e.filters); err != nil {
+		return fmt.Errorf("writing rows of block %s: %w", blk, err)
+	}
+
+	e.partition.lastBlockNum = blockNum
+
+	if e.config.StopBlockNum != 0 && blockNum+1 >= e.config.StopBlockNum {
+		e.reachedStopBlock = true
+		return e.flushPartition(ctx)
+	}
+
+	return nil
+}
+
+func (e *Exporter) newPartition(blockNum uint64) (*partition, error) {
+	stopBlockNum := blockNum - blockNum%e.config.PartitionSize + e.config.PartitionSize
+	if e.config.StopBlockNum != 0 && stopBlockNum > e.config.StopBlockNum {
+		stopBlockNum = e.config.StopBlockNum
+	}
+
+	partition := &partition{
+		startBlockNum: e.nextBlockNum,
+		stopBlockNum:  stopBlockNum,
+		writers:       map[RowKind]rowWriter{},
+	}
+
+	for kind := range e.filters {
+		writer, err := newRowWriter(e.config.Format, kind)
+		if err != nil {
+			partition.discard()
+			return nil, err
+		}
+
+		partition.writers[kind] = writer
+	}
+
+	return partition, nil
+}
+
+// flushPartition uploads the files of the current partition, named after the
+// range of blocks actually exported, then records the checkpoint.
+func (e *Exporter) flushPartition(ctx context.Context) error {
+	if e.partition == nil {
+		return nil
+	}
+
+	partition := e.partition
+	for kind, writer := range partition.writers {
+		content, err := writer.Close()
+		if err != nil {
+			return fmt.Errorf("closing %s writer: %w", kind, err)
+		}
+
+		filename := PartitionFilename(kind, partition.startBlockNum, partition.lastBlockNum, e.config.Format)
+		err = e.destStore.WriteObject(ctx, filename, content)
+		content.Close()
+		if err != nil {
+			return fmt.Errorf("writing %q: %w", filename, err)
+		}
+
+		zlog.Info("exported partition file", zap.String("filename", filename), zap.Int("row_count", partition.rowCounts[kind]))
+	}
+
+	e.nextBlockNum = partition.lastBlockNum + 1
+	e.partition = nil
+
+	return writeCheckpoint(ctx, e.destStore, &Checkpoint{NextBlockNum: e.nextBlockNum})
+}
+
+// discardPartition drops the temporary files of the partition being
+// exported, if any, when the export stops before it could be flushed.
+func (e *Exporter) discardPartition() {
+	if e.partition != nil {
+		e.partition.discard()
+		e.partition = nil
+	}
+}
+
+// PartitionFilename returns the name, relative to the destination store, of
+// the file holding the `kind` rows of blocks `startBlockNum` up to
+// `lastBlockNum` inclusively.
+func PartitionFilename(kind RowKind, startBlockNum, lastBlockNum uint64, format Format) string {
+	return fmt.Sprintf("%s/%010d-%010d.%s", kind, startBlockNum, lastBlockNum, format.Extension())
+}
+
+type partition struct {
+	startBlockNum uint64
+	lastBlockNum  uint64
+	// stopBlockNum is exclusive
+	stopBlockNum uint64
+	writers      map[RowKind]rowWriter
+	rowCounts    map[RowKind]int
+}
+
+func (p *partition) discard() {
+	for kind, writer := range p.writers {
+		if err := writer.Discard(); err != nil {
+			zlog.Warn("unable to discard partition file", zap.String("kind", string(kind)), zap.Error(err))
+		}
+	}
+}
+
+func (p *partition) write(rows *Rows, filters map[RowKind]*Filter) error {
+	if p.rowCounts == nil {
+		p.rowCounts = map[RowKind]int{}
+	}
+
+	writeRow := func(kind RowKind, row interface{}) error {
+		writer, found := p.writers[kind]
+		if !found || !filters[kind].Matches(row) {
+			return nil
+		}
+
+		p.rowCounts[kind]++
+		return writer.Write(row)
+	}
+
+	for _, row := range rows.Actions {
+		if err := writeRow(ActionRowKind, row); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows.DBOps {
+		if err := writeRow(DBOpRowKind, row); err != nil {
+			return err
+		}
+	}
+
+	for _, row := range rows.Transactions {
+		if err := writeRow(TransactionRowKind, row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
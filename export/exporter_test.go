@@ -0,0 +1,447 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dstore"
+	"github.com/eoscanada/eos-go"
+	"github.com/eoscanada/eos-go/system"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+func TestExporter_CSV(t *testing.T) {
+	blocksStore, destStore, destDir, cleanup := newTestStores(t)
+	defer cleanup()
+	writeMergedBlocks(t, blocksStore, testChain(t, 25))
+
+	config := &Config{
+		StartBlockNum: 3,
+		PartitionSize: 10,
+		Format:        CSVFormat,
+		Filters: map[RowKind]string{
+			ActionRowKind: `account == "eosio.token" && !notif && data.to == "bob"`,
+		},
+	}
+
+	exporter, err := NewExporter(config, blocksStore, destStore)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Run(context.Background()))
+
+	// Block 25 is the last one read, its LIB (24) is the last irreversible block
+	assert.Equal(t, []string{
+		"actions/0000000003-0000000009.csv",
+		"actions/0000000010-0000000019.csv",
+		"actions/0000000020-0000000024.csv",
+		"checkpoint.json",
+		"dbops/0000000003-0000000009.csv",
+		"dbops/0000000010-0000000019.csv",
+		"dbops/0000000020-0000000024.csv",
+		"transactions/0000000003-0000000009.csv",
+		"transactions/0000000010-0000000019.csv",
+		"transactions/0000000020-0000000024.csv",
+	}, listFiles(t, destDir))
+
+	actions := readCSV(t, filepath.Join(destDir, "actions/0000000003-0000000009.csv"))
+	require.Len(t, actions, 2)
+	assert.Equal(t, columnNames(ActionRowKind), actions[0])
+	assert.Equal(t, map[string]string{
+		"block_num":              "5",
+		"block_id":               "00000005aa",
+		"block_time":             "2020-01-01T00:00:02.5Z",
+		"trx_id":                 "trx.5",
+		"trx_index":              "0",
+		"execution_index":        "0",
+		"action_ordinal":         "1",
+		"creator_action_ordinal": "0",
+		"global_sequence":        "50",
+		"receiver":               "eosio.token",
+		"account":                "eosio.token",
+		"action":                 "transfer",
+		"auth":                   "alice@active",
+		"input":                  "true",
+		"notif":                  "false",
+		"scheduled":              "false",
+		"json_data":              `{"from":"alice","to":"bob"}`,
+		"hex_data":               "",
+	}, csvRecord(actions[0], actions[1]))
+
+	dbOps := readCSV(t, filepath.Join(destDir, "dbops/0000000003-0000000009.csv"))
+	require.Len(t, dbOps, 2)
+	dbOp := csvRecord(dbOps[0], dbOps[1])
+	assert.Equal(t, "UPD", dbOp["operation"])
+	assert.Equal(t, "test/alice/accounts", dbOp["code"]+"/"+dbOp["scope"]+"/"+dbOp["table"])
+	assert.Equal(t, `{"balance":"1.0000 EOS"}`, dbOp["old_json"])
+	assert.Equal(t, `{"balance":"2.0000 EOS"}`, dbOp["new_json"])
+
+	// The reverted transaction of block 6 is there, its actions are not
+	transactions := readCSV(t, filepath.Join(destDir, "transactions/0000000003-0000000009.csv"))
+	require.Len(t, transactions, 3)
+	assert.Equal(t, "executed", csvRecord(transactions[0], transactions[1])["status"])
+	assert.Equal(t, "hardfail", csvRecord(transactions[0], transactions[2])["status"])
+
+	assert.Equal(t, `{"next_block_num":25}`, readFile(t, filepath.Join(destDir, "checkpoint.json")))
+}
+
+func TestExporter_ResumesFromCheckpoint(t *testing.T) {
+	blocksStore, destStore, destDir, cleanup := newTestStores(t)
+	defer cleanup()
+	chain := testChain(t, 150)
+	writeMergedBlocks(t, blocksStore, chain[:99])
+
+	config := &Config{StartBlockNum: 90, StopBlockNum: 120, PartitionSize: 20, Format: CSVFormat, Kinds: []RowKind{TransactionRowKind}}
+
+	exporter, err := NewExporter(config, blocksStore, destStore)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Run(context.Background()))
+
+	assert.Equal(t, []string{
+		"checkpoint.json",
+		"transactions/0000000090-0000000098.csv",
+	}, listFiles(t, destDir))
+
+	writeMergedBlocks(t, blocksStore, chain)
+
+	exporter, err = NewExporter(config, blocksStore, destStore)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Run(context.Background()))
+
+	assert.Equal(t, []string{
+		"checkpoint.json",
+		"transactions/0000000090-0000000098.csv",
+		"transactions/0000000099-0000000099.csv",
+		"transactions/0000000100-0000000119.csv",
+	}, listFiles(t, destDir))
+	assert.Equal(t, `{"next_block_num":120}`, readFile(t, filepath.Join(destDir, "checkpoint.json")))
+}
+
+func TestExporter_Parquet(t *testing.T) {
+	blocksStore, destStore, destDir, cleanup := newTestStores(t)
+	defer cleanup()
+	writeMergedBlocks(t, blocksStore, testChain(t, 12))
+
+	config := &Config{StartBlockNum: 1, StopBlockNum: 10, PartitionSize: 100, Format: ParquetFormat, Kinds: []RowKind{ActionRowKind}}
+
+	exporter, err := NewExporter(config, blocksStore, destStore)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Run(context.Background()))
+
+	content, err := ioutil.ReadFile(filepath.Join(destDir, "actions/0000000001-0000000009.parquet"))
+	require.NoError(t, err)
+
+	parquetReader, err := reader.NewParquetReader(&bytesParquetFile{bytes.NewReader(content)}, new(ActionRow), 1)
+	require.NoError(t, err)
+	defer parquetReader.ReadStop()
+
+	rows := make([]ActionRow, parquetReader.GetNumRows())
+	require.NoError(t, parquetReader.Read(&rows))
+
+	var summaries []string
+	for _, row := range rows {
+		summaries = append(summaries, fmt.Sprintf("%d:%s:%s", row.BlockNum, row.Receiver, row.Action))
+	}
+
+	// Block 1 has no LIB link so it is never emitted, the file range still starts at the start block
+	assert.Equal(t, []string{"2:eosio:setabi", "5:eosio.token:transfer", "5:bob:transfer", "5:test:update"}, summaries)
+}
+
+func TestExporter_SeedsABIs(t *testing.T) {
+	blocksStore, destStore, destDir, cleanup := newTestStores(t)
+	defer cleanup()
+	chain := testChain(t, 12)
+
+	// The `test` ABI is not set in the blocks read anymore, it's only known by the ABI source
+	chain[1].TransactionTraces = nil
+	writeMergedBlocks(t, blocksStore, chain)
+
+	abiSource := &testABISource{abis: map[string]*eos.ABI{"test": tableABI}}
+	config := &Config{StartBlockNum: 3, StopBlockNum: 10, PartitionSize: 100, Format: CSVFormat, Kinds: []RowKind{DBOpRowKind}, ABISource: abiSource}
+
+	exporter, err := NewExporter(config, blocksStore, destStore)
+	require.NoError(t, err)
+	require.NoError(t, exporter.Run(context.Background()))
+
+	dbOps := readCSV(t, filepath.Join(destDir, "dbops/0000000003-0000000009.csv"))
+	require.Len(t, dbOps, 2)
+	assert.Equal(t, `{"balance":"2.0000 EOS"}`, csvRecord(dbOps[0], dbOps[1])["new_json"])
+
+	// Block 2 is the first one decoded, each contract's ABI is fetched once, as of block 1
+	assert.Equal(t, []string{"eosio.token@1", "test@1"}, abiSource.requests)
+}
+
+func TestNewExporter_Errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        *Config
+		expectedError string
+	}{
+		{"no partition size", &Config{}, "partition size must be greater than 0"},
+		{"stop before start", &Config{PartitionSize: 1, StartBlockNum: 10, StopBlockNum: 10}, "stop block 10 must be greater than start block 10"},
+		{"unknown kind", &Config{PartitionSize: 1, Kinds: []RowKind{"rams"}}, `unknown row kind "rams"`},
+		{"filter on skipped kind", &Config{PartitionSize: 1, Kinds: []RowKind{ActionRowKind}, Filters: map[RowKind]string{DBOpRowKind: "true"}}, `filter defined for row kind "dbops" which is not exported`},
+		{"invalid filter", &Config{PartitionSize: 1, Filters: map[RowKind]string{ActionRowKind: "receiver"}}, "actions filter expression should return a boolean, returned primitive:STRING"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := NewExporter(test.config, nil, nil)
+			require.Error(t, err)
+			assert.Equal(t, test.expectedError, strings.TrimSpace(err.Error()))
+		})
+	}
+}
+
+var tableABI = &eos.ABI{
+	Version: "eosio::abi/1.1",
+	Structs: []eos.StructDef{{Name: "account", Fields: []eos.FieldDef{{Name: "balance", Type: "asset"}}}},
+	Tables:  []eos.TableDef{{Name: "accounts", IndexType: "i64", Type: "account"}},
+}
+
+// testChain returns blocks 1 up to `count`, each one's LIB being its previous
+// block. Block 2 sets the `test` contract ABI, block 5 has a transfer updating
+// a `test` row and block 6 has a failed transaction.
+func testChain(t *testing.T, count int) (out []*pbcodec.Block) {
+	for num := uint32(1); num <= uint32(count); num++ {
+		block := testBlock(t, num)
+
+		switch num {
+		case 2:
+			abiData, err := eos.MarshalBinary(tableABI)
+			require.NoError(t, err)
+
+			setABIData, err := eos.MarshalBinary(&system.SetABI{Account: "test", ABI: eos.HexBytes(abiData)})
+			require.NoError(t, err)
+
+			block.TransactionTraces = []*pbcodec.TransactionTrace{testTrace("trx.2", pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED,
+				testActionTrace(1, 0, 20, "eosio", "eosio", "setabi", "", setABIData),
+			)}
+		case 5:
+			oldData, err := tableABI.EncodeTable("accounts", []byte(`{"balance":"1.0000 EOS"}`))
+			require.NoError(t, err)
+			newData, err := tableABI.EncodeTable("accounts", []byte(`{"balance":"2.0000 EOS"}`))
+			require.NoError(t, err)
+
+			trace := testTrace("trx.5", pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED,
+				testActionTrace(1, 0, 50, "eosio.token", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`, nil),
+				testActionTrace(2, 1, 51, "bob", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`, nil),
+				testActionTrace(3, 1, 52, "test", "test", "update", `{}`, nil),
+			)
+			trace.DbOps = []*pbcodec.DBOp{{
+				Operation:   pbcodec.DBOp_OPERATION_UPDATE,
+				ActionIndex: 2,
+				Code:        "test",
+				Scope:       "alice",
+				TableName:   "accounts",
+				PrimaryKey:  "alice",
+				OldPayer:    "alice",
+				NewPayer:    "alice",
+				OldData:     oldData,
+				NewData:     newData,
+			}}
+			block.TransactionTraces = []*pbcodec.TransactionTrace{trace}
+		case 6:
+			block.TransactionTraces = []*pbcodec.TransactionTrace{testTrace("trx.6", pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL,
+				testActionTrace(1, 0, 0, "eosio.token", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`, nil),
+			)}
+		}
+
+		out = append(out, block)
+	}
+
+	return
+}
+
+func testBlock(t *testing.T, num uint32) *pbcodec.Block {
+	blockTime, err := ptypes.TimestampProto(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(num) * 500 * time.Millisecond))
+	require.NoError(t, err)
+
+	return &pbcodec.Block{
+		Id:                       fmt.Sprintf("%08xaa", num),
+		Number:                   num,
+		DposIrreversibleBlocknum: num - 1,
+		Header: &pbcodec.BlockHeader{
+			Previous:  fmt.Sprintf("%08xaa", num-1),
+			Producer:  "eosio",
+			Timestamp: blockTime,
+		},
+	}
+}
+
+func testTrace(id string, status pbcodec.TransactionStatus, actionTraces ...*pbcodec.ActionTrace) *pbcodec.TransactionTrace {
+	for i, actionTrace := range actionTraces {
+		actionTrace.ExecutionIndex = uint32(i)
+		actionTrace.TransactionId = id
+	}
+
+	return &pbcodec.TransactionTrace{
+		Id:           id,
+		Receipt:      &pbcodec.TransactionReceiptHeader{Status: status},
+		ActionTraces: actionTraces,
+	}
+}
+
+func testActionTrace(ordinal, creatorOrdinal uint32, globalSequence uint64, receiver, account, name, jsonData string, rawData []byte) *pbcodec.ActionTrace {
+	actionTrace := &pbcodec.ActionTrace{
+		Receiver:             receiver,
+		ActionOrdinal:        ordinal,
+		CreatorActionOrdinal: creatorOrdinal,
+		Action: &pbcodec.Action{
+			Account:       account,
+			Name:          name,
+			Authorization: []*pbcodec.PermissionLevel{{Actor: "alice", Permission: "active"}},
+			JsonData:      jsonData,
+			RawData:       rawData,
+		},
+	}
+
+	if globalSequence != 0 {
+		actionTrace.Receipt = &pbcodec.ActionReceipt{Receiver: receiver, GlobalSequence: globalSequence}
+	}
+
+	return actionTrace
+}
+
+func newTestStores(t *testing.T) (blocksStore, destStore dstore.Store, destDir string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "export")
+	require.NoError(t, err)
+	cleanup = func() { os.RemoveAll(dir) }
+
+	blocksStore, err = dstore.NewDBinStore("file://" + filepath.Join(dir, "blocks"))
+	require.NoError(t, err)
+	blocksStore.SetOverwrite(true)
+
+	destDir = filepath.Join(dir, "export")
+	destStore, err = dstore.NewSimpleStore("file://" + destDir)
+	require.NoError(t, err)
+
+	return blocksStore, destStore, destDir, cleanup
+}
+
+func writeMergedBlocks(t *testing.T, store dstore.Store, blocks []*pbcodec.Block) {
+	buffers := map[uint32]*bytes.Buffer{}
+	writers := map[uint32]*codec.BlockWriter{}
+
+	for _, block := range blocks {
+		baseNum := block.Number - block.Number%mergedBlocksFileSize
+		if writers[baseNum] == nil {
+			buffers[baseNum] = &bytes.Buffer{}
+
+			writer, err := codec.NewBlockWriter(buffers[baseNum])
+			require.NoError(t, err)
+			writers[baseNum] = writer
+		}
+
+		blk, err := codec.BlockFromProto(block)
+		require.NoError(t, err)
+		require.NoError(t, writers[baseNum].Write(blk))
+	}
+
+	for baseNum, buffer := range buffers {
+		require.NoError(t, store.WriteObject(context.Background(), fmt.Sprintf("%010d", baseNum), buffer))
+	}
+}
+
+func listFiles(t *testing.T, dir string) (out []string) {
+	require.NoError(t, filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		relative, err := filepath.Rel(dir, path)
+		out = append(out, filepath.ToSlash(relative))
+		return err
+	}))
+
+	sort.Strings(out)
+	return
+}
+
+func readFile(t *testing.T, path string) string {
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	return strings.TrimSpace(string(content))
+}
+
+func readCSV(t *testing.T, path string) [][]string {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	records, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+
+	return records
+}
+
+func csvRecord(header, record []string) map[string]string {
+	out := map[string]string{}
+	for i, name := range header {
+		out[name] = record[i]
+	}
+
+	return out
+}
+
+// bytesParquetFile is a read-only `source.ParquetFile` over in-memory content.
+type bytesParquetFile struct {
+	*bytes.Reader
+}
+
+func (f *bytesParquetFile) Open(name string) (source.ParquetFile, error) {
+	content := make([]byte, f.Size())
+	_, err := f.ReadAt(content, 0)
+
+	return &bytesParquetFile{bytes.NewReader(content)}, err
+}
+
+func (f *bytesParquetFile) Create(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("read-only")
+}
+
+func (f *bytesParquetFile) Write(p []byte) (int, error) {
+	return 0, fmt.Errorf("read-only")
+}
+
+func (f *bytesParquetFile) Close() error {
+	return nil
+}
+
+type testABISource struct {
+	abis     map[string]*eos.ABI
+	requests []string
+}
+
+func (s *testABISource) GetABI(ctx context.Context, blockNum uint32, account eos.AccountName) (*eos.ABI, error) {
+	s.requests = append(s.requests, fmt.Sprintf("%s@%d", account, blockNum))
+	return s.abis[string(account)], nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types"
+	"go.uber.org/zap"
+)
+
+// Filter decides, through a CEL expression, which rows of a given kind are
+// exported. The expression sees each column of the row as a variable (with
+// timestamps as `timestamp` and integers as `int`) and, for actions and DB
+// ops, the decoded JSON as `data` (respectively `old_data` and `new_data`),
+// an empty map when the row could not be decoded.
+type Filter struct {
+	kind       RowKind
+	expression string
+	program    cel.Program
+}
+
+// NewFilter compiles `expression` for rows of `kind`, an empty expression
+// (or `true`) matching everything.
+func NewFilter(kind RowKind, expression string) (*Filter, error) {
+	stripped := strings.TrimSpace(expression)
+	if stripped == "" || stripped == "true" {
+		return &Filter{kind: kind}, nil
+	}
+
+	var declarations []cel.EnvOption
+	for _, column := range rowColumns[kind] {
+		declarations = append(declarations, columnCELDeclaration(column))
+	}
+
+	for _, name := range jsonVariables[kind] {
+		declarations = append(declarations, cel.Declarations(decls.NewIdent(name, decls.NewMapType(decls.String, decls.Any), nil)))
+	}
+
+	env, err := cel.NewEnv(declarations...)
+	if err != nil {
+		return nil, err
+	}
+
+	exprAst, issues := env.Compile(stripped)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%s filter expression parse/check error: %w", kind, issues.Err())
+	}
+
+	if exprAst.ResultType() != decls.Bool {
+		return nil, fmt.Errorf("%s filter expression should return a boolean, returned %s", kind, exprAst.ResultType())
+	}
+
+	program, err := env.Program(exprAst)
+	if err != nil {
+		return nil, fmt.Errorf("%s filter cel program construction error: %w", kind, err)
+	}
+
+	return &Filter{kind: kind, expression: stripped, program: program}, nil
+}
+
+// Matches returns whether the row should be exported, an expression failing
+// to evaluate (like accessing a missing `data` key) being a non-match. Such
+// failures are logged at debug level since they are expected for rows whose
+// data does not have the shape the expression assumes.
+func (f *Filter) Matches(row interface{}) bool {
+	if f.program == nil {
+		return true
+	}
+
+	res, _, err := f.program.Eval(f.activation(row))
+	if err != nil {
+		zlog.Debug("filter expression evaluation failed, row not exported", zap.String("kind", string(f.kind)), zap.String("expression", f.expression), zap.Error(err))
+		return false
+	}
+
+	matched, ok := res.(types.Bool)
+	return ok && bool(matched)
+}
+
+var jsonVariables = map[RowKind][]string{
+	ActionRowKind: {"data"},
+	DBOpRowKind:   {"old_data", "new_data"},
+}
+
+func (f *Filter) activation(row interface{}) map[string]interface{} {
+	values := rowValues(f.kind, row)
+	for name, value := range values {
+		switch v := value.(type) {
+		case time.Time:
+			// Conversion can only fail for out of range years, never the case for a block time
+			values[name], _ = ptypes.TimestampProto(v)
+		}
+	}
+
+	switch v := row.(type) {
+	case *ActionRow:
+		values["data"] = jsonToMap(v.JSONData)
+	case *DBOpRow:
+		values["old_data"] = jsonToMap(v.OldJSON)
+		values["new_data"] = jsonToMap(v.NewJSON)
+	}
+
+	return values
+}
+
+func columnCELDeclaration(column *column) cel.EnvOption {
+	celType := decls.String
+	switch {
+	case column.isTimestamp:
+		celType = decls.Timestamp
+	case column.kind == reflect.Int64:
+		celType = decls.Int
+	case column.kind == reflect.Bool:
+		celType = decls.Bool
+	}
+
+	return cel.Declarations(decls.NewIdent(column.name, celType, nil))
+}
+
+func jsonToMap(jsonData string) map[string]interface{} {
+	out := map[string]interface{}{}
+	if jsonData != "" {
+		// A non-object payload is simply not filterable through `data`, we keep the empty map
+		_ = json.Unmarshal([]byte(jsonData), &out)
+	}
+
+	return out
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilter_Matches(t *testing.T) {
+	action := &ActionRow{
+		BlockNum:  10,
+		BlockTime: 1577836800500,
+		Receiver:  "eosio.token",
+		Account:   "eosio.token",
+		Action:    "transfer",
+		Input:     true,
+		JSONData:  `{"from":"alice","to":"bob","quantity":"1.0000 EOS"}`,
+	}
+
+	dbOp := &DBOpRow{
+		Code:    "eosio.token",
+		Table:   "accounts",
+		OldJSON: `{"balance":"1.0000 EOS"}`,
+	}
+
+	tests := []struct {
+		name       string
+		kind       RowKind
+		expression string
+		row        interface{}
+		expected   bool
+	}{
+		{"empty", ActionRowKind, "", action, true},
+		{"true", ActionRowKind, " true ", action, true},
+		{"string columns", ActionRowKind, `account == "eosio.token" && action == "transfer"`, action, true},
+		{"int column", ActionRowKind, `block_num > 9`, action, true},
+		{"bool column", ActionRowKind, `!input`, action, false},
+		{"timestamp column", ActionRowKind, `block_time > timestamp("2020-01-01T00:00:00Z")`, action, true},
+		{"data", ActionRowKind, `data.to == "bob"`, action, true},
+		{"missing data key", ActionRowKind, `data.memo == ""`, action, false},
+		{"missing data", ActionRowKind, `data.to == "bob"`, &ActionRow{}, false},
+		{"db op old data", DBOpRowKind, `table == "accounts" && old_data.balance == "1.0000 EOS"`, dbOp, true},
+		{"db op new data", DBOpRowKind, `has(new_data.balance)`, dbOp, false},
+		{"transaction", TransactionRowKind, `status != "executed"`, &TransactionRow{Status: "hardfail"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := NewFilter(test.kind, test.expression)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, filter.Matches(test.row))
+		})
+	}
+}
+
+func TestNewFilter_UnknownVariable(t *testing.T) {
+	_, err := NewFilter(TransactionRowKind, `data.to == "bob"`)
+	assert.Error(t, err)
+}
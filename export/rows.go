@@ -0,0 +1,237 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+)
+
+// RowKind identifies one of the flattened views of a block, each kind being
+// written to its own set of files.
+type RowKind string
+
+const (
+	ActionRowKind      RowKind = "actions"
+	DBOpRowKind        RowKind = "dbops"
+	TransactionRowKind RowKind = "transactions"
+)
+
+var RowKinds = []RowKind{ActionRowKind, DBOpRowKind, TransactionRowKind}
+
+// ActionRow is an executed action, notifications included, of a transaction
+// that was not reverted.
+type ActionRow struct {
+	BlockNum             int64  `parquet:"name=block_num, type=INT64"`
+	BlockID              string `parquet:"name=block_id, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	BlockTime            int64  `parquet:"name=block_time, type=TIMESTAMP_MILLIS"`
+	TransactionID        string `parquet:"name=trx_id, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	TransactionIndex     int64  `parquet:"name=trx_index, type=INT64"`
+	ExecutionIndex       int64  `parquet:"name=execution_index, type=INT64"`
+	ActionOrdinal        int64  `parquet:"name=action_ordinal, type=INT64"`
+	CreatorActionOrdinal int64  `parquet:"name=creator_action_ordinal, type=INT64"`
+	GlobalSequence       int64  `parquet:"name=global_sequence, type=INT64"`
+	Receiver             string `parquet:"name=receiver, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Account              string `parquet:"name=account, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Action               string `parquet:"name=action, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Authorization        string `parquet:"name=auth, type=UTF8"`
+	Input                bool   `parquet:"name=input, type=BOOLEAN"`
+	Notif                bool   `parquet:"name=notif, type=BOOLEAN"`
+	Scheduled            bool   `parquet:"name=scheduled, type=BOOLEAN"`
+	JSONData             string `parquet:"name=json_data, type=UTF8"`
+	HexData              string `parquet:"name=hex_data, type=UTF8"`
+}
+
+// DBOpRow is a contract table row change, `old_json` and `new_json` being
+// empty when the contract's ABI is unknown at this point of the export.
+type DBOpRow struct {
+	BlockNum         int64  `parquet:"name=block_num, type=INT64"`
+	BlockID          string `parquet:"name=block_id, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	BlockTime        int64  `parquet:"name=block_time, type=TIMESTAMP_MILLIS"`
+	TransactionID    string `parquet:"name=trx_id, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	TransactionIndex int64  `parquet:"name=trx_index, type=INT64"`
+	ActionIndex      int64  `parquet:"name=action_index, type=INT64"`
+	Operation        string `parquet:"name=operation, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Code             string `parquet:"name=code, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Scope            string `parquet:"name=scope, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Table            string `parquet:"name=table, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	PrimaryKey       string `parquet:"name=primary_key, type=UTF8"`
+	OldPayer         string `parquet:"name=old_payer, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	NewPayer         string `parquet:"name=new_payer, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	OldJSON          string `parquet:"name=old_json, type=UTF8"`
+	NewJSON          string `parquet:"name=new_json, type=UTF8"`
+	OldHexData       string `parquet:"name=old_hex_data, type=UTF8"`
+	NewHexData       string `parquet:"name=new_hex_data, type=UTF8"`
+}
+
+// TransactionRow is a transaction trace, whatever its status.
+type TransactionRow struct {
+	BlockNum             int64  `parquet:"name=block_num, type=INT64"`
+	BlockID              string `parquet:"name=block_id, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	BlockTime            int64  `parquet:"name=block_time, type=TIMESTAMP_MILLIS"`
+	TransactionID        string `parquet:"name=trx_id, type=UTF8"`
+	TransactionIndex     int64  `parquet:"name=trx_index, type=INT64"`
+	Status               string `parquet:"name=status, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Scheduled            bool   `parquet:"name=scheduled, type=BOOLEAN"`
+	CPUUsageMicroSeconds int64  `parquet:"name=cpu_usage_us, type=INT64"`
+	NetUsageWords        int64  `parquet:"name=net_usage_words, type=INT64"`
+	Elapsed              int64  `parquet:"name=elapsed_us, type=INT64"`
+	ActionCount          int64  `parquet:"name=action_count, type=INT64"`
+	DBOpCount            int64  `parquet:"name=db_op_count, type=INT64"`
+	ErrorCode            int64  `parquet:"name=error_code, type=INT64"`
+	ErrorMessage         string `parquet:"name=error_message, type=UTF8"`
+}
+
+// Rows holds the flattened rows of one or more blocks.
+type Rows struct {
+	Actions      []*ActionRow
+	DBOps        []*DBOpRow
+	Transactions []*TransactionRow
+}
+
+// FlattenBlock turns `block` into rows. The block must have gone through
+// `decoder` (see `codec.ABIDecoder.DecodeBlock`) so that the ABIs it sets
+// are known when decoding its DB ops. A row failing to decode is still
+// exported, without its JSON.
+func FlattenBlock(block *pbcodec.Block, decoder *codec.ABIDecoder) (*Rows, error) {
+	blockTime, err := ptypes.Timestamp(block.Header.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block time: %w", err)
+	}
+
+	blockNum := int64(block.Number)
+	blockTimeMillis := blockTime.UnixNano() / 1e6
+
+	rows := &Rows{}
+	for trxIndex, trxTrace := range block.TransactionTraces {
+		trxRow := &TransactionRow{
+			BlockNum:         blockNum,
+			BlockID:          block.Id,
+			BlockTime:        blockTimeMillis,
+			TransactionID:    trxTrace.Id,
+			TransactionIndex: int64(trxIndex),
+			Scheduled:        trxTrace.Scheduled,
+			Elapsed:          trxTrace.Elapsed,
+			ActionCount:      int64(len(trxTrace.ActionTraces)),
+			DBOpCount:        int64(len(trxTrace.DbOps)),
+			ErrorCode:        int64(trxTrace.ErrorCode),
+		}
+
+		if receipt := trxTrace.Receipt; receipt != nil {
+			trxRow.Status = strings.ToLower(strings.TrimPrefix(receipt.Status.String(), "TRANSACTIONSTATUS_"))
+			trxRow.CPUUsageMicroSeconds = int64(receipt.CpuUsageMicroSeconds)
+			trxRow.NetUsageWords = int64(receipt.NetUsageWords)
+		}
+
+		if trxTrace.Exception != nil {
+			trxRow.ErrorMessage = trxTrace.Exception.Message
+		}
+
+		rows.Transactions = append(rows.Transactions, trxRow)
+
+		if trxTrace.HasBeenReverted() {
+			continue
+		}
+
+		for _, actionTrace := range trxTrace.ActionTraces {
+			rows.Actions = append(rows.Actions, &ActionRow{
+				BlockNum:             blockNum,
+				BlockID:              block.Id,
+				BlockTime:            blockTimeMillis,
+				TransactionID:        trxTrace.Id,
+				TransactionIndex:     int64(trxIndex),
+				ExecutionIndex:       int64(actionTrace.ExecutionIndex),
+				ActionOrdinal:        int64(actionTrace.ActionOrdinal),
+				CreatorActionOrdinal: int64(actionTrace.CreatorActionOrdinal),
+				GlobalSequence:       int64(actionTraceGlobalSequence(actionTrace)),
+				Receiver:             actionTrace.Receiver,
+				Account:              actionTrace.Account(),
+				Action:               actionTrace.Name(),
+				Authorization:        authorization(actionTrace.Action),
+				Input:                actionTrace.IsInput(),
+				Notif:                actionTrace.Receiver != actionTrace.Account(),
+				Scheduled:            trxTrace.Scheduled,
+				JSONData:             actionTrace.Action.JsonData,
+				HexData:              hex.EncodeToString(actionTrace.Action.RawData),
+			})
+		}
+
+		for _, dbOp := range trxTrace.DbOps {
+			var globalSequence uint64
+			if int(dbOp.ActionIndex) < len(trxTrace.ActionTraces) {
+				globalSequence = actionTraceGlobalSequence(trxTrace.ActionTraces[dbOp.ActionIndex])
+			}
+
+			rows.DBOps = append(rows.DBOps, &DBOpRow{
+				BlockNum:         blockNum,
+				BlockID:          block.Id,
+				BlockTime:        blockTimeMillis,
+				TransactionID:    trxTrace.Id,
+				TransactionIndex: int64(trxIndex),
+				ActionIndex:      int64(dbOp.ActionIndex),
+				Operation:        dbOp.LegacyOperation(),
+				Code:             dbOp.Code,
+				Scope:            dbOp.Scope,
+				Table:            dbOp.TableName,
+				PrimaryKey:       dbOp.PrimaryKey,
+				OldPayer:         dbOp.OldPayer,
+				NewPayer:         dbOp.NewPayer,
+				OldJSON:          decodeRow(decoder, dbOp, globalSequence, dbOp.OldData),
+				NewJSON:          decodeRow(decoder, dbOp, globalSequence, dbOp.NewData),
+				OldHexData:       hex.EncodeToString(dbOp.OldData),
+				NewHexData:       hex.EncodeToString(dbOp.NewData),
+			})
+		}
+	}
+
+	return rows, nil
+}
+
+func decodeRow(decoder *codec.ABIDecoder, dbOp *pbcodec.DBOp, globalSequence uint64, data []byte) string {
+	if decoder == nil {
+		return ""
+	}
+
+	jsonData, err := decoder.DecodeTableRow(dbOp.Code, dbOp.TableName, globalSequence, data)
+	if err != nil {
+		zlog.Debug("unable to decode db op row, exporting it without json", zap.String("table", dbOp.Code+":"+dbOp.TableName), zap.Error(err))
+		return ""
+	}
+
+	return jsonData
+}
+
+func actionTraceGlobalSequence(actionTrace *pbcodec.ActionTrace) uint64 {
+	if actionTrace.Receipt == nil {
+		return 0
+	}
+
+	return actionTrace.Receipt.GlobalSequence
+}
+
+func authorization(action *pbcodec.Action) string {
+	auths := make([]string, len(action.Authorization))
+	for i, auth := range action.Authorization {
+		auths[i] = auth.Actor + "@" + auth.Permission
+	}
+
+	return strings.Join(auths, ",")
+}
@@ -0,0 +1,196 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type Format string
+
+const (
+	ParquetFormat Format = "parquet"
+	CSVFormat     Format = "csv"
+)
+
+func ParseFormat(in string) (Format, error) {
+	switch Format(in) {
+	case ParquetFormat, CSVFormat:
+		return Format(in), nil
+	}
+
+	return "", fmt.Errorf("unknown export format %q, valid values are %q and %q", in, ParquetFormat, CSVFormat)
+}
+
+// Extension returns the file extension of the format, without the dot.
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// rowWriter streams the rows of a single partition file to a temporary file,
+// uploaded to the destination store once closed.
+type rowWriter interface {
+	Write(row interface{}) error
+	// Close completes the file and returns its content, which removes the
+	// temporary file once closed
+	Close() (io.ReadCloser, error)
+	// Discard removes the temporary file without completing it
+	Discard() error
+}
+
+func newRowWriter(format Format, kind RowKind) (rowWriter, error) {
+	switch format {
+	case CSVFormat:
+		return newCSVRowWriter(kind)
+	case ParquetFormat:
+		return newParquetRowWriter(kind)
+	}
+
+	return nil, fmt.Errorf("unknown export format %q", format)
+}
+
+type csvRowWriter struct {
+	kind   RowKind
+	file   *tempFile
+	writer *csv.Writer
+}
+
+func newCSVRowWriter(kind RowKind) (*csvRowWriter, error) {
+	file, err := newTempFile(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := csv.NewWriter(file)
+	if err := writer.Write(columnNames(kind)); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("write csv header: %w", err)
+	}
+
+	return &csvRowWriter{kind: kind, file: file, writer: writer}, nil
+}
+
+func (w *csvRowWriter) Write(row interface{}) error {
+	return w.writer.Write(rowStrings(w.kind, row))
+}
+
+func (w *csvRowWriter) Close() (io.ReadCloser, error) {
+	w.writer.Flush()
+	if err := w.writer.Error(); err != nil {
+		w.file.Close()
+		return nil, err
+	}
+
+	return w.file.rewind()
+}
+
+func (w *csvRowWriter) Discard() error {
+	return w.file.Close()
+}
+
+type parquetRowWriter struct {
+	file   *tempFile
+	writer *writer.ParquetWriter
+}
+
+var parquetSchemas = map[RowKind]interface{}{
+	ActionRowKind:      new(ActionRow),
+	DBOpRowKind:        new(DBOpRow),
+	TransactionRowKind: new(TransactionRow),
+}
+
+func newParquetRowWriter(kind RowKind) (*parquetRowWriter, error) {
+	file, err := newTempFile(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	parquetWriter, err := writer.NewParquetWriter(file, parquetSchemas[kind], 1)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("new parquet writer: %w", err)
+	}
+
+	return &parquetRowWriter{file: file, writer: parquetWriter}, nil
+}
+
+func (w *parquetRowWriter) Write(row interface{}) error {
+	return w.writer.Write(row)
+}
+
+func (w *parquetRowWriter) Close() (io.ReadCloser, error) {
+	if err := w.writer.WriteStop(); err != nil {
+		w.file.Close()
+		return nil, fmt.Errorf("write parquet footer: %w", err)
+	}
+
+	return w.file.rewind()
+}
+
+func (w *parquetRowWriter) Discard() error {
+	return w.file.Close()
+}
+
+// tempFile is the temporary file backing a partition file, removed once
+// closed. It is also the `source.ParquetFile` the Parquet writer appends to.
+type tempFile struct {
+	*os.File
+	removed bool
+}
+
+func newTempFile(kind RowKind) (*tempFile, error) {
+	file, err := ioutil.TempFile("", fmt.Sprintf("export-%s-*", kind))
+	if err != nil {
+		return nil, fmt.Errorf("create temporary file: %w", err)
+	}
+
+	return &tempFile{File: file}, nil
+}
+
+// rewind positions the file back at its start, to read its content.
+func (f *tempFile) rewind() (io.ReadCloser, error) {
+	if _, err := f.File.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("rewind temporary file: %w", err)
+	}
+
+	return f, nil
+}
+
+func (f *tempFile) Open(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("temporary parquet file cannot be opened")
+}
+
+func (f *tempFile) Create(name string) (source.ParquetFile, error) {
+	return nil, fmt.Errorf("temporary parquet file cannot be created")
+}
+
+// Close removes the file, it can safely be called more than once.
+func (f *tempFile) Close() error {
+	if f.removed {
+		return nil
+	}
+
+	f.removed = true
+	f.File.Close()
+	return os.Remove(f.File.Name())
+}
@@ -9,6 +9,7 @@ import (
 	"net/url"
 
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/tracing"
 	"github.com/eoscanada/eos-go"
 )
 
@@ -18,6 +19,7 @@ type Client interface {
 	GetTableScopes(ctx context.Context, startBlock uint32, request *GetTableScopesRequest) (*GetTableScopesResponse, error)
 	GetTablesMultiScopes(ctx context.Context, startBlock uint32, request *GetTablesMultiScopesRequest) (*GetTablesMultiScopesResponse, error)
 	GetAccountByPubKey(ctx context.Context, startBlock uint32, pubKey string) (*GetAccountByPubKeyResponses, error)
+	GetControlledAccounts(ctx context.Context, startBlock uint32, controllingAccount eos.AccountName) (*GetControlledAccountsResponse, error)
 }
 
 type DefaultClient struct {
@@ -25,11 +27,13 @@ type DefaultClient struct {
 	httpClient *http.Client
 }
 
+// NewClient propagates the trace context of the requests over `transport`,
+// which can be nil to use the default transport.
 func NewClient(addr string, transport http.RoundTripper) *DefaultClient {
 	return &DefaultClient{
 		addr: addr,
 		httpClient: &http.Client{
-			Transport: transport,
+			Transport: tracing.NewHTTPTransport(transport),
 		},
 	}
 }
@@ -57,6 +61,29 @@ func (c *DefaultClient) GetAccountByPubKey(ctx context.Context, startBlock uint3
 	return response, nil
 }
 
+type GetControlledAccountsResponse struct {
+	BlockNum           uint32            `json:"block_num"`
+	ControlledAccounts []eos.AccountName `json:"controlled_accounts"`
+}
+
+func (c *DefaultClient) GetControlledAccounts(ctx context.Context, startBlock uint32, controllingAccount eos.AccountName) (*GetControlledAccountsResponse, error) {
+	val := url.Values{}
+	val.Set("block_num", fmt.Sprintf("%d", startBlock))
+	val.Set("controlling_account", string(controllingAccount))
+
+	body, err := c.performFormRequest(ctx, "/v0/state/controlled_accounts", val)
+	if err != nil {
+		return nil, derr.Wrap(err, "unable to get controlled accounts")
+	}
+
+	var response *GetControlledAccountsResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, derr.Wrap(err, "unable to decode response")
+	}
+
+	return response, nil
+}
+
 type GetABIResponse struct {
 	BlockNum uint32          `json:"block_num"`
 	Account  eos.AccountName `json:"account"`
@@ -82,25 +109,31 @@ func (c *DefaultClient) GetABI(ctx context.Context, startBlock uint32, account e
 	return response, nil
 }
 
+// GetTableRequest reads a single table. When `KeyType` is left empty, FluxDB
+// infers it from the contract's ABI. `ScopeType` controls how `Scope` is
+// parsed, defaulting to an extended name (name, symbol or symbol code).
 type GetTableRequest struct {
-	Account eos.AccountName
-	Scope   eos.Name
-	Table   eos.TableName
-	KeyType string
-	JSON    bool
+	Account   eos.AccountName
+	Scope     eos.Name
+	Table     eos.TableName
+	KeyType   string
+	ScopeType string
+	JSON      bool
 }
 
 type GetTablesMultiScopesRequest struct {
-	Account eos.AccountName
-	Scopes  []eos.Name
-	Table   eos.TableName
-	KeyType string
-	JSON    bool
+	Account   eos.AccountName
+	Scopes    []eos.Name
+	Table     eos.TableName
+	KeyType   string
+	ScopeType string
+	JSON      bool
 }
 
 type GetTableScopesRequest struct {
-	Account eos.AccountName
-	Table   eos.TableName
+	Account   eos.AccountName
+	Table     eos.TableName
+	ScopeType string
 }
 
 type GetTableScopesResponse struct {
@@ -154,7 +187,7 @@ func (c *DefaultClient) GetTablesMultiScopes(ctx context.Context, startBlock uin
 	}
 	val.Set("scopes", scopes)
 	val.Set("table", string(request.Table))
-	val.Set("key_type", request.KeyType)
+	setTypeParams(val, request.KeyType, request.ScopeType)
 	val.Set("json", fmt.Sprintf("%v", request.JSON))
 
 	body, err := c.performFormRequest(ctx, "/v0/state/tables/scopes", val)
@@ -176,7 +209,7 @@ func (c *DefaultClient) GetTable(ctx context.Context, startBlock uint32, request
 	val.Set("account", string(request.Account))
 	val.Set("scope", string(request.Scope))
 	val.Set("table", string(request.Table))
-	val.Set("key_type", request.KeyType)
+	setTypeParams(val, request.KeyType, request.ScopeType)
 	val.Set("json", fmt.Sprintf("%v", request.JSON))
 
 	body, err := c.performFormRequest(ctx, "/v0/state/table", val)
@@ -197,6 +230,7 @@ func (c *DefaultClient) GetTableScopes(ctx context.Context, startBlock uint32, r
 	val.Set("block_num", fmt.Sprintf("%d", startBlock))
 	val.Set("account", string(request.Account))
 	val.Set("table", string(request.Table))
+	setTypeParams(val, "", request.ScopeType)
 
 	body, err := c.performFormRequest(ctx, "/v0/state/table_scopes", val)
 	if err != nil {
@@ -211,6 +245,18 @@ func (c *DefaultClient) GetTableScopes(ctx context.Context, startBlock uint32, r
 	return response, nil
 }
 
+// setTypeParams only sends the key & scope types when explicitly set, letting
+// FluxDB infer them otherwise.
+func setTypeParams(val url.Values, keyType, scopeType string) {
+	if keyType != "" {
+		val.Set("key_type", keyType)
+	}
+
+	if scopeType != "" {
+		val.Set("scope_type", scopeType)
+	}
+}
+
 func (c *DefaultClient) performFormRequest(ctx context.Context, path string, form url.Values) (body []byte, err error) {
 	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s?%s", c.addr, path, form.Encode()), nil)
 	if err != nil {
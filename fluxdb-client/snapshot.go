@@ -0,0 +1,167 @@
+package fluxdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/eoscanada/eos-go"
+)
+
+// MaxTableRefs is the maximum amount of table scopes a `StateSnapshotter`
+// resolves for each block, each distinct account and table pair costing one
+// FluxDB request per block.
+const MaxTableRefs = 10
+
+// TableRef identifies the rows of a contract table in a given scope.
+type TableRef struct {
+	Account eos.AccountName
+	Table   eos.TableName
+	Scope   eos.Name
+}
+
+// ParseTableRef parses the `<account>:<table>:<scope>` form of a table ref.
+func ParseTableRef(in string) (*TableRef, error) {
+	parts := strings.Split(in, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid table ref %q, expected <account>:<table>:<scope>", in)
+	}
+
+	return &TableRef{
+		Account: eos.AccountName(parts[0]),
+		Table:   eos.TableName(parts[1]),
+		Scope:   eos.Name(parts[2]),
+	}, nil
+}
+
+func (r *TableRef) String() string {
+	return fmt.Sprintf("%s:%s:%s", r.Account, r.Table, r.Scope)
+}
+
+// TableSnapshot holds the rows of a table scope as of a block. `Error` is set
+// instead of `Rows` when the table scope could not be read, like when the
+// contract has no ABI at this block.
+type TableSnapshot struct {
+	Account  string          `json:"account"`
+	Table    string          `json:"table"`
+	Scope    string          `json:"scope"`
+	BlockNum uint32          `json:"block_num"`
+	Rows     json.RawMessage `json:"rows,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+type tableGroup struct {
+	account eos.AccountName
+	table   eos.TableName
+	scopes  []eos.Name
+}
+
+// StateSnapshotter resolves the rows of a fixed set of table scopes at the
+// blocks of search matches. The scopes of a same account and table are read
+// in a single FluxDB request and the snapshots of a block are cached, matches
+// from the same block thus sharing the requests.
+//
+// FluxDB reads at a block include the changes of the block itself, snapshots
+// are thus the state after the whole block, not after the matching
+// transaction when other transactions of the block touched the same rows.
+type StateSnapshotter struct {
+	client Client
+	refs   []*TableRef
+	groups []*tableGroup
+
+	lock  sync.Mutex
+	cache map[uint32][]*TableSnapshot
+}
+
+func NewStateSnapshotter(client Client, refs []*TableRef) *StateSnapshotter {
+	var groups []*tableGroup
+	byTable := map[string]*tableGroup{}
+	for _, ref := range refs {
+		key := string(ref.Account) + ":" + string(ref.Table)
+		group := byTable[key]
+		if group == nil {
+			group = &tableGroup{account: ref.Account, table: ref.Table}
+			byTable[key] = group
+			groups = append(groups, group)
+		}
+
+		group.scopes = append(group.scopes, ref.Scope)
+	}
+
+	return &StateSnapshotter{
+		client: client,
+		refs:   refs,
+		groups: groups,
+		cache:  map[uint32][]*TableSnapshot{},
+	}
+}
+
+// SnapshotsAt returns a snapshot of each table ref, in the order they were
+// given, as of `blockNum`. Table scopes FluxDB failed to read have their
+// error recorded in their snapshot, only a cancelled context fails the call.
+func (s *StateSnapshotter) SnapshotsAt(ctx context.Context, blockNum uint32) ([]*TableSnapshot, error) {
+	s.lock.Lock()
+	snapshots, found := s.cache[blockNum]
+	s.lock.Unlock()
+
+	if found {
+		return snapshots, nil
+	}
+
+	rows := map[string]json.RawMessage{}
+	errors := map[string]string{}
+	for _, group := range s.groups {
+		response, err := s.client.GetTablesMultiScopes(ctx, blockNum, &GetTablesMultiScopesRequest{
+			Account:   group.account,
+			Scopes:    group.scopes,
+			Table:     group.table,
+			ScopeType: "name",
+			JSON:      true,
+		})
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		if err != nil {
+			for _, scope := range group.scopes {
+				ref := &TableRef{Account: group.account, Table: group.table, Scope: scope}
+				errors[ref.String()] = err.Error()
+			}
+			continue
+		}
+
+		for _, table := range response.Tables {
+			ref := &TableRef{Account: group.account, Table: group.table, Scope: eos.Name(table.Scope)}
+			rows[ref.String()] = table.Rows
+		}
+	}
+
+	snapshots = make([]*TableSnapshot, len(s.refs))
+	for i, ref := range s.refs {
+		key := ref.String()
+		snapshot := &TableSnapshot{
+			Account:  string(ref.Account),
+			Table:    string(ref.Table),
+			Scope:    string(ref.Scope),
+			BlockNum: blockNum,
+			Error:    errors[key],
+		}
+
+		if snapshot.Error == "" {
+			snapshot.Rows = rows[key]
+			if snapshot.Rows == nil {
+				snapshot.Rows = json.RawMessage("[]")
+			}
+		}
+
+		snapshots[i] = snapshot
+	}
+
+	s.lock.Lock()
+	s.cache[blockNum] = snapshots
+	s.lock.Unlock()
+
+	return snapshots, nil
+}
@@ -0,0 +1,70 @@
+package fluxdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingClient struct {
+	*TestClient
+	requests []*GetTablesMultiScopesRequest
+}
+
+func (c *countingClient) GetTablesMultiScopes(ctx context.Context, startBlock uint32, request *GetTablesMultiScopesRequest) (*GetTablesMultiScopesResponse, error) {
+	c.requests = append(c.requests, request)
+	if request.Table == "broken" {
+		return nil, errors.New("no abi")
+	}
+
+	response := &GetTablesMultiScopesResponse{}
+	for _, scope := range request.Scopes {
+		response.Tables = append(response.Tables, struct {
+			Scope string          `json:"scope"`
+			Rows  json.RawMessage `json:"rows"`
+		}{Scope: string(scope), Rows: json.RawMessage(`[{"scope":"` + string(scope) + `"}]`)})
+	}
+
+	return response, nil
+}
+
+func TestParseTableRef(t *testing.T) {
+	ref, err := ParseTableRef("eosio.token:accounts:eoscanadacom")
+	require.NoError(t, err)
+	assert.Equal(t, &TableRef{Account: "eosio.token", Table: "accounts", Scope: "eoscanadacom"}, ref)
+
+	for _, in := range []string{"", "eosio.token:accounts", "eosio.token::eoscanadacom", "a:b:c:d"} {
+		_, err := ParseTableRef(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestStateSnapshotter_SnapshotsAt(t *testing.T) {
+	client := &countingClient{TestClient: NewTestFluxClient()}
+	snapshotter := NewStateSnapshotter(client, []*TableRef{
+		{Account: "eosio.token", Table: "accounts", Scope: "alice"},
+		{Account: "eosio", Table: "broken", Scope: "eosio"},
+		{Account: "eosio.token", Table: "accounts", Scope: "bob"},
+	})
+
+	snapshots, err := snapshotter.SnapshotsAt(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 3)
+
+	assert.Equal(t, &TableSnapshot{Account: "eosio.token", Table: "accounts", Scope: "alice", BlockNum: 10, Rows: json.RawMessage(`[{"scope":"alice"}]`)}, snapshots[0])
+	assert.Equal(t, &TableSnapshot{Account: "eosio", Table: "broken", Scope: "eosio", BlockNum: 10, Error: "no abi"}, snapshots[1])
+	assert.Equal(t, json.RawMessage(`[{"scope":"bob"}]`), snapshots[2].Rows)
+	assert.Len(t, client.requests, 2, "one request per account and table")
+
+	_, err = snapshotter.SnapshotsAt(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Len(t, client.requests, 2, "snapshots of a block are cached")
+
+	_, err = snapshotter.SnapshotsAt(context.Background(), 11)
+	require.NoError(t, err)
+	assert.Len(t, client.requests, 4)
+}
@@ -17,20 +17,30 @@ type getTableResponse struct {
 	err      error
 }
 
-type TestClient struct {
-	getABIResponse   *getABIResponse
-	getTableResponse *getTableResponse
+type getTablesMultiScopesResponse struct {
+	response *GetTablesMultiScopesResponse
+	err      error
 }
 
-func (c *TestClient) GetTableScopes(ctx context.Context, startBlock uint32, request *GetTableScopesRequest) (*GetTableScopesResponse, error) {
-	panic("implement me")
+type getAccountByPubKeyResponse struct {
+	response *GetAccountByPubKeyResponses
+	err      error
 }
 
-func (c *TestClient) GetTablesMultiScopes(ctx context.Context, startBlock uint32, request *GetTablesMultiScopesRequest) (*GetTablesMultiScopesResponse, error) {
-	panic("implement me")
+type getControlledAccountsResponse struct {
+	response *GetControlledAccountsResponse
+	err      error
 }
 
-func (c *TestClient) GetAccountByPubKey(ctx context.Context, startBlock uint32, pubKey string) (*GetAccountByPubKeyResponses, error) {
+type TestClient struct {
+	getABIResponse                *getABIResponse
+	getTableResponse              *getTableResponse
+	getTablesMultiScopesResponse  *getTablesMultiScopesResponse
+	getAccountByPubKeyResponse    *getAccountByPubKeyResponse
+	getControlledAccountsResponse *getControlledAccountsResponse
+}
+
+func (c *TestClient) GetTableScopes(ctx context.Context, startBlock uint32, request *GetTableScopesRequest) (*GetTableScopesResponse, error) {
 	panic("implement me")
 }
 
@@ -60,6 +70,39 @@ func (c *TestClient) SetGetTableResponse(response string, err error) *TestClient
 	})
 }
 
+func (c *TestClient) SetGetTablesMultiScopesResponse(response string, err error) *TestClient {
+	var unmarshalledResponse *GetTablesMultiScopesResponse
+
+	return c.setResponse(response, &unmarshalledResponse, func() {
+		c.getTablesMultiScopesResponse = &getTablesMultiScopesResponse{
+			response: unmarshalledResponse,
+			err:      err,
+		}
+	})
+}
+
+func (c *TestClient) SetGetAccountByPubKeyResponse(response string, err error) *TestClient {
+	var unmarshalledResponse *GetAccountByPubKeyResponses
+
+	return c.setResponse(response, &unmarshalledResponse, func() {
+		c.getAccountByPubKeyResponse = &getAccountByPubKeyResponse{
+			response: unmarshalledResponse,
+			err:      err,
+		}
+	})
+}
+
+func (c *TestClient) SetGetControlledAccountsResponse(response string, err error) *TestClient {
+	var unmarshalledResponse *GetControlledAccountsResponse
+
+	return c.setResponse(response, &unmarshalledResponse, func() {
+		c.getControlledAccountsResponse = &getControlledAccountsResponse{
+			response: unmarshalledResponse,
+			err:      err,
+		}
+	})
+}
+
 func (c *TestClient) setResponse(response string, receiver interface{}, setter func()) *TestClient {
 	if response != "" {
 		merr := json.Unmarshal([]byte(response), receiver)
@@ -80,3 +123,15 @@ func (c *TestClient) GetABI(ctx context.Context, startBlock uint32, account eos.
 func (c *TestClient) GetTable(ctx context.Context, startBlock uint32, request *GetTableRequest) (*GetTableResponse, error) {
 	return c.getTableResponse.response, c.getTableResponse.err
 }
+
+func (c *TestClient) GetTablesMultiScopes(ctx context.Context, startBlock uint32, request *GetTablesMultiScopesRequest) (*GetTablesMultiScopesResponse, error) {
+	return c.getTablesMultiScopesResponse.response, c.getTablesMultiScopesResponse.err
+}
+
+func (c *TestClient) GetAccountByPubKey(ctx context.Context, startBlock uint32, pubKey string) (*GetAccountByPubKeyResponses, error) {
+	return c.getAccountByPubKeyResponse.response, c.getAccountByPubKeyResponse.err
+}
+
+func (c *TestClient) GetControlledAccounts(ctx context.Context, startBlock uint32, controllingAccount eos.AccountName) (*GetControlledAccountsResponse, error) {
+	return c.getControlledAccountsResponse.response, c.getControlledAccountsResponse.err
+}
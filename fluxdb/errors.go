@@ -47,6 +47,13 @@ func DataABINotFoundError(ctx context.Context, account string, blockNum uint32)
 	)
 }
 
+func DataCodeNotFoundError(ctx context.Context, account string, blockNum uint32) *derr.ErrorResponse {
+	return derr.HTTPBadRequestError(ctx, nil, derr.C("data_code_not_found_error"), "Cannot find a contract code for request account at this block height.",
+		"account", account,
+		"block_num", blockNum,
+	)
+}
+
 func DataDecodingRowError(ctx context.Context, hexData string) *derr.ErrorResponse {
 	return derr.HTTPBadRequestError(ctx, nil, derr.C("data_decoding_table_row_error"), "Unable to decode row against ABI.",
 		"data", hexData,
@@ -358,6 +358,10 @@ func indexPrimaryKeyByteCountByTableKey(tableKey string) int {
 	// Block resource limit has no fields after prefix, so we must match without the :
 	case strings.HasPrefix(tableKey, "brl"):
 		return 1
+	case strings.HasPrefix(tableKey, "cc:"):
+		return 1
+	case strings.HasPrefix(tableKey, "ca:"):
+		return 16
 	case strings.HasPrefix(tableKey, "ka2:"):
 		return 16
 	case strings.HasPrefix(tableKey, "td:"):
@@ -378,6 +382,10 @@ func indexPrimaryKeyReaderByTableKey(tableKey string) indexPrimaryKeyReader {
 	// Block resource limit has no fields after prefix, so we must match without the :
 	case strings.HasPrefix(tableKey, "brl"):
 		return blockResourceLimitIndexPrimaryKeyReader
+	case strings.HasPrefix(tableKey, "cc:"):
+		return contractCodeIndexPrimaryKeyReader
+	case strings.HasPrefix(tableKey, "ca:"):
+		return controlledAccountIndexPrimaryKeyReader
 	case strings.HasPrefix(tableKey, "ka2:"):
 		return keyAccountIndexPrimaryKeyReader
 	case strings.HasPrefix(tableKey, "td:"):
@@ -398,6 +406,10 @@ func indexPrimaryKeyWriterByTableKey(tableKey string) indexPrimaryKeyWriter {
 	// Block resource limit has no fields after prefix, so we must match without the :
 	case strings.HasPrefix(tableKey, "brl"):
 		return blockResourceLimitIndexPrimaryKeyWriter
+	case strings.HasPrefix(tableKey, "cc:"):
+		return contractCodeIndexPrimaryKeyWriter
+	case strings.HasPrefix(tableKey, "ca:"):
+		return controlledAccountIndexPrimaryKeyWriter
 	case strings.HasPrefix(tableKey, "ka2:"):
 		return keyAccountIndexPrimaryKeyWriter
 	case strings.HasPrefix(tableKey, "td:"):
@@ -412,6 +424,8 @@ func indexPrimaryKeyWriterByTableKey(tableKey string) indexPrimaryKeyWriter {
 var authLinkIndexPrimaryKeyReader = twoUint64PrimaryKeyReaderFactory("auth link")
 var accountResourceLimitIndexPrimaryKeyReader = oneBytePrimaryKeyReaderFactory("account resource limit")
 var blockResourceLimitIndexPrimaryKeyReader = oneBytePrimaryKeyReaderFactory("block resource limit")
+var contractCodeIndexPrimaryKeyReader = oneBytePrimaryKeyReaderFactory("contract code")
+var controlledAccountIndexPrimaryKeyReader = twoUint64PrimaryKeyReaderFactory("controlled account")
 var keyAccountIndexPrimaryKeyReader = twoUint64PrimaryKeyReaderFactory("key account")
 var tableDataIndexPrimaryKeyReader = oneUint64PrimaryKeyReaderFactory("table data")
 var tableScopeIndexPrimaryKeyReader = oneUint64PrimaryKeyReaderFactory("table scope")
@@ -468,6 +482,8 @@ func readOneUint64(buffer []byte) (string, error) {
 var authLinkIndexPrimaryKeyWriter = twoUint64PrimaryKeyWriterFactory("auth link")
 var accountResourceLimitIndexPrimaryKeyWriter = oneBytePrimaryKeyWriterFactory("account resource limit")
 var blockResourceLimitIndexPrimaryKeyWriter = oneBytePrimaryKeyWriterFactory("block resource limit")
+var contractCodeIndexPrimaryKeyWriter = oneBytePrimaryKeyWriterFactory("contract code")
+var controlledAccountIndexPrimaryKeyWriter = twoUint64PrimaryKeyWriterFactory("controlled account")
 var keyAccountIndexPrimaryKeyWriter = twoUint64PrimaryKeyWriterFactory("key account")
 var tableDataIndexPrimaryKeyWriter = oneUint64PrimaryKeyWriterFactory("table data")
 var tableScopeIndexPrimaryKeyWriter = oneUint64PrimaryKeyWriterFactory("table scope")
@@ -233,6 +233,8 @@ func (p *FluxDBHandler) ProcessBlock(rawBlk *bstream.Block, rawObj interface{})
 				p.batchWritableRows += len(req.AuthLinks) +
 					len(req.AuthLinks) +
 					len(req.AuthLinks) +
+					len(req.ContractCodes) +
+					len(req.ControlledAccounts) +
 					len(req.KeyAccounts) +
 					len(req.TableDatas) +
 					len(req.TableScopes)
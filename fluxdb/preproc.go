@@ -41,6 +41,8 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 	lastDbOpForRowPath := map[string]*pbcodec.DBOp{}
 	firstDbOpWasInsert := map[string]bool{}
 	lastKeyAccountOpForRowPath := map[string]*keyAccountOp{}
+	lastControlledAccountForRowPath := map[string]*ControlledAccountRow{}
+	lastContractCodeForAccount := map[uint64]*ContractCodeRow{}
 	lastTableOpForTablePath := map[string]*pbcodec.TableOp{}
 
 	req := &WriteRequest{
@@ -73,6 +75,10 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 			for _, keyAccountOp := range permOpToKeyAccountOps(permOp) {
 				lastKeyAccountOpForRowPath[keyAccountOp.rowPath] = keyAccountOp
 			}
+
+			for rowPath, controlledAccountRow := range permOpToControlledAccountRows(permOp) {
+				lastControlledAccountForRowPath[rowPath] = controlledAccountRow
+			}
 		}
 
 		for _, tableOp := range trx.TableOps {
@@ -89,6 +95,14 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 
 				req.ABIs = append(req.ABIs, abi)
 
+			case "eosio:eosio:setcode":
+				contractCode, err := extractContractCodeRow(act.Action)
+				if err != nil {
+					return nil, derr.Wrap(err, "extract contract code")
+				}
+
+				lastContractCodeForAccount[contractCode.Account] = contractCode
+
 			case "eosio:eosio:linkauth":
 				linkStruct, err := extractLinkAuthLinkRow(act.Action)
 				if err != nil {
@@ -108,7 +122,13 @@ func PreprocessBlock(rawBlk *bstream.Block) (interface{}, error) {
 		}
 	}
 
+	for _, row := range lastContractCodeForAccount {
+		req.ContractCodes = append(req.ContractCodes, row)
+	}
 	req.KeyAccounts = keyAccountOpsToWritableRows(lastKeyAccountOpForRowPath)
+	for _, row := range lastControlledAccountForRowPath {
+		req.ControlledAccounts = append(req.ControlledAccounts, row)
+	}
 	req.TableScopes = tableOpsToWritableRows(lastTableOpForTablePath)
 
 	req.TableDatas, err = dbOpsToWritableRows(lastDbOpForRowPath)
@@ -132,6 +152,11 @@ func permOpToKeyAccountOps(permOp *pbcodec.PermOp) []*keyAccountOp {
 		return ops
 	case pbcodec.PermOp_OPERATION_REMOVE:
 		return permOpDataToKeyAccountOps(keyAccountOperationRemove, permOp.OldPerm)
+	case pbcodec.PermOp_OPERATION_UNKNOWN:
+		// Partially instrumented blocks only know the permission's new value
+		if permOp.NewPerm != nil {
+			return permOpDataToKeyAccountOps(keyAccountOperationInsert, permOp.NewPerm)
+		}
 	}
 
 	panic(fmt.Errorf("unknown perm op %s", permOp.Operation))
@@ -163,6 +188,53 @@ func permOpDataToKeyAccountOps(operation keyAccountOperation, perm *pbcodec.Perm
 	return ops
 }
 
+// permOpToControlledAccountRows returns the controlled account rows
+// affected by the permission operation, keyed by their row path. On
+// update, the old authority's accounts are first removed then the new
+// one's are added, the latter wins when both contain the same account.
+func permOpToControlledAccountRows(permOp *pbcodec.PermOp) map[string]*ControlledAccountRow {
+	rows := map[string]*ControlledAccountRow{}
+	addRows := func(deletion bool, perm *pbcodec.PermissionObject) {
+		if perm == nil || perm.Authority == nil {
+			return
+		}
+
+		for _, level := range perm.Authority.Accounts {
+			if level.Permission == nil {
+				continue
+			}
+
+			rowPath := level.Permission.Actor + ":" + perm.Owner + ":" + perm.Name
+			rows[rowPath] = &ControlledAccountRow{
+				ControllingAccount: N(level.Permission.Actor),
+				Account:            N(perm.Owner),
+				Permission:         N(perm.Name),
+				Deletion:           deletion,
+			}
+		}
+	}
+
+	switch permOp.Operation {
+	case pbcodec.PermOp_OPERATION_INSERT:
+		addRows(false, permOp.NewPerm)
+	case pbcodec.PermOp_OPERATION_UPDATE:
+		addRows(true, permOp.OldPerm)
+		addRows(false, permOp.NewPerm)
+	case pbcodec.PermOp_OPERATION_REMOVE:
+		addRows(true, permOp.OldPerm)
+	case pbcodec.PermOp_OPERATION_UNKNOWN:
+		// Partially instrumented blocks only know the permission's new value
+		if permOp.NewPerm == nil {
+			panic(fmt.Errorf("unknown perm op %s", permOp.Operation))
+		}
+		addRows(false, permOp.NewPerm)
+	default:
+		panic(fmt.Errorf("unknown perm op %s", permOp.Operation))
+	}
+
+	return rows
+}
+
 func dbOpsToWritableRows(latestDbOps map[string]*pbcodec.DBOp) (rows []*TableDataRow, err error) {
 	for _, op := range latestDbOps {
 		rows = append(rows, &TableDataRow{
@@ -219,6 +291,21 @@ func extractABIRow(blockNum uint32, action *pbcodec.Action) (*ABIRow, error) {
 	}, nil
 }
 
+func extractContractCodeRow(action *pbcodec.Action) (*ContractCodeRow, error) {
+	var setCode *system.SetCode
+	if err := action.UnmarshalData(&setCode); err != nil {
+		return nil, err
+	}
+
+	return &ContractCodeRow{
+		Account:   N(string(setCode.Account)),
+		VMType:    setCode.VMType,
+		VMVersion: setCode.VMVersion,
+		Code:      []byte(setCode.Code),
+		Deletion:  len(setCode.Code) == 0,
+	}, nil
+}
+
 func extractLinkAuthLinkRow(action *pbcodec.Action) (*AuthLinkRow, error) {
 	var linkAuth *system.LinkAuth
 	if err := action.UnmarshalData(&linkAuth); err != nil {
@@ -243,6 +243,78 @@ func TestPreprocessBlock_PermOps(t *testing.T) {
 	}, keyAccountRows)
 }
 
+func TestPreprocessBlock_ControlledAccounts(t *testing.T) {
+	blk := newBlock("0000003a", []string{"1", "2"})
+	blk.TransactionTraces[0].PermOps = []*pbcodec.PermOp{
+		newPermOp("INS", 0, nil, newAccountsPermOpData("msig", "owner", []string{"alice", "bob"})),
+		newPermOp("INS", 1, nil, newAccountsPermOpData("msig", "active", []string{"bob"})),
+	}
+
+	blk.TransactionTraces[1].PermOps = []*pbcodec.PermOp{
+		newPermOp("UPD", 0, newAccountsPermOpData("msig", "owner", []string{"alice", "bob"}), newAccountsPermOpData("msig", "owner", []string{"bob", "carol"})),
+	}
+
+	bstreamBlock, err := codec.BlockFromProto(blk)
+	require.NoError(t, err)
+	req, err := PreprocessBlock(bstreamBlock)
+	require.NoError(t, err)
+
+	controlledAccountRows := req.(*WriteRequest).ControlledAccounts
+	key := func(row *ControlledAccountRow) string {
+		return fmt.Sprintf("%s:%s:%s", eos.NameToString(row.ControllingAccount), eos.NameToString(row.Account), eos.NameToString(row.Permission))
+	}
+
+	sort.Slice(controlledAccountRows, func(i, j int) bool {
+		return key(controlledAccountRows[i]) < key(controlledAccountRows[j])
+	})
+
+	assert.Equal(t, []*ControlledAccountRow{
+		{N("alice"), N("msig"), N("owner"), true},
+		{N("bob"), N("msig"), N("active"), false},
+		{N("bob"), N("msig"), N("owner"), false},
+		{N("carol"), N("msig"), N("owner"), false},
+	}, controlledAccountRows)
+}
+
+func TestPreprocessBlock_ContractCodes(t *testing.T) {
+	blk := newBlock("0000003a", []string{"1", "2"})
+	blk.TransactionTraces[0].ActionTraces = []*pbcodec.ActionTrace{
+		newSetCodeActionTrace("token", "0061736d01"),
+		newSetCodeActionTrace("msig", "0061736d02"),
+	}
+
+	blk.TransactionTraces[1].ActionTraces = []*pbcodec.ActionTrace{
+		newSetCodeActionTrace("token", "0061736d03"),
+		newSetCodeActionTrace("msig", ""),
+	}
+
+	bstreamBlock, err := codec.BlockFromProto(blk)
+	require.NoError(t, err)
+	req, err := PreprocessBlock(bstreamBlock)
+	require.NoError(t, err)
+
+	contractCodeRows := req.(*WriteRequest).ContractCodes
+	sort.Slice(contractCodeRows, func(i, j int) bool {
+		return contractCodeRows[i].Account < contractCodeRows[j].Account
+	})
+
+	assert.Equal(t, []*ContractCodeRow{
+		{Account: N("msig"), VMVersion: 1, Code: []byte{}, Deletion: true},
+		{Account: N("token"), VMVersion: 1, Code: []byte{0x00, 0x61, 0x73, 0x6d, 0x03}},
+	}, contractCodeRows)
+}
+
+func newSetCodeActionTrace(account string, hexCode string) *pbcodec.ActionTrace {
+	return &pbcodec.ActionTrace{
+		Receiver: "eosio",
+		Action: &pbcodec.Action{
+			Account:  "eosio",
+			Name:     "setcode",
+			JsonData: fmt.Sprintf(`{"account":"%s","vmtype":0,"vmversion":1,"code":"%s"}`, account, hexCode),
+		},
+	}
+}
+
 func newBlock(blockID string, trxIDs []string) *pbcodec.Block {
 	traces := make([]*pbcodec.TransactionTrace, len(trxIDs))
 	for i, trxID := range trxIDs {
@@ -294,3 +366,21 @@ func newPermOpData(account string, permission string, publicKeys []string) *pbco
 		},
 	}
 }
+
+func newAccountsPermOpData(account string, permission string, controllingAccounts []string) *pbcodec.PermissionObject {
+	authAccounts := make([]*pbcodec.PermissionLevelWeight, len(controllingAccounts))
+	for i, controllingAccount := range controllingAccounts {
+		authAccounts[i] = &pbcodec.PermissionLevelWeight{
+			Permission: &pbcodec.PermissionLevel{Actor: controllingAccount, Permission: "active"},
+			Weight:     1,
+		}
+	}
+
+	return &pbcodec.PermissionObject{
+		Owner: account,
+		Name:  permission,
+		Authority: &pbcodec.Authority{
+			Accounts: authAccounts,
+		},
+	}
+}
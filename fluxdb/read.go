@@ -16,6 +16,7 @@ package fluxdb
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"math"
@@ -84,7 +85,7 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading state table", zap.Reflect("request", r))
 
-	rowData := make(map[string]*TableRow)
+	rowData := make(map[uint64]*TableRow)
 	rowUpdated := func(blockNum uint32, primaryKey string, value []byte) error {
 		if len(value) < 8 {
 			return errors.New("table data index mappings should contain at least the payer")
@@ -96,18 +97,21 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 			return derr.Wrap(err, "unable to transform table data primary key to uint64")
 		}
 
-		rowData[primaryKey] = &TableRow{tableDataPrimaryKey, payer, value[8:], blockNum}
+		rowData[tableDataPrimaryKey] = &TableRow{tableDataPrimaryKey, payer, value[8:], blockNum}
 
 		return nil
 	}
 
-	rowDeleted := func(_ uint32, primaryKey string) error {
-		delete(rowData, primaryKey)
-		return nil
+	// Speculative deletions can remove rows read from the database, so as many more rows
+	// are read, plus one to know the key following the last row returned.
+	readLimit := r.Limit
+	if readLimit > 0 {
+		readLimit += uint32(speculativeTableDataDeletionCount(r)) + 1
 	}
 
 	tableKey := r.tableKey()
-	err = fdb.read(ctx, tableKey, r.BlockNum, rowUpdated, rowDeleted)
+	lowerKey, upperKey := r.primaryKeyBounds()
+	truncated, err := fdb.readKeyRange(ctx, tableKey, r.BlockNum, lowerKey, upperKey, readLimit, r.Reverse, rowUpdated)
 	if err != nil {
 		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
 	}
@@ -117,6 +121,20 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 		return nil, err
 	}
 
+	// When the read was truncated, the rows past the last one read are unknown, so speculative
+	// writes past it are ignored.
+	lastReadKey, hasLastReadKey := lastTableRowKey(rowData, r.Reverse)
+	pastLastRead := func(key uint64) bool {
+		if !truncated || !hasLastReadKey {
+			return false
+		}
+
+		if r.Reverse {
+			return key < lastReadKey
+		}
+		return key > lastReadKey
+	}
+
 	zlog.Debug("handling speculative writes", zap.Int("write_count", len(r.SpeculativeWrites)))
 	for _, blockWrite := range r.SpeculativeWrites {
 		for _, row := range blockWrite.TableDatas {
@@ -124,12 +142,14 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 				continue
 			}
 
-			stringPrimaryKey := fmt.Sprintf("%016x", row.PrimKey)
+			if !r.contains(row.PrimKey) || pastLastRead(row.PrimKey) {
+				continue
+			}
 
 			if row.Deletion {
-				delete(rowData, stringPrimaryKey)
+				delete(rowData, row.PrimKey)
 			} else {
-				rowData[stringPrimaryKey] = &TableRow{
+				rowData[row.PrimKey] = &TableRow{
 					Key:      row.PrimKey,
 					Payer:    row.Payer,
 					Data:     row.Data,
@@ -146,12 +166,74 @@ func (fdb *FluxDB) ReadTable(ctx context.Context, r *ReadTableRequest) (resp *Re
 	}
 
 	zlog.Debug("sorting table rows")
-	sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+	sort.Slice(rows, func(i, j int) bool {
+		if r.Reverse {
+			return rows[i].Key > rows[j].Key
+		}
+		return rows[i].Key < rows[j].Key
+	})
 
-	return &ReadTableResponse{
-		ABI:  abi,
-		Rows: rows,
-	}, nil
+	resp = &ReadTableResponse{ABI: abi, Rows: rows}
+	if r.Limit > 0 && uint32(len(rows)) > r.Limit {
+		resp.Rows = rows[:r.Limit]
+		resp.NextKey = &rows[r.Limit].Key
+	}
+
+	return resp, nil
+}
+
+func speculativeTableDataDeletionCount(r *ReadTableRequest) (count int) {
+	for _, blockWrite := range r.SpeculativeWrites {
+		for _, row := range blockWrite.TableDatas {
+			if row.Deletion && r.Account == row.Account && r.Scope == row.Scope && r.Table == row.Table {
+				count++
+			}
+		}
+	}
+
+	return
+}
+
+// lastTableRowKey returns the greatest key of the rows, the smallest one when
+// `reverse` is true.
+func lastTableRowKey(rows map[uint64]*TableRow, reverse bool) (last uint64, found bool) {
+	for key := range rows {
+		if !found || (reverse && key < last) || (!reverse && key > last) {
+			last, found = key, true
+		}
+	}
+
+	return
+}
+
+// CountTableRows returns the number of rows of the table at `blockNum`.
+func (fdb *FluxDB) CountTableRows(ctx context.Context, r *ReadTableRequest) (count uint32, err error) {
+	tableKey := r.tableKey()
+	keys, _, _, err := fdb.readKeys(ctx, tableKey, r.BlockNum, "", "")
+	if err != nil {
+		return 0, derr.Wrapf(err, "unable to read keys for table key %q", tableKey)
+	}
+
+	present := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		present[key] = true
+	}
+
+	for _, blockWrite := range r.SpeculativeWrites {
+		for _, row := range blockWrite.TableDatas {
+			if r.Account == row.Account && r.Scope == row.Scope && r.Table == row.Table {
+				present[fmt.Sprintf("%016x", row.PrimKey)] = !row.Deletion
+			}
+		}
+	}
+
+	for _, isPresent := range present {
+		if isPresent {
+			count++
+		}
+	}
+
+	return count, nil
 }
 
 func (fdb *FluxDB) ReadTableRow(ctx context.Context, r *ReadTableRowRequest) (resp *ReadTableRowResponse, err error) {
@@ -220,9 +302,12 @@ func (fdb *FluxDB) ReadTableRow(ctx context.Context, r *ReadTableRowRequest) (re
 	// 	return nil, DataRowNotFoundError(ctx, eos.AccountName(eos.NameToString(r.Account)), eos.TableName(eos.NameToString(r.Table)), eos.NameToString(r.PrimaryKey))
 	// }
 
-	abi, err := fdb.GetABI(ctx, r.BlockNum, r.Account, r.SpeculativeWrites)
-	if err != nil {
-		return nil, err
+	abi := r.ABI
+	if abi == nil {
+		abi, err = fdb.GetABI(ctx, r.BlockNum, r.Account, r.SpeculativeWrites)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &ReadTableRowResponse{
@@ -312,6 +397,80 @@ func (fdb *FluxDB) ReadKeyAccounts(
 	return accountNames, nil
 }
 
+func (fdb *FluxDB) ReadControlledAccounts(
+	ctx context.Context,
+	blockNum uint32,
+	controllingAccount eos.AccountName,
+	speculativeWrites []*WriteRequest,
+) (accountNames []eos.AccountName, err error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("reading controlled accounts",
+		zap.String("controlling_account", string(controllingAccount)),
+		zap.Uint32("block_num", blockNum),
+	)
+
+	rows := map[string]interface{}{}
+	rowUpdated := func(_ uint32, primaryKey string, _ []byte) error {
+		rows[primaryKey] = nil
+		return nil
+	}
+
+	rowDeleted := func(_ uint32, primaryKey string) error {
+		delete(rows, primaryKey)
+		return nil
+	}
+
+	controllingAccountName := N(string(controllingAccount))
+
+	tableKey := fmt.Sprintf("ca:%016x", controllingAccountName)
+	err = fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
+	}
+
+	zlogger.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		for _, controlledAccountRow := range blockWrite.ControlledAccounts {
+			if controlledAccountRow.ControllingAccount != controllingAccountName {
+				continue
+			}
+
+			zlogger.Debug("updating controlled account", zap.Reflect("controlled_account_row", controlledAccountRow))
+			stringPrimaryKey := fmt.Sprintf("%016x:%016x", controlledAccountRow.Account, controlledAccountRow.Permission)
+
+			if controlledAccountRow.Deletion {
+				delete(rows, stringPrimaryKey)
+			} else {
+				rows[stringPrimaryKey] = nil
+			}
+		}
+	}
+
+	zlogger.Debug("post-processing controlled accounts", zap.Int("controlled_account_count", len(rows)))
+	buffer := make([]byte, indexPrimaryKeyByteCountByTableKey("ca:"))
+
+	accountNameSet := map[string]bool{}
+	for primaryKey := range rows {
+		err := controlledAccountIndexPrimaryKeyWriter(primaryKey, buffer)
+		if err != nil {
+			return nil, derr.Wrapf(err, "unable to transform controlled account primary key %s", primaryKey)
+		}
+
+		accountNameSet[eos.NameToString(big.Uint64(buffer))] = true
+	}
+
+	for account := range accountNameSet {
+		accountNames = append(accountNames, eos.AccountName(account))
+	}
+
+	zlogger.Debug("sorting controlled accounts")
+	sort.Slice(accountNames, func(i, j int) bool {
+		return accountNames[i] < accountNames[j]
+	})
+
+	return accountNames, nil
+}
+
 func (fdb *FluxDB) ReadLinkedPermissions(ctx context.Context, blockNum uint32, account eos.AccountName, speculativeWrites []*WriteRequest) (resp []*LinkedPermission, err error) {
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading linked permissions", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
@@ -395,6 +554,220 @@ func (fdb *FluxDB) HasSeenTableOnce(
 	return fdb.hasRowKeyPrefix(ctx, fmt.Sprintf("ts:%016x:%016x", N(string(account)), N(string(table))))
 }
 
+// ReadContractCode returns the code deployed on `account` at `blockNum`, or
+// `nil` if the account has no code at this block height. The actual code is
+// only included when `withCode` is set, the hash is always present.
+func (fdb *FluxDB) ReadContractCode(
+	ctx context.Context,
+	blockNum uint32,
+	account eos.AccountName,
+	withCode bool,
+	speculativeWrites []*WriteRequest,
+) (out *ContractCode, err error) {
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("reading contract code", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
+
+	accountName := N(string(account))
+	rowUpdated := func(rowBlockNum uint32, _ string, value []byte) (err error) {
+		out, err = decodeContractCode(accountName, rowBlockNum, value)
+		return err
+	}
+
+	rowDeleted := func(_ uint32, _ string) error {
+		out = nil
+		return nil
+	}
+
+	codeRow := &ContractCodeRow{Account: accountName}
+	tableKey := codeRow.tableKey()
+	err = fdb.readSingle(ctx, tableKey, codeRow.primKey(), blockNum, rowUpdated, rowDeleted)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to read single row for table key %q", tableKey)
+	}
+
+	zlogger.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.ContractCodes {
+			if row.Account != accountName {
+				continue
+			}
+
+			if row.Deletion {
+				out = nil
+				continue
+			}
+
+			out = speculativeContractCode(blockWrite.BlockNum, row)
+		}
+	}
+
+	if out == nil {
+		return nil, nil
+	}
+
+	if err := fdb.loadContractCodes(ctx, []*ContractCode{out}, withCode); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ReadContractCodeDeployments returns every code deployment that happened on
+// `account` up to `blockNum` inclusively, in chronological order. A code
+// removal is reported as a deployment without a hash.
+func (fdb *FluxDB) ReadContractCodeDeployments(
+	ctx context.Context,
+	blockNum uint32,
+	account eos.AccountName,
+	withCode bool,
+	speculativeWrites []*WriteRequest,
+) (out []*ContractCode, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "read contract code deployments", "account", account, "block_num", blockNum)
+	defer span.End()
+
+	zlogger := logging.Logger(ctx, zlog)
+	zlogger.Debug("reading contract code deployments", zap.String("account", string(account)), zap.Uint32("block_num", blockNum))
+
+	accountName := N(string(account))
+	tableKey := (&ContractCodeRow{Account: accountName}).tableKey()
+
+	// Deployments are never compacted away by indexes, so scanning the raw rows gives the full history
+	lastBlockNum := blockNum
+	if lastBlockNum < math.MaxUint32 {
+		lastBlockNum++
+	}
+
+	firstRowKey := tableKey + ":00000000"
+	lastRowKey := tableKey + ":" + HexBlockNum(lastBlockNum)
+
+	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
+		_, rowBlockNum, _, err := explodeWritableRowKey(rowKey)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+		}
+
+		if len(value) == 0 {
+			out = append(out, &ContractCode{Account: accountName, BlockNum: rowBlockNum})
+			return nil
+		}
+
+		code, err := decodeContractCode(accountName, rowBlockNum, value)
+		if err != nil {
+			return err
+		}
+
+		out = append(out, code)
+		return nil
+	})
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to scan rows for table key %q", tableKey)
+	}
+
+	zlogger.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
+	for _, blockWrite := range speculativeWrites {
+		for _, row := range blockWrite.ContractCodes {
+			if row.Account != accountName {
+				continue
+			}
+
+			if row.Deletion {
+				out = append(out, &ContractCode{Account: accountName, BlockNum: blockWrite.BlockNum})
+				continue
+			}
+
+			out = append(out, speculativeContractCode(blockWrite.BlockNum, row))
+		}
+	}
+
+	if err := fdb.loadContractCodes(ctx, out, withCode); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// loadContractCodes fetches, when `withCode` is set, the code of the deployments
+// not having it yet, once per hash, and clears it otherwise.
+func (fdb *FluxDB) loadContractCodes(ctx context.Context, codes []*ContractCode, withCode bool) error {
+	if !withCode {
+		for _, code := range codes {
+			code.Code = nil
+		}
+
+		return nil
+	}
+
+	codeByKey := map[string][]byte{}
+	var keys []string
+	for _, code := range codes {
+		if len(code.Hash) == 0 || code.Code != nil {
+			continue
+		}
+
+		key := contractCodeKey(code.Hash)
+		if _, seen := codeByKey[key]; !seen {
+			codeByKey[key] = nil
+			keys = append(keys, key)
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	err := fdb.store.FetchTabletRows(ctx, keys, func(key string, value []byte) error {
+		codeByKey[key] = value
+		return nil
+	})
+	if err != nil {
+		return derr.Wrap(err, "unable to fetch contract codes")
+	}
+
+	for _, code := range codes {
+		if len(code.Hash) == 0 || code.Code != nil {
+			continue
+		}
+
+		code.Code = codeByKey[contractCodeKey(code.Hash)]
+		if code.Code == nil {
+			return fmt.Errorf("code with hash %x deployed on %q at block %d not found", code.Hash, NameToString(code.Account), code.BlockNum)
+		}
+	}
+
+	return nil
+}
+
+// speculativeContractCode returns the code deployed by a speculative write,
+// which still carries the code itself.
+func speculativeContractCode(blockNum uint32, row *ContractCodeRow) *ContractCode {
+	return &ContractCode{
+		Account:   row.Account,
+		BlockNum:  blockNum,
+		VMType:    row.VMType,
+		VMVersion: row.VMVersion,
+		Hash:      row.hash(),
+		Code:      row.Code,
+	}
+}
+
+// decodeContractCode decodes a contract code row, the code itself is fetched
+// separately by hash (see `loadContractCodes`).
+func decodeContractCode(account uint64, blockNum uint32, value []byte) (*ContractCode, error) {
+	if len(value) < 2+sha256.Size {
+		return nil, fmt.Errorf("contract code row should contain at least vm type, vm version and code hash, got %d bytes", len(value))
+	}
+
+	out := &ContractCode{
+		Account:   account,
+		BlockNum:  blockNum,
+		VMType:    value[0],
+		VMVersion: value[1],
+		Hash:      value[2 : 2+sha256.Size],
+	}
+
+	return out, nil
+}
+
 func (fdb *FluxDB) ReadTableScopes(
 	ctx context.Context,
 	blockNum uint32,
@@ -402,69 +775,131 @@ func (fdb *FluxDB) ReadTableScopes(
 	table eos.TableName,
 	speculativeWrites []*WriteRequest,
 ) (scopes []eos.Name, err error) {
+	rows, err := fdb.ReadTableScopeRows(ctx, blockNum, account, table, KeyRange{}, speculativeWrites)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes = make([]eos.Name, len(rows))
+	for i, row := range rows {
+		scopes[i] = eos.Name(eos.NameToString(row.Scope))
+	}
+
+	return scopes, nil
+}
+
+// ReadTableScopeRows returns the scopes of the table within `keyRange` along
+// with their payer, sorted by scope, in reverse order when `keyRange.Reverse`
+// is true. When limited, the scope following the last one in range is kept, so
+// callers know where the next page starts.
+func (fdb *FluxDB) ReadTableScopeRows(
+	ctx context.Context,
+	blockNum uint32,
+	account eos.AccountName,
+	table eos.TableName,
+	keyRange KeyRange,
+	speculativeWrites []*WriteRequest,
+) (out []*TableScopeRow, err error) {
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading table scopes",
 		zap.String("account", string(account)),
 		zap.String("table", string(table)),
 		zap.Uint32("block_num", blockNum),
+		zap.Reflect("key_range", keyRange),
 	)
 
-	rows := map[string]interface{}{}
-	rowUpdated := func(_ uint32, primaryKey string, _ []byte) error {
-		rows[primaryKey] = nil
-		return nil
-	}
+	payers := map[string]uint64{}
+	rowUpdated := func(_ uint32, primaryKey string, value []byte) error {
+		if len(value) < 8 {
+			return fmt.Errorf("table scope row %q should contain at least 8 bytes, got %d", primaryKey, len(value))
+		}
 
-	rowDeleted := func(_ uint32, primaryKey string) error {
-		delete(rows, primaryKey)
+		payers[primaryKey] = big.Uint64(value)
 		return nil
 	}
 
 	accountName := N(string(account))
 	tableName := N(string(table))
 
+	// Like for table rows, speculative deletions can remove scopes read from the database
+	var readLimit uint32
+	if keyRange.Limit > 0 {
+		readLimit = keyRange.Limit + 1
+		for _, blockWrite := range speculativeWrites {
+			for _, tableScopeRow := range blockWrite.TableScopes {
+				if tableScopeRow.Deletion && tableScopeRow.Account == accountName && tableScopeRow.Table == tableName {
+					readLimit++
+				}
+			}
+		}
+	}
+
 	tableKey := fmt.Sprintf("ts:%016x:%016x", accountName, tableName)
-	err = fdb.read(ctx, tableKey, blockNum, rowUpdated, rowDeleted)
+	lowerKey, upperKey := keyRange.primaryKeyBounds()
+	truncated, err := fdb.readKeyRange(ctx, tableKey, blockNum, lowerKey, upperKey, readLimit, keyRange.Reverse, rowUpdated)
 	if err != nil {
 		return nil, derr.Wrapf(err, "unable to read rows for table key %q", tableKey)
 	}
 
+	// Scopes are 16 hex characters primary keys, so they compare like their value
+	var lastReadKey string
+	for primaryKey := range payers {
+		if lastReadKey == "" || (keyRange.Reverse && primaryKey < lastReadKey) || (!keyRange.Reverse && primaryKey > lastReadKey) {
+			lastReadKey = primaryKey
+		}
+	}
+
 	zlog.Debug("handling speculative writes", zap.Int("write_count", len(speculativeWrites)))
 	for _, blockWrite := range speculativeWrites {
 		for _, tableScopeRow := range blockWrite.TableScopes {
-			if tableScopeRow.Account != accountName || tableScopeRow.Table != tableName {
+			if tableScopeRow.Account != accountName || tableScopeRow.Table != tableName || !keyRange.contains(tableScopeRow.Scope) {
 				continue
 			}
 
-			zlog.Debug("updating table scope", zap.Reflect("table_scope_row", tableScopeRow))
 			stringPrimaryKey := fmt.Sprintf("%016x", tableScopeRow.Scope)
+			if truncated && lastReadKey != "" && ((keyRange.Reverse && stringPrimaryKey < lastReadKey) || (!keyRange.Reverse && stringPrimaryKey > lastReadKey)) {
+				continue
+			}
 
+			zlog.Debug("updating table scope", zap.Reflect("table_scope_row", tableScopeRow))
 			if tableScopeRow.Deletion {
-				delete(rows, stringPrimaryKey)
+				delete(payers, stringPrimaryKey)
 			} else {
-				rows[stringPrimaryKey] = nil
+				payers[stringPrimaryKey] = tableScopeRow.Payer
 			}
 		}
 	}
 
-	zlog.Debug("post-processing table scopes", zap.Int("table_scope_count", len(rows)))
+	zlog.Debug("post-processing table scopes", zap.Int("table_scope_count", len(payers)))
 	buffer := make([]byte, indexPrimaryKeyByteCountByTableKey("ts:"))
 
-	for primaryKey := range rows {
+	for primaryKey, payer := range payers {
 		err := tableScopeIndexPrimaryKeyWriter(primaryKey, buffer)
 		if err != nil {
 			return nil, derr.Wrap(err, "unable to transform table scope primary key")
 		}
 
-		scopes = append(scopes, eos.Name(eos.NameToString(big.Uint64(buffer))))
+		out = append(out, &TableScopeRow{
+			Account: accountName,
+			Scope:   big.Uint64(buffer),
+			Table:   tableName,
+			Payer:   payer,
+		})
 	}
 
 	zlog.Debug("sorting table scopes")
-	sort.Slice(scopes, func(i, j int) bool {
-		return scopes[i] < scopes[j]
+	sort.Slice(out, func(i, j int) bool {
+		if keyRange.Reverse {
+			return out[i].Scope > out[j].Scope
+		}
+		return out[i].Scope < out[j].Scope
 	})
 
-	return scopes, nil
+	if keyRange.Limit > 0 && uint32(len(out)) > keyRange.Limit+1 {
+		out = out[:keyRange.Limit+1]
+	}
+
+	return out, nil
 }
 
 func (fdb *FluxDB) hasRowKeyPrefix(ctx context.Context, keyPrefix string) (exists bool, err error) {
@@ -600,6 +1035,174 @@ func (fdb *FluxDB) read(
 	return nil
 }
 
+// readKeyRange reads the rows of the table at `blockNum` whose primary key is
+// between `lowerKey` and `upperKey`, both inclusive and unbounded when empty,
+// stopping after the first `limit` ones in primary key order, or reverse order
+// when `reverse` is true, 0 meaning no limit. Unlike `read`, only the indexed
+// rows selected are fetched, and `rowUpdated` is called once per row, in order.
+// The returned `truncated` is true when rows were left out by `limit`.
+func (fdb *FluxDB) readKeyRange(
+	ctx context.Context,
+	tableKey string,
+	blockNum uint32,
+	lowerKey, upperKey string,
+	limit uint32,
+	reverse bool,
+	rowUpdated func(blockNum uint32, primaryKey string, value []byte) error,
+) (truncated bool, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "read table range", "table_key", tableKey, "block_num", blockNum)
+	defer span.End()
+
+	keys, idx, liveRows, err := fdb.readKeys(ctx, tableKey, blockNum, lowerKey, upperKey)
+	if err != nil {
+		return false, err
+	}
+
+	if reverse {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+
+	if limit > 0 && uint32(len(keys)) > limit {
+		keys = keys[:limit]
+		truncated = true
+	}
+
+	var indexedRowKeys []string
+	for _, primaryKey := range keys {
+		if _, found := liveRows[primaryKey]; !found {
+			indexedRowKeys = append(indexedRowKeys, fmt.Sprintf("%s:%08x:%s", tableKey, idx.Map[primaryKey], primaryKey))
+		}
+	}
+
+	indexedRows := make(map[string]*liveRow, len(indexedRowKeys))
+	err = fdb.fetchIndexedRows(ctx, indexedRowKeys, func(rowBlockNum uint32, primaryKey string, value []byte) error {
+		indexedRows[primaryKey] = &liveRow{rowBlockNum, value}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	for _, primaryKey := range keys {
+		row := liveRows[primaryKey]
+		if row == nil {
+			row = indexedRows[primaryKey]
+		}
+
+		if row == nil {
+			return false, fmt.Errorf("reading indexed key %q yielded no row", primaryKey)
+		}
+
+		if err := rowUpdated(row.blockNum, primaryKey, row.value); err != nil {
+			return false, derr.Wrapf(err, "rowUpdated callback failed for primary key %q", primaryKey)
+		}
+	}
+
+	return truncated, nil
+}
+
+// liveRow is the last write of a row, `value` being empty when it was deleted.
+type liveRow struct {
+	blockNum uint32
+	value    []byte
+}
+
+// readKeys returns, sorted, the primary keys of the rows of the table at `blockNum`
+// that are between `lowerKey` and `upperKey` (see `readKeyRange`), along with the
+// table index used and the rows in range written since it. Only the keys of the
+// indexed rows are known, their value is not fetched.
+func (fdb *FluxDB) readKeys(
+	ctx context.Context,
+	tableKey string,
+	blockNum uint32,
+	lowerKey, upperKey string,
+) (keys []string, idx *TableIndex, liveRows map[string]*liveRow, err error) {
+	zlog := logging.Logger(ctx, zlog)
+	zlog.Debug("reading keys from database", zap.String("table_key", tableKey), zap.Uint32("block_num", blockNum), zap.String("lower_key", lowerKey), zap.String("upper_key", upperKey))
+
+	inRange := func(primaryKey string) bool {
+		return (lowerKey == "" || primaryKey >= lowerKey) && (upperKey == "" || primaryKey <= upperKey)
+	}
+
+	idx, err = fdb.getIndex(ctx, tableKey, blockNum)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	firstRowKey := tableKey + ":00000000"
+	lastRowKey := tableKey + ":" + HexBlockNum(blockNum+1)
+	if idx != nil {
+		firstRowKey = tableKey + ":" + HexBlockNum(idx.AtBlockNum+1)
+	}
+
+	liveRows = map[string]*liveRow{}
+	err = fdb.store.ScanTabletRows(ctx, firstRowKey, lastRowKey, func(rowKey string, value []byte) error {
+		_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
+		if err != nil {
+			return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+		}
+
+		if inRange(primaryKey) {
+			liveRows[primaryKey] = &liveRow{rowBlockNum, value}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if idx != nil {
+		for primaryKey := range idx.Map {
+			if _, written := liveRows[primaryKey]; !written && inRange(primaryKey) {
+				keys = append(keys, primaryKey)
+			}
+		}
+	}
+
+	for primaryKey, row := range liveRows {
+		if len(row.value) > 0 {
+			keys = append(keys, primaryKey)
+		}
+	}
+
+	sort.Strings(keys)
+
+	zlog.Debug("finished reading keys from database", zap.Int("key_count", len(keys)), zap.Int("live_row_count", len(liveRows)))
+	return keys, idx, liveRows, nil
+}
+
+// fetchIndexedRows fetches the indexed rows keys, in chunks so the requests
+// don't blow up the store limits.
+func (fdb *FluxDB) fetchIndexedRows(ctx context.Context, rowKeys []string, onRow func(blockNum uint32, primaryKey string, value []byte) error) error {
+	chunkSize := 5000
+	for chunkStart := 0; chunkStart < len(rowKeys); chunkStart += chunkSize {
+		chunkEnd := chunkStart + chunkSize
+		if chunkEnd > len(rowKeys) {
+			chunkEnd = len(rowKeys)
+		}
+
+		err := fdb.store.FetchTabletRows(ctx, rowKeys[chunkStart:chunkEnd], func(rowKey string, value []byte) error {
+			if len(value) == 0 {
+				return fmt.Errorf("indexes mappings should not contain empty data, empty rows don't make sense in an index, row %s", rowKey)
+			}
+
+			_, rowBlockNum, primaryKey, err := explodeWritableRowKey(rowKey)
+			if err != nil {
+				return fmt.Errorf("couldn't parse row key %q: %w", rowKey, err)
+			}
+
+			return onRow(rowBlockNum, primaryKey, value)
+		})
+		if err != nil {
+			return derr.Wrap(err, "reading keys chunks")
+		}
+	}
+
+	return nil
+}
+
 var errRowNotFound = errors.New("row not found")
 
 func (fdb *FluxDB) readSingle(
@@ -35,7 +35,6 @@ func TestReadWithSpeculative(t *testing.T) {
 	scope := uint64(1)
 	table := uint64(2)
 	key := uint64(3)
-	offset, limit := uint32(0), uint32(0)
 
 	executeWriteRequests(t, db, writeEmptyABI(blockNum, account))
 
@@ -44,13 +43,84 @@ func TestReadWithSpeculative(t *testing.T) {
 	)
 
 	resp, err := db.ReadTable(context.Background(), &ReadTableRequest{
-		account, scope, table, &key, 123, &offset, &limit, speculativeWrites,
+		Account:           account,
+		Scope:             scope,
+		Table:             table,
+		BlockNum:          123,
+		SpeculativeWrites: speculativeWrites,
 	})
 
 	require.NoError(t, err)
 	require.Len(t, resp.Rows, 0)
 }
 
+func TestReadTableKeyRange(t *testing.T) {
+	db, closer := NewTestDB(t)
+	defer closer()
+
+	account, scope, table := uint64(0), uint64(1), uint64(2)
+	row := func(key uint64, deletion bool) *TableDataRow {
+		return &TableDataRow{account, scope, table, key, 5, deletion, []byte{byte(key)}}
+	}
+
+	executeWriteRequests(t, db,
+		writeEmptyABI(1, account),
+		tableDataRows(2, row(1, false), row(2, false), row(3, false), row(4, false), row(5, false)),
+	)
+
+	// Rows 1 to 5 are indexed at block 2, then row 2 is deleted and row 6 inserted
+	request := &ReadTableRequest{Account: account, Scope: scope, Table: table}
+	db.idxCache.ScheduleIndex(request.tableKey(), 2)
+	require.NoError(t, db.IndexTables(context.Background()))
+
+	executeWriteRequests(t, db, tableDataRows(3, row(2, true), row(6, false)))
+
+	bound := func(value uint64) *uint64 { return &value }
+	tests := []struct {
+		name              string
+		keyRange          KeyRange
+		speculativeWrites []*WriteRequest
+		expectedKeys      []uint64
+		expectedNextKey   *uint64
+	}{
+		{"all rows", KeyRange{}, nil, []uint64{1, 3, 4, 5, 6}, nil},
+		{"bounded", KeyRange{LowerBound: bound(2), UpperBound: bound(5)}, nil, []uint64{3, 4, 5}, nil},
+		{"limited", KeyRange{Limit: 2}, nil, []uint64{1, 3}, bound(4)},
+		{"limit matches count", KeyRange{Limit: 5}, nil, []uint64{1, 3, 4, 5, 6}, nil},
+		{"reverse limited", KeyRange{UpperBound: bound(5), Limit: 2, Reverse: true}, nil, []uint64{5, 4}, bound(3)},
+		{"speculative deletion", KeyRange{Limit: 2}, writeRequests(tableDataRows(4, row(1, true))), []uint64{3, 4}, bound(5)},
+		{"speculative insertion", KeyRange{Limit: 2}, writeRequests(tableDataRows(4, row(2, false))), []uint64{1, 2}, bound(3)},
+		{"speculative insertion past limit", KeyRange{Limit: 2}, writeRequests(tableDataRows(4, row(7, false))), []uint64{1, 3}, bound(4)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := db.ReadTable(context.Background(), &ReadTableRequest{
+				Account:           account,
+				Scope:             scope,
+				Table:             table,
+				BlockNum:          3,
+				KeyRange:          test.keyRange,
+				SpeculativeWrites: test.speculativeWrites,
+			})
+			require.NoError(t, err)
+
+			var keys []uint64
+			for _, row := range resp.Rows {
+				keys = append(keys, row.Key)
+				assert.Equal(t, []byte{byte(row.Key)}, row.Data)
+			}
+
+			assert.Equal(t, test.expectedKeys, keys)
+			assert.Equal(t, test.expectedNextKey, resp.NextKey)
+		})
+	}
+
+	count, err := db.CountTableRows(context.Background(), &ReadTableRequest{Account: account, Scope: scope, Table: table, BlockNum: 3})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), count)
+}
+
 func TestReadGetABI(t *testing.T) {
 	acct := N("eosio")
 	traceID := fixedTraceID("00000000000000000000000000000001")
@@ -0,0 +1,689 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+// The handlers of this file mimics the `chain_api_plugin` endpoints of nodeos
+// (`/v1/chain/...`), both in request and response format, so that existing
+// nodeos clients can be pointed to FluxDB as-is. Each request accepts an extra
+// `block_num` field to perform the call at any given block height.
+
+const defaultChainAPILimit = 10
+
+func (srv *EOSServer) chainGetTableRowsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	request := &chainGetTableRowsRequest{}
+	err := extractChainAPIRequest(r, request, validator.Rules{
+		"code":        []string{"required", "fluxdb.eos.name"},
+		"table":       []string{"required", "fluxdb.eos.name"},
+		"scope":       []string{"fluxdb.eos.extendedName"},
+		"encode_type": []string{"in:,dec,hex"},
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	if !request.isPrimaryIndex() {
+		writeError(ctx, w, derr.RequestValidationError(ctx, url.Values{
+			"index_position": []string{"The index_position field must be the primary index, secondary indexes are not supported"},
+		}))
+		return
+	}
+
+	lowerBound, upperBound, boundErrors := request.primaryKeyBounds()
+	if len(boundErrors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, boundErrors))
+		return
+	}
+
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	resp, err := srv.db.ReadTable(ctx, &fluxdb.ReadTableRequest{
+		Account:  fluxdb.N(string(request.Code)),
+		Scope:    fluxdb.EN(request.Scope),
+		Table:    fluxdb.N(string(request.Table)),
+		BlockNum: actualBlockNum,
+		KeyRange: fluxdb.KeyRange{
+			LowerBound: lowerBound,
+			UpperBound: upperBound,
+			Limit:      request.limit(),
+			Reverse:    request.Reverse,
+		},
+		SpeculativeWrites: speculativeWrites,
+	})
+	if err != nil {
+		if isABINotFoundError(err) {
+			writeResponse(ctx, w, &chainGetTableRowsResponse{Rows: []interface{}{}})
+			return
+		}
+
+		writeError(ctx, w, derr.Wrap(err, "read rows failed"))
+		return
+	}
+
+	var abiObj *eos.ABI
+	if err := eos.UnmarshalBinary(resp.ABI.PackedABI, &abiObj); err != nil {
+		writeError(ctx, w, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", resp.ABI.PackedABI))
+		return
+	}
+
+	tableDef := abiObj.TableForName(request.Table)
+	if tableDef == nil {
+		writeError(ctx, w, fluxdb.DataTableNotFoundError(ctx, request.Code, request.Table))
+		return
+	}
+
+	response := &chainGetTableRowsResponse{Rows: make([]interface{}, len(resp.Rows))}
+	for i, row := range resp.Rows {
+		var data interface{} = eos.HexBytes(row.Data)
+		if request.JSON {
+			jsonData, err := abiObj.DecodeTableRowTyped(tableDef.Type, row.Data)
+			if err != nil {
+				writeError(ctx, w, fluxdb.DataDecodingRowError(ctx, hex.EncodeToString(row.Data)))
+				return
+			}
+
+			data = json.RawMessage(jsonData)
+		}
+
+		if request.ShowPayer {
+			data = &chainTableRow{Data: data, Payer: fluxdb.NameToString(row.Payer)}
+		}
+
+		response.Rows[i] = data
+	}
+
+	if resp.NextKey != nil {
+		response.More = true
+		response.NextKey = request.formatKey(*resp.NextKey)
+	}
+
+	zlogger.Debug("writing response", zap.Int("row_count", len(response.Rows)), zap.Bool("more", response.More))
+	writeResponse(ctx, w, response)
+}
+
+// chainGetTableByScopeHandler lists the scopes of a table, or of all the
+// tables of the contract's ABI when no table is given. The table payer comes
+// from the table scope index, while the row count is computed from the keys of
+// the scope's rows.
+func (srv *EOSServer) chainGetTableByScopeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	request := &chainGetTableByScopeRequest{}
+	err := extractChainAPIRequest(r, request, validator.Rules{
+		"code":        []string{"required", "fluxdb.eos.name"},
+		"table":       []string{"fluxdb.eos.name"},
+		"lower_bound": []string{"fluxdb.eos.extendedName"},
+		"upper_bound": []string{"fluxdb.eos.extendedName"},
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	tables := []eos.TableName{request.Table}
+	if request.Table == "" {
+		tables, err = srv.chainABITables(ctx, actualBlockNum, request.Code, speculativeWrites)
+		if err != nil {
+			writeError(ctx, w, err)
+			return
+		}
+	}
+
+	var lowerBound, upperBound *uint64
+	if request.LowerBound != "" {
+		value := fluxdb.EN(request.LowerBound)
+		lowerBound = &value
+	}
+
+	if request.UpperBound != "" {
+		value := fluxdb.EN(request.UpperBound)
+		upperBound = &value
+	}
+
+	// Each table yields at most the first scopes of the page plus the following one, which
+	// is enough to find the page and the next key once merged
+	keyRange := fluxdb.KeyRange{LowerBound: lowerBound, UpperBound: upperBound, Limit: request.limit(), Reverse: request.Reverse}
+
+	var scopeRows []*fluxdb.TableScopeRow
+	for _, table := range tables {
+		rows, err := srv.db.ReadTableScopeRows(ctx, actualBlockNum, request.Code, table, keyRange, speculativeWrites)
+		if err != nil {
+			writeError(ctx, w, derr.Wrapf(err, "read scopes of table %q failed", table))
+			return
+		}
+
+		scopeRows = append(scopeRows, rows...)
+	}
+
+	// Like nodeos, rows are ordered by scope then by table
+	sort.SliceStable(scopeRows, func(i, j int) bool {
+		if scopeRows[i].Scope == scopeRows[j].Scope {
+			return scopeRows[i].Table < scopeRows[j].Table
+		}
+		return scopeRows[i].Scope < scopeRows[j].Scope
+	})
+
+	keys := make([]uint64, len(scopeRows))
+	for i, row := range scopeRows {
+		keys[i] = row.Scope
+	}
+
+	indexes, more, nextKey := paginateChainAPIKeys(keys, lowerBound, upperBound, request.limit(), request.Reverse)
+
+	response := &chainGetTableByScopeResponse{Rows: make([]*chainTableScopeRow, len(indexes))}
+	for i, index := range indexes {
+		row := scopeRows[index]
+		count, err := srv.db.CountTableRows(ctx, &fluxdb.ReadTableRequest{
+			Account:           row.Account,
+			Scope:             row.Scope,
+			Table:             row.Table,
+			BlockNum:          actualBlockNum,
+			SpeculativeWrites: speculativeWrites,
+		})
+		if err != nil {
+			writeError(ctx, w, derr.Wrapf(err, "count rows of scope %q failed", fluxdb.NameToString(row.Scope)))
+			return
+		}
+
+		response.Rows[i] = &chainTableScopeRow{
+			Code:  request.Code,
+			Scope: fluxdb.NameToString(row.Scope),
+			Table: eos.TableName(fluxdb.NameToString(row.Table)),
+			Payer: fluxdb.NameToString(row.Payer),
+			Count: count,
+		}
+	}
+
+	if more {
+		response.More = fluxdb.NameToString(nextKey)
+	}
+
+	zlogger.Debug("writing response", zap.Int("row_count", len(response.Rows)), zap.String("more", response.More))
+	writeResponse(ctx, w, response)
+}
+
+// chainABITables returns the tables of the account's ABI sorted by name, none
+// when the account has no ABI.
+func (srv *EOSServer) chainABITables(ctx context.Context, blockNum uint32, account eos.AccountName, speculativeWrites []*fluxdb.WriteRequest) ([]eos.TableName, error) {
+	abiRow, err := srv.db.GetABI(ctx, blockNum, fluxdb.N(string(account)), speculativeWrites)
+	if err != nil {
+		if isABINotFoundError(err) {
+			return nil, nil
+		}
+
+		return nil, derr.Wrap(err, "fetching ABI from db")
+	}
+
+	var abiObj *eos.ABI
+	if err := eos.UnmarshalBinary(abiRow.PackedABI, &abiObj); err != nil {
+		return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", abiRow.PackedABI)
+	}
+
+	tables := make([]eos.TableName, len(abiObj.Tables))
+	for i, table := range abiObj.Tables {
+		tables[i] = table.Name
+	}
+
+	sort.Slice(tables, func(i, j int) bool { return fluxdb.N(string(tables[i])) < fluxdb.N(string(tables[j])) })
+	return tables, nil
+}
+
+func (srv *EOSServer) chainGetCurrencyBalanceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	request := &chainGetCurrencyBalanceRequest{}
+	err := extractChainAPIRequest(r, request, validator.Rules{
+		"code":    []string{"required", "fluxdb.eos.name"},
+		"account": []string{"required", "fluxdb.eos.name"},
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	// Like nodeos, we assume the standard `eosio.token` layout, where the rows are keyed by symbol code
+	var keyRange fluxdb.KeyRange
+	if request.Symbol != "" {
+		if symbolCode, err := eos.StringToSymbolCode(request.Symbol); err == nil {
+			key := uint64(symbolCode)
+			keyRange = fluxdb.KeyRange{LowerBound: &key, UpperBound: &key}
+		}
+	}
+
+	resp, err := srv.db.ReadTable(ctx, &fluxdb.ReadTableRequest{
+		Account:           fluxdb.N(string(request.Code)),
+		Scope:             fluxdb.N(string(request.Account)),
+		Table:             fluxdb.N("accounts"),
+		BlockNum:          actualBlockNum,
+		KeyRange:          keyRange,
+		SpeculativeWrites: speculativeWrites,
+	})
+	if err != nil {
+		if isABINotFoundError(err) {
+			writeResponse(ctx, w, []eos.Asset{})
+			return
+		}
+
+		writeError(ctx, w, derr.Wrap(err, "read rows failed"))
+		return
+	}
+
+	balances := []eos.Asset{}
+	for _, row := range resp.Rows {
+		// The asset is the first field of the row
+		var balance eos.Asset
+		if err := eos.UnmarshalBinary(row.Data, &balance); err != nil {
+			writeError(ctx, w, fluxdb.DataDecodingRowError(ctx, hex.EncodeToString(row.Data)))
+			return
+		}
+
+		if request.Symbol != "" && balance.Symbol.Symbol != request.Symbol {
+			continue
+		}
+
+		balances = append(balances, balance)
+	}
+
+	writeResponse(ctx, w, balances)
+}
+
+func (srv *EOSServer) chainGetCurrencyStatsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	request := &chainGetCurrencyStatsRequest{}
+	err := extractChainAPIRequest(r, request, validator.Rules{
+		"code":   []string{"required", "fluxdb.eos.name"},
+		"symbol": []string{"required"},
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	symbolCode, err := eos.StringToSymbolCode(strings.ToUpper(request.Symbol))
+	if err != nil {
+		writeError(ctx, w, derr.RequestValidationError(ctx, url.Values{"symbol": []string{"The symbol field must be a valid symbol code"}}))
+		return
+	}
+
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	// The `stat` table holds a single row keyed by the symbol code
+	statKey := uint64(symbolCode)
+	resp, err := srv.db.ReadTable(ctx, &fluxdb.ReadTableRequest{
+		Account:           fluxdb.N(string(request.Code)),
+		Scope:             uint64(symbolCode),
+		Table:             fluxdb.N("stat"),
+		BlockNum:          actualBlockNum,
+		KeyRange:          fluxdb.KeyRange{LowerBound: &statKey, UpperBound: &statKey},
+		SpeculativeWrites: speculativeWrites,
+	})
+	if err != nil {
+		if isABINotFoundError(err) {
+			writeResponse(ctx, w, map[string]*chainCurrencyStats{})
+			return
+		}
+
+		writeError(ctx, w, derr.Wrap(err, "read rows failed"))
+		return
+	}
+
+	stats := map[string]*chainCurrencyStats{}
+	for _, row := range resp.Rows {
+		var stat *chainCurrencyStats
+		if err := eos.UnmarshalBinary(row.Data, &stat); err != nil {
+			writeError(ctx, w, fluxdb.DataDecodingRowError(ctx, hex.EncodeToString(row.Data)))
+			return
+		}
+
+		stats[stat.Supply.Symbol.Symbol] = stat
+	}
+
+	writeResponse(ctx, w, stats)
+}
+
+func (srv *EOSServer) chainGetABIHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	request := &chainGetABIRequest{}
+	err := extractChainAPIRequest(r, request, validator.Rules{
+		"account_name": []string{"required", "fluxdb.eos.name"},
+	})
+	if err != nil {
+		writeError(ctx, w, err)
+		return
+	}
+
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, request.BlockNum, false)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "prepare read failed"))
+		return
+	}
+
+	response := &chainGetABIResponse{AccountName: request.AccountName}
+
+	abiRow, err := srv.db.GetABI(ctx, actualBlockNum, fluxdb.N(string(request.AccountName)), speculativeWrites)
+	if err != nil {
+		// Like nodeos, an account without an ABI is not an error, the `abi` field is simply omitted
+		if !isABINotFoundError(err) {
+			writeError(ctx, w, derr.Wrap(err, "fetching ABI from db"))
+			return
+		}
+	}
+
+	if abiRow != nil {
+		if err := eos.UnmarshalBinary(abiRow.PackedABI, &response.ABI); err != nil {
+			writeError(ctx, w, derr.Wrapf(err, "failed to decode packed ABI %q to JSON", abiRow.PackedABI))
+			return
+		}
+	}
+
+	writeResponse(ctx, w, response)
+}
+
+// paginateChainAPIKeys applies nodeos pagination semantics over keys sorted
+// in ascending order, returning the indexes of the selected ones: both bounds
+// are inclusive, and when more keys are available past `limit`, the next key
+// is returned so it can be used as the bound of the following request.
+func paginateChainAPIKeys(keys []uint64, lowerBound, upperBound *uint64, limit uint32, reverse bool) (out []int, more bool, nextKey uint64) {
+	startIndex, endIndex := 0, len(keys)
+	for startIndex < endIndex && lowerBound != nil && keys[startIndex] < *lowerBound {
+		startIndex++
+	}
+
+	for endIndex > startIndex && upperBound != nil && keys[endIndex-1] > *upperBound {
+		endIndex--
+	}
+
+	for i := 0; i < endIndex-startIndex; i++ {
+		index := startIndex + i
+		if reverse {
+			index = endIndex - 1 - i
+		}
+
+		if uint32(len(out)) >= limit {
+			return out, true, keys[index]
+		}
+
+		out = append(out, index)
+	}
+
+	return out, false, 0
+}
+
+func isABINotFoundError(err error) bool {
+	errResponse, ok := err.(*derr.ErrorResponse)
+	return ok && errResponse.Code == "data_abi_not_found_error"
+}
+
+func extractChainAPIRequest(r *http.Request, request interface{}, rules validator.Rules) error {
+	ctx := r.Context()
+	if r.Body == nil || r.Body == http.NoBody {
+		return derr.MissingBodyError(ctx)
+	}
+
+	requestErrors := validator.ValidateJSONBody(r, request, rules)
+	if len(requestErrors) > 0 {
+		if _, ok := requestErrors["_error"]; ok {
+			return derr.InvalidJSONError(ctx, errors.New(requestErrors["_error"][0]))
+		}
+
+		return derr.RequestValidationError(ctx, requestErrors)
+	}
+
+	return nil
+}
+
+type chainGetTableRowsRequest struct {
+	Code          eos.AccountName `json:"code"`
+	Scope         string          `json:"scope"`
+	Table         eos.TableName   `json:"table"`
+	JSON          bool            `json:"json"`
+	LowerBound    chainAPIBound   `json:"lower_bound"`
+	UpperBound    chainAPIBound   `json:"upper_bound"`
+	Limit         uint32          `json:"limit"`
+	KeyType       string          `json:"key_type"`
+	IndexPosition string          `json:"index_position"`
+	EncodeType    string          `json:"encode_type"`
+	Reverse       bool            `json:"reverse"`
+	ShowPayer     bool            `json:"show_payer"`
+	BlockNum      uint32          `json:"block_num"`
+}
+
+func (r *chainGetTableRowsRequest) limit() uint32 {
+	if r.Limit == 0 {
+		return defaultChainAPILimit
+	}
+
+	return r.Limit
+}
+
+// isPrimaryIndex is true when `index_position` targets the primary index, the
+// only one tracked by FluxDB.
+func (r *chainGetTableRowsRequest) isPrimaryIndex() bool {
+	return r.IndexPosition == "" || r.IndexPosition == "1" || r.IndexPosition == "primary"
+}
+
+func (r *chainGetTableRowsRequest) primaryKeyBounds() (lowerBound, upperBound *uint64, errors url.Values) {
+	errors = url.Values{}
+	parseBound := func(field string, bound chainAPIBound) *uint64 {
+		if bound == "" {
+			return nil
+		}
+
+		value, err := chainAPIPrimaryKey(string(bound))
+		if err != nil {
+			errors[field] = []string{fmt.Sprintf("The %s field could not be converted to a primary key value", field)}
+			return nil
+		}
+
+		return &value
+	}
+
+	return parseBound("lower_bound", r.LowerBound), parseBound("upper_bound", r.UpperBound), errors
+}
+
+func (r *chainGetTableRowsRequest) formatKey(key uint64) string {
+	if r.KeyType == "name" {
+		return eos.NameToString(key)
+	}
+
+	if r.EncodeType == "hex" {
+		return "0x" + strconv.FormatUint(key, 16)
+	}
+
+	return strconv.FormatUint(key, 10)
+}
+
+type chainGetTableRowsResponse struct {
+	Rows    []interface{} `json:"rows"`
+	More    bool          `json:"more"`
+	NextKey string        `json:"next_key"`
+}
+
+type chainTableRow struct {
+	Data  interface{} `json:"data"`
+	Payer string      `json:"payer"`
+}
+
+type chainGetTableByScopeRequest struct {
+	Code       eos.AccountName `json:"code"`
+	Table      eos.TableName   `json:"table"`
+	LowerBound string          `json:"lower_bound"`
+	UpperBound string          `json:"upper_bound"`
+	Limit      uint32          `json:"limit"`
+	Reverse    bool            `json:"reverse"`
+	BlockNum   uint32          `json:"block_num"`
+}
+
+func (r *chainGetTableByScopeRequest) limit() uint32 {
+	if r.Limit == 0 {
+		return defaultChainAPILimit
+	}
+
+	return r.Limit
+}
+
+type chainGetTableByScopeResponse struct {
+	Rows []*chainTableScopeRow `json:"rows"`
+	More string                `json:"more"`
+}
+
+type chainTableScopeRow struct {
+	Code  eos.AccountName `json:"code"`
+	Scope string          `json:"scope"`
+	Table eos.TableName   `json:"table"`
+	Payer string          `json:"payer"`
+	Count uint32          `json:"count"`
+}
+
+type chainGetCurrencyBalanceRequest struct {
+	Code     eos.AccountName `json:"code"`
+	Account  eos.AccountName `json:"account"`
+	Symbol   string          `json:"symbol"`
+	BlockNum uint32          `json:"block_num"`
+}
+
+type chainGetCurrencyStatsRequest struct {
+	Code     eos.AccountName `json:"code"`
+	Symbol   string          `json:"symbol"`
+	BlockNum uint32          `json:"block_num"`
+}
+
+type chainCurrencyStats struct {
+	Supply    eos.Asset       `json:"supply"`
+	MaxSupply eos.Asset       `json:"max_supply"`
+	Issuer    eos.AccountName `json:"issuer"`
+}
+
+type chainGetABIRequest struct {
+	AccountName eos.AccountName `json:"account_name"`
+	BlockNum    uint32          `json:"block_num"`
+}
+
+type chainGetABIResponse struct {
+	AccountName eos.AccountName `json:"account_name"`
+	ABI         *eos.ABI        `json:"abi,omitempty"`
+}
+
+// chainAPIBound is a table bound as sent by nodeos clients, which can be
+// either a JSON string or a JSON number.
+type chainAPIBound string
+
+func (b *chainAPIBound) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var value string
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+
+		*b = chainAPIBound(value)
+		return nil
+	}
+
+	var value json.Number
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	*b = chainAPIBound(value.String())
+	return nil
+}
+
+// chainAPIPrimaryKey converts a bound to a primary key value the same way
+// nodeos does, trying in order a number, an account name, a symbol (`4,EOS`)
+// and finally a symbol code.
+func chainAPIPrimaryKey(in string) (uint64, error) {
+	if value, err := strconv.ParseUint(in, 10, 64); err == nil {
+		return value, nil
+	}
+
+	trimmed := strings.TrimSpace(in)
+	if validator.IsValidName(trimmed) {
+		return eos.StringToName(trimmed)
+	}
+
+	if strings.Contains(in, ",") {
+		symbol, err := eos.StringToSymbol(in)
+		if err == nil {
+			return symbol.ToUint64()
+		}
+	}
+
+	symbolCode, err := eos.StringToSymbolCode(in)
+	if err != nil {
+		return 0, fmt.Errorf("unable to convert %q to a primary key value: %w", in, err)
+	}
+
+	return uint64(symbolCode), nil
+}
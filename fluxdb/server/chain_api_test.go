@@ -0,0 +1,99 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginateChainAPIKeys(t *testing.T) {
+	keys := []uint64{1, 2, 3, 4, 5}
+	bound := func(value uint64) *uint64 { return &value }
+
+	tests := []struct {
+		name            string
+		lowerBound      *uint64
+		upperBound      *uint64
+		limit           uint32
+		reverse         bool
+		expectedKeys    []uint64
+		expectedMore    bool
+		expectedNextKey uint64
+	}{
+		{"all rows", nil, nil, 10, false, []uint64{1, 2, 3, 4, 5}, false, 0},
+		{"limited", nil, nil, 2, false, []uint64{1, 2}, true, 3},
+		{"limit matches count", nil, nil, 5, false, []uint64{1, 2, 3, 4, 5}, false, 0},
+		{"inclusive bounds", bound(2), bound(4), 10, false, []uint64{2, 3, 4}, false, 0},
+		{"bounds not matching keys", bound(0), bound(10), 10, false, []uint64{1, 2, 3, 4, 5}, false, 0},
+		{"lower bound limited", bound(2), nil, 2, false, []uint64{2, 3}, true, 4},
+		{"reverse", nil, nil, 10, true, []uint64{5, 4, 3, 2, 1}, false, 0},
+		{"reverse limited", nil, bound(4), 2, true, []uint64{4, 3}, true, 2},
+		{"empty range", bound(4), bound(2), 10, false, nil, false, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			indexes, more, nextKey := paginateChainAPIKeys(keys, test.lowerBound, test.upperBound, test.limit, test.reverse)
+
+			var selected []uint64
+			for _, index := range indexes {
+				selected = append(selected, keys[index])
+			}
+
+			assert.Equal(t, test.expectedKeys, selected)
+			assert.Equal(t, test.expectedMore, more)
+			assert.Equal(t, test.expectedNextKey, nextKey)
+		})
+	}
+}
+
+func TestChainAPIPrimaryKey(t *testing.T) {
+	tests := []struct {
+		in            string
+		expected      uint64
+		expectedError bool
+	}{
+		{"12", 12, false},
+		{"eosio", 6138663577826885632, false},
+		{"4,EOS", 1397703940, false},
+		{"EOS", 5459781, false},
+		{"not-valid!", 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			actual, err := chainAPIPrimaryKey(test.in)
+			if test.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestChainAPIBound_UnmarshalJSON(t *testing.T) {
+	var request chainGetTableRowsRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"lower_bound":"eosio","upper_bound":18446744073709551615}`), &request))
+
+	assert.Equal(t, chainAPIBound("eosio"), request.LowerBound)
+	assert.Equal(t, chainAPIBound("18446744073709551615"), request.UpperBound)
+}
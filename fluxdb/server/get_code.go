@@ -0,0 +1,140 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) getCodeHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateGetCodeRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetCodeRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	code, err := srv.fetchCode(ctx, request.Account, request.BlockNum, !request.HashOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "fetch code"))
+		return
+	}
+
+	writeResponse(ctx, w, &getCodeResponse{
+		Account:        request.Account,
+		codeDeployment: newCodeDeployment(code),
+	})
+}
+
+func (srv *EOSServer) listCodeDeploymentsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateGetCodeRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractGetCodeRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	codes, actualBlockNum, err := srv.listCodeDeployments(ctx, request.Account, request.BlockNum, !request.HashOnly)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "list code deployments"))
+		return
+	}
+
+	response := &listCodeDeploymentsResponse{
+		BlockNum:    actualBlockNum,
+		Account:     request.Account,
+		Deployments: make([]*codeDeployment, len(codes)),
+	}
+
+	for i, code := range codes {
+		response.Deployments[i] = newCodeDeployment(code)
+	}
+
+	writeResponse(ctx, w, response)
+}
+
+type getCodeRequest struct {
+	Account  eos.AccountName `json:"account"`
+	BlockNum uint32          `json:"block_num"`
+	HashOnly bool            `json:"hash_only"`
+}
+
+type getCodeResponse struct {
+	Account eos.AccountName `json:"account"`
+	*codeDeployment
+}
+
+type listCodeDeploymentsResponse struct {
+	BlockNum    uint32            `json:"block_num"`
+	Account     eos.AccountName   `json:"account"`
+	Deployments []*codeDeployment `json:"deployments"`
+}
+
+// codeDeployment is a contract code as deployed at `BlockNum`, a deployment
+// without a `CodeHash` means the code was cleared from the account.
+type codeDeployment struct {
+	BlockNum  uint32       `json:"block_num"`
+	CodeHash  eos.HexBytes `json:"code_hash,omitempty"`
+	VMType    byte         `json:"vm_type"`
+	VMVersion byte         `json:"vm_version"`
+	Code      eos.HexBytes `json:"code,omitempty"`
+}
+
+func newCodeDeployment(code *fluxdb.ContractCode) *codeDeployment {
+	return &codeDeployment{
+		BlockNum:  code.BlockNum,
+		CodeHash:  eos.HexBytes(code.Hash),
+		VMType:    code.VMType,
+		VMVersion: code.VMVersion,
+		Code:      eos.HexBytes(code.Code),
+	}
+}
+
+func validateGetCodeRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"account":   []string{"required", "fluxdb.eos.name"},
+		"block_num": []string{"fluxdb.eos.blockNum"},
+		"hash_only": []string{"bool"},
+	})
+}
+
+func extractGetCodeRequest(r *http.Request) *getCodeRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &getCodeRequest{
+		Account:  eos.AccountName(r.FormValue("account")),
+		BlockNum: uint32(blockNum64),
+		HashOnly: boolInput(r.FormValue("hash_only")),
+	}
+}
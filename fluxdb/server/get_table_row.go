@@ -53,7 +53,6 @@ func (srv *EOSServer) getTableRowHandler(w http.ResponseWriter, r *http.Request)
 		request.Scope,
 		request.PrimaryKey,
 		request.readRequestCommon,
-		getKeyConverterForType(request.KeyType),
 		speculativeWrites,
 	)
 
@@ -91,7 +90,7 @@ func validateGetTableRowRequest(r *http.Request) url.Values {
 	errors := validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
 		"account":           []string{"required", "fluxdb.eos.name"},
 		"table":             []string{"required", "fluxdb.eos.name"},
-		"scope":             []string{"fluxdb.eos.extendedName"},
+		"scope":             scopeRules(r, false),
 		"primary_key":       []string{"required"},
 		"irreversible_only": []string{"bool"},
 	}))
@@ -103,7 +102,7 @@ func validateGetTableRowRequest(r *http.Request) url.Values {
 
 	// FIXME (MATT): Deal with KeyType here and conversion from key to uint64 to check if conversion if correct
 
-	return errors
+	return validateTypedScopes(r, errors, "scope", []string{r.FormValue("scope")})
 }
 
 func extractGetTableRowRequest(r *http.Request) *getTableRowRequest {
@@ -113,6 +113,104 @@ func (c *HexBEKeyConverter) ToString(key uint64) (string, error) {
 	return hex.EncodeToString(keyBuffer), nil
 }
 
+// abiFieldTypeToKeyType maps the type of a table's primary key field to our
+// own key types. An `asset` field is keyed by its symbol code, like the
+// `accounts` and `stat` tables of `eosio.token`.
+//
+// Numeric types are purposely absent, those tables keep the historical `name`
+// rendering so existing clients are not broken.
+var abiFieldTypeToKeyType = map[string]string{
+	"name":         "name",
+	"account_name": "name",
+	"symbol":       "symbol",
+	"symbol_code":  "symbol_code",
+	"asset":        "symbol_code",
+}
+
+// abiKeyType is the `key_type` value asking for the key type to be inferred
+// from the ABI, it's opt-in so the default `name` rendering of existing
+// clients is kept.
+const abiKeyType = "abi"
+
+// inferKeyType infers the key type of a table from the type of its primary
+// key field when the `abi` key type was requested, falling back to `name`
+// when nothing can be inferred. Any other key type is returned as-is.
+//
+// The primary key field is the one named by the table definition's
+// `key_names` when present, the first field of the table's struct otherwise.
+// Legacy ABIs often list a `key_names` not matching any field (`currency` in
+// `eosio.token`), those are not inferred.
+func inferKeyType(keyType string, abi *eos.ABI, tableDef *eos.TableDef) string {
+	if keyType != abiKeyType {
+		return keyType
+	}
+
+	if tableDef == nil {
+		return "name"
+	}
+
+	field := primaryKeyField(abi, tableDef)
+	if field == nil {
+		return "name"
+	}
+
+	if inferred, found := abiFieldTypeToKeyType[field.Type]; found {
+		return inferred
+	}
+
+	return "name"
+}
+
+func primaryKeyField(abi *eos.ABI, tableDef *eos.TableDef) *eos.FieldDef {
+	fields := structFields(abi, tableDef.Type)
+	if len(tableDef.KeyNames) == 0 {
+		if len(fields) == 0 {
+			return nil
+		}
+
+		return &fields[0]
+	}
+
+	for i, field := range fields {
+		if field.Name == tableDef.KeyNames[0] {
+			return &fields[i]
+		}
+	}
+
+	return nil
+}
+
+// structFields returns the fields of the struct, including the ones of its
+// base structs first, like they are serialized.
+func structFields(abi *eos.ABI, structName string) (out []eos.FieldDef) {
+	// Bounded so a cyclic base chain in a malformed ABI cannot loop forever
+	for depth := 0; depth < 16 && structName != ""; depth++ {
+		structDef := abi.StructForName(structName)
+		if structDef == nil {
+			break
+		}
+
+		out = append(append([]eos.FieldDef{}, structDef.Fields...), out...)
+		structName = structDef.Base
+	}
+
+	return out
+}
+
+// getScopeConverterForType returns the converter of the requested scope type.
+// Unlike keys, scopes are not described by the ABI, contracts choose them
+// freely (account names, symbol codes, arbitrary numbers), so their type
+// cannot be inferred and is either explicit or defaults to extended names.
+func getScopeConverterForType(scopeType string) KeyConverter {
+	keyConverter, exists := keyTypeToKeyConverter[scopeType]
+	if !exists {
+		// The name converter also accepts symbols (`4,EOS`) and symbol codes (`EOS`), like scopes always did
+		keyConverter = keyTypeToKeyConverter["name"]
+	}
+
+	return keyConverter
+}
+
 func getKeyConverterForType(keyType string) KeyConverter {
 	keyConverter, exists := keyTypeToKeyConverter[keyType]
 	if !exists {
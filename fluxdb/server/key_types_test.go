@@ -0,0 +1,151 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	eos "github.com/eoscanada/eos-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tokenABI is the `eosio.token` ABI generated by a recent CDT, without `key_names`
+const tokenABI = `{
+	"version": "eosio::abi/1.1",
+	"structs": [
+		{"name": "account", "base": "", "fields": [{"name": "balance", "type": "asset"}]},
+		{"name": "currency_stats", "base": "", "fields": [{"name": "supply", "type": "asset"}, {"name": "max_supply", "type": "asset"}, {"name": "issuer", "type": "name"}]}
+	],
+	"tables": [
+		{"name": "accounts", "type": "account", "index_type": "i64", "key_names": [], "key_types": []},
+		{"name": "stat", "type": "currency_stats", "index_type": "i64", "key_names": [], "key_types": []}
+	]
+}`
+
+// legacyTokenABI is the `eosio.token` ABI as deployed on mainnet, its `key_names` not
+// matching any field
+const legacyTokenABI = `{
+	"version": "eosio::abi/1.0",
+	"structs": [
+		{"name": "account", "base": "", "fields": [{"name": "balance", "type": "asset"}]}
+	],
+	"tables": [
+		{"name": "accounts", "type": "account", "index_type": "i64", "key_names": ["currency"], "key_types": ["uint64"]}
+	]
+}`
+
+const customABI = `{
+	"version": "eosio::abi/1.1",
+	"structs": [
+		{"name": "base_row", "base": "", "fields": [{"name": "owner", "type": "name"}]},
+		{"name": "vote", "base": "base_row", "fields": [{"name": "weight", "type": "uint64"}]},
+		{"name": "order", "base": "", "fields": [{"name": "id", "type": "uint64"}, {"name": "symbol", "type": "symbol"}]},
+		{"name": "market", "base": "", "fields": [{"name": "id", "type": "uint64"}, {"name": "symbol", "type": "symbol"}]}
+	],
+	"tables": [
+		{"name": "votes", "type": "vote", "index_type": "i64", "key_names": [], "key_types": []},
+		{"name": "orders", "type": "order", "index_type": "i64", "key_names": [], "key_types": []},
+		{"name": "markets", "type": "market", "index_type": "i64", "key_names": ["symbol"], "key_types": ["symbol"]}
+	]
+}`
+
+func TestInferKeyType(t *testing.T) {
+	tests := []struct {
+		name     string
+		abi      string
+		table    string
+		keyType  string
+		expected string
+	}{
+		{"explicit wins", tokenABI, "accounts", "hex", "hex"},
+		{"not requested", tokenABI, "accounts", "", ""},
+		{"no table definition", tokenABI, "unknown", "abi", "name"},
+		{"asset first field", tokenABI, "accounts", "abi", "symbol_code"},
+		{"asset first field, multiple fields", tokenABI, "stat", "abi", "symbol_code"},
+		{"legacy key names not matching a field", legacyTokenABI, "accounts", "abi", "name"},
+		{"name field from base struct", customABI, "votes", "abi", "name"},
+		{"numeric field keeps name", customABI, "orders", "abi", "name"},
+		{"field from key names", customABI, "markets", "abi", "symbol"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			abi := new(eos.ABI)
+			require.NoError(t, json.Unmarshal([]byte(test.abi), abi))
+
+			assert.Equal(t, test.expected, inferKeyType(test.keyType, abi, abi.TableForName(eos.TableName(test.table))))
+		})
+	}
+}
+
+func TestKeyConverters_RoundTrip(t *testing.T) {
+	tests := []struct {
+		keyType string
+		in      string
+	}{
+		{"name", "eosio.token"},
+		{"uint64", "18446744073709551615"},
+		{"symbol", "4,EOS"},
+		{"symbol_code", "EOS"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.keyType, func(t *testing.T) {
+			for _, converter := range []KeyConverter{getKeyConverterForType(test.keyType), getScopeConverterForType(test.keyType)} {
+				value, err := converter.FromString(test.in)
+				require.NoError(t, err)
+
+				out, err := converter.ToString(value)
+				require.NoError(t, err)
+				assert.Equal(t, test.in, out)
+			}
+		})
+	}
+}
+
+func TestRenderScope(t *testing.T) {
+	tests := []struct {
+		scopeType string
+		in        string
+		expected  string
+	}{
+		{"", "EOS", "EOS"},
+		{"", "eosio", "eosio"},
+		{"uint64", "0012", "12"},
+		{"symbol_code", "EOS", "EOS"},
+		{"name", "eosio", "eosio"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.scopeType+"_"+test.in, func(t *testing.T) {
+			request := &readRequestCommon{ScopeType: test.scopeType}
+			assert.Equal(t, test.expected, request.renderScope(test.in))
+		})
+	}
+}
+
+func TestRenderTableScopes(t *testing.T) {
+	scopes := []eos.Name{"eosio", eos.Name(eos.NameToString(5459781))}
+
+	rendered, err := renderTableScopes(scopes, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eosio", eos.NameToString(5459781)}, rendered)
+
+	rendered, err = renderTableScopes(scopes, "symbol_code")
+	require.NoError(t, err)
+	assert.Equal(t, "EOS", rendered[1])
+}
@@ -0,0 +1,82 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/logging"
+	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
+	"go.uber.org/zap"
+)
+
+func (srv *EOSServer) listControlledAccountsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	zlogger := logging.Logger(ctx, zlog)
+
+	errors := validateListControlledAccountsRequest(r)
+	if len(errors) > 0 {
+		writeError(ctx, w, derr.RequestValidationError(ctx, errors))
+		return
+	}
+
+	request := extractListControlledAccountsRequest(r)
+	zlogger.Debug("extracted request", zap.Reflect("request", request))
+
+	accountNames, actualBlockNum, err := srv.listControlledAccounts(ctx, request.ControllingAccount, request.BlockNum)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "list controlled accounts"))
+		return
+	}
+
+	if accountNames == nil {
+		accountNames = []eos.AccountName{}
+	}
+
+	writeResponse(ctx, w, &listControlledAccountsResponse{
+		BlockNum:           actualBlockNum,
+		ControlledAccounts: accountNames,
+	})
+}
+
+type listControlledAccountsRequest struct {
+	ControllingAccount eos.AccountName `json:"controlling_account"`
+	BlockNum           uint32          `json:"block_num"`
+}
+
+type listControlledAccountsResponse struct {
+	BlockNum           uint32            `json:"block_num"`
+	ControlledAccounts []eos.AccountName `json:"controlled_accounts"`
+}
+
+func validateListControlledAccountsRequest(r *http.Request) url.Values {
+	return validator.ValidateQueryParams(r, validator.Rules{
+		"controlling_account": []string{"required", "fluxdb.eos.name"},
+		"block_num":           []string{"fluxdb.eos.blockNum"},
+	})
+}
+
+func extractListControlledAccountsRequest(r *http.Request) *listControlledAccountsRequest {
+	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
+
+	return &listControlledAccountsRequest{
+		ControllingAccount: eos.AccountName(r.FormValue("controlling_account")),
+		BlockNum:           uint32(blockNum64),
+	}
+}
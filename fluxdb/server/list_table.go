@@ -51,7 +51,6 @@ func (srv *EOSServer) listTableRowsHandler(w http.ResponseWriter, r *http.Reques
 		request.Table,
 		request.Scope,
 		request.readRequestCommon,
-		getKeyConverterForType(request.KeyType),
 		speculativeWrites,
 	)
 
@@ -82,7 +81,7 @@ func validateGetTableRequest(r *http.Request) url.Values {
 	errors := validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
 		"account":           []string{"required", "fluxdb.eos.name"},
 		"table":             []string{"required", "fluxdb.eos.name"},
-		"scope":             []string{"fluxdb.eos.extendedName"},
+		"scope":             scopeRules(r, false),
 		"irreversible_only": []string{"bool"},
 	}))
 
@@ -91,7 +90,7 @@ func validateGetTableRequest(r *http.Request) url.Values {
 		errors["scope"] = []string{"The scope field is required"}
 	}
 
-	return errors
+	return validateTypedScopes(r, errors, "scope", []string{r.FormValue("scope")})
 }
 
 func extractGetTableRequest(r *http.Request) *listTableRowsRequest {
@@ -47,7 +47,6 @@ func (srv *EOSServer) listTablesRowsForAccountsHandler(w http.ResponseWriter, r
 
 	accountCount := len(request.Accounts)
 	tableResponses := make(chan *getTableResponse, accountCount)
-	keyConverter := getKeyConverterForType(request.KeyType)
 	group := llerrgroup.New(parallelReadRequestCount)
 
 	zlog.Debug("starting read table operations group", zap.Int("account_count", accountCount))
@@ -66,7 +65,6 @@ func (srv *EOSServer) listTablesRowsForAccountsHandler(w http.ResponseWriter, r
 				request.Table,
 				request.Scope,
 				request.readRequestCommon,
-				keyConverter,
 				speculativeWrites,
 			)
 
@@ -77,7 +75,7 @@ func (srv *EOSServer) listTablesRowsForAccountsHandler(w http.ResponseWriter, r
 			zlog.Debug("adding table read rows to response channel", zap.Int("row_count", len(response.Rows)))
 			tableResponses <- &getTableResponse{
 				Account:           account,
-				Scope:             request.Scope,
+				Scope:             request.renderScope(request.Scope),
 				readTableResponse: response,
 			}
 
@@ -124,7 +122,7 @@ func validateListTablesRowsForAccountsRequest(r *http.Request) url.Values {
 	errors := validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
 		"accounts": []string{"required", "fluxdb.eos.accountsList"},
 		"table":    []string{"required", "fluxdb.eos.name"},
-		"scope":    []string{"fluxdb.eos.extendedName"},
+		"scope":    scopeRules(r, false),
 	}))
 
 	// Let's ensure the scope param is at least present (but can be the empty string)
@@ -132,7 +130,7 @@ func validateListTablesRowsForAccountsRequest(r *http.Request) url.Values {
 		errors["scope"] = []string{"The scope field is required"}
 	}
 
-	return errors
+	return validateTypedScopes(r, errors, "scope", []string{r.FormValue("scope")})
 }
 
 func extractListTablesRowsForAccountsRequest(r *http.Request) *listTablesRowsForAccountsRequest {
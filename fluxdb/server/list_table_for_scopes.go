@@ -47,7 +47,6 @@ func (srv *EOSServer) listTablesRowsForScopesHandler(w http.ResponseWriter, r *h
 
 	scopeCount := len(request.Scopes)
 	tableResponses := make(chan *getTableResponse, scopeCount)
-	keyConverter := getKeyConverterForType(request.KeyType)
 	group := llerrgroup.New(parallelReadRequestCount)
 
 	for _, scope := range request.Scopes {
@@ -65,7 +64,6 @@ func (srv *EOSServer) listTablesRowsForScopesHandler(w http.ResponseWriter, r *h
 				request.Table,
 				scope,
 				request.readRequestCommon,
-				keyConverter,
 				speculativeWrites,
 			)
 
@@ -76,7 +74,7 @@ func (srv *EOSServer) listTablesRowsForScopesHandler(w http.ResponseWriter, r *h
 			zlog.Debug("adding table read rows to response channel", zap.Int("row_count", len(response.Rows)))
 			tableResponses <- &getTableResponse{
 				Account:           request.Account,
-				Scope:             scope,
+				Scope:             request.renderScope(scope),
 				readTableResponse: response,
 			}
 			return nil
@@ -118,11 +116,13 @@ type listTablesRowsForScopesRequest struct {
 }
 
 func validateListTablesRowsForScopesRequest(r *http.Request) url.Values {
-	return validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
+	errors := validator.ValidateQueryParams(r, withCommonValidationRules(validator.Rules{
 		"account": []string{"required", "fluxdb.eos.name"},
 		"table":   []string{"required", "fluxdb.eos.name"},
-		"scopes":  []string{"required", "fluxdb.eos.scopesList"},
+		"scopes":  scopeRules(r, true, "required"),
 	}))
+
+	return validateTypedScopes(r, errors, "scopes", validator.ExplodeNames(r.FormValue("scopes"), "|"))
 }
 
 func extractListTablesRowsForScopesRequest(r *http.Request) *listTablesRowsForScopesRequest {
@@ -20,9 +20,10 @@ import (
 	"strconv"
 
 	"github.com/dfuse-io/derr"
-	eos "github.com/eoscanada/eos-go"
+	"github.com/dfuse-io/dfuse-eosio/fluxdb"
 	"github.com/dfuse-io/logging"
 	"github.com/dfuse-io/validator"
+	eos "github.com/eoscanada/eos-go"
 	"go.uber.org/zap"
 )
 
@@ -45,32 +46,54 @@ func (srv *EOSServer) listTableScopesHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	if scopes == nil {
-		scopes = []eos.Name{}
+	renderedScopes, err := renderTableScopes(scopes, request.ScopeType)
+	if err != nil {
+		writeError(ctx, w, derr.Wrap(err, "render table scopes"))
+		return
 	}
 
 	writeResponse(ctx, w, &listTableScopesResponse{
 		BlockNum: actualBlockNum,
-		Scopes:   scopes,
+		Scopes:   renderedScopes,
 	})
 }
 
 type listTableScopesRequest struct {
-	Account  eos.AccountName `json:"account"`
-	Table    eos.TableName   `json:"table"`
-	BlockNum uint32          `json:"block_num"`
+	Account   eos.AccountName `json:"account"`
+	Table     eos.TableName   `json:"table"`
+	BlockNum  uint32          `json:"block_num"`
+	ScopeType string          `json:"scope_type"`
 }
 
 type listTableScopesResponse struct {
-	BlockNum uint32     `json:"block_num"`
-	Scopes   []eos.Name `json:"scopes"`
+	BlockNum uint32   `json:"block_num"`
+	Scopes   []string `json:"scopes"`
+}
+
+// renderTableScopes renders scopes using the requested `scope_type`, scopes
+// are rendered as names when no type is requested.
+func renderTableScopes(scopes []eos.Name, scopeType string) ([]string, error) {
+	scopeConverter := getScopeConverterForType(scopeType)
+
+	out := make([]string, len(scopes))
+	for i, scope := range scopes {
+		rendered, err := scopeConverter.ToString(fluxdb.N(string(scope)))
+		if err != nil {
+			return nil, derr.Wrapf(err, "unable to render scope %q as %q", scope, scopeType)
+		}
+
+		out[i] = rendered
+	}
+
+	return out, nil
 }
 
 func validateListTableScopesRequest(r *http.Request) url.Values {
 	return validator.ValidateQueryParams(r, validator.Rules{
-		"account":   []string{"required", "fluxdb.eos.name"},
-		"table":     []string{"required", "fluxdb.eos.name"},
-		"block_num": []string{"fluxdb.eos.blockNum"},
+		"account":    []string{"required", "fluxdb.eos.name"},
+		"table":      []string{"required", "fluxdb.eos.name"},
+		"block_num":  []string{"fluxdb.eos.blockNum"},
+		"scope_type": []string{"in:hex,hex_be,uint64,name,symbol,symbol_code"},
 	})
 }
 
@@ -78,8 +101,9 @@ func extractListTableScopesRequest(r *http.Request) *listTableScopesRequest {
 	blockNum64, _ := strconv.ParseInt(r.FormValue("block_num"), 10, 64)
 
 	return &listTableScopesRequest{
-		Account:  eos.AccountName(r.FormValue("account")),
-		Table:    eos.TableName(r.FormValue("table")),
-		BlockNum: uint32(blockNum64),
+		Account:   eos.AccountName(r.FormValue("account")),
+		Table:     eos.TableName(r.FormValue("table")),
+		BlockNum:  uint32(blockNum64),
+		ScopeType: r.FormValue("scope_type"),
 	}
 }
@@ -0,0 +1,170 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"github.com/dfuse-io/dfuse-eosio/openapi"
+	eos "github.com/eoscanada/eos-go"
+)
+
+// OpenAPIRoutes describes the REST endpoints served by FluxDB. Their paths
+// must be kept in sync with the routes registered in `New`, a mismatch
+// fails the server creation.
+func OpenAPIRoutes() []*openapi.Route {
+	stateTags := []string{"State"}
+	chainTags := []string{"Chain"}
+	getOrPost := []string{"GET", "POST"}
+	post := []string{"POST"}
+
+	return []*openapi.Route{
+		{Path: "/v0/state/abi", Tags: stateTags, Summary: "Fetch the ABI of an account at a given block",
+			Query: getABIRequest{}, Required: []string{"account"}, Response: &getABIResponse{}},
+		{Path: "/v0/state/abi/bin_to_json", Methods: []string{"POST"}, Tags: stateTags, Summary: "Decode table rows using the ABI of an account at a given block",
+			Body: &decodeABIRequest{}, Response: &decodeABIResponse{}},
+		{Path: "/v0/state/code", Tags: stateTags, Summary: "Fetch the code of an account at a given block",
+			Query: getCodeRequest{}, Required: []string{"account"}, Response: &getCodeResponse{}},
+		{Path: "/v0/state/code/deployments", Tags: stateTags, Summary: "List the code deployments of an account",
+			Query: getCodeRequest{}, Required: []string{"account"}, Response: &listCodeDeploymentsResponse{}},
+		{Path: "/v0/state/controlled_accounts", Methods: getOrPost, Tags: stateTags, Summary: "List the accounts controlled by an account",
+			Query: listControlledAccountsRequest{}, Required: []string{"controlling_account"}, Response: &listControlledAccountsResponse{}},
+		{Path: "/v0/state/key_accounts", Methods: getOrPost, Tags: stateTags, Summary: "List the accounts controlled by a public key",
+			Query: listKeyAccountsRequest{}, Required: []string{"public_key"}, Response: &listKeyAccountsResponse{}},
+		{Path: "/v0/state/permission_links", Tags: stateTags, Summary: "List the linked permissions of an account",
+			Query: listLinkedPermissionsRequest{}, Required: []string{"account"}, Response: &listLinkedPermissionsResponse{}},
+		{Path: "/v0/state/table", Tags: stateTags, Summary: "List the rows of a contract table",
+			Query: listTableRowsRequest{}, Required: []string{"account", "table", "scope"}, Response: &getTableRowsResponse{}},
+		{Path: "/v0/state/table/row", Tags: stateTags, Summary: "Fetch a single row of a contract table",
+			Query: getTableRowRequest{}, Required: []string{"account", "table", "scope", "primary_key"}, Response: &getTableRowResponse{}},
+		{Path: "/v0/state/table_scopes", Tags: stateTags, Summary: "List the scopes of a contract table",
+			Query: listTableScopesRequest{}, Required: []string{"account", "table"}, Response: &listTableScopesResponse{}},
+		{Path: "/v0/state/tables/accounts", Methods: getOrPost, Tags: stateTags, Summary: "List the rows of a table for multiple contracts",
+			Query: listTablesRowsForAccountsQuery{}, Required: []string{"accounts", "table", "scope"}, Response: &getMultiTableRowsResponse{}},
+		{Path: "/v0/state/tables/scopes", Methods: getOrPost, Tags: stateTags, Summary: "List the rows of a contract table for multiple scopes",
+			Query: listTablesRowsForScopesQuery{}, Required: []string{"account", "table", "scopes"}, Response: &getMultiTableRowsResponse{}},
+
+		{Path: "/v1/chain/get_abi", Methods: post, Tags: chainTags, Summary: "nodeos compatible get_abi",
+			Body: &chainGetABIRequest{}, Response: &chainGetABIResponse{}},
+		{Path: "/v1/chain/get_currency_balance", Methods: post, Tags: chainTags, Summary: "nodeos compatible get_currency_balance",
+			Body: &chainGetCurrencyBalanceRequest{}, Response: []eos.Asset{}},
+		{Path: "/v1/chain/get_currency_stats", Methods: post, Tags: chainTags, Summary: "nodeos compatible get_currency_stats",
+			Body: &chainGetCurrencyStatsRequest{}, Response: map[string]*chainCurrencyStats{}},
+		{Path: "/v1/chain/get_table_by_scope", Methods: post, Tags: chainTags, Summary: "nodeos compatible get_table_by_scope",
+			Body: &chainGetTableByScopeRequest{}, Response: &chainGetTableByScopeResponse{}},
+		{Path: "/v1/chain/get_table_rows", Methods: post, Tags: chainTags, Summary: "nodeos compatible get_table_rows",
+			Body: &chainGetTableRowsRequest{}, Response: &chainGetTableRowsResponse{}},
+	}
+}
+
+// The multi tables requests receive their lists as a single `|` separated
+// parameter, those are described as such instead of their extracted form.
+
+type listTablesRowsForAccountsQuery struct {
+	*readRequestCommon
+
+	Accounts string `json:"accounts"`
+	Table    string `json:"table"`
+	Scope    string `json:"scope"`
+}
+
+type listTablesRowsForScopesQuery struct {
+	*readRequestCommon
+
+	Account string `json:"account"`
+	Table   string `json:"table"`
+	Scopes  string `json:"scopes"`
+}
+
+// The streamed responses below are encoded through `gojay` (see
+// `json_stream.go`), their schemas mirror what the encoders write.
+
+func (*tableRow) OpenAPISchema() *openapi.Schema {
+	return &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"key":   {Type: "string"},
+			"payer": {Type: "string"},
+			"hex":   {Type: "string"},
+			"json":  {Description: "Row decoded using the contract ABI"},
+			"error": {Type: "string"},
+			"block": {Type: "integer", Format: "int32"},
+		},
+		Required:             []string{"key"},
+		AdditionalProperties: false,
+	}
+}
+
+func (*getTableRowsResponse) OpenAPISchema() *openapi.Schema {
+	return commonStateSchema(readTableProperties(map[string]*openapi.Schema{}), "rows")
+}
+
+func (*getMultiTableRowsResponse) OpenAPISchema() *openapi.Schema {
+	table := &openapi.Schema{
+		Type: "object",
+		Properties: readTableProperties(map[string]*openapi.Schema{
+			"account": {Type: "string"},
+			"scope":   {Type: "string"},
+		}),
+		Required:             []string{"account", "scope", "rows"},
+		AdditionalProperties: false,
+	}
+
+	return commonStateSchema(map[string]*openapi.Schema{
+		"tables": {Type: "array", Items: table},
+	}, "tables")
+}
+
+func (*getTableRowResponse) OpenAPISchema() *openapi.Schema {
+	row := (*tableRow)(nil).OpenAPISchema()
+	row.Nullable = true
+
+	return commonStateSchema(map[string]*openapi.Schema{"row": row}, "row")
+}
+
+func (*decodeABIResponse) OpenAPISchema() *openapi.Schema {
+	return &openapi.Schema{
+		Type: "object",
+		Properties: map[string]*openapi.Schema{
+			"block_num": {Type: "integer", Format: "int32"},
+			"account":   {Type: "string"},
+			"table":     {Type: "string"},
+			"rows":      {Type: "array", Items: &openapi.Schema{Description: "Row decoded using the contract ABI"}},
+		},
+		Required:             []string{"block_num", "account", "table", "rows"},
+		AdditionalProperties: false,
+	}
+}
+
+func readTableProperties(properties map[string]*openapi.Schema) map[string]*openapi.Schema {
+	properties["abi"] = &openapi.Schema{Type: "object", Description: "Contract ABI, present when `with_abi` is set"}
+	properties["rows"] = &openapi.Schema{Type: "array", Items: (*tableRow)(nil).OpenAPISchema()}
+
+	return properties
+}
+
+// commonStateSchema adds the block references written by
+// `commonStateResponse.MarshalJSONObject` to `properties`.
+func commonStateSchema(properties map[string]*openapi.Schema, required ...string) *openapi.Schema {
+	properties["up_to_block_id"] = &openapi.Schema{Type: "string"}
+	properties["up_to_block_num"] = &openapi.Schema{Type: "integer", Format: "int32"}
+	properties["last_irreversible_block_id"] = &openapi.Schema{Type: "string"}
+	properties["last_irreversible_block_num"] = &openapi.Schema{Type: "integer", Format: "int32"}
+
+	return &openapi.Schema{
+		Type:                 "object",
+		Properties:           properties,
+		Required:             append([]string{"last_irreversible_block_id", "last_irreversible_block_num"}, required...),
+		AdditionalProperties: false,
+	}
+}
@@ -96,7 +96,6 @@ func (srv *EOSServer) readTable(
 	table string,
 	scope string,
 	request *readRequestCommon,
-	keyConverter KeyConverter,
 	speculativeWrites []*fluxdb.WriteRequest,
 ) (*readTableResponse, error) {
 	ctx, span := dtracing.StartSpan(ctx, "read rows")
@@ -105,9 +104,14 @@ func (srv *EOSServer) readTable(
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading rows", zap.String("account", account), zap.String("table", table), zap.String("scope", scope))
 
+	scopeValue, err := request.scopeValue(scope)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to convert scope %q", scope)
+	}
+
 	resp, err := srv.db.ReadTable(ctx, &fluxdb.ReadTableRequest{
 		Account:           fluxdb.N(account),
-		Scope:             fluxdb.EN(scope),
+		Scope:             scopeValue,
 		Table:             fluxdb.N(table),
 		BlockNum:          blockNum,
 		SpeculativeWrites: speculativeWrites,
@@ -135,6 +139,8 @@ func (srv *EOSServer) readTable(
 		return nil, fluxdb.DataTableNotFoundError(ctx, eos.AccountName(account), tableName)
 	}
 
+	keyConverter := getKeyConverterForType(inferKeyType(request.KeyType, abiObj, tableDef))
+
 	zlog.Debug("post-processing each row (maybe convert to JSON)")
 	for _, row := range resp.Rows {
 		var data interface{}
@@ -182,7 +188,6 @@ func (srv *EOSServer) readTableRow(
 	scope string,
 	primaryKey string,
 	request *readRequestCommon,
-	keyConverter KeyConverter,
 	speculativeWrites []*fluxdb.WriteRequest,
 ) (*readTableRowResponse, error) {
 	ctx, span := dtracing.StartSpan(ctx, "read table row")
@@ -191,6 +196,29 @@ func (srv *EOSServer) readTableRow(
 	zlog := logging.Logger(ctx, zlog)
 	zlog.Debug("reading table row", zap.String("account", account), zap.String("table", table), zap.String("scope", scope), zap.String("primary_key", primaryKey))
 
+	scopeValue, err := request.scopeValue(scope)
+	if err != nil {
+		return nil, derr.Wrapf(err, "unable to convert scope %q", scope)
+	}
+
+	// The primary key must be converted prior reading the row, so we need the ABI upfront to infer its type
+	var abiRow *fluxdb.ABIRow
+	var abiObj *eos.ABI
+	keyType := request.KeyType
+	if keyType == abiKeyType {
+		abiRow, err = srv.db.GetABI(ctx, blockNum, fluxdb.N(account), speculativeWrites)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := eos.UnmarshalBinary(abiRow.PackedABI, &abiObj); err != nil {
+			return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", abiRow.PackedABI)
+		}
+
+		keyType = inferKeyType(keyType, abiObj, abiObj.TableForName(eos.TableName(table)))
+	}
+
+	keyConverter := getKeyConverterForType(keyType)
 	primaryKeyValue, err := keyConverter.FromString(primaryKey)
 	if err != nil {
 		return nil, derr.Wrapf(err, "unable to convert key %q to uint64", primaryKey)
@@ -199,21 +227,23 @@ func (srv *EOSServer) readTableRow(
 	resp, err := srv.db.ReadTableRow(ctx, &fluxdb.ReadTableRowRequest{
 		ReadTableRequest: fluxdb.ReadTableRequest{
 			Account:           fluxdb.N(account),
-			Scope:             fluxdb.EN(scope),
+			Scope:             scopeValue,
 			Table:             fluxdb.N(table),
 			BlockNum:          blockNum,
 			SpeculativeWrites: speculativeWrites,
 		},
 		PrimaryKey: primaryKeyValue,
+		ABI:        abiRow,
 	})
 
 	if err != nil {
 		return nil, derr.Wrap(err, "unable to retrieve single row from database")
 	}
 
-	var abiObj *eos.ABI
-	if err := eos.UnmarshalBinary(resp.ABI.PackedABI, &abiObj); err != nil {
-		return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", resp.ABI.PackedABI)
+	if abiObj == nil {
+		if err := eos.UnmarshalBinary(resp.ABI.PackedABI, &abiObj); err != nil {
+			return nil, derr.Wrapf(err, "unable to decode packed ABI %q to JSON", resp.ABI.PackedABI)
+		}
 	}
 
 	out := &readTableRowResponse{}
@@ -291,6 +321,26 @@ func (srv *EOSServer) listKeyAccounts(
 	return
 }
 
+func (srv *EOSServer) listControlledAccounts(
+	ctx context.Context,
+	controllingAccount eos.AccountName,
+	blockNum uint32,
+) (accountNames []eos.AccountName, actualBlockNum uint32, err error) {
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, blockNum, false)
+	if err != nil {
+		err = derr.Wrap(err, "unable to prepare read")
+		return
+	}
+
+	accountNames, err = srv.db.ReadControlledAccounts(ctx, actualBlockNum, controllingAccount, speculativeWrites)
+	if err != nil {
+		err = derr.Wrap(err, "unable to read controlled accounts from db")
+		return
+	}
+
+	return
+}
+
 func (srv *EOSServer) listTableScopes(
 	ctx context.Context,
 	account eos.AccountName,
@@ -350,3 +400,49 @@ func (srv *EOSServer) fetchABI(
 
 	return
 }
+
+func (srv *EOSServer) fetchCode(
+	ctx context.Context,
+	account eos.AccountName,
+	blockNum uint32,
+	withCode bool,
+) (code *fluxdb.ContractCode, err error) {
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, blockNum, false)
+	if err != nil {
+		return
+	}
+
+	code, err = srv.db.ReadContractCode(ctx, actualBlockNum, account, withCode, speculativeWrites)
+	if err != nil {
+		err = derr.Wrap(err, "fetching contract code from db")
+		return
+	}
+
+	if code == nil {
+		err = fluxdb.DataCodeNotFoundError(ctx, string(account), actualBlockNum)
+		return
+	}
+
+	return
+}
+
+func (srv *EOSServer) listCodeDeployments(
+	ctx context.Context,
+	account eos.AccountName,
+	blockNum uint32,
+	withCode bool,
+) (deployments []*fluxdb.ContractCode, actualBlockNum uint32, err error) {
+	actualBlockNum, _, _, speculativeWrites, err := srv.prepareRead(ctx, blockNum, false)
+	if err != nil {
+		err = derr.Wrap(err, "unable to prepare read")
+		return
+	}
+
+	deployments, err = srv.db.ReadContractCodeDeployments(ctx, actualBlockNum, account, withCode, speculativeWrites)
+	if err != nil {
+		err = derr.Wrap(err, "unable to read contract code deployments from db")
+		return
+	}
+
+	return
+}
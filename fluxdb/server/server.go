@@ -29,6 +29,7 @@ import (
 	"github.com/dfuse-io/derr"
 	"github.com/dfuse-io/dtracing"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb"
+	"github.com/dfuse-io/dfuse-eosio/openapi"
 	"github.com/dfuse-io/logging"
 	"github.com/francoispqt/gojay"
 	"github.com/gorilla/mux"
@@ -45,6 +46,7 @@ type EOSServer struct {
 	db         *fluxdb.FluxDB
 	addr       string
 	mux        *mux.Router
+	openAPI    *openapi.Document
 }
 
 func New(addr string, db *fluxdb.FluxDB) *EOSServer {
@@ -69,6 +71,9 @@ func New(addr string, db *fluxdb.FluxDB) *EOSServer {
 
 	coreRouter.Methods("GET").Path("/v0/state/abi").HandlerFunc(srv.getABIHandler)
 	coreRouter.Methods("POST").Path("/v0/state/abi/bin_to_json").HandlerFunc(srv.decodeABIHandler)
+	coreRouter.Methods("GET").Path("/v0/state/code").HandlerFunc(srv.getCodeHandler)
+	coreRouter.Methods("GET").Path("/v0/state/code/deployments").HandlerFunc(srv.listCodeDeploymentsHandler)
+	coreRouter.Methods("GET", "POST").Path("/v0/state/controlled_accounts").HandlerFunc(srv.listControlledAccountsHandler)
 	coreRouter.Methods("GET", "POST").Path("/v0/state/key_accounts").HandlerFunc(srv.listKeyAccountsHandler)
 	coreRouter.Methods("GET").Path("/v0/state/permission_links").HandlerFunc(srv.listLinkedPermissionsHandler)
 	coreRouter.Methods("GET").Path("/v0/state/table").HandlerFunc(srv.listTableRowsHandler)
@@ -77,6 +82,25 @@ func New(addr string, db *fluxdb.FluxDB) *EOSServer {
 	coreRouter.Methods("GET", "POST").Path("/v0/state/tables/accounts").HandlerFunc(srv.listTablesRowsForAccountsHandler)
 	coreRouter.Methods("GET", "POST").Path("/v0/state/tables/scopes").HandlerFunc(srv.listTablesRowsForScopesHandler)
 
+	// nodeos `chain_api_plugin` compatible endpoints
+	coreRouter.Methods("POST").Path("/v1/chain/get_abi").HandlerFunc(srv.chainGetABIHandler)
+	coreRouter.Methods("POST").Path("/v1/chain/get_currency_balance").HandlerFunc(srv.chainGetCurrencyBalanceHandler)
+	coreRouter.Methods("POST").Path("/v1/chain/get_currency_stats").HandlerFunc(srv.chainGetCurrencyStatsHandler)
+	coreRouter.Methods("POST").Path("/v1/chain/get_table_by_scope").HandlerFunc(srv.chainGetTableByScopeHandler)
+	coreRouter.Methods("POST").Path("/v1/chain/get_table_rows").HandlerFunc(srv.chainGetTableRowsHandler)
+
+	// Routes are static, a description not matching them is a programming error
+	srv.openAPI = openapi.NewDocument("dfuse for EOSIO - FluxDB", "v0")
+	if err := srv.openAPI.AddRouterRoutes(router, OpenAPIRoutes(), "/ping", "/healthz"); err != nil {
+		panic(fmt.Errorf("invalid openapi description: %w", err))
+	}
+
+	openAPIHandler, err := srv.openAPI.Handler()
+	if err != nil {
+		panic(err)
+	}
+	metricsRouter.Handle("/v0/openapi.json", openAPIHandler)
+
 	db.OnTerminating(func(e error) {
 		zlog.Info("gracefully shutting down http server, draining connections")
 		if srv.httpServer != nil {
@@ -94,6 +118,11 @@ func (srv *EOSServer) Handler() http.Handler {
 	return srv.mux
 }
 
+// OpenAPIDocument returns the OpenAPI description of the served routes.
+func (srv *EOSServer) OpenAPIDocument() *openapi.Document {
+	return srv.openAPI
+}
+
 func (srv *EOSServer) Serve() {
 	zlog.Info("listening & serving HTTP content", zap.String("http_listen_addr", srv.addr))
 	srv.httpServer = &http.Server{
@@ -210,6 +239,7 @@ func extractReadRequestCommon(r *http.Request) *readRequestCommon {
 		Limit:        limit,
 		Key:          r.FormValue("key"),
 		KeyType:      r.FormValue("key_type"),
+		ScopeType:    r.FormValue("scope_type"),
 		ToJSON:       boolInput(r.FormValue("json")),
 		WithABI:      boolInput(r.FormValue("with_abi")),
 		WithBlockNum: boolInput(r.FormValue("with_block_num")),
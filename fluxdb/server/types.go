@@ -27,6 +27,7 @@ type readRequestCommon struct {
 	BlockNum     uint32 `json:"block_num"`
 	Key          string `json:"key"`
 	KeyType      string `json:"key_type"`
+	ScopeType    string `json:"scope_type"`
 	Offset       int    `json:"offset"`
 	Limit        int    `json:"limit"`
 	ToJSON       bool   `json:"json"`
@@ -34,6 +35,34 @@ type readRequestCommon struct {
 	WithBlockNum bool   `json:"with_block_num"`
 }
 
+// scopeValue converts a scope received in the request using the converter
+// matching `scope_type`, falling back to extended names when not provided.
+func (r *readRequestCommon) scopeValue(scope string) (uint64, error) {
+	return getScopeConverterForType(r.ScopeType).FromString(scope)
+}
+
+// renderScope returns the scope as it should appear in responses. Without an
+// explicit `scope_type`, the scope is echoed as received, otherwise it's
+// rendered in the canonical form of the requested type so it round-trips.
+func (r *readRequestCommon) renderScope(scope string) string {
+	if r.ScopeType == "" {
+		return scope
+	}
+
+	scopeConverter := getScopeConverterForType(r.ScopeType)
+	value, err := scopeConverter.FromString(scope)
+	if err != nil {
+		return scope
+	}
+
+	rendered, err := scopeConverter.ToString(value)
+	if err != nil {
+		return scope
+	}
+
+	return rendered
+}
+
 //
 /// HTTP Responses
 //
@@ -16,6 +16,8 @@ package server
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/dfuse-io/validator"
 	"github.com/eoscanada/eos-go/ecc"
@@ -34,6 +36,49 @@ func init() {
 	govalidator.AddCustomRule("fluxdb.eos.extendedName", validator.EOSExtendedNameRule)
 	govalidator.AddCustomRule("fluxdb.eos.publicKey", eosPublicKeyRule)
 	govalidator.AddCustomRule("fluxdb.eos.scopesList", validator.EOSExtendedNamesListRuleFactory("|", maxScopeCount))
+	govalidator.AddCustomRule("fluxdb.typedScopesList", validator.StringListRuleFactory("|", maxScopeCount, anyRule))
+}
+
+func anyRule(field string, rule string, message string, value interface{}) error {
+	return nil
+}
+
+// scopeRules returns the validation rules to apply on a scope field. When an
+// explicit `scope_type` is requested, the extended name check is replaced by
+// `validateTypedScopes` which actually tries to convert the scope(s).
+func scopeRules(r *http.Request, listField bool, rules ...string) []string {
+	typed := r.FormValue("scope_type") != ""
+
+	switch {
+	case listField && typed:
+		return append(rules, "fluxdb.typedScopesList")
+	case listField:
+		return append(rules, "fluxdb.eos.scopesList")
+	case typed:
+		return rules
+	default:
+		return append(rules, "fluxdb.eos.extendedName")
+	}
+}
+
+func validateTypedScopes(r *http.Request, errors url.Values, field string, scopes []string) url.Values {
+	scopeType := r.FormValue("scope_type")
+	if scopeType == "" || len(errors[field]) > 0 || len(errors["scope_type"]) > 0 {
+		return errors
+	}
+
+	scopeConverter := getScopeConverterForType(scopeType)
+	for _, scope := range scopes {
+		if scope == "" {
+			continue
+		}
+
+		if _, err := scopeConverter.FromString(scope); err != nil {
+			errors[field] = append(errors[field], fmt.Sprintf("The %s field must be a valid %s, got %q", field, scopeType, scope))
+		}
+	}
+
+	return errors
 }
 
 // FIXME: Extract to `github.com/dfuse-io/validator` library (with associated tests)
@@ -68,7 +113,8 @@ func commonReadValidationRules() validator.Rules {
 		"block_num":      []string{"fluxdb.eos.blockNum"},
 		"offset":         []string{"numeric"},
 		"limit":          []string{"numeric"},
-		"key_type":       []string{"in:hex,hex_be,uint64,name,symbol,symbol_code"},
+		"key_type":       []string{"in:hex,hex_be,uint64,name,symbol,symbol_code,abi"},
+		"scope_type":     []string{"in:hex,hex_be,uint64,name,symbol,symbol_code"},
 		"json":           []string{"bool"},
 		"with_abi":       []string{"bool"},
 		"with_block_num": []string{"bool"},
@@ -163,6 +163,18 @@ func TestValidateGetTableRequest(t *testing.T) {
 		{"scope not name", "account=c&scope=0&table=a", url.Values{
 			"scope": []string{"The scope field must be a valid EOS name"},
 		}},
+
+		{"scope typed uint64", "account=c&scope=0&table=a&scope_type=uint64", url.Values{}},
+
+		{"scope typed symbol", "account=c&scope=4,EOS&table=a&scope_type=symbol", url.Values{}},
+
+		{"scope typed not matching type", "account=c&scope=eosio&table=a&scope_type=uint64", url.Values{
+			"scope": []string{`The scope field must be a valid uint64, got "eosio"`},
+		}},
+
+		{"scope type invalid", "account=c&scope=s&table=a&scope_type=unknown", url.Values{
+			"scope_type": []string{"The scope_type field must be one of hex, hex_be, uint64, name, symbol, symbol_code"},
+		}},
 	}
 
 	runQueryValidatorTests(t, "TestValidateGetTableRequest", tests, validateGetTableRequest)
@@ -253,6 +265,16 @@ func TestValidateListTablesRowsForScopesRequest(t *testing.T) {
 		{"scopes invalid name", "account=a&scopes=9&table=a", url.Values{
 			"scopes": []string{`The scopes[0] field must be a valid EOS name`},
 		}},
+
+		{"scopes typed", "account=a&scopes=1|2|18446744073709551615&table=a&scope_type=uint64", url.Values{}},
+
+		{"scopes typed invalid", "account=a&scopes=1|eosio&table=a&scope_type=uint64", url.Values{
+			"scopes": []string{`The scopes field must be a valid uint64, got "eosio"`},
+		}},
+
+		{"scopes typed above max", fmt.Sprintf("account=a&scopes=%s&table=a&scope_type=name", scopesAboveMax), url.Values{
+			"scopes": []string{"The scopes field must have at most 1500 elements"},
+		}},
 	}
 
 	runQueryValidatorTests(t, "TestValidateListTablesRowsForScopesRequest", tests, validateListTablesRowsForScopesRequest)
@@ -295,6 +317,7 @@ func validateCommonReadRequest(t *testing.T, tag string, validQueryPrefix string
 		{"key_type hex_be", validQuery("key_type=hex_be"), url.Values{}},
 		{"key_type name", validQuery("key_type=name"), url.Values{}},
 		{"key_type uint64", validQuery("key_type=uint64"), url.Values{}},
+		{"key_type abi", validQuery("key_type=abi"), url.Values{}},
 		{"json valid 0", validQuery("json=0"), url.Values{}},
 		{"json valid true", validQuery("json=true"), url.Values{}},
 		{"with_abi valid 0", validQuery("with_abi=0"), url.Values{}},
@@ -315,7 +338,7 @@ func validateCommonReadRequest(t *testing.T, tag string, validQueryPrefix string
 		}},
 
 		{"key_type invalid", validQuery("key_type=a"), url.Values{
-			"key_type": []string{"The key_type field must be one of hex, hex_be, uint64, name, symbol, symbol_code"},
+			"key_type": []string{"The key_type field must be one of hex, hex_be, uint64, name, symbol, symbol_code, abi"},
 		}},
 
 		{"json not boolean", validQuery("json=a"), url.Values{
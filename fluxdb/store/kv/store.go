@@ -224,6 +224,8 @@ func (s *KVStore) ScanLastShardsWrittenBlock(ctx context.Context, keyPrefix stri
 }
 
 func (s *KVStore) fetchKey(ctx context.Context, table byte, key string) (out []byte, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "fetch key", "table", TblPrefixName[table], "key", key)
+	defer span.End()
 
 	kvKey := packKey(table, key)
 
@@ -240,6 +242,9 @@ func (s *KVStore) fetchKey(ctx context.Context, table byte, key string) (out []b
 }
 
 func (s *KVStore) fetchKeys(ctx context.Context, table byte, keys []string) (out [][]byte, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "fetch keys", "table", TblPrefixName[table], "key_count", len(keys))
+	defer span.End()
+
 	kvKeys := make([][]byte, len(keys))
 	for i, key := range keys {
 		kvKeys[i] = packKey(table, key)
@@ -265,6 +270,9 @@ func (s *KVStore) fetchKeys(ctx context.Context, table byte, keys []string) (out
 }
 
 func (s *KVStore) scanPrefix(ctx context.Context, table byte, prefixKey string, limit int, onRow func(key string, value []byte) error) error {
+	ctx, span := dtracing.StartSpan(ctx, "scan prefix", "table", TblPrefixName[table], "prefix_key", prefixKey, "limit", limit)
+	defer span.End()
+
 	kvPrefix := packKey(table, prefixKey)
 
 	itrCtx, cancelIterator := context.WithCancel(ctx)
@@ -292,6 +300,9 @@ func (s *KVStore) scanPrefix(ctx context.Context, table byte, prefixKey string,
 }
 
 func (s *KVStore) scanRange(ctx context.Context, table byte, keyStart, keyEnd string, limit int, onRow func(key string, value []byte) error) error {
+	ctx, span := dtracing.StartSpan(ctx, "scan range", "table", TblPrefixName[table], "key_start", keyStart, "key_end", keyEnd, "limit", limit)
+	defer span.End()
+
 	zlog.Debug("scanning range", zap.String("start", keyStart), zap.String("end", keyEnd))
 	startKey := packKey(table, keyStart)
 	var endKey []byte
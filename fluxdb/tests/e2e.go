@@ -15,7 +15,9 @@
 package tests
 
 import (
+	"bytes"
 	"context"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/dfuse-io/bstream/forkable"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb"
 	"github.com/dfuse-io/dfuse-eosio/fluxdb/server"
+	"github.com/dfuse-io/dfuse-eosio/openapi"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	"github.com/gavv/httpexpect/v2"
 	"github.com/stretchr/testify/require"
@@ -58,7 +61,7 @@ func e2eTest(t *testing.T, storeFactory StoreFactory, tester e2eTester) {
 
 	tester(ctx, t, runSource, httpexpect.WithConfig(httpexpect.Config{
 		Client: &http.Client{
-			Transport: httpexpect.NewBinder(server.Handler()),
+			Transport: &openAPIValidatingTransport{t: t, document: server.OpenAPIDocument(), next: httpexpect.NewBinder(server.Handler())},
 			Jar:       httpexpect.NewJar(),
 		},
 		Reporter: httpexpect.NewAssertReporter(t),
@@ -67,3 +70,31 @@ func e2eTest(t *testing.T, storeFactory StoreFactory, tester e2eTester) {
 		},
 	}))
 }
+
+// openAPIValidatingTransport checks every successful response against the
+// OpenAPI document of the server, so handler changes breaking it fail tests.
+type openAPIValidatingTransport struct {
+	t        *testing.T
+	document *openapi.Document
+	next     http.RoundTripper
+}
+
+func (v *openAPIValidatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := v.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK || v.document.Operation(req.URL.Path, req.Method) == nil {
+		return resp, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if err := v.document.ValidateResponse(req.URL.Path, req.Method, body); err != nil {
+		v.t.Errorf("response of %s %s does not match the openapi document: %s", req.Method, req.URL.Path, err)
+	}
+
+	return resp, nil
+}
@@ -16,6 +16,9 @@ package tests
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -113,6 +116,24 @@ func runAll(t *testing.T, storeFactory StoreFactory) {
 		{"state tables for accounts, historical", testStateTablesForAccountsHistoricalJSON},
 
 		{"state table row, historical", testStateTableRowHeadJSON},
+
+		{"state code, head", testStateCodeHead},
+		{"state code, historical", testStateCodeHistorical},
+		{"state code, cleared", testStateCodeCleared},
+		{"state code deployments, head", testStateCodeDeploymentsHead},
+		{"state code deployments, historical", testStateCodeDeploymentsHistorical},
+		{"state code deployments, with code", testStateCodeDeploymentsWithCode},
+		{"state code, shared code", testStateCodeShared},
+
+		{"chain get_table_rows, head, json", testChainGetTableRowsHeadJSON},
+		{"chain get_table_rows, no abi", testChainGetTableRowsNoABI},
+		{"chain get_table_rows, secondary index", testChainGetTableRowsSecondaryIndex},
+		{"chain get_table_by_scope, single table", testChainGetTableByScopeSingleTable},
+		{"chain get_table_by_scope, all tables", testChainGetTableByScopeAllTables},
+		{"chain get_currency_balance", testChainGetCurrencyBalance},
+		{"chain get_abi", testChainGetABI},
+
+		{"openapi document", testOpenAPIDocument},
 	}
 
 	for _, test := range tests {
@@ -255,6 +276,152 @@ func testStateTableRowHeadJSON(ctx context.Context, t *testing.T, feedSourceWith
 	jsonValueEqual(t, `{"key":"SOE","payer":"eosio5","json":{"balance":"5.0000 SOE"}}`, response.Path("$.row"))
 }
 
+func testStateCodeHead(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code", "account=eosio.test")
+
+	jsonObjectEqual(t, str(`{"account":"eosio.test","block_num":5,"code_hash":"%s","vm_type":0,"vm_version":0,"code":"0061736d03"}`, codeHash("0061736d03")), response)
+}
+
+func testStateCodeHistorical(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code", "account=eosio.test&block_num=3&hash_only=true")
+
+	jsonObjectEqual(t, str(`{"account":"eosio.test","block_num":3,"code_hash":"%s","vm_type":0,"vm_version":0}`, codeHash("0061736d02")), response)
+}
+
+func testStateCodeCleared(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	e.GET("/v0/state/code").
+		WithQueryString("account=eosio.test&block_num=4").
+		Expect().
+		Status(http.StatusBadRequest).JSON().Object().
+		ValueEqual("code", "data_code_not_found_error")
+}
+
+func testStateCodeDeploymentsHead(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code/deployments", "account=eosio.test&hash_only=true")
+
+	jsonObjectEqual(t, str(`{"block_num":5,"account":"eosio.test","deployments":[
+		{"block_num":2,"code_hash":"%s","vm_type":0,"vm_version":0},
+		{"block_num":3,"code_hash":"%s","vm_type":0,"vm_version":0},
+		{"block_num":4,"vm_type":0,"vm_version":0},
+		{"block_num":5,"code_hash":"%s","vm_type":0,"vm_version":0}
+	]}`, codeHash("0061736d01"), codeHash("0061736d02"), codeHash("0061736d03")), response)
+}
+
+func testStateCodeDeploymentsHistorical(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code/deployments", "account=eosio.test&block_num=2")
+
+	jsonObjectEqual(t, str(`{"block_num":2,"account":"eosio.test","deployments":[
+		{"block_num":2,"code_hash":"%s","vm_type":0,"vm_version":0,"code":"0061736d01"}
+	]}`, codeHash("0061736d01")), response)
+}
+
+func testStateCodeDeploymentsWithCode(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	response := okQuery(e, "/v0/state/code/deployments", "account=eosio.test")
+
+	jsonObjectEqual(t, str(`{"block_num":5,"account":"eosio.test","deployments":[
+		{"block_num":2,"code_hash":"%s","vm_type":0,"vm_version":0,"code":"0061736d01"},
+		{"block_num":3,"code_hash":"%s","vm_type":0,"vm_version":0,"code":"0061736d02"},
+		{"block_num":4,"vm_type":0,"vm_version":0},
+		{"block_num":5,"code_hash":"%s","vm_type":0,"vm_version":0,"code":"0061736d03"}
+	]}`, codeHash("0061736d01"), codeHash("0061736d02"), codeHash("0061736d03")), response)
+}
+
+func testStateCodeShared(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(codeBlocks(t)...)
+
+	// The code of `eosio.other` is the one first deployed on `eosio.test`, it's stored once by hash
+	response := okQuery(e, "/v0/state/code", "account=eosio.other")
+
+	jsonObjectEqual(t, str(`{"account":"eosio.other","block_num":3,"code_hash":"%s","vm_type":0,"vm_version":0,"code":"0061736d01"}`, codeHash("0061736d01")), response)
+}
+
+func testChainGetTableRowsHeadJSON(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okChainQuery(e, "get_table_rows", `{"code":"eosio.test","table":"rows2","scope":"s","json":true,"limit":2,"key_type":"name"}`)
+
+	jsonValueEqual(t, `{"rows":[{"to":20},{"to":3}],"more":true,"next_key":"d"}`, response)
+}
+
+func testChainGetTableRowsNoABI(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okChainQuery(e, "get_table_rows", `{"code":"eosio.nope","table":"rows","scope":"s","json":true}`)
+
+	jsonValueEqual(t, `{"rows":[],"more":false,"next_key":""}`, response)
+}
+
+func testChainGetTableRowsSecondaryIndex(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	e.POST("/v1/chain/get_table_rows").
+		WithJSON(json.RawMessage(`{"code":"eosio.test","table":"rows2","scope":"s","index_position":"2"}`)).
+		Expect().
+		Status(http.StatusBadRequest).JSON().Object().
+		Path("$.details.errors").Object().ContainsKey("index_position")
+}
+
+func testChainGetTableByScopeSingleTable(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okChainQuery(e, "get_table_by_scope", `{"code":"eosio.token","table":"accounts"}`)
+
+	jsonValueEqual(t, `{"rows":[
+		{"code":"eosio.token","scope":"eosio1","table":"accounts","payer":"eosio1","count":0},
+		{"code":"eosio.token","scope":"eosio2","table":"accounts","payer":"eosio2","count":0}
+	],"more":""}`, response)
+}
+
+func testChainGetTableByScopeAllTables(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okChainQuery(e, "get_table_by_scope", `{"code":"eosio.token","limit":1}`)
+
+	jsonValueEqual(t, `{"rows":[
+		{"code":"eosio.token","scope":"eosio1","table":"accounts","payer":"eosio1","count":0}
+	],"more":"eosio2"}`, response)
+}
+
+func testChainGetCurrencyBalance(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	jsonValueEqual(t, `["10.0000 EOS"]`, okChainQuery(e, "get_currency_balance", `{"code":"eosio.token","account":"eosio1"}`))
+	jsonValueEqual(t, `["10.0000 EOS"]`, okChainQuery(e, "get_currency_balance", `{"code":"eosio.token","account":"eosio1","symbol":"EOS"}`))
+	jsonValueEqual(t, `[]`, okChainQuery(e, "get_currency_balance", `{"code":"eosio.token","account":"eosio1","symbol":"eos"}`))
+	jsonValueEqual(t, `[]`, okChainQuery(e, "get_currency_balance", `{"code":"eosio.nope","account":"eosio1"}`))
+}
+
+func testChainGetABI(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	feedSourceWithBlocks(tableBlocks(t)...)
+
+	response := okChainQuery(e, "get_abi", `{"account_name":"eosio.test"}`).Object()
+	response.ValueEqual("account_name", "eosio.test")
+	response.Path("$.abi.tables[0].name").Equal("rows2")
+
+	response = okChainQuery(e, "get_abi", `{"account_name":"eosio.nope"}`).Object()
+	response.ValueEqual("account_name", "eosio.nope")
+	response.NotContainsKey("abi")
+}
+
+func testOpenAPIDocument(ctx context.Context, t *testing.T, feedSourceWithBlocks blocksFeeder, e *httpexpect.Expect) {
+	response := e.GET("/v0/openapi.json").Expect().Status(http.StatusOK).JSON().Object()
+
+	response.ValueEqual("openapi", "3.0.3")
+	response.Path("$.paths").Object().ContainsKey("/v0/state/table").ContainsKey("/v1/chain/get_table_rows")
+}
+
 func tableBlocks(t *testing.T) []*pbcodec.Block {
 	eosioTokenABI1 := readABI(t, "eosio.token.1.abi.json")
 	eosioTestABI1 := readABI(t, "eosio.test.1.abi.json")
@@ -368,6 +535,35 @@ func tableBlocks(t *testing.T) []*pbcodec.Block {
 	}
 }
 
+func codeBlocks(t *testing.T) []*pbcodec.Block {
+	return []*pbcodec.Block{
+		// Block #2 | Deploys a first code on `eosio.test`
+		testBlock(t, "00000002aa", "0000000000000000000000000000000000000000000000000000000000000000",
+			trxTrace(t, actionSetCode(t, "eosio.test", "0061736d01")),
+		),
+
+		// Block #3 | Deploys a second code on `eosio.test` and the first one on `eosio.other`
+		testBlock(t, "00000003aa", "00000002aa",
+			trxTrace(t, actionSetCode(t, "eosio.test", "0061736d02"), actionSetCode(t, "eosio.other", "0061736d01")),
+		),
+
+		// Block #4 | Clears the code of `eosio.test`
+		testBlock(t, "00000004aa", "00000003aa",
+			trxTrace(t, actionSetCode(t, "eosio.test", "")),
+		),
+
+		// Block #5 | This block will be in the reversible segment, i.e. in the speculative writes
+		testBlock(t, "00000005aa", "00000004aa",
+			trxTrace(t, actionSetCode(t, "eosio.test", "0061736d03")),
+		),
+	}
+}
+
+func codeHash(hexCode string) string {
+	hash := sha256.Sum256(b(hexCode))
+	return hex.EncodeToString(hash[:])
+}
+
 func okQueryStateTable(e *httpexpect.Expect, table string, extraQuery string) (response *httpexpect.Object) {
 	parts := strings.Split(table, "/")
 
@@ -430,6 +626,13 @@ func okQuery(e *httpexpect.Expect, path string, queryString string) (response *h
 		Status(http.StatusOK).JSON().Object()
 }
 
+func okChainQuery(e *httpexpect.Expect, call string, body string) (response *httpexpect.Value) {
+	return e.POST("/v1/chain/" + call).
+		WithJSON(json.RawMessage(body)).
+		Expect().
+		Status(http.StatusOK).JSON()
+}
+
 func assertIrrBlockInfo(response *httpexpect.Object) {
 	response.NotContainsKey("up_to_block_id")
 	response.NotContainsKey("up_to_block_num")
@@ -185,3 +185,17 @@ func actionSetABI(t *testing.T, account string, abi *eos.ABI) *pbcodec.ActionTra
 		},
 	}
 }
+
+func actionSetCode(t *testing.T, account string, hexCode string) *pbcodec.ActionTrace {
+	return &pbcodec.ActionTrace{
+		Receiver: "eosio",
+		Receipt: &pbcodec.ActionReceipt{
+			Receiver: "eosio",
+		},
+		Action: &pbcodec.Action{
+			Account:  "eosio",
+			Name:     "setcode",
+			JsonData: str(`{"account":"%s","vmtype":0,"vmversion":0,"code":"%s"}`, account, hexCode),
+		},
+	}
+}
@@ -56,6 +56,13 @@ func jsonValueEqual(t *testing.T, expected string, actual *httpexpect.Value) {
 	require.JSONEq(t, expected, string(out))
 }
 
+func jsonObjectEqual(t *testing.T, expected string, actual *httpexpect.Object) {
+	out, err := json.Marshal(actual.Raw())
+	require.NoError(t, err)
+
+	require.JSONEq(t, expected, string(out))
+}
+
 func decodeHex(s string) []byte {
 	out, err := hex.DecodeString(s)
 	if err != nil {
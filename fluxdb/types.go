@@ -16,16 +16,17 @@ package fluxdb
 
 import (
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 )
 
 type ReadTableRequest struct {
 	Account, Scope, Table uint64
-	Key                   *uint64
 	BlockNum              uint32
-	Offset, Limit         *uint32
-	SpeculativeWrites     []*WriteRequest
+	KeyRange
+	SpeculativeWrites []*WriteRequest
 }
 
 func (r *ReadTableRequest) tableKey() string {
@@ -35,15 +36,53 @@ func (r *ReadTableRequest) tableKey() string {
 type ReadTableRowRequest struct {
 	ReadTableRequest
 	PrimaryKey uint64
+
+	// ABI is the account's ABI at `BlockNum` when already fetched by the
+	// caller, it's fetched from the database when nil.
+	ABI *ABIRow
 }
 
 func (r *ReadTableRowRequest) primaryKeyString() string {
 	return fmt.Sprintf("%016x", r.PrimaryKey)
 }
 
+// KeyRange restricts a read to the rows whose primary key is between
+// `LowerBound` and `UpperBound`, both inclusive and unbounded when nil, and
+// to the first `Limit` ones of them in key order, or reverse key order when
+// `Reverse` is true. A `Limit` of 0 reads all the rows in range.
+type KeyRange struct {
+	LowerBound, UpperBound *uint64
+	Limit                  uint32
+	Reverse                bool
+}
+
+func (r KeyRange) contains(key uint64) bool {
+	return (r.LowerBound == nil || key >= *r.LowerBound) && (r.UpperBound == nil || key <= *r.UpperBound)
+}
+
+// primaryKeyBounds returns the bounds as primary key strings, empty when
+// unbounded.
+func (r KeyRange) primaryKeyBounds() (lowerKey, upperKey string) {
+	if r.LowerBound != nil {
+		lowerKey = fmt.Sprintf("%016x", *r.LowerBound)
+	}
+
+	if r.UpperBound != nil {
+		upperKey = fmt.Sprintf("%016x", *r.UpperBound)
+	}
+
+	return
+}
+
 type ReadTableResponse struct {
-	ABI  *ABIRow
+	ABI *ABIRow
+
+	// Rows are sorted by key, in reverse order when the request is `Reverse`
 	Rows []*TableRow
+
+	// NextKey is the key of the row following the last one returned when
+	// rows were left out by the request's `Limit`, nil otherwise
+	NextKey *uint64
 }
 
 type ReadTableRowResponse struct {
@@ -58,6 +97,17 @@ type TableRow struct {
 	BlockNum uint32
 }
 
+// ContractCode is the code deployed on an account at a given block, the
+// `Code` field is left empty when only the hash was requested.
+type ContractCode struct {
+	Account   uint64
+	BlockNum  uint32
+	VMType    byte
+	VMVersion byte
+	Hash      []byte
+	Code      []byte
+}
+
 type LinkedPermission struct {
 	Contract       string `json:"contract"`
 	Action         string `json:"action"`
@@ -67,10 +117,12 @@ type LinkedPermission struct {
 type WriteRequest struct {
 	ABIs []*ABIRow
 
-	AuthLinks   []*AuthLinkRow
-	KeyAccounts []*KeyAccountRow
-	TableDatas  []*TableDataRow
-	TableScopes []*TableScopeRow
+	AuthLinks          []*AuthLinkRow
+	ContractCodes      []*ContractCodeRow
+	ControlledAccounts []*ControlledAccountRow
+	KeyAccounts        []*KeyAccountRow
+	TableDatas         []*TableDataRow
+	TableScopes        []*TableScopeRow
 
 	BlockNum uint32
 	BlockID  []byte
@@ -97,6 +149,22 @@ func (req *WriteRequest) purgeShardedRows(shardIdx, shardCount uint32) {
 	}
 	req.AuthLinks = newAuthLinks
 
+	var newContractCodes []*ContractCodeRow
+	for _, el := range req.ContractCodes {
+		if include(el) {
+			newContractCodes = append(newContractCodes, el)
+		}
+	}
+	req.ContractCodes = newContractCodes
+
+	var newControlledAccounts []*ControlledAccountRow
+	for _, el := range req.ControlledAccounts {
+		if include(el) {
+			newControlledAccounts = append(newControlledAccounts, el)
+		}
+	}
+	req.ControlledAccounts = newControlledAccounts
+
 	var newKeyAccounts []*KeyAccountRow
 	for _, el := range req.KeyAccounts {
 		if include(el) {
@@ -130,6 +198,10 @@ func (req *WriteRequest) appendRow(row interface{}) {
 	switch obj := row.(type) {
 	case *AuthLinkRow:
 		req.AuthLinks = append(req.AuthLinks, obj)
+	case *ContractCodeRow:
+		req.ContractCodes = append(req.ContractCodes, obj)
+	case *ControlledAccountRow:
+		req.ControlledAccounts = append(req.ControlledAccounts, obj)
 	case *KeyAccountRow:
 		req.KeyAccounts = append(req.KeyAccounts, obj)
 	case *TableDataRow:
@@ -146,6 +218,14 @@ func (req *WriteRequest) AllWritableRows() (out []writableRow) {
 		out = append(out, el)
 	}
 
+	for _, el := range req.ContractCodes {
+		out = append(out, el)
+	}
+
+	for _, el := range req.ControlledAccounts {
+		out = append(out, el)
+	}
+
 	for _, el := range req.KeyAccounts {
 		out = append(out, el)
 	}
@@ -205,6 +285,85 @@ func (r *AuthLinkRow) buildData() []byte {
 	return value
 }
 
+// ContractCodeRow records the code deployed by a `setcode` action on
+// `Account`. An empty code (i.e. code was cleared) is recorded as a deletion.
+// The row only holds the code hash, the code itself is stored once per hash
+// (see `contractCodeKey`) since the same code is usually deployed many times.
+type ContractCodeRow struct {
+	Account   uint64
+	VMType    byte
+	VMVersion byte
+	Code      []byte
+	Deletion  bool
+}
+
+func (r *ContractCodeRow) tableKey() string {
+	return fmt.Sprintf("cc:%016x", r.Account)
+}
+
+func (r *ContractCodeRow) rowKey(blockNum uint32) string {
+	return fmt.Sprintf("%s:%08x:%s", r.tableKey(), blockNum, r.primKey())
+}
+
+// primKey is always the same since there is a single code per account
+func (r *ContractCodeRow) primKey() string {
+	return "00"
+}
+
+func (r *ContractCodeRow) isDeletion() bool {
+	return r.Deletion
+}
+
+func (r *ContractCodeRow) buildData() []byte {
+	value := make([]byte, 2+sha256.Size)
+	value[0] = r.VMType
+	value[1] = r.VMVersion
+	copy(value[2:], r.hash())
+
+	return value
+}
+
+func (r *ContractCodeRow) hash() []byte {
+	hash := sha256.Sum256(r.Code)
+	return hash[:]
+}
+
+// contractCodeKey is the key of the code having `hash`, the code is content
+// addressed so it's shared by all the deployments of the same code.
+func contractCodeKey(hash []byte) string {
+	return "cw:" + hex.EncodeToString(hash)
+}
+
+// ControlledAccountRow records that `Account`'s `Permission` lists
+// `ControllingAccount` in its authority accounts, which is what is
+// needed to answer nodeos `get_controlled_accounts` history call.
+type ControlledAccountRow struct {
+	ControllingAccount uint64
+	Account            uint64
+	Permission         uint64
+	Deletion           bool
+}
+
+func (r *ControlledAccountRow) tableKey() string {
+	return fmt.Sprintf("ca:%016x", r.ControllingAccount)
+}
+
+func (r *ControlledAccountRow) rowKey(blockNum uint32) string {
+	return fmt.Sprintf("%s:%08x:%s", r.tableKey(), blockNum, r.primKey())
+}
+
+func (r *ControlledAccountRow) primKey() string {
+	return fmt.Sprintf("%016x:%016x", r.Account, r.Permission)
+}
+
+func (r *ControlledAccountRow) isDeletion() bool {
+	return r.Deletion
+}
+
+func (r *ControlledAccountRow) buildData() []byte {
+	return emptyRowData
+}
+
 type KeyAccountRow struct {
 	PublicKey  string
 	Account    uint64
@@ -17,10 +17,12 @@ package fluxdb
 import (
 	"crypto/md5"
 	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAuthLink_BuildData(t *testing.T) {
@@ -54,6 +56,69 @@ func TestAuthLink_RowKey(t *testing.T) {
 	}
 }
 
+func TestContractCode_BuildData(t *testing.T) {
+	row := &ContractCodeRow{Account: N("eosio"), VMType: 0, VMVersion: 1, Code: []byte{0x00, 0x61, 0x73, 0x6d}}
+
+	value := row.buildData()
+
+	require.Len(t, value, 2+32)
+	assert.Equal(t, []byte{0x00, 0x01}, value[0:2])
+	assert.Equal(t, "cd5d4935a48c0672cb06407bb443bc0087aff947c6b864bac886982c73b3027f", hex.EncodeToString(value[2:34]))
+	assert.Equal(t, "cw:cd5d4935a48c0672cb06407bb443bc0087aff947c6b864bac886982c73b3027f", contractCodeKey(row.hash()))
+
+	code, err := decodeContractCode(row.Account, 10, value)
+	require.NoError(t, err)
+	assert.Equal(t, &ContractCode{Account: row.Account, BlockNum: 10, VMType: 0, VMVersion: 1, Hash: value[2:34]}, code)
+}
+
+func TestContractCode_RowKey(t *testing.T) {
+	tests := []struct {
+		row      *ContractCodeRow
+		blockNum uint32
+		expected string
+	}{
+		{
+			&ContractCodeRow{Account: N("eosio")},
+			10,
+			"cc:5530ea0000000000:0000000a:00",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			actual := test.row.rowKey(test.blockNum)
+			assert.Equal(t, test.expected, actual)
+
+			tableKey, blockNum, primKey, err := explodeWritableRowKey(actual)
+			require.NoError(t, err)
+			assert.Equal(t, test.row.tableKey(), tableKey)
+			assert.Equal(t, test.blockNum, blockNum)
+			assert.Equal(t, test.row.primKey(), primKey)
+		})
+	}
+}
+
+func TestControlledAccount_RowKey(t *testing.T) {
+	tests := []struct {
+		row      *ControlledAccountRow
+		blockNum uint32
+		expected string
+	}{
+		{
+			&ControlledAccountRow{N("eosio"), N("token"), N("active"), false},
+			0,
+			"ca:5530ea0000000000:00000000:cd20a98000000000:3232eda800000000",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			actual := test.row.rowKey(test.blockNum)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
 func TestKeyAccount_RowKey(t *testing.T) {
 	tests := []struct {
 		row      *KeyAccountRow
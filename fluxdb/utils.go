@@ -104,6 +104,28 @@ func explodeWritableRowKey(rowKey string) (tableKey string, blockNum uint32, pri
 		blockNum, err = keyChunkToBlockNum(parts[1])
 		primKey = parts[2]
 
+	// ContractCode cc:<account>:<blockNum>:<primaryKey>
+	case parts[0] == "cc":
+		if partCount != 4 {
+			err = fmt.Errorf("contract code row key should have 4 parts, got %d", partCount)
+			return
+		}
+
+		tableKey = strings.Join(parts[0:2], ":")
+		blockNum, err = keyChunkToBlockNum(parts[2])
+		primKey = parts[3]
+
+	// ControlledAccount ca:<controllingAccount>:<blockNum>:<account>:<permission>
+	case parts[0] == "ca":
+		if partCount != 5 {
+			err = fmt.Errorf("controlled account row key should have 5 parts, got %d", partCount)
+			return
+		}
+
+		tableKey = strings.Join(parts[0:2], ":")
+		blockNum, err = keyChunkToBlockNum(parts[2])
+		primKey = strings.Join(parts[3:5], ":")
+
 	// KeyAccount ka2:<publicKey>:<blockNum>:<account>:<permission>
 	case parts[0] == "ka2":
 		if partCount != 5 {
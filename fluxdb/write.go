@@ -130,6 +130,13 @@ func (fdb *FluxDB) writeBlock(ctx context.Context, batch store.Batch, w *WriteRe
 		}
 	}
 
+	for _, row := range w.ContractCodes {
+		// Writing the code again on each deployment of the same code simply overwrites it
+		if !row.Deletion {
+			batch.SetRow(contractCodeKey(row.hash()), row.Code)
+		}
+	}
+
 	for _, abi := range w.ABIs {
 		key := fmt.Sprintf("%s:%s", HexName(abi.Account), HexRevBlockNum(w.BlockNum))
 
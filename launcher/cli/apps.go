@@ -17,6 +17,7 @@ package cli
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
 	"os"
 	"path/filepath"
@@ -39,6 +40,8 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/launcher"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	batchIndexerApp "github.com/dfuse-io/dfuse-eosio/search/indexer/app/indexer"
+	sinksApp "github.com/dfuse-io/dfuse-eosio/sinks/app/sinks"
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	trxdbLoaderApp "github.com/dfuse-io/dfuse-eosio/trxdb-loader/app/trxdb-loader"
 	dgraphqlApp "github.com/dfuse-io/dgraphql/app/dgraphql"
@@ -66,9 +69,9 @@ func init() {
 	launcher.RegisterCommonFlags = func(cmd *cobra.Command) error {
 		// Common stores configuration flags
 		cmd.Flags().String("common-backup-store-url", PitreosURL, "[COMMON] Store URL (with prefix) where to read or write backups.")
-		cmd.Flags().String("common-blocks-store-url", MergedBlocksStoreURL, "[COMMON] Store URL (with prefix) where to read/write. Used by: relayer, fluxdb, trxdb-loader, blockmeta, search-indexer, search-live, search-forkresolver, eosws")
+		cmd.Flags().String("common-blocks-store-url", MergedBlocksStoreURL, "[COMMON] Store URL (with prefix) where to read/write. Used by: relayer, fluxdb, trxdb-loader, sinks, blockmeta, search-indexer, search-live, search-forkresolver, eosws")
 		cmd.Flags().String("common-oneblock-store-url", OneBlockStoreURL, "[COMMON] Store URL (with prefix) to read/write one-block files. Used by: mindreader, merger")
-		cmd.Flags().String("common-blockstream-addr", RelayerServingAddr, "gRPC endpoint to get real-time blocks. Used by: fluxdb, trxdb-loader, blockmeta, search-indexer, search-live, eosws (relayer uses its own --relayer-blockstream-addr)")
+		cmd.Flags().String("common-blockstream-addr", RelayerServingAddr, "gRPC endpoint to get real-time blocks. Used by: fluxdb, trxdb-loader, sinks, blockmeta, search-indexer, search-live, eosws, dashboard (relayer uses its own --relayer-blockstream-addr)")
 
 		// Network config
 		cmd.Flags().String("common-network-id", NetworkID, "Short network identifier, for billing purposes (usually maps namespaces on deployments). Used by: dgraphql")
@@ -77,10 +80,14 @@ func init() {
 		// Authentication, metering and rate limiter plugins
 		cmd.Flags().String("common-auth-plugin", "null://", "Auth plugin URI, see dfuse-io/dauth repository")
 		cmd.Flags().String("common-metering-plugin", "null://", "Metering plugin URI, see dfuse-io/dmetering repository")
+		cmd.Flags().StringSlice("common-metering-backends", nil, "Backends receiving the usage aggregated in-process per user, method and time bucket: file://<path> (JSON lines), prometheus://[?with_user=true] (counters), http(s)://<url> (POSTed JSON) (repeat flag as needed). Used by: eosws, dgraphql")
+		cmd.Flags().Duration("common-metering-bucket-size", 5*time.Minute, "Time span over which usage is aggregated before being sent to the metering backends")
+		cmd.Flags().Duration("common-metering-usage-retention", 24*time.Hour, "How long aggregated usage is kept in memory to be served by eosws /v0/usage")
+		cmd.Flags().Duration("common-metering-flush-interval", 30*time.Second, "Interval at which closed usage buckets are sent to the metering backends")
 		cmd.Flags().String("common-ratelimiter-plugin", "null://", "Rate Limiter plugin URI, see dfuse-io/dauth repository")
 
 		// Database connection strings
-		cmd.Flags().String("common-trxdb-dsn", TrxdbDSN, "kvdb connection string to trxdb database. Used by: trxdb-loader, abicodec, eosws, dgraphql")
+		cmd.Flags().String("common-trxdb-dsn", TrxdbDSN, "kvdb connection string to trxdb database. Used by: trxdb-loader, abicodec, eosws, dgraphql, dashboard")
 
 		// Service addresses
 		cmd.Flags().String("common-search-addr", RouterServingAddr, "gRPC endpoint to reach the Search Router. Used by: abicodec, eosws, dgraphql")
@@ -229,6 +236,8 @@ func init() {
 			cmd.Flags().Bool("mindreader-merge-and-store-directly", false, "[BATCH] When enabled, do not write oneblock files, sidestep the merger and write the merged 100-blocks logs directly to --common-blocks-store-url")
 			cmd.Flags().Bool("mindreader-start-failure-handler", true, "Enables the startup function handler, that gets called if mindreader fails on startup")
 			cmd.Flags().Bool("mindreader-fail-on-non-contiguous-block", false, "Enables the Continuity Checker that stops (or refuses to start) the nodeos if a block was missed. It has a significant performance cost on reprocessing large segments of blocks")
+			cmd.Flags().String("mindreader-block-source", "deep-mind", "Where blocks are read from, either 'deep-mind' (the deep-mind instrumented nodeos output) or 'state-history' (the standard nodeos state_history_plugin websocket endpoint, producing partially instrumented blocks, nodeos must be configured with the plugin, see --mindreader-nodeos-args)")
+			cmd.Flags().String("mindreader-state-history-addr", MindreaderStateHistoryAddr, "Websocket address of the nodeos state_history_plugin endpoint, used when --mindreader-block-source is 'state-history'")
 			return nil
 		},
 		InitFunc: func(modules *launcher.RuntimeModules) error {
@@ -257,8 +266,21 @@ func init() {
 				}
 
 			}
-			consoleReaderFactory := func(reader io.Reader) (mindreader.ConsolerReader, error) {
-				return codec.NewConsoleReader(reader)
+			var consoleReaderFactory mindreader.ConsolerReaderFactory
+			switch blockSource := viper.GetString("mindreader-block-source"); blockSource {
+			case "deep-mind":
+				consoleReaderFactory = func(reader io.Reader) (mindreader.ConsolerReader, error) {
+					return codec.NewConsoleReader(reader)
+				}
+			case "state-history":
+				consoleReaderFactory = func(reader io.Reader) (mindreader.ConsolerReader, error) {
+					// Blocks come from the websocket endpoint, the nodeos output is not used but must still be consumed
+					go io.Copy(ioutil.Discard, reader)
+
+					return codec.NewStateHistoryReader(viper.GetString("mindreader-state-history-addr"), uint32(viper.GetUint64("mindreader-start-block-num")))
+				}
+			default:
+				return nil, fmt.Errorf("invalid mindreader block source %q, must be one of 'deep-mind' or 'state-history'", blockSource)
 			}
 			//
 			consoleReaderBlockTransformer := func(obj interface{}) (*bstream.Block, error) {
@@ -515,6 +537,56 @@ func init() {
 		},
 	})
 
+	// Sinks
+	launcher.RegisterApp(&launcher.AppDef{
+		ID:          "sinks",
+		Title:       "Event sinks",
+		Description: "Publishes filtered actions to files, webhooks and message queues",
+		MetricsID:   "sinks",
+		Logger:      launcher.NewLoggingDef("github.com/dfuse-io/dfuse-eosio/sinks.*", nil),
+		RegisterFlags: func(cmd *cobra.Command) error {
+			cmd.Flags().StringSlice("sinks-dsn", nil, "Destinations of the events, one of 'file:///path/events.jsonl' (JSON lines), 'http(s)://...' (webhook) or '<queue-scheme>://<address>/<topic>' for registered queue drivers ('memory' built-in)")
+			cmd.Flags().String("sinks-filter-on-expr", "", "CEL program to whitelist actions to publish, same identifiers as search. See https://github.com/dfuse-io/dfuse-eosio/blob/develop/search/README.md")
+			cmd.Flags().String("sinks-filter-out-expr", "", "CEL program to blacklist actions to publish")
+			cmd.Flags().String("sinks-format", "json", "Encoding of the published events, either 'json' or 'protobuf' (payload being the action trace, event fields sent as headers)")
+			cmd.Flags().String("sinks-cursor-path", "{dfuse-data-dir}/sinks/cursor.json", "File recording the last irreversible block streamed, streaming resumes right after it on start")
+			cmd.Flags().Bool("sinks-irreversible-only", false, "Publish only irreversible events instead of new, undo and redo ones")
+			cmd.Flags().Int("sinks-webhook-max-retries", 10, "Amount of times a failed webhook call is retried before the app stops")
+			cmd.Flags().String("sinks-start-cursor", "", "Cursor of an event, as received by a consumer, to resume right after when there is no cursor file yet")
+			cmd.Flags().Uint64("sinks-start-block-num", 0, "Block number to start from when there is no cursor yet, 0 meaning the live head")
+			cmd.Flags().Uint64("sinks-num-blocks-before-start", 300, "Number of blocks to fetch before start block")
+			cmd.Flags().Int("sinks-parallel-file-download-count", 2, "Maximum number of files to download in parallel")
+			return nil
+		},
+		FactoryFunc: func(modules *launcher.RuntimeModules) (launcher.App, error) {
+			dfuseDataDir, err := dfuseAbsoluteDataDir()
+			if err != nil {
+				return nil, err
+			}
+
+			cursorPath := mustReplaceDataDir(dfuseDataDir, viper.GetString("sinks-cursor-path"))
+			if err := os.MkdirAll(filepath.Dir(cursorPath), 0755); err != nil {
+				return nil, fmt.Errorf("unable to create sinks cursor directory: %w", err)
+			}
+
+			return sinksApp.New(&sinksApp.Config{
+				BlocksStoreURL:            mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url")),
+				BlockStreamAddr:           viper.GetString("common-blockstream-addr"),
+				FilterOnExpr:              viper.GetString("sinks-filter-on-expr"),
+				FilterOutExpr:             viper.GetString("sinks-filter-out-expr"),
+				Format:                    viper.GetString("sinks-format"),
+				SinkDSNs:                  viper.GetStringSlice("sinks-dsn"),
+				CursorPath:                cursorPath,
+				IrreversibleOnly:          viper.GetBool("sinks-irreversible-only"),
+				WebhookMaxRetries:         viper.GetInt("sinks-webhook-max-retries"),
+				StartCursor:               viper.GetString("sinks-start-cursor"),
+				StartBlockNum:             viper.GetUint64("sinks-start-block-num"),
+				NumBlocksBeforeStart:      viper.GetUint64("sinks-num-blocks-before-start"),
+				ParallelFileDownloadCount: viper.GetInt("sinks-parallel-file-download-count"),
+			}), nil
+		},
+	})
+
 	// Blockmeta
 	launcher.RegisterApp(&launcher.AppDef{
 		ID:          "blockmeta",
@@ -614,6 +686,8 @@ func init() {
 			cmd.Flags().Bool("search-indexer-enable-index-truncation", false, "Enable index truncation, requires a relative --start-block (negative number)")
 			cmd.Flags().Uint64("search-indexer-shard-size", 200, "Number of blocks to store in a given Bleve index")
 			cmd.Flags().String("search-indexer-writable-path", "{dfuse-data-dir}/search/indexer", "Writable base path for storing index files")
+			cmd.Flags().Int("search-indexer-batch-workers", 0, "When greater than 0 in batch mode, number of shards indexed in parallel from the merged blocks files, skipping shards already in the indices store (requires --search-indexer-stop-block)")
+			cmd.Flags().Uint64("search-indexer-batch-lookback-blocks", 400, "In parallel batch mode, number of blocks read before each shard to determine the irreversibility of its first blocks")
 			return nil
 		},
 		FactoryFunc: func(modules *launcher.RuntimeModules) (launcher.App, error) {
@@ -631,6 +705,24 @@ func init() {
 				return nil, fmt.Errorf("unable to create EOS block mapper: %w", err)
 			}
 
+			if viper.GetBool("search-indexer-enable-batch-mode") && viper.GetInt("search-indexer-batch-workers") > 0 {
+				startBlock := viper.GetInt("search-indexer-start-block")
+				if startBlock < 0 {
+					return nil, fmt.Errorf("parallel batch indexing requires an absolute start block, got %d", startBlock)
+				}
+
+				return batchIndexerApp.New(&batchIndexerApp.Config{
+					BlocksStoreURL:     mustReplaceDataDir(dfuseDataDir, viper.GetString("common-blocks-store-url")),
+					IndicesStoreURL:    mustReplaceDataDir(dfuseDataDir, viper.GetString("search-common-indices-store-url")),
+					WritablePath:       mustReplaceDataDir(dfuseDataDir, viper.GetString("search-indexer-writable-path")),
+					StartBlockNum:      uint64(startBlock),
+					StopBlockNum:       viper.GetUint64("search-indexer-stop-block"),
+					ShardSize:          viper.GetUint64("search-indexer-shard-size"),
+					Workers:            viper.GetInt("search-indexer-batch-workers"),
+					LookbackBlockCount: viper.GetUint64("search-indexer-batch-lookback-blocks"),
+				}, mapper), nil
+			}
+
 			var startBlockResolvers []bstream.StartBlockResolver
 			blockmetaAddr := viper.GetString("common-blockmeta-addr")
 			if blockmetaAddr != "" {
@@ -872,9 +964,17 @@ func init() {
 			cmd.Flags().String("eosws-search-addr-secondary", "", "secondary search grpc endpoint")
 			cmd.Flags().Duration("eosws-filesource-ratelimit", 2*time.Millisecond, "time to sleep between blocks coming from filesource to control replay speed")
 			cmd.Flags().String("eosws-healthz-secret", "", "Secret to access healthz")
+			cmd.Flags().StringSlice("eosws-health-thresholds", nil, "Override the degraded and unhealthy latencies of a monitored subsystem (hub (in seconds), trxdb, flux, search, merger (in blocks)), in the form <subsystem>:<degraded>:<unhealthy>[:<timeout>] like 'search:50:500:1s' (repeat flag as needed)")
+			cmd.Flags().Duration("eosws-health-check-interval", 5*time.Second, "Interval between two health checks of the subsystems eosws depends on")
+			cmd.Flags().Int("eosws-health-history-size", 100, "Number of health level transitions kept for /healthz?history=true")
 			cmd.Flags().String("eosws-data-integrity-proof-secret", "boo", "Data integrity secret for DIPP middleware")
 			cmd.Flags().Bool("eosws-authenticate-nodeos-api", false, "Gate access to native nodeos APIs with authentication")
 			cmd.Flags().Bool("eosws-use-opencensus-stack-driver", false, "Enables stack driver tracing")
+			cmd.Flags().StringSlice("eosws-simple-search-token-contracts", []string{"eosio.token"}, "Token contracts whose 'stat' table is looked up to resolve symbols in simple search (repeat flag as needed)")
+			cmd.Flags().Int("eosws-ws-max-streams-per-connection", 12, "Maximum number of concurrent listening streams of a single websocket connection")
+			cmd.Flags().Int("eosws-ws-max-streams-per-user", 0, "Maximum number of concurrent listening streams of an authenticated user across all its websocket connections, 0 for no limit")
+			cmd.Flags().Int("eosws-ws-max-queued-messages", 1000, "Maximum number of outgoing messages buffered for a websocket client not reading fast enough, past it the overflowing stream is dropped (the connection is closed when the message is not part of a stream)")
+			cmd.Flags().StringSlice("eosws-ws-lossy-streams", nil, "Ticker-style streams (get_head_info, get_price, get_vote_tally) only keeping the latest update for slow websocket clients instead of queuing them all (repeat flag as needed)")
 			return nil
 		},
 		FactoryFunc: func(modules *launcher.RuntimeModules) (launcher.App, error) {
@@ -903,6 +1003,14 @@ func init() {
 				RealtimeTolerance:           viper.GetDuration("eosws-realtime-tolerance"),
 				DataIntegrityProofSecret:    viper.GetString("eosws-data-integrity-proof-secret"),
 				HealthzSecret:               viper.GetString("eosws-healthz-secret"),
+				HealthThresholds:            viper.GetStringSlice("eosws-health-thresholds"),
+				HealthCheckInterval:         viper.GetDuration("eosws-health-check-interval"),
+				HealthHistorySize:           viper.GetInt("eosws-health-history-size"),
+				SimpleSearchTokenContracts:  viper.GetStringSlice("eosws-simple-search-token-contracts"),
+				WSMaxStreamsPerConnection:   viper.GetInt("eosws-ws-max-streams-per-connection"),
+				WSMaxStreamsPerUser:         viper.GetInt("eosws-ws-max-streams-per-user"),
+				WSMaxQueuedMessages:         viper.GetInt("eosws-ws-max-queued-messages"),
+				WSLossyStreams:              viper.GetStringSlice("eosws-ws-lossy-streams"),
 			}), nil
 		},
 	})
@@ -917,6 +1025,7 @@ func init() {
 			cmd.Flags().String("dgraphql-http-addr", DgraphqlHTTPServingAddr, "TCP Listener addr for http")
 			cmd.Flags().String("dgraphql-grpc-addr", DgraphqlGrpcServingAddr, "TCP Listener addr for gRPC")
 			cmd.Flags().String("dgraphql-abi-addr", AbiServingAddr, "Base URL for abicodec service")
+			cmd.Flags().String("dgraphql-fluxdb-addr", FluxDBServingAddr, "FluxDB server address, used to resolve the `withStateAfter` argument of search queries")
 			cmd.Flags().Duration("dgraphql-graceful-shutdown-delay", 0, "delay before shutting down, after the health endpoint returns unhealthy")
 			cmd.Flags().Bool("dgraphql-disable-authentication", false, "disable authentication for both grpc and http services")
 			cmd.Flags().Bool("dgraphql-override-trace-id", false, "flag to override trace id or not")
@@ -939,6 +1048,7 @@ func init() {
 				// eos specifc configs
 				SearchAddr:        viper.GetString("common-search-addr"),
 				ABICodecAddr:      viper.GetString("dgraphql-abi-addr"),
+				FluxDBAddr:        viper.GetString("dgraphql-fluxdb-addr"),
 				BlockMetaAddr:     viper.GetString("common-blockmeta-addr"),
 				KVDBDSN:           mustReplaceDataDir(absDataDir, viper.GetString("common-trxdb-dsn")),
 				RatelimiterPlugin: viper.GetString("common-ratelimiter-plugin"),
@@ -1010,19 +1120,31 @@ func init() {
 			cmd.Flags().String("dashboard-grpc-listen-addr", DashboardGrpcServingAddr, "TCP Listener addr for http")
 			cmd.Flags().String("dashboard-http-listen-addr", DashboardHTTPListenAddr, "TCP Listener addr for gRPC")
 			cmd.Flags().String("dashboard-eos-node-manager-api-addr", EosManagerAPIAddr, "Address of the nodeos manager api")
+			cmd.Flags().Int("dashboard-explorer-window-size", 500, "Number of last blocks summarized by the chain explorer, backfilled from trxdb then followed from --common-blockstream-addr")
 			// FIXME: we can re-add when the app actually makes use of it.
 			//cmd.Flags().String("dashboard-mindreader-manager-api-addr", MindreaderNodeosAPIAddr, "Address of the mindreader nodeos manager api")
 			return nil
 		},
 		FactoryFunc: func(modules *launcher.RuntimeModules) (launcher.App, error) {
+			dfuseDataDir, err := dfuseAbsoluteDataDir()
+			if err != nil {
+				return nil, err
+			}
+
 			return dashboard.New(&dashboard.Config{
 				GRPCListenAddr:        viper.GetString("dashboard-grpc-listen-addr"),
 				HTTPListenAddr:        viper.GetString("dashboard-http-listen-addr"),
 				EosNodeManagerAPIAddr: viper.GetString("dashboard-eos-node-manager-api-addr"),
+				BlockStreamAddr:       viper.GetString("common-blockstream-addr"),
+				KVDBDSN:               mustReplaceDataDir(dfuseDataDir, viper.GetString("common-trxdb-dsn")),
+				ExplorerWindowSize:    viper.GetInt("dashboard-explorer-window-size"),
 				//NodeosAPIHTTPServingAddr: viper.GetString("dashboard-mindreader-manager-api-addr"),
 			}, &dashboard.Modules{
-				Launcher:    modules.Launcher,
-				DmeshClient: modules.SearchDmeshClient,
+				Launcher:       modules.Launcher,
+				DmeshClient:    modules.SearchDmeshClient,
+				AppLogs:        appLogs,
+				SetAppLogLevel: changeAppLogLevel,
+				Traces:         traceBuffer,
 			}), nil
 		},
 	})
@@ -1041,6 +1163,7 @@ func init() {
 			cmd.Flags().String("apiproxy-eosws-http-addr", EoswsHTTPServingAddr, "Target address of the eosws API endpoint")
 			cmd.Flags().String("apiproxy-dgraphql-http-addr", DgraphqlHTTPServingAddr, "Target address of the dgraphql API endpoint")
 			cmd.Flags().String("apiproxy-nodeos-http-addr", NodeosAPIAddr, "Address of a queriable nodeos instance")
+			cmd.Flags().String("apiproxy-fluxdb-http-addr", "", "If non-empty, nodeos compatible state calls (get_table_rows, get_table_by_scope, get_currency_balance, get_currency_stats, get_abi) are routed to this FluxDB address instead of nodeos")
 			cmd.Flags().String("apiproxy-root-http-addr", EosqHTTPServingAddr, "What to serve at the root of the proxy (defaults to eosq)")
 			return nil
 		},
@@ -1058,6 +1181,7 @@ func init() {
 				EoswsHTTPAddr:    viper.GetString("apiproxy-eosws-http-addr"),
 				DgraphqlHTTPAddr: viper.GetString("apiproxy-dgraphql-http-addr"),
 				NodeosHTTPAddr:   viper.GetString("apiproxy-nodeos-http-addr"),
+				FluxDBHTTPAddr:   viper.GetString("apiproxy-fluxdb-http-addr"),
 				RootHTTPAddr:     viper.GetString("apiproxy-root-http-addr"),
 			}), nil
 		},
@@ -44,6 +44,7 @@ const (
 	DashboardHTTPListenAddr     string = ":8081"
 	APIProxyHTTPListenAddr      string = ":8080"
 	MindreaderNodeosAPIAddr     string = ":9888"
+	MindreaderStateHistoryAddr  string = "ws://localhost:9889"
 	NodeosAPIAddr               string = ":8888"
 
 	DgraphqlAPIKey string = "web_0000"
@@ -0,0 +1,120 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	zapbox "github.com/dfuse-io/dfuse-eosio/zap-box"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// logFileSink writes the logs of all apps to `{data-dir}/dfuse.log.json`, or
+// of each app to `{data-dir}/logs/<app>.log.json` when per app, rotating the
+// files when they reach their maximum size and, optionally, at a fixed
+// interval.
+type logFileSink struct {
+	dir        string
+	perApp     bool
+	format     string
+	maxSize    int
+	maxBackups int
+	maxAge     int
+
+	lock  sync.Mutex
+	files map[string]*lumberjack.Logger
+}
+
+func newLogFileSink(dataDir string, perApp bool, format string, maxSize, maxBackups, maxAge int) (*logFileSink, error) {
+	if format != "json" && format != "text" {
+		return nil, fmt.Errorf("invalid log file format %q, either 'json' or 'text'", format)
+	}
+
+	dir := dataDir
+	if perApp {
+		dir = filepath.Join(dataDir, "logs")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("unable to create logs directory %q: %w", dir, err)
+	}
+
+	return &logFileSink{
+		dir:        dir,
+		perApp:     perApp,
+		format:     format,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+		maxAge:     maxAge,
+		files:      map[string]*lumberjack.Logger{},
+	}, nil
+}
+
+// core returns the core writing the entries of `appID` to its file, the file
+// is shared by all apps when not per app.
+func (s *logFileSink) core(appID string) zapcore.Core {
+	name := "dfuse"
+	if s.perApp {
+		name = appID
+	}
+
+	extension := ".log.json"
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	if s.format == "text" {
+		extension = ".log"
+		encoder = zapbox.NewEncoder(4)
+	}
+
+	return zapcore.NewCore(encoder, zapcore.AddSync(s.file(name+extension)), zap.InfoLevel)
+}
+
+func (s *logFileSink) file(name string) *lumberjack.Logger {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if file, found := s.files[name]; found {
+		return file
+	}
+
+	file := &lumberjack.Logger{
+		Filename:   filepath.Join(s.dir, name),
+		MaxSize:    s.maxSize,
+		MaxBackups: s.maxBackups,
+		MaxAge:     s.maxAge,
+	}
+	s.files[name] = file
+
+	return file
+}
+
+// rotateEvery rotates all the files at each `interval`, in addition to the
+// rotations on size.
+func (s *logFileSink) rotateEvery(interval time.Duration) {
+	for range time.Tick(interval) {
+		s.lock.Lock()
+		for name, file := range s.files {
+			if err := file.Rotate(); err != nil {
+				userLog.Warn("unable to rotate log file", zap.String("file", name), zap.Error(err))
+			}
+		}
+		s.lock.Unlock()
+	}
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLogFileSink(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "log-files")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+
+	_, err = newLogFileSink(dataDir, true, "yaml", 1, 1, 0)
+	require.Error(t, err)
+
+	sink, err := newLogFileSink(dataDir, true, "json", 1, 1, 0)
+	require.NoError(t, err)
+
+	zap.New(sink.core("merger")).Info("merged")
+	zap.New(sink.core("relayer")).Debug("skipped")
+	zap.New(sink.core("relayer")).Warn("relayed")
+
+	content, err := ioutil.ReadFile(filepath.Join(dataDir, "logs", "merger.log.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"msg":"merged"`)
+	assert.NotContains(t, string(content), "relayed")
+
+	content, err = ioutil.ReadFile(filepath.Join(dataDir, "logs", "relayer.log.json"))
+	require.NoError(t, err)
+	assert.Contains(t, string(content), `"msg":"relayed"`)
+	assert.NotContains(t, string(content), "skipped")
+}
+
+func TestResetLoggersLevel(t *testing.T) {
+	appToDefaultLevel["test-app"] = zap.InfoLevel
+	appToAtomicLevel["test-app"] = zap.NewAtomicLevelAt(zap.InfoLevel)
+	defer func() {
+		delete(appToDefaultLevel, "test-app")
+		delete(appToAtomicLevel, "test-app")
+	}()
+
+	assert.True(t, changeAppLogLevel("test-app", zap.DebugLevel))
+	assert.Equal(t, "debug", currentLogLevels()["test-app"])
+
+	assert.Error(t, resetLoggersLevel("test-app,unknown"))
+	require.NoError(t, resetLoggersLevel(" test-app"))
+	assert.Equal(t, "info", currentLogLevels()["test-app"])
+}
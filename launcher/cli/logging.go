@@ -20,7 +20,6 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
-	"path/filepath"
 	"strings"
 	"sync"
 
@@ -63,23 +62,39 @@ func setupLogger() {
 	logformat := viper.GetString("global-log-format")
 	logToFile := viper.GetBool("global-log-to-file")
 	listenAddr := viper.GetString("global-log-level-switcher-listen-addr")
+	if bufferSize := viper.GetInt("global-log-buffer-size"); bufferSize > 0 {
+		appLogs = launcher.NewAppLogs(bufferSize)
+	}
 
-	// TODO: The logger expect that the dataDir already exists...
-
-	var logFileWriter zapcore.WriteSyncer
+	var fileSink *logFileSink
 	if logToFile {
-		logFileWriter = createLogFileWriter(dataDir)
+		var err error
+		fileSink, err = newLogFileSink(
+			dataDir,
+			viper.GetBool("global-log-file-per-app"),
+			viper.GetString("global-log-file-format"),
+			viper.GetInt("global-log-file-max-size"),
+			viper.GetInt("global-log-file-max-backups"),
+			viper.GetInt("global-log-file-max-age"),
+		)
+		if err != nil {
+			fmt.Printf("Unable to use log files, logs won't be saved in a log file and will be printed to console only (error: %s)\n", err)
+		}
 	}
 	logStdoutWriter := zapcore.Lock(os.Stdout)
 
-	commonLogger := createLogger("common", commongLoggingDef, verbosity, logFileWriter, logStdoutWriter, logformat)
+	commonLogger := createLogger("common", commongLoggingDef, verbosity, fileSink, logStdoutWriter, logformat)
 	logging.Set(commonLogger)
 
 	for _, appDef := range launcher.AppRegistry {
-		logging.Set(createLogger(appDef.ID, appDef.Logger, verbosity, logFileWriter, logStdoutWriter, logformat), appDef.Logger.Regex)
+		logging.Set(createLogger(appDef.ID, appDef.Logger, verbosity, fileSink, logStdoutWriter, logformat), appDef.Logger.Regex)
+	}
+	logging.Set(createLogger("dfuse", dfuseLoggingDef, verbosity, fileSink, logStdoutWriter, logformat), dfuseLoggingDef.Regex)
+	logging.Set(createLogger("bstream", bstreamLoggingDef, verbosity, fileSink, logStdoutWriter, logformat), bstreamLoggingDef.Regex)
+
+	if interval := viper.GetDuration("global-log-file-rotate-interval"); fileSink != nil && interval > 0 {
+		go fileSink.rotateEvery(interval)
 	}
-	logging.Set(createLogger("dfuse", dfuseLoggingDef, verbosity, logFileWriter, logStdoutWriter, logformat), dfuseLoggingDef.Regex)
-	logging.Set(createLogger("bstream", bstreamLoggingDef, verbosity, logFileWriter, logStdoutWriter, logformat), bstreamLoggingDef.Regex)
 
 	// Fine-grain customization
 	//
@@ -121,7 +136,20 @@ type logChangeReq struct {
 	Level  string `json:"level"`
 }
 
+type logLevelsResp struct {
+	Levels map[string]string `json:"levels"`
+}
+
+// handleHTTPLogChange returns the current level of each app on `GET` and
+// changes the level of loggers on `POST`, the `reset` level restoring the
+// apps listed in `inputs`, or all of them when empty, to the level they
+// started with.
 func handleHTTPLogChange(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(logLevelsResp{Levels: currentLogLevels()})
+		return
+	}
 
 	b, err := ioutil.ReadAll(r.Body)
 	defer r.Body.Close()
@@ -138,12 +166,24 @@ func handleHTTPLogChange(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if strings.ToLower(in.Level) == "reset" {
+		if err := resetLoggersLevel(in.Inputs); err != nil {
+			http.Error(w, err.Error(), 400)
+			return
+		}
+
+		w.Write([]byte("ok"))
+		return
+	}
+
 	if in.Inputs == "" {
 		http.Error(w, fmt.Sprintf("inputs not defined, should be comma-separated list of words or a regular expressions: %s", err), 400)
 		return
 	}
 
 	switch strings.ToLower(in.Level) {
+	case "error":
+		changeLoggersLevel(in.Inputs, zap.ErrorLevel)
 	case "warn", "warning":
 		changeLoggersLevel(in.Inputs, zap.WarnLevel)
 	case "info":
@@ -159,12 +199,18 @@ func handleHTTPLogChange(w http.ResponseWriter, r *http.Request) {
 }
 
 var appToAtomicLevel = map[string]zap.AtomicLevel{}
+var appToDefaultLevel = map[string]zapcore.Level{}
 var appToAtomicLevelLock sync.Mutex
 
-func createLogger(appID string, loggingDef *launcher.LoggingDef, verbosity int, fileSyncer zapcore.WriteSyncer, consoleSyncer zapcore.WriteSyncer, format string) *zap.Logger {
+// appLogs keeps the last log entries of each app for the dashboard, it is nil
+// when the log buffer size is 0
+var appLogs *launcher.AppLogs
+
+func createLogger(appID string, loggingDef *launcher.LoggingDef, verbosity int, fileSink *logFileSink, consoleSyncer zapcore.WriteSyncer, format string) *zap.Logger {
 
 	// It's ok for concurrent use here, we assume all logger are created in a single goroutine
-	appToAtomicLevel[appID] = zap.NewAtomicLevelAt(appLoggerLevel(loggingDef.Levels, verbosity))
+	appToDefaultLevel[appID] = appLoggerLevel(loggingDef.Levels, verbosity)
+	appToAtomicLevel[appID] = zap.NewAtomicLevelAt(appToDefaultLevel[appID])
 	opts := []zap.Option{zap.AddCaller()}
 
 	var consoleCore zapcore.Core
@@ -177,15 +223,16 @@ func createLogger(appID string, loggingDef *launcher.LoggingDef, verbosity int,
 		consoleCore = zapcore.NewCore(zapbox.NewEncoder(verbosity), consoleSyncer, appToAtomicLevel[appID])
 	}
 
-	if fileSyncer == nil {
-		return zap.New(consoleCore, opts...).Named(appID)
+	cores := []zapcore.Core{consoleCore}
+	if fileSink != nil {
+		cores = append(cores, fileSink.core(appID))
 	}
 
-	encoderConfig := zap.NewProductionEncoderConfig()
-	fileCore := zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), fileSyncer, zap.InfoLevel)
-	teeCore := zapcore.NewTee(consoleCore, fileCore)
+	if appLogs != nil {
+		cores = append(cores, appLogs.Core(appID, appToAtomicLevel[appID]))
+	}
 
-	return zap.New(teeCore, opts...).Named(appID)
+	return zap.New(zapcore.NewTee(cores...), opts...).Named(appID)
 
 }
 
@@ -201,11 +248,9 @@ func changeLoggersLevel(inputs string, level zapcore.Level) {
 	}
 }
 
-// At some point, we will want to control the level from the server directly. It will
-// be possible to use this method to achieve that. However, it might be required to be
-// moved to `dfuse` package directly, so it's available to be used by the `gRPC` server
-// in dashboard. To be determined once the issue is tackled.
-func changeAppLogLevel(appID string, level zapcore.Level) {
+// changeAppLogLevel is also used by the dashboard to change the level of an app
+// from its `gRPC` server, it returns false when the app has no logger.
+func changeAppLogLevel(appID string, level zapcore.Level) bool {
 	appToAtomicLevelLock.Lock()
 	defer appToAtomicLevelLock.Unlock()
 
@@ -213,6 +258,49 @@ func changeAppLogLevel(appID string, level zapcore.Level) {
 	if found {
 		atomicLevel.SetLevel(level)
 	}
+
+	return found
+}
+
+// resetLoggersLevel restores the apps in `inputs`, or all apps when empty, to
+// the level they started with. Levels overridden through a regular
+// expression are not reset.
+func resetLoggersLevel(inputs string) error {
+	appToAtomicLevelLock.Lock()
+	defer appToAtomicLevelLock.Unlock()
+
+	appIDs := make([]string, 0, len(appToDefaultLevel))
+	if inputs == "" {
+		for appID := range appToDefaultLevel {
+			appIDs = append(appIDs, appID)
+		}
+	} else {
+		for _, input := range strings.Split(inputs, ",") {
+			appID := strings.Trim(input, " ")
+			if _, found := appToDefaultLevel[appID]; !found {
+				return fmt.Errorf("unknown app %q, only apps can be reset", appID)
+			}
+			appIDs = append(appIDs, appID)
+		}
+	}
+
+	for _, appID := range appIDs {
+		appToAtomicLevel[appID].SetLevel(appToDefaultLevel[appID])
+	}
+
+	return nil
+}
+
+func currentLogLevels() map[string]string {
+	appToAtomicLevelLock.Lock()
+	defer appToAtomicLevelLock.Unlock()
+
+	out := make(map[string]string, len(appToAtomicLevel))
+	for appID, atomicLevel := range appToAtomicLevel {
+		out[appID] = atomicLevel.Level().String()
+	}
+
+	return out
 }
 
 func overrideLoggerLevel(level zapcore.Level) logging.LoggerExtender {
@@ -229,22 +317,3 @@ func appLoggerLevel(levels []zl, verbosity int) zapcore.Level {
 
 	return levels[severityIndex]
 }
-
-func createLogFileWriter(dataDir string) zapcore.WriteSyncer {
-	_ = os.Mkdir(dataDir, 0755)
-
-	logFile := filepath.Join(dataDir, "dfuse.log.json")
-	writer, _, err := zap.Open(logFile)
-	if err != nil {
-		tempLogFile := filepath.Join(os.TempDir(), "dfuse.log.json")
-		fmt.Printf("Unable to use %q for logging purposes, trying with %q instead (error: %s)\n", logFile, tempLogFile, err)
-		writer, _, err := zap.Open(logFile)
-		if err != nil {
-			fmt.Printf("Unable to use %q for logging purposes, logs won't be saved in a log file and will be printed to console only (error: %s)\n", tempLogFile, err)
-			return writer
-		}
-	}
-
-	// Might return `nil`, which is handled by logging
-	return writer
-}
@@ -44,7 +44,17 @@ func Main() {
 	RootCmd.PersistentFlags().Bool("skip-checks", false, "Skip checks to ensure 'nodeos' binary is supported")
 	RootCmd.PersistentFlags().String("log-format", "text", "Format for logging to stdout. Either 'text' or 'stackdriver'")
 	RootCmd.PersistentFlags().Bool("log-to-file", true, "Also write logs to {data-dir}/dfuse.log.json ")
+	RootCmd.PersistentFlags().Bool("log-file-per-app", false, "Write the logs of each app to {data-dir}/logs/<app>.log.json instead of a single file")
+	RootCmd.PersistentFlags().String("log-file-format", "json", "Format for logging to files. Either 'json' or 'text' (same as the console, with a '.log' extension)")
+	RootCmd.PersistentFlags().Int("log-file-max-size", 100, "Size in megabytes at which a log file is rotated")
+	RootCmd.PersistentFlags().Int("log-file-max-backups", 10, "Number of rotated log files kept per log file, 0 keeps all of them")
+	RootCmd.PersistentFlags().Int("log-file-max-age", 0, "Number of days rotated log files are kept, 0 keeps them regardless of their age")
+	RootCmd.PersistentFlags().Duration("log-file-rotate-interval", 0, "If non-zero, log files are also rotated at this interval")
 	RootCmd.PersistentFlags().CountP("verbose", "v", "Enables verbose output (-vvvv for max verbosity)")
+	RootCmd.PersistentFlags().Int("log-buffer-size", 1000, "Number of log entries of each app kept in memory and streamed by the dashboard, 0 disables it")
+
+	RootCmd.PersistentFlags().Float64("trace-sampling-fraction", 1/8.0, "Fraction of the requests traced, between 0 and 1")
+	RootCmd.PersistentFlags().Int("trace-buffer-size", 100, "Number of traces kept in memory and viewable in the dashboard, 0 disables it")
 
 	RootCmd.PersistentFlags().String("log-level-switcher-listen-addr", "localhost:1065", "If non-empty, the process will listen on this address for json-formatted requests to change different logger levels (see DEBUG.md for more info)")
 	RootCmd.PersistentFlags().String("pprof-listen-addr", "localhost:6060", "If non-empty, the process will listen on this address for pprof analysis (see https://golang.org/pkg/net/http/pprof/)")
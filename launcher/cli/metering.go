@@ -0,0 +1,53 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dfuse-io/dfuse-eosio/metering"
+	"github.com/spf13/viper"
+)
+
+// setupMetering sets the process wide meter aggregating the usage of the
+// metered apps, returning the function stopping it after a last flush of
+// all buckets to the backends.
+func setupMetering() (stop func(), err error) {
+	var backends []metering.Backend
+	for _, dsn := range viper.GetStringSlice("common-metering-backends") {
+		backend, err := metering.NewBackend(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("metering backend: %w", err)
+		}
+
+		backends = append(backends, backend)
+	}
+
+	meter := metering.NewMeter(viper.GetDuration("common-metering-bucket-size"), viper.GetDuration("common-metering-usage-retention"), backends...)
+	metering.SetDefaultMeter(meter)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		meter.Launch(ctx, viper.GetDuration("common-metering-flush-interval"))
+		close(done)
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}, nil
+}
@@ -103,6 +103,12 @@ func dfuseStartE(cmd *cobra.Command, args []string) (err error) {
 		maybeCheckNodeosVersion()
 	}
 
+	stopMetering, err := setupMetering()
+	if err != nil {
+		userLog.Error("unable to setup metering", zap.Error(err))
+		os.Exit(1)
+	}
+
 	userLog.Printf("Launching applications: %s", strings.Join(apps, ","))
 	if err = launch.Launch(apps); err != nil {
 		userLog.Error("unable to launch", zap.Error(err))
@@ -127,6 +133,7 @@ func dfuseStartE(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	launch.WaitForTermination()
+	stopMetering()
 
 	// At this point, everything is terminated, if we got an error
 	// we exit right away with status code 1. If we let the error go
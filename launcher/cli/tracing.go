@@ -16,11 +16,21 @@ package cli
 
 import (
 	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/tracing"
 	"github.com/dfuse-io/dtracing"
+	"github.com/spf13/viper"
 	"go.opencensus.io/trace"
 )
 
+// traceBuffer is nil when traces are not kept in memory
+var traceBuffer *tracing.Buffer
+
 func setupTracing() {
-	err := dtracing.SetupTracing("dfuse", trace.ProbabilitySampler(1/8.0))
+	err := dtracing.SetupTracing("dfuse", trace.ProbabilitySampler(viper.GetFloat64("global-trace-sampling-fraction")))
 	derr.Check("unable to setup tracing correctly", err)
+
+	if bufferSize := viper.GetInt("global-trace-buffer-size"); bufferSize > 0 {
+		traceBuffer = tracing.NewBuffer(bufferSize)
+		trace.RegisterExporter(traceBuffer)
+	}
 }
@@ -0,0 +1,221 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package launcher
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// LogEntry is a log line written by one of the apps, kept in memory so it can
+// be streamed by the dashboard.
+type LogEntry struct {
+	// Seq orders the entries of all apps, it increases with each entry
+	Seq uint64
+
+	AppID   string
+	Time    time.Time
+	Level   zapcore.Level
+	Logger  string
+	Caller  string
+	Message string
+
+	// Fields is the JSON object of the entry's fields, empty when it has none
+	Fields string
+}
+
+// AppLogs keeps the last log entries of each app in a ring buffer and
+// forwards new entries to subscribers.
+type AppLogs struct {
+	seq uint64
+
+	lock        sync.RWMutex
+	size        int
+	buffers     map[string]*logBuffer
+	subscribers map[*LogSubscription]bool
+}
+
+func NewAppLogs(size int) *AppLogs {
+	return &AppLogs{
+		size:        size,
+		buffers:     map[string]*logBuffer{},
+		subscribers: map[*LogSubscription]bool{},
+	}
+}
+
+// Core returns a zap core appending the entries enabled by `enabler` to the
+// ring buffer of `appID`.
+func (l *AppLogs) Core(appID string, enabler zapcore.LevelEnabler) zapcore.Core {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, found := l.buffers[appID]; !found {
+		l.buffers[appID] = &logBuffer{entries: make([]*LogEntry, l.size)}
+	}
+
+	return &logBufferCore{LevelEnabler: enabler, appID: appID, logs: l}
+}
+
+// Entries returns the buffered entries of `appID`, or of all apps when empty,
+// in the order they were written.
+func (l *AppLogs) Entries(appID string) (out []*LogEntry) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	for id, buffer := range l.buffers {
+		if appID == "" || id == appID {
+			out = append(out, buffer.snapshot()...)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Seq < out[j].Seq })
+	return out
+}
+
+// Subscribe returns a subscription receiving the entries appended from now
+// on. Entries are dropped when the subscriber is not reading fast enough.
+func (l *AppLogs) Subscribe(bufferSize int) *LogSubscription {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	sub := &LogSubscription{Entries: make(chan *LogEntry, bufferSize)}
+	l.subscribers[sub] = true
+
+	return sub
+}
+
+func (l *AppLogs) Unsubscribe(sub *LogSubscription) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.subscribers, sub)
+}
+
+func (l *AppLogs) append(entry *LogEntry) {
+	entry.Seq = atomic.AddUint64(&l.seq, 1)
+
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	l.buffers[entry.AppID].append(entry)
+	for sub := range l.subscribers {
+		select {
+		case sub.Entries <- entry:
+		default:
+		}
+	}
+}
+
+type LogSubscription struct {
+	Entries chan *LogEntry
+}
+
+type logBuffer struct {
+	lock    sync.Mutex
+	entries []*LogEntry
+	next    int
+	full    bool
+}
+
+func (b *logBuffer) append(entry *LogEntry) {
+	if len(b.entries) == 0 {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.entries[b.next] = entry
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+func (b *logBuffer) snapshot() []*LogEntry {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.full {
+		return append([]*LogEntry(nil), b.entries[:b.next]...)
+	}
+
+	return append(append([]*LogEntry(nil), b.entries[b.next:]...), b.entries[:b.next]...)
+}
+
+type logBufferCore struct {
+	zapcore.LevelEnabler
+
+	appID  string
+	logs   *AppLogs
+	fields []zapcore.Field
+}
+
+func (c *logBufferCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := *c
+	clone.fields = append(append([]zapcore.Field(nil), c.fields...), fields...)
+
+	return &clone
+}
+
+func (c *logBufferCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c *logBufferCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	out := &LogEntry{
+		AppID:   c.appID,
+		Time:    entry.Time,
+		Level:   entry.Level,
+		Logger:  entry.LoggerName,
+		Message: entry.Message,
+	}
+
+	if entry.Caller.Defined {
+		out.Caller = entry.Caller.TrimmedPath()
+	}
+
+	if len(c.fields)+len(fields) > 0 {
+		encoder := zapcore.NewMapObjectEncoder()
+		for _, field := range c.fields {
+			field.AddTo(encoder)
+		}
+		for _, field := range fields {
+			field.AddTo(encoder)
+		}
+
+		data, err := json.Marshal(encoder.Fields)
+		if err != nil {
+			return err
+		}
+		out.Fields = string(data)
+	}
+
+	c.logs.append(out)
+	return nil
+}
+
+func (c *logBufferCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package launcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAppLogs(t *testing.T) {
+	logs := NewAppLogs(2)
+	app1 := zap.New(logs.Core("app1", zap.InfoLevel)).Named("app1")
+	app2 := zap.New(logs.Core("app2", zap.DebugLevel)).Named("app2")
+
+	sub := logs.Subscribe(10)
+	defer logs.Unsubscribe(sub)
+
+	app1.Debug("skipped")
+	app1.Info("first", zap.String("key", "value"))
+	app2.Debug("second")
+	app1.With(zap.Int("count", 1)).Warn("third")
+	app1.Error("fourth")
+
+	entries := logs.Entries("")
+	require.Len(t, entries, 3, "app1 keeps its last 2 entries")
+	assert.Equal(t, "second", entries[0].Message)
+	assert.Equal(t, "app2", entries[0].AppID)
+	assert.Equal(t, "third", entries[1].Message)
+	assert.Equal(t, `{"count":1}`, entries[1].Fields)
+	assert.Equal(t, zap.WarnLevel, entries[1].Level)
+	assert.Equal(t, "fourth", entries[2].Message)
+	assert.Equal(t, "app1", entries[2].Logger)
+
+	entries = logs.Entries("app2")
+	require.Len(t, entries, 1)
+	assert.Equal(t, "second", entries[0].Message)
+
+	require.Len(t, sub.Entries, 4)
+	first := <-sub.Entries
+	assert.Equal(t, "first", first.Message)
+	assert.Equal(t, `{"key":"value"}`, first.Fields)
+}
@@ -63,6 +63,9 @@ func ParseAppsFromArgs(args []string) (apps []string) {
 					if app == "search-forkresolver" {
 						continue // keep this until we fix search-forkresolver here
 					}
+					if app == "sinks" {
+						continue // requires explicit destinations, must be asked for
+					}
 					apps = append(apps, app)
 				}
 			} else {
@@ -0,0 +1,252 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Backend receives the aggregated usages once their bucket is closed.
+// `Write` must return only once the usages are durably handed off, they
+// are retried on the next flush otherwise.
+type Backend interface {
+	Write(ctx context.Context, usages []*Usage) error
+	Close() error
+}
+
+// BackendFactory creates a backend out of a metering backend dsn.
+type BackendFactory func(dsn *url.URL) (Backend, error)
+
+var backendFactories = map[string]BackendFactory{}
+
+// RegisterBackend makes metering backend dsns with the `scheme` scheme use
+// the backends created by `factory`.
+func RegisterBackend(scheme string, factory BackendFactory) {
+	backendFactories[scheme] = factory
+}
+
+func init() {
+	RegisterBackend("file", func(dsn *url.URL) (Backend, error) {
+		return NewFileBackend(dsn.Path)
+	})
+
+	RegisterBackend("prometheus", func(dsn *url.URL) (Backend, error) {
+		withUser, _ := strconv.ParseBool(dsn.Query().Get("with_user"))
+		return NewPrometheusBackend(withUser)
+	})
+
+	httpFactory := func(dsn *url.URL) (Backend, error) {
+		return NewHTTPBackend(dsn.String()), nil
+	}
+	RegisterBackend("http", httpFactory)
+	RegisterBackend("https", httpFactory)
+}
+
+// NewBackend creates the backend for `dsn`, its scheme selecting the kind
+// of backend: `file://` for a JSON lines file, `prometheus://` for
+// counters exported with the other metrics (`?with_user=true` adding a
+// `user_id` label), `http://` and `https://` to POST the usages to an
+// endpoint, or any scheme registered through `RegisterBackend`.
+func NewBackend(dsn string) (Backend, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid metering backend dsn %q: %w", dsn, err)
+	}
+
+	factory, found := backendFactories[u.Scheme]
+	if !found {
+		return nil, fmt.Errorf("no metering backend registered for scheme %q, known schemes are %s", u.Scheme, strings.Join(backendSchemes(), ", "))
+	}
+
+	return factory(u)
+}
+
+func backendSchemes() (out []string) {
+	for scheme := range backendFactories {
+		out = append(out, scheme)
+	}
+	sort.Strings(out)
+
+	return
+}
+
+// FileBackend appends the usages, one JSON object per line, to a file.
+type FileBackend struct {
+	lock sync.Mutex
+	file *os.File
+}
+
+func NewFileBackend(path string) (*FileBackend, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("opening metering file %q: %w", path, err)
+	}
+
+	return &FileBackend{file: file}, nil
+}
+
+func (b *FileBackend) Write(ctx context.Context, usages []*Usage) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	writer := bufio.NewWriter(b.file)
+	encoder := json.NewEncoder(writer)
+	for _, usage := range usages {
+		if err := encoder.Encode(usage); err != nil {
+			return fmt.Errorf("encoding usage: %w", err)
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("writing usages: %w", err)
+	}
+
+	return b.file.Sync()
+}
+
+func (b *FileBackend) Close() error {
+	return b.file.Close()
+}
+
+// PrometheusBackend adds the usages to counters labelled by source, kind
+// and method (and user when `withUser` is set, mind the cardinality).
+type PrometheusBackend struct {
+	withUser  bool
+	requests  *prometheus.CounterVec
+	responses *prometheus.CounterVec
+	ingress   *prometheus.CounterVec
+	egress    *prometheus.CounterVec
+}
+
+func NewPrometheusBackend(withUser bool) (*PrometheusBackend, error) {
+	labels := []string{"source", "kind", "method"}
+	if withUser {
+		labels = append(labels, "user_id")
+	}
+
+	backend := &PrometheusBackend{withUser: withUser}
+	var err error
+	if backend.requests, err = registerCounterVec("dfuse_metering_requests_total", "Number of metered requests", labels); err != nil {
+		return nil, err
+	}
+	if backend.responses, err = registerCounterVec("dfuse_metering_responses_total", "Number of metered responses (documents)", labels); err != nil {
+		return nil, err
+	}
+	if backend.ingress, err = registerCounterVec("dfuse_metering_ingress_bytes_total", "Number of metered ingress bytes", labels); err != nil {
+		return nil, err
+	}
+	if backend.egress, err = registerCounterVec("dfuse_metering_egress_bytes_total", "Number of metered egress bytes", labels); err != nil {
+		return nil, err
+	}
+
+	return backend, nil
+}
+
+func registerCounterVec(name, help string, labels []string) (*prometheus.CounterVec, error) {
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, labels)
+	if err := prometheus.Register(counter); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if errors.As(err, &alreadyRegistered) {
+			if existing, ok := alreadyRegistered.ExistingCollector.(*prometheus.CounterVec); ok {
+				return existing, nil
+			}
+		}
+
+		return nil, fmt.Errorf("registering counter %q: %w", name, err)
+	}
+
+	return counter, nil
+}
+
+func (b *PrometheusBackend) Write(ctx context.Context, usages []*Usage) error {
+	for _, usage := range usages {
+		labels := []string{usage.Source, usage.Kind, usage.Method}
+		if b.withUser {
+			labels = append(labels, usage.UserID)
+		}
+
+		b.requests.WithLabelValues(labels...).Add(float64(usage.RequestsCount))
+		b.responses.WithLabelValues(labels...).Add(float64(usage.ResponsesCount))
+		b.ingress.WithLabelValues(labels...).Add(float64(usage.IngressBytes))
+		b.egress.WithLabelValues(labels...).Add(float64(usage.EgressBytes))
+	}
+
+	return nil
+}
+
+func (b *PrometheusBackend) Close() error {
+	return nil
+}
+
+// HTTPBackend POSTs the usages as a JSON `{"usages": [...]}` document,
+// any non-2xx response failing the write.
+type HTTPBackend struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPBackend(url string) *HTTPBackend {
+	return &HTTPBackend{
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (b *HTTPBackend) Write(ctx context.Context, usages []*Usage) error {
+	body, err := json.Marshal(map[string][]*Usage{"usages": usages})
+	if err != nil {
+		return fmt.Errorf("encoding usages: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", b.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("metering endpoint %s: %w", b.url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("metering endpoint %s: unexpected status %s", b.url, resp.Status)
+	}
+
+	return nil
+}
+
+func (b *HTTPBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,298 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dfuse-io/dauth/authenticator"
+	"github.com/dfuse-io/dmetering"
+	"go.uber.org/zap"
+)
+
+const AnonymousUserID = "anonymous"
+
+// maxPendingUsages bounds the usages kept for a backend failing to write,
+// the oldest ones are dropped past it.
+const maxPendingUsages = 100000
+
+// Event is a unit of consumption of a user, it mirrors `dmetering.Event` to
+// which every event is also forwarded.
+type Event struct {
+	Source         string
+	Kind           string
+	Method         string
+	RequestsCount  int64
+	ResponsesCount int64
+	IngressBytes   int64
+	EgressBytes    int64
+}
+
+func (e Event) dmetering() dmetering.Event {
+	return dmetering.Event{
+		Source:         e.Source,
+		Kind:           e.Kind,
+		Method:         e.Method,
+		RequestsCount:  e.RequestsCount,
+		ResponsesCount: e.ResponsesCount,
+		IngressBytes:   e.IngressBytes,
+		EgressBytes:    e.EgressBytes,
+	}
+}
+
+// Usage is the consumption of a user on a given method, aggregated over a
+// time bucket.
+type Usage struct {
+	UserID         string    `json:"user_id"`
+	Source         string    `json:"source"`
+	Kind           string    `json:"kind"`
+	Method         string    `json:"method"`
+	BucketStart    time.Time `json:"bucket_start"`
+	BucketEnd      time.Time `json:"bucket_end"`
+	RequestsCount  int64     `json:"requests_count"`
+	ResponsesCount int64     `json:"responses_count"`
+	IngressBytes   int64     `json:"ingress_bytes"`
+	EgressBytes    int64     `json:"egress_bytes"`
+}
+
+type usageKey struct {
+	userID string
+	source string
+	kind   string
+	method string
+	bucket int64
+}
+
+// Meter aggregates events per user, method and time bucket in-process. The
+// buckets are shipped to the backends once closed (see `Launch`) then kept
+// for `retention` to be queried through `Usage`.
+type Meter struct {
+	bucketSize time.Duration
+	retention  time.Duration
+	backends   []Backend
+	now        func() time.Time
+
+	lock    sync.Mutex
+	open    map[usageKey]*Usage
+	closed  []*Usage
+	pending [][]*Usage
+}
+
+func NewMeter(bucketSize, retention time.Duration, backends ...Backend) *Meter {
+	return &Meter{
+		bucketSize: bucketSize,
+		retention:  retention,
+		backends:   backends,
+		now:        time.Now,
+		open:       map[usageKey]*Usage{},
+		pending:    make([][]*Usage, len(backends)),
+	}
+}
+
+func (m *Meter) Add(userID string, ev Event) {
+	now := m.now()
+	start := now.Truncate(m.bucketSize)
+	key := usageKey{userID, ev.Source, ev.Kind, ev.Method, start.UnixNano()}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	usage, found := m.open[key]
+	if !found {
+		usage = &Usage{
+			UserID:      userID,
+			Source:      ev.Source,
+			Kind:        ev.Kind,
+			Method:      ev.Method,
+			BucketStart: start,
+			BucketEnd:   start.Add(m.bucketSize),
+		}
+		m.open[key] = usage
+	}
+
+	usage.RequestsCount += ev.RequestsCount
+	usage.ResponsesCount += ev.ResponsesCount
+	usage.IngressBytes += ev.IngressBytes
+	usage.EgressBytes += ev.EgressBytes
+}
+
+// Launch flushes the closed buckets every `interval` until `ctx` is done,
+// at which point all buckets, even open ones, are flushed and the backends
+// closed.
+func (m *Meter) Launch(ctx context.Context, interval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			m.Flush(context.Background(), true)
+			for _, backend := range m.backends {
+				if err := backend.Close(); err != nil {
+					zlog.Warn("unable to close metering backend", zap.Error(err))
+				}
+			}
+			return
+		case <-time.After(interval):
+			m.Flush(ctx, false)
+		}
+	}
+}
+
+// Flush writes the closed buckets (all of them when `all` is set) to the
+// backends. A backend failing to write keeps its usages for the next
+// flush.
+func (m *Meter) Flush(ctx context.Context, all bool) {
+	now := m.now()
+
+	m.lock.Lock()
+	var flushed []*Usage
+	for key, usage := range m.open {
+		if all || !usage.BucketEnd.After(now) {
+			flushed = append(flushed, usage)
+			delete(m.open, key)
+		}
+	}
+	sortUsages(flushed)
+
+	m.closed = append(m.closed, flushed...)
+	cutoff := now.Add(-m.retention)
+	for len(m.closed) > 0 && m.closed[0].BucketEnd.Before(cutoff) {
+		m.closed = m.closed[1:]
+	}
+
+	for i := range m.backends {
+		m.pending[i] = append(m.pending[i], flushed...)
+		if overflow := len(m.pending[i]) - maxPendingUsages; overflow > 0 {
+			zlog.Warn("dropping metering usages never written to backend", zap.Int("backend_index", i), zap.Int("dropped", overflow))
+			m.pending[i] = m.pending[i][overflow:]
+		}
+	}
+	pending := append([][]*Usage(nil), m.pending...)
+	m.lock.Unlock()
+
+	for i, backend := range m.backends {
+		if len(pending[i]) == 0 {
+			continue
+		}
+
+		if err := backend.Write(ctx, pending[i]); err != nil {
+			zlog.Warn("unable to write usages to metering backend, will retry on next flush", zap.Int("backend_index", i), zap.Int("usage_count", len(pending[i])), zap.Error(err))
+			continue
+		}
+
+		m.lock.Lock()
+		m.pending[i] = m.pending[i][len(pending[i]):]
+		m.lock.Unlock()
+	}
+}
+
+// Usage returns the usages of `userID` whose bucket ends after `since`,
+// the currently open buckets included.
+func (m *Meter) Usage(userID string, since time.Time) []*Usage {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var out []*Usage
+	collect := func(usage *Usage) {
+		if usage.UserID == userID && usage.BucketEnd.After(since) {
+			copied := *usage
+			out = append(out, &copied)
+		}
+	}
+
+	for _, usage := range m.closed {
+		collect(usage)
+	}
+	for _, usage := range m.open {
+		collect(usage)
+	}
+
+	sortUsages(out)
+	return out
+}
+
+func sortUsages(usages []*Usage) {
+	sort.Slice(usages, func(i, j int) bool {
+		left, right := usages[i], usages[j]
+		if !left.BucketStart.Equal(right.BucketStart) {
+			return left.BucketStart.Before(right.BucketStart)
+		}
+		if left.UserID != right.UserID {
+			return left.UserID < right.UserID
+		}
+		if left.Source != right.Source {
+			return left.Source < right.Source
+		}
+		if left.Kind != right.Kind {
+			return left.Kind < right.Kind
+		}
+		return left.Method < right.Method
+	})
+}
+
+var defaultMeter *Meter
+var defaultMeterLock sync.RWMutex
+
+// SetDefaultMeter sets the meter aggregating the events emitted through
+// `EmitWithContext` and `EmitWithCredentials`, nil to only forward them to
+// the `dmetering` plugin.
+func SetDefaultMeter(meter *Meter) {
+	defaultMeterLock.Lock()
+	defer defaultMeterLock.Unlock()
+
+	defaultMeter = meter
+}
+
+func GetDefaultMeter() *Meter {
+	defaultMeterLock.RLock()
+	defer defaultMeterLock.RUnlock()
+
+	return defaultMeter
+}
+
+// EmitWithContext forwards `ev` to the `dmetering` plugin and aggregates it
+// in the default meter for the user authenticated in `ctx`.
+func EmitWithContext(ev Event, ctx context.Context) {
+	dmetering.EmitWithContext(ev.dmetering(), ctx)
+
+	if meter := GetDefaultMeter(); meter != nil {
+		meter.Add(UserID(authenticator.GetCredentials(ctx)), ev)
+	}
+}
+
+// EmitWithCredentials forwards `ev` to the `dmetering` plugin and
+// aggregates it in the default meter for the user of `creds`.
+func EmitWithCredentials(ev Event, creds authenticator.Credentials) {
+	dmetering.EmitWithCredentials(ev.dmetering(), creds)
+
+	if meter := GetDefaultMeter(); meter != nil {
+		meter.Add(UserID(creds), ev)
+	}
+}
+
+// UserID is the identifier usages of `creds` are aggregated under,
+// `AnonymousUserID` when not authenticated.
+func UserID(creds authenticator.Credentials) string {
+	if creds == nil {
+		return AnonymousUserID
+	}
+
+	if userID := creds.GetUserID(); userID != "" {
+		return userID
+	}
+
+	return AnonymousUserID
+}
@@ -0,0 +1,212 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/dauth/authenticator"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testCredentials struct {
+	userID string
+}
+
+func (c *testCredentials) GetUserID() string {
+	return c.userID
+}
+
+func (c *testCredentials) GetLogFields() []zap.Field {
+	return nil
+}
+
+type testBackend struct {
+	failures int
+	written  []*Usage
+}
+
+func (b *testBackend) Write(ctx context.Context, usages []*Usage) error {
+	if b.failures > 0 {
+		b.failures--
+		return errors.New("unavailable")
+	}
+
+	b.written = append(b.written, usages...)
+	return nil
+}
+
+func (b *testBackend) Close() error {
+	return nil
+}
+
+func newTestMeter(backends ...Backend) (*Meter, *time.Time) {
+	now := time.Date(2020, 6, 1, 10, 0, 30, 0, time.UTC)
+	meter := NewMeter(time.Minute, time.Hour, backends...)
+	meter.now = func() time.Time { return now }
+
+	return meter, &now
+}
+
+func TestMeter_Aggregation(t *testing.T) {
+	backend := &testBackend{failures: 1}
+	meter, now := newTestMeter(backend)
+
+	meter.Add("user1", Event{Source: "eosws", Kind: "REST API", Method: "/v0/blocks", RequestsCount: 1, ResponsesCount: 5, EgressBytes: 100})
+	meter.Add("user1", Event{Source: "eosws", Kind: "REST API", Method: "/v0/blocks", RequestsCount: 1, ResponsesCount: 2, IngressBytes: 10})
+	meter.Add("user2", Event{Source: "eosws", Kind: "REST API", Method: "/v0/blocks", RequestsCount: 1})
+
+	*now = now.Add(time.Minute)
+	meter.Add("user1", Event{Source: "eosws", Kind: "Websocket Message", Method: "get_actions", ResponsesCount: 1})
+
+	minute := time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC)
+	expectedFirst := &Usage{UserID: "user1", Source: "eosws", Kind: "REST API", Method: "/v0/blocks", BucketStart: minute, BucketEnd: minute.Add(time.Minute), RequestsCount: 2, ResponsesCount: 7, IngressBytes: 10, EgressBytes: 100}
+	expectedOpen := &Usage{UserID: "user1", Source: "eosws", Kind: "Websocket Message", Method: "get_actions", BucketStart: minute.Add(time.Minute), BucketEnd: minute.Add(2 * time.Minute), ResponsesCount: 1}
+
+	assert.Equal(t, []*Usage{expectedFirst, expectedOpen}, meter.Usage("user1", time.Time{}))
+	assert.Equal(t, []*Usage{expectedOpen}, meter.Usage("user1", minute.Add(time.Minute)))
+
+	// Backend failure keeps the closed buckets pending, the open one is not flushed
+	meter.Flush(context.Background(), false)
+	assert.Len(t, backend.written, 0)
+
+	meter.Flush(context.Background(), false)
+	require.Len(t, backend.written, 2)
+	assert.Equal(t, expectedFirst, backend.written[0])
+	assert.Equal(t, "user2", backend.written[1].UserID)
+
+	meter.Flush(context.Background(), true)
+	require.Len(t, backend.written, 3)
+	assert.Equal(t, expectedOpen, backend.written[2])
+
+	// Flushed buckets remain queryable until the retention expires
+	assert.Len(t, meter.Usage("user1", time.Time{}), 2)
+
+	*now = now.Add(2 * time.Hour)
+	meter.Flush(context.Background(), false)
+	assert.Len(t, meter.Usage("user1", time.Time{}), 0)
+}
+
+func TestFileBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "metering")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "usage.jsonl")
+	backend, err := NewBackend("file://" + path)
+	require.NoError(t, err)
+
+	require.NoError(t, backend.Write(context.Background(), []*Usage{{UserID: "user1", Method: "a"}, {UserID: "user2", Method: "b"}}))
+	require.NoError(t, backend.Write(context.Background(), []*Usage{{UserID: "user3", Method: "c"}}))
+	require.NoError(t, backend.Close())
+
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[2], `"user_id":"user3"`)
+
+	_, err = NewBackend("unknown://host")
+	assert.Error(t, err)
+}
+
+func TestHTTPBackend(t *testing.T) {
+	var received map[string][]*Usage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer server.Close()
+
+	backend, err := NewBackend(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, backend.Write(context.Background(), []*Usage{{UserID: "user1", RequestsCount: 3}}))
+
+	require.Len(t, received["usages"], 1)
+	assert.Equal(t, int64(3), received["usages"][0].RequestsCount)
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	meter, _ := newTestMeter()
+	SetDefaultMeter(meter)
+	defer SetDefaultMeter(nil)
+
+	router := mux.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(authenticator.WithCredentials(r.Context(), &testCredentials{userID: "user1"})))
+		})
+	})
+	router.Use(HTTPMiddleware("eosws", "REST API"))
+	router.Path("/v0/blocks/{id}").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ioutil.ReadAll(r.Body)
+		SetResponsesCount(r.Context(), 3)
+		w.Write([]byte("0123456789"))
+	})
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/v0/blocks/abc", strings.NewReader("abcd")))
+
+	usages := meter.Usage("user1", time.Time{})
+	require.Len(t, usages, 1)
+	assert.Equal(t, "/v0/blocks/{id}", usages[0].Method)
+	assert.Equal(t, int64(1), usages[0].RequestsCount)
+	assert.Equal(t, int64(3), usages[0].ResponsesCount)
+	assert.Equal(t, int64(4), usages[0].IngressBytes)
+	assert.Equal(t, int64(10), usages[0].EgressBytes)
+}
+
+func TestUsageHandler(t *testing.T) {
+	meter, _ := newTestMeter()
+	meter.Add("user1", Event{Source: "eosws", Kind: "REST API", Method: "/v0/blocks", RequestsCount: 1})
+	meter.Add("user2", Event{Source: "eosws", Kind: "REST API", Method: "/v0/blocks", RequestsCount: 1})
+	SetDefaultMeter(meter)
+	defer SetDefaultMeter(nil)
+
+	serve := func(creds authenticator.Credentials, query string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest("GET", "/v0/usage"+query, nil)
+		if creds != nil {
+			request = request.WithContext(authenticator.WithCredentials(request.Context(), creds))
+		}
+
+		recorder := httptest.NewRecorder()
+		UsageHandler().ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	recorder := serve(&testCredentials{userID: "user1"}, "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	var response *UsageResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, "user1", response.UserID)
+	require.Len(t, response.Usage, 1)
+	assert.Equal(t, "user1", response.Usage[0].UserID)
+
+	assert.Equal(t, http.StatusUnauthorized, serve(nil, "").Code)
+	assert.Equal(t, http.StatusBadRequest, serve(&testCredentials{userID: "user1"}, "?since=yesterday").Code)
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/gorilla/mux"
+)
+
+type requestUsageKeyType int
+
+const requestUsageKey requestUsageKeyType = 0
+
+// requestUsage is the event of the request being served, placed in its
+// context by the middlewares so handlers can adjust the documents count.
+type requestUsage struct {
+	responsesCount int64
+}
+
+func withRequestUsage(ctx context.Context) (context.Context, *requestUsage) {
+	usage := &requestUsage{responsesCount: 1}
+	return context.WithValue(ctx, requestUsageKey, usage), usage
+}
+
+// SetResponsesCount sets the number of documents returned by the request
+// served with `ctx`, one by default. It does nothing when the request is
+// not metered.
+func SetResponsesCount(ctx context.Context, count int64) {
+	if usage, ok := ctx.Value(requestUsageKey).(*requestUsage); ok {
+		atomic.StoreInt64(&usage.responsesCount, count)
+	}
+}
+
+// HTTPMiddleware meters every request with one event counting its body
+// and response bytes, the method being the route's path template (the
+// request path for prefix routes).
+func HTTPMiddleware(source, kind string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, usage := withRequestUsage(r.Context())
+
+			body := &countingReadCloser{ReadCloser: r.Body}
+			if r.Body != nil {
+				r.Body = body
+			}
+
+			writer := &countingResponseWriter{ResponseWriter: w}
+			next.ServeHTTP(writer, r.WithContext(ctx))
+
+			EmitWithContext(Event{
+				Source:         source,
+				Kind:           kind,
+				Method:         routeMethod(r),
+				RequestsCount:  1,
+				ResponsesCount: atomic.LoadInt64(&usage.responsesCount),
+				IngressBytes:   body.count,
+				EgressBytes:    writer.count,
+			}, ctx)
+		})
+	}
+}
+
+func routeMethod(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return r.URL.Path
+	}
+
+	template, err := route.GetPathTemplate()
+	if err != nil {
+		return r.URL.Path
+	}
+
+	if regexp, err := route.GetPathRegexp(); err == nil && !strings.HasSuffix(regexp, "$") {
+		return r.URL.Path
+	}
+
+	return template
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	count int64
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.count += int64(n)
+	return n, err
+}
+
+type countingResponseWriter struct {
+	http.ResponseWriter
+	count int64
+}
+
+func (w *countingResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	w.count += int64(n)
+	return n, err
+}
+
+func (w *countingResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *countingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer does not support hijacking")
+	}
+
+	return hijacker.Hijack()
+}
@@ -0,0 +1,90 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dfuse-io/dauth/authenticator"
+	"github.com/dfuse-io/derr"
+	"go.uber.org/zap"
+)
+
+type UsageRequest struct {
+	Since string `json:"since"`
+}
+
+type UsageResponse struct {
+	UserID string   `json:"user_id"`
+	Since  string   `json:"since,omitempty"`
+	Usage  []*Usage `json:"usage"`
+}
+
+// UsageHandler serves the usage of the authenticated user as aggregated by
+// the default meter, from the `since` (RFC3339) parameter, the whole
+// retained history when absent. Anonymous requests are rejected.
+func UsageHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		userID := UserID(authenticator.GetCredentials(ctx))
+		if userID == AnonymousUserID {
+			derr.WriteError(ctx, w, "usage requires an authenticated user", derr.HTTPUnauthorizedError(ctx, nil, derr.C("metering_usage_unauthenticated_error"),
+				"Usage is only available to authenticated users.",
+			))
+			return
+		}
+
+		meter := GetDefaultMeter()
+		if meter == nil {
+			derr.WriteError(ctx, w, "usage is not tracked", derr.HTTPServiceUnavailableError(ctx, nil, derr.C("metering_usage_unavailable_error"),
+				"Usage is not tracked by this deployment.",
+			))
+			return
+		}
+
+		var since time.Time
+		if value := r.URL.Query().Get("since"); value != "" {
+			var err error
+			since, err = time.Parse(time.RFC3339, value)
+			if err != nil {
+				derr.WriteError(ctx, w, "invalid since parameter", derr.RequestValidationError(ctx, url.Values{
+					"since": []string{"The since field must be an RFC3339 timestamp"},
+				}))
+				return
+			}
+		}
+
+		response := &UsageResponse{
+			UserID: userID,
+			Usage:  meter.Usage(userID, since),
+		}
+		if !since.IsZero() {
+			response.Since = since.Format(time.RFC3339)
+		}
+
+		if response.Usage == nil {
+			response.Usage = []*Usage{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			zlog.Debug("unable to write usage response", zap.Error(err))
+		}
+	})
+}
@@ -0,0 +1,278 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi generates OpenAPI 3 documents out of the routes registered
+// on a `mux.Router` and the Go types of their requests and responses, and
+// validates responses against them so handlers and documents can't drift
+// apart silently.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const Version = "3.0.3"
+
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       *Info                `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components *Components          `json:"components,omitempty"`
+
+	schemaNames map[reflect.Type]string
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem maps lower case HTTP methods to their operation.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	OperationID string               `json:"operationId,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []*Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas,omitempty"`
+}
+
+func NewDocument(title, version string) *Document {
+	return &Document{
+		OpenAPI:     Version,
+		Info:        &Info{Title: title, Version: version},
+		Paths:       map[string]*PathItem{},
+		Components:  &Components{Schemas: map[string]*Schema{}},
+		schemaNames: map[reflect.Type]string{},
+	}
+}
+
+// Route describes the request and response of an HTTP route.
+type Route struct {
+	Path        string
+	Methods     []string
+	Summary     string
+	Description string
+	Tags        []string
+
+	// Query is a struct whose `json` tagged fields are the query (or url
+	// encoded form) parameters of the route, those listed in `Required`
+	// being mandatory.
+	Query    interface{}
+	Required []string
+
+	// Body is the JSON request body of the route
+	Body interface{}
+
+	// Response is the JSON body of a successful (200) response, a plain
+	// text response when nil.
+	Response interface{}
+}
+
+var pathParamRegex = regexp.MustCompile(`\{([^}:]+)(:[^}]*)?\}`)
+
+// AddRoute adds the operations of `route` to the document.
+func (d *Document) AddRoute(route *Route) error {
+	if !strings.HasPrefix(route.Path, "/") {
+		return fmt.Errorf("route path %q must start with /", route.Path)
+	}
+
+	methods := route.Methods
+	if len(methods) == 0 {
+		methods = []string{http.MethodGet}
+	}
+
+	pathItem, found := d.Paths[route.Path]
+	if !found {
+		pathItem = &PathItem{}
+		d.Paths[route.Path] = pathItem
+	}
+
+	for _, method := range methods {
+		method = strings.ToLower(method)
+		if _, found := (*pathItem)[method]; found {
+			return fmt.Errorf("route %s %s described twice", strings.ToUpper(method), route.Path)
+		}
+
+		operation, err := d.newOperation(route, method)
+		if err != nil {
+			return fmt.Errorf("route %s %s: %w", strings.ToUpper(method), route.Path, err)
+		}
+
+		(*pathItem)[method] = operation
+	}
+
+	return nil
+}
+
+func (d *Document) newOperation(route *Route, method string) (*Operation, error) {
+	operation := &Operation{
+		Summary:     route.Summary,
+		Description: route.Description,
+		OperationID: operationID(method, route.Path),
+		Tags:        route.Tags,
+		Responses:   map[string]*Response{},
+	}
+
+	for _, match := range pathParamRegex.FindAllStringSubmatch(route.Path, -1) {
+		operation.Parameters = append(operation.Parameters, &Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	if route.Query != nil {
+		parameters, err := d.queryParameters(route.Query, route.Required)
+		if err != nil {
+			return nil, err
+		}
+
+		operation.Parameters = append(operation.Parameters, parameters...)
+	}
+
+	if route.Body != nil {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{"application/json": {Schema: d.SchemaFor(route.Body)}},
+		}
+	}
+
+	success := &Response{Description: "Successful response"}
+	if route.Response != nil {
+		success.Content = map[string]*MediaType{"application/json": {Schema: d.SchemaFor(route.Response)}}
+	} else {
+		success.Content = map[string]*MediaType{"text/plain": {Schema: &Schema{Type: "string"}}}
+	}
+
+	operation.Responses["200"] = success
+	operation.Responses["default"] = &Response{
+		Description: "Error response",
+		Content:     map[string]*MediaType{"application/json": {Schema: d.errorSchema()}},
+	}
+
+	return operation, nil
+}
+
+func (d *Document) queryParameters(query interface{}, required []string) ([]*Parameter, error) {
+	schema := d.inlineSchema(reflect.TypeOf(query))
+	if schema.Type != "object" {
+		return nil, fmt.Errorf("query type %T is not a struct", query)
+	}
+
+	requiredSet := map[string]bool{}
+	for _, name := range required {
+		if _, found := schema.Properties[name]; !found {
+			return nil, fmt.Errorf("required query parameter %q is not a field of %T", name, query)
+		}
+
+		requiredSet[name] = true
+	}
+
+	var names []string
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make([]*Parameter, len(names))
+	for i, name := range names {
+		parameters[i] = &Parameter{Name: name, In: "query", Required: requiredSet[name], Schema: schema.Properties[name]}
+	}
+
+	return parameters, nil
+}
+
+// errorSchema is the shape of the errors written by `derr.WriteError`.
+func (d *Document) errorSchema() *Schema {
+	if _, found := d.Components.Schemas["Error"]; !found {
+		d.Components.Schemas["Error"] = &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"code":     {Type: "string"},
+				"trace_id": {Type: "string"},
+				"message":  {Type: "string"},
+				"details":  {},
+			},
+			Required: []string{"code", "message"},
+		}
+	}
+
+	return &Schema{Ref: "#/components/schemas/Error"}
+}
+
+// Operation returns the operation of `method` on `path`, nil if there is none.
+func (d *Document) Operation(path, method string) *Operation {
+	pathItem, found := d.Paths[path]
+	if !found {
+		return nil
+	}
+
+	return (*pathItem)[strings.ToLower(method)]
+}
+
+func (d *Document) Handler() (http.Handler, error) {
+	content, err := json.Marshal(d)
+	if err != nil {
+		return nil, fmt.Errorf("marshal openapi document: %w", err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(content)
+	}), nil
+}
+
+var nonAlphanumericRegex = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+func operationID(method, path string) string {
+	return method + strings.TrimRight(nonAlphanumericRegex.ReplaceAllString(path, "_"), "_")
+}
@@ -0,0 +1,139 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCommon struct {
+	BlockNum uint32 `json:"block_num"`
+}
+
+type testItem struct {
+	Name     string          `json:"name"`
+	Time     time.Time       `json:"time"`
+	Children []*testItem     `json:"children,omitempty"`
+	Extra    json.RawMessage `json:"extra,omitempty"`
+	Ignored  string          `json:"-"`
+}
+
+type testResponse struct {
+	*testCommon
+
+	Items []*testItem `json:"items"`
+	Next  *testItem   `json:"next"`
+}
+
+type testQuery struct {
+	Account string `json:"account"`
+	Limit   int    `json:"limit"`
+}
+
+type testStreamed struct{}
+
+func (*testStreamed) OpenAPISchema() *Schema {
+	return &Schema{Type: "object", Properties: map[string]*Schema{"key": {Type: "string"}}}
+}
+
+func TestDocument_SchemaFor(t *testing.T) {
+	doc := NewDocument("test", "v0")
+
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/openapi.testResponse"}, doc.SchemaFor(&testResponse{}))
+	assert.Equal(t, &Schema{Type: "object", Properties: map[string]*Schema{"key": {Type: "string"}}}, doc.SchemaFor(&testStreamed{}))
+
+	response := doc.Components.Schemas["openapi.testResponse"]
+	assert.Equal(t, []string{"block_num", "items", "next"}, response.Required)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int32"}, response.Properties["block_num"])
+	assert.Equal(t, &Schema{Nullable: true, AllOf: []*Schema{{Ref: "#/components/schemas/openapi.testItem"}}}, response.Properties["next"])
+
+	item := doc.Components.Schemas["openapi.testItem"]
+	assert.Equal(t, []string{"name", "time"}, item.Required)
+	assert.Equal(t, &Schema{Type: "string", Format: "date-time"}, item.Properties["time"])
+	assert.Equal(t, &Schema{}, item.Properties["extra"])
+	assert.Equal(t, &Schema{Ref: "#/components/schemas/openapi.testItem"}, item.Properties["children"].Items)
+	assert.NotContains(t, item.Properties, "Ignored")
+
+	require.NoError(t, doc.Validate())
+}
+
+func TestDocument_ValidateResponse(t *testing.T) {
+	doc := NewDocument("test", "v0")
+	require.NoError(t, doc.AddRoute(&Route{Path: "/v0/items", Query: testQuery{}, Required: []string{"account"}, Response: &testResponse{}}))
+
+	operation := doc.Operation("/v0/items", "GET")
+	require.NotNil(t, operation)
+	require.Len(t, operation.Parameters, 2)
+	assert.Equal(t, &Parameter{Name: "account", In: "query", Required: true, Schema: &Schema{Type: "string"}}, operation.Parameters[0])
+
+	tests := []struct {
+		name        string
+		body        string
+		expectedErr string
+	}{
+		{"valid", `{"block_num":1,"items":[{"name":"a","time":"2020-01-01T00:00:00Z","extra":{"any":1}}],"next":null}`, ""},
+		{"missing property", `{"block_num":1,"next":null}`, `$: missing required property "items"`},
+		{"unknown property", `{"block_num":1,"items":[],"next":null,"other":1}`, `$: property "other" is not part of the schema`},
+		{"wrong type", `{"block_num":"1","items":[],"next":null}`, `$.block_num: expected a number, got string`},
+		{"not an integer", `{"block_num":1.5,"items":[],"next":null}`, `$.block_num: expected an integer, got 1.5`},
+		{"nested", `{"block_num":1,"items":[{"name":1,"time":""}],"next":null}`, `$.items[0].name: expected a string, got json.Number`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := doc.ValidateResponse("/v0/items", "GET", []byte(test.body))
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
+func TestDocument_AddRouterRoutes(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	router := mux.NewRouter()
+	router.Path("/").Handler(handler)
+	subRouter := router.PathPrefix("/").Subrouter()
+	subRouter.Path("/v0/items").Methods("GET").Handler(handler)
+	subRouter.Path("/v0/items/{id}").Handler(handler)
+	subRouter.PathPrefix("/v1/chain").Handler(handler)
+
+	routes := []*Route{
+		{Path: "/v0/items", Response: &testResponse{}},
+		{Path: "/v0/items/{id}", Response: &testItem{}},
+		{Path: "/v1/chain/push_transaction", Methods: []string{"POST"}, Body: &testItem{}, Response: &testItem{}},
+	}
+
+	doc := NewDocument("test", "v0")
+	require.NoError(t, doc.AddRouterRoutes(router, routes, "/"))
+	assert.NotNil(t, doc.Operation("/v0/items/{id}", "GET"))
+	assert.Equal(t, "path", doc.Operation("/v0/items/{id}", "GET").Parameters[0].In)
+
+	err := NewDocument("test", "v0").AddRouterRoutes(router, routes[1:], "/")
+	assert.EqualError(t, err, "registered routes without openapi description: /v0/items")
+
+	err = NewDocument("test", "v0").AddRouterRoutes(router, append(routes, &Route{Path: "/v0/items", Methods: []string{"POST"}}), "/")
+	assert.EqualError(t, err, "described route POST /v0/items is not registered on the router")
+}
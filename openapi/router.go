@@ -0,0 +1,165 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// registeredRoute is a route with a handler found on a `mux.Router`.
+type registeredRoute struct {
+	template string
+	prefix   bool
+	methods  []string
+}
+
+// AddRouterRoutes adds the `routes` descriptions to the document, checking
+// them against the routes actually registered on `router`: every registered
+// route (but those whose path is in `ignoredPaths`) must be described, and
+// every description must match a registered route and its methods. Prefix
+// routes (`PathPrefix`) are covered by any description under the prefix.
+func (d *Document) AddRouterRoutes(router *mux.Router, routes []*Route, ignoredPaths ...string) error {
+	registered, err := registeredRoutes(router)
+	if err != nil {
+		return err
+	}
+
+	ignored := map[string]bool{}
+	for _, path := range ignoredPaths {
+		ignored[path] = true
+	}
+
+	var undocumented []string
+	for _, reg := range registered {
+		if ignored[reg.template] {
+			continue
+		}
+
+		if !reg.describedBy(routes) {
+			undocumented = append(undocumented, reg.template)
+		}
+	}
+
+	if len(undocumented) > 0 {
+		sort.Strings(undocumented)
+		return fmt.Errorf("registered routes without openapi description: %s", strings.Join(undocumented, ", "))
+	}
+
+	for _, route := range routes {
+		if err := checkRouteRegistered(route, registered); err != nil {
+			return err
+		}
+
+		if err := d.AddRoute(route); err != nil {
+			return err
+		}
+	}
+
+	return d.Validate()
+}
+
+func registeredRoutes(router *mux.Router) (out []*registeredRoute, err error) {
+	err = router.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		// Subrouter holders don't have a handler, only their leaves matter
+		if route.GetHandler() == nil {
+			return nil
+		}
+
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return fmt.Errorf("route without path: %w", err)
+		}
+
+		regexp, err := route.GetPathRegexp()
+		if err != nil {
+			return fmt.Errorf("route %q without path regexp: %w", template, err)
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			// No method matcher, any method is accepted
+			methods = nil
+		}
+
+		out = append(out, &registeredRoute{
+			template: template,
+			prefix:   !strings.HasSuffix(regexp, "$"),
+			methods:  methods,
+		})
+		return nil
+	})
+
+	return
+}
+
+func (r *registeredRoute) matches(path string) bool {
+	if r.prefix {
+		return path == r.template || strings.HasPrefix(path, strings.TrimSuffix(r.template, "/")+"/")
+	}
+
+	return path == r.template
+}
+
+func (r *registeredRoute) describedBy(routes []*Route) bool {
+	for _, route := range routes {
+		if r.matches(route.Path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *registeredRoute) acceptsMethod(method string) bool {
+	if r.methods == nil {
+		return true
+	}
+
+	for _, candidate := range r.methods {
+		if strings.EqualFold(candidate, method) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkRouteRegistered(route *Route, registered []*registeredRoute) error {
+	methods := route.Methods
+	if len(methods) == 0 {
+		methods = []string{http.MethodGet}
+	}
+
+	for _, method := range methods {
+		found := false
+		for _, reg := range registered {
+			if reg.matches(route.Path) && reg.acceptsMethod(method) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("described route %s %s is not registered on the router", method, route.Path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+}
+
+// Schemaer is implemented by types whose JSON encoding doesn't follow their
+// Go structure (custom marshallers, streamed encoding), describing it
+// explicitly instead.
+type Schemaer interface {
+	OpenAPISchema() *Schema
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	schemaerType      = reflect.TypeOf((*Schemaer)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// SchemaFor returns the schema of the JSON encoding of `value`, named
+// structs being added to the document components and referenced.
+func (d *Document) SchemaFor(value interface{}) *Schema {
+	return d.schemaFor(reflect.TypeOf(value))
+}
+
+func (d *Document) schemaFor(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr {
+		if t.Implements(schemaerType) {
+			break
+		}
+
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct || t.Name() == "" || isSpecialType(t) {
+		return d.inlineSchema(t)
+	}
+
+	name, found := d.schemaNames[t]
+	if !found {
+		name = d.componentName(t)
+		d.schemaNames[t] = name
+
+		// Registered before being computed so recursive types terminate
+		d.Components.Schemas[name] = &Schema{}
+		*d.Components.Schemas[name] = *d.inlineSchema(t)
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func (d *Document) componentName(t reflect.Type) string {
+	pkgPath := strings.Split(t.PkgPath(), "/")
+	name := pkgPath[len(pkgPath)-1] + "." + t.Name()
+
+	candidate := name
+	for i := 2; ; i++ {
+		if _, taken := d.Components.Schemas[candidate]; !taken {
+			return candidate
+		}
+
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+}
+
+func isSpecialType(t reflect.Type) bool {
+	return t == timeType || implementsAny(t, schemaerType, jsonMarshalerType, textMarshalerType)
+}
+
+func implementsAny(t reflect.Type, interfaces ...reflect.Type) bool {
+	for _, iface := range interfaces {
+		if t.Implements(iface) || reflect.PtrTo(t).Implements(iface) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Document) inlineSchema(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Ptr && !t.Implements(schemaerType) {
+		t = t.Elem()
+	}
+
+	switch {
+	case implementsAny(t, schemaerType):
+		if t.Kind() != reflect.Ptr {
+			t = reflect.PtrTo(t)
+		}
+		return reflect.Zero(t).Interface().(Schemaer).OpenAPISchema()
+
+	case t == timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+
+	case t == rawMessageType:
+		return &Schema{}
+
+	case implementsAny(t, jsonMarshalerType, textMarshalerType):
+		// A custom encoding we can't introspect, string based types are
+		// assumed to stay strings (names, hex bytes, assets, keys, ...)
+		if t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8) {
+			return &Schema{Type: "string"}
+		}
+
+		return &Schema{Description: fmt.Sprintf("Custom encoding of %s", t)}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: d.schemaFor(t.Elem()), Nullable: t.Kind() == reflect.Slice}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: d.schemaFor(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}, AdditionalProperties: false}
+		d.addStructFields(schema, t)
+		return schema
+	}
+
+	// Interfaces and anything else can be any JSON value
+	return &Schema{}
+}
+
+// addStructFields adds the fields of `t` as `encoding/json` would encode them,
+// embedded structs fields being promoted.
+func (d *Document) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options := parseTag(tag)
+		fieldType := field.Type
+		if field.Anonymous && name == "" {
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct && !isSpecialType(fieldType) {
+				d.addStructFields(schema, fieldType)
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			// Unexported field
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		propertySchema := d.schemaFor(field.Type)
+		if options.Contains("string") {
+			propertySchema = &Schema{Type: "string"}
+		}
+
+		if field.Type.Kind() == reflect.Ptr || field.Type.Kind() == reflect.Interface {
+			propertySchema = nullable(propertySchema)
+		}
+
+		schema.Properties[name] = propertySchema
+		if !options.Contains("omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// nullable marks a schema as nullable, a reference can't have siblings so
+// it's wrapped.
+func nullable(schema *Schema) *Schema {
+	if schema.Ref == "" {
+		out := *schema
+		out.Nullable = true
+		return &out
+	}
+
+	return &Schema{Nullable: true, AllOf: []*Schema{schema}}
+}
+
+type tagOptions string
+
+func parseTag(tag string) (string, tagOptions) {
+	if idx := strings.Index(tag, ","); idx != -1 {
+		return tag[:idx], tagOptions(tag[idx+1:])
+	}
+
+	return tag, tagOptions("")
+}
+
+func (o tagOptions) Contains(name string) bool {
+	for _, option := range strings.Split(string(o), ",") {
+		if option == name {
+			return true
+		}
+	}
+
+	return false
+}
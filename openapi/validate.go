@@ -0,0 +1,257 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Validate checks the document is consistent: every reference resolves and
+// every path parameter is declared.
+func (d *Document) Validate() error {
+	for path, pathItem := range d.Paths {
+		for method, operation := range *pathItem {
+			for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+				if !hasParameter(operation, match[1], "path") {
+					return fmt.Errorf("%s %s: path parameter %q not declared", strings.ToUpper(method), path, match[1])
+				}
+			}
+
+			if _, found := operation.Responses["200"]; !found {
+				return fmt.Errorf("%s %s: no successful response", strings.ToUpper(method), path)
+			}
+		}
+	}
+
+	var walkErr error
+	d.walkSchemas(func(at string, schema *Schema) {
+		if walkErr != nil || schema.Ref == "" {
+			return
+		}
+
+		if _, err := d.resolve(schema); err != nil {
+			walkErr = fmt.Errorf("%s: %w", at, err)
+		}
+	})
+
+	return walkErr
+}
+
+func hasParameter(operation *Operation, name, in string) bool {
+	for _, parameter := range operation.Parameters {
+		if parameter.Name == name && parameter.In == in {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *Document) walkSchemas(visit func(at string, schema *Schema)) {
+	var walk func(at string, schema *Schema)
+	walk = func(at string, schema *Schema) {
+		if schema == nil {
+			return
+		}
+
+		visit(at, schema)
+		walk(at+".items", schema.Items)
+		for name, property := range schema.Properties {
+			walk(at+"."+name, property)
+		}
+		if additional, ok := schema.AdditionalProperties.(*Schema); ok {
+			walk(at+".additionalProperties", additional)
+		}
+		for i, sub := range schema.AllOf {
+			walk(fmt.Sprintf("%s.allOf[%d]", at, i), sub)
+		}
+	}
+
+	for name, schema := range d.Components.Schemas {
+		walk("components."+name, schema)
+	}
+
+	for path, pathItem := range d.Paths {
+		for method, operation := range *pathItem {
+			at := strings.ToUpper(method) + " " + path
+			for _, parameter := range operation.Parameters {
+				walk(at+" parameter "+parameter.Name, parameter.Schema)
+			}
+			if operation.RequestBody != nil {
+				for _, mediaType := range operation.RequestBody.Content {
+					walk(at+" request", mediaType.Schema)
+				}
+			}
+			for status, response := range operation.Responses {
+				for _, mediaType := range response.Content {
+					walk(at+" response "+status, mediaType.Schema)
+				}
+			}
+		}
+	}
+}
+
+func (d *Document) resolve(schema *Schema) (*Schema, error) {
+	if schema.Ref == "" {
+		return schema, nil
+	}
+
+	name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+	resolved, found := d.Components.Schemas[name]
+	if !found {
+		return nil, fmt.Errorf("unresolved reference %q", schema.Ref)
+	}
+
+	return resolved, nil
+}
+
+// ValidateResponse checks that `body` is a JSON document matching the
+// successful response schema of `method` on `path`.
+func (d *Document) ValidateResponse(path, method string, body []byte) error {
+	operation := d.Operation(path, method)
+	if operation == nil {
+		return fmt.Errorf("no operation %s %s in document", strings.ToUpper(method), path)
+	}
+
+	mediaType := operation.Responses["200"].Content["application/json"]
+	if mediaType == nil {
+		return fmt.Errorf("operation %s %s has no JSON response", strings.ToUpper(method), path)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return fmt.Errorf("invalid JSON response: %w", err)
+	}
+
+	return d.ValidateValue(mediaType.Schema, value)
+}
+
+// ValidateValue checks that a decoded JSON value (numbers decoded as
+// `json.Number`) matches `schema`.
+func (d *Document) ValidateValue(schema *Schema, value interface{}) error {
+	return d.validate("$", schema, value)
+}
+
+func (d *Document) validate(at string, schema *Schema, value interface{}) error {
+	schema, err := d.resolve(schema)
+	if err != nil {
+		return fmt.Errorf("%s: %w", at, err)
+	}
+
+	if value == nil {
+		if schema.Nullable || (schema.Type == "" && len(schema.AllOf) == 0) {
+			return nil
+		}
+
+		return fmt.Errorf("%s: null is not allowed", at)
+	}
+
+	for _, sub := range schema.AllOf {
+		if err := d.validate(at, sub, value); err != nil {
+			return err
+		}
+	}
+
+	switch schema.Type {
+	case "":
+		return nil
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean, got %T", at, value)
+		}
+
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: expected a string, got %T", at, value)
+		}
+
+	case "integer", "number":
+		number, ok := value.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: expected a number, got %T", at, value)
+		}
+
+		if schema.Type == "integer" {
+			float, err := number.Float64()
+			if err != nil || float != math.Trunc(float) {
+				return fmt.Errorf("%s: expected an integer, got %s", at, number)
+			}
+		}
+
+	case "array":
+		elements, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an array, got %T", at, value)
+		}
+
+		for i, element := range elements {
+			if err := d.validate(fmt.Sprintf("%s[%d]", at, i), schema.Items, element); err != nil {
+				return err
+			}
+		}
+
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected an object, got %T", at, value)
+		}
+
+		return d.validateObject(at, schema, object)
+
+	default:
+		return fmt.Errorf("%s: unknown schema type %q", at, schema.Type)
+	}
+
+	return nil
+}
+
+func (d *Document) validateObject(at string, schema *Schema, object map[string]interface{}) error {
+	for _, name := range schema.Required {
+		if _, found := object[name]; !found {
+			return fmt.Errorf("%s: missing required property %q", at, name)
+		}
+	}
+
+	for name, property := range object {
+		propertySchema, found := schema.Properties[name]
+		if !found {
+			switch additional := schema.AdditionalProperties.(type) {
+			case *Schema:
+				propertySchema = additional
+			case bool:
+				if !additional {
+					return fmt.Errorf("%s: property %q is not part of the schema", at, name)
+				}
+				continue
+			default:
+				continue
+			}
+		}
+
+		if err := d.validate(at+"."+name, propertySchema, property); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
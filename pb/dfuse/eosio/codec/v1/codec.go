@@ -98,6 +98,18 @@ func (b *Block) CreatedDTrxIDs() (out []string) {
 	return
 }
 
+// MarkPartiallyInstrumented flags the block as not being produced by a
+// deep-mind instrumented `nodeos`, see `PartiallyInstrumented` for details.
+func (b *Block) MarkPartiallyInstrumented() {
+	b.PartiallyInstrumented = true
+}
+
+// IsPartiallyInstrumented returns whether the block was flagged as being
+// partially instrumented.
+func (b *Block) IsPartiallyInstrumented() bool {
+	return b.GetPartiallyInstrumented()
+}
+
 // PopulateActionAndTransactionCount will compute block stats
 // for the total number of transaction, transacation trace,
 // input action and execute action.
@@ -546,10 +546,15 @@ type Block struct {
 	// counterpart. The inner element for a producer can then be composed with
 	// multiple keys, each with their own weight and the threshold required to
 	// accept the block signature.
-	ActiveScheduleV2     *ProducerAuthoritySchedule `protobuf:"bytes,31,opt,name=active_schedule_v2,json=activeScheduleV2,proto3" json:"active_schedule_v2,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	ActiveScheduleV2 *ProducerAuthoritySchedule `protobuf:"bytes,31,opt,name=active_schedule_v2,json=activeScheduleV2,proto3" json:"active_schedule_v2,omitempty"`
+	// Set when the block was not produced by a deep-mind instrumented `nodeos`
+	// (like the ones converted from the `state_history_plugin` stream), such
+	// blocks lacking some elements (RAM ops, creation tree, deferred
+	// transaction ops, block state, etc.).
+	PartiallyInstrumented bool     `protobuf:"varint,32,opt,name=partially_instrumented,json=partiallyInstrumented,proto3" json:"partially_instrumented,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *Block) Reset()         { *m = Block{} }
@@ -759,6 +764,13 @@ func (m *Block) GetActiveScheduleV2() *ProducerAuthoritySchedule {
 	return nil
 }
 
+func (m *Block) GetPartiallyInstrumented() bool {
+	if m != nil {
+		return m.PartiallyInstrumented
+	}
+	return false
+}
+
 // BlockWithRefs is a lightweight block, with traces and transactions
 // purged from the `block` within, and only.  It is used in transports
 // to pass block data around.
@@ -5517,373 +5529,371 @@ func init() {
 func init() { proto.RegisterFile("dfuse/eosio/codec/v1/codec.proto", fileDescriptor_3286b8d338e80dff) }
 
 var fileDescriptor_3286b8d338e80dff = []byte{
-	// 5882 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7c, 0xc9, 0x6f, 0x23, 0xd9,
-	0x79, 0x78, 0x93, 0xe2, 0xfa, 0x91, 0x94, 0x4a, 0xaf, 0xb5, 0x50, 0xea, 0x4d, 0x5d, 0xe3, 0xe9,
-	0xe9, 0x19, 0x7b, 0xd4, 0xd3, 0x3d, 0x8b, 0x3d, 0xb6, 0xe7, 0xd7, 0x43, 0x91, 0xec, 0x91, 0xa6,
-	0x25, 0x52, 0x78, 0xa4, 0xba, 0xa7, 0xfd, 0xb3, 0x53, 0x28, 0x55, 0x3d, 0x49, 0xe5, 0x26, 0xab,
-	0xca, 0x55, 0x45, 0x2d, 0x46, 0x60, 0x20, 0xf0, 0x25, 0x01, 0x6c, 0x04, 0xc8, 0x25, 0x40, 0x82,
-	0x20, 0x41, 0xe0, 0x6b, 0x0e, 0x31, 0x10, 0x20, 0x71, 0x90, 0x63, 0x80, 0x5c, 0x83, 0x9c, 0x72,
-	0x48, 0x02, 0xe4, 0x10, 0xc4, 0xff, 0x40, 0x72, 0x0d, 0xde, 0x56, 0x0b, 0x59, 0x64, 0x4b, 0xed,
-	0x46, 0x90, 0x93, 0xf8, 0xbe, 0xf7, 0x7d, 0xdf, 0xdb, 0xbe, 0xfd, 0xbd, 0x12, 0x6c, 0x98, 0x47,
-	0x23, 0x9f, 0x3c, 0x20, 0x8e, 0x6f, 0x39, 0x0f, 0x0c, 0xc7, 0x24, 0xc6, 0x83, 0xd3, 0x87, 0xfc,
-	0xc7, 0xa6, 0xeb, 0x39, 0x81, 0x83, 0x96, 0x18, 0xc6, 0x26, 0xc3, 0xd8, 0xe4, 0x1d, 0xa7, 0x0f,
-	0xd7, 0xef, 0x1c, 0x3b, 0xce, 0xf1, 0x80, 0x3c, 0x60, 0x38, 0x87, 0xa3, 0xa3, 0x07, 0x81, 0x35,
-	0x24, 0x7e, 0xa0, 0x0f, 0x5d, 0x4e, 0xa6, 0xfe, 0xc9, 0x3c, 0xe4, 0xb7, 0x06, 0x8e, 0xf1, 0x12,
-	0xcd, 0x43, 0xd6, 0x32, 0xeb, 0x99, 0x8d, 0xcc, 0xfd, 0x32, 0xce, 0x5a, 0x26, 0x5a, 0x81, 0x82,
-	0x3d, 0x1a, 0x1e, 0x12, 0xaf, 0x9e, 0xdd, 0xc8, 0xdc, 0xaf, 0x61, 0xd1, 0x42, 0x9f, 0x42, 0xe1,
-	0x84, 0xe8, 0x26, 0xf1, 0xea, 0xb9, 0x8d, 0xcc, 0xfd, 0xca, 0xa3, 0xbb, 0x9b, 0x69, 0x23, 0x6f,
-	0x32, 0xa6, 0xdb, 0x0c, 0x11, 0x0b, 0x02, 0xf4, 0x3e, 0x20, 0xd7, 0x73, 0xcc, 0x91, 0x41, 0x3c,
-	0xcd, 0xb7, 0x8e, 0x6d, 0x3d, 0x18, 0x79, 0xa4, 0x9e, 0x67, 0x43, 0x2e, 0xca, 0x9e, 0x9e, 0xec,
-	0x40, 0xbb, 0x50, 0x0d, 0x3c, 0xdd, 0xf6, 0x75, 0x23, 0xb0, 0x1c, 0xdb, 0xaf, 0x17, 0x36, 0xe6,
-	0xee, 0x57, 0x1e, 0xdd, 0x4f, 0x1f, 0xaf, 0x1f, 0x61, 0x62, 0x62, 0x10, 0xcb, 0x0d, 0x70, 0x82,
-	0x1a, 0x7d, 0x1d, 0x16, 0x63, 0x6d, 0xcd, 0x70, 0x46, 0x76, 0x50, 0x5f, 0x61, 0x4b, 0x53, 0x62,
-	0x1d, 0x4d, 0x0a, 0x47, 0x5f, 0x82, 0x72, 0x48, 0x17, 0xa0, 0x91, 0xf3, 0x80, 0xd8, 0x3e, 0x1b,
-	0xbe, 0xc8, 0x86, 0xbf, 0x93, 0x3e, 0x7c, 0x5b, 0xe2, 0xe1, 0x05, 0x46, 0x18, 0xb6, 0x7d, 0xb4,
-	0x07, 0x6f, 0x99, 0xae, 0xe3, 0x6b, 0xae, 0xe7, 0xb8, 0x8e, 0x4f, 0x4c, 0xcd, 0xf2, 0x3c, 0x72,
-	0x4a, 0x3c, 0xdf, 0x3a, 0x1c, 0x10, 0x8d, 0x61, 0xdb, 0xa3, 0x61, 0xbd, 0xc4, 0xa6, 0xb2, 0x41,
-	0x51, 0xf7, 0x05, 0xe6, 0x4e, 0x0c, 0x71, 0x4b, 0xe0, 0xa1, 0xef, 0xc2, 0x3a, 0x63, 0x97, 0xce,
-	0xa5, 0xcc, 0xb8, 0xd4, 0x29, 0x46, 0x2a, 0xf5, 0xbe, 0x58, 0x98, 0xe7, 0x38, 0x81, 0x36, 0x24,
-	0xde, 0xcb, 0x01, 0xa9, 0x57, 0xd8, 0x39, 0xbe, 0x3d, 0xe3, 0x1c, 0xb1, 0xe3, 0x04, 0x7b, 0x0c,
-	0x59, 0x2c, 0xcf, 0x0b, 0x01, 0xe8, 0x18, 0xd6, 0xc2, 0x43, 0x0d, 0x1c, 0x6d, 0xa0, 0xfb, 0x81,
-	0x26, 0x00, 0x66, 0xbd, 0xca, 0xf6, 0xec, 0x1b, 0xe9, 0xac, 0xf7, 0x05, 0x59, 0xdf, 0xd9, 0xd5,
-	0xfd, 0x40, 0xb4, 0x4c, 0xbc, 0xe2, 0xa6, 0xc2, 0x91, 0x0d, 0x37, 0x27, 0x06, 0xb2, 0x86, 0xee,
-	0xc0, 0x62, 0x5b, 0x7a, 0x58, 0xaf, 0xb1, 0xb1, 0x36, 0x2f, 0x33, 0xd6, 0x0e, 0x27, 0xdb, 0xc1,
-	0x5b, 0xb8, 0xee, 0xa6, 0xf6, 0x78, 0x87, 0xe8, 0x2d, 0xa8, 0x19, 0x8e, 0x7d, 0x64, 0x79, 0x43,
-	0x21, 0x2c, 0x0b, 0x1b, 0x73, 0xf7, 0x6b, 0xb8, 0x2a, 0x80, 0x5c, 0x50, 0xbe, 0x02, 0xc5, 0x25,
-	0xb6, 0x69, 0xd9, 0xc7, 0x9a, 0x6f, 0x9c, 0x10, 0x73, 0x34, 0x20, 0x75, 0x85, 0xed, 0xe7, 0xfb,
-	0x53, 0x26, 0xc2, 0xb1, 0xe5, 0x7c, 0x7a, 0x82, 0x08, 0x2f, 0x08, 0x36, 0x12, 0x80, 0x1c, 0xb8,
-	0x41, 0x25, 0xf2, 0x54, 0x0f, 0x88, 0xa9, 0x31, 0x65, 0x35, 0x9c, 0x81, 0x76, 0x44, 0x98, 0x6e,
-	0xf8, 0xf5, 0x45, 0x36, 0xc8, 0x83, 0xf4, 0x41, 0x1a, 0x92, 0x70, 0x5f, 0xd0, 0x3d, 0x11, 0x64,
-	0x78, 0x4d, 0x9f, 0xd6, 0x85, 0x6e, 0x42, 0xf9, 0x54, 0x1f, 0x58, 0x26, 0xed, 0xac, 0xa3, 0x8d,
-	0xcc, 0xfd, 0x12, 0x8e, 0x00, 0xe8, 0x33, 0x00, 0x6f, 0x60, 0x0d, 0xad, 0x40, 0x73, 0x5c, 0xbf,
-	0x7e, 0x9d, 0xed, 0xf5, 0xed, 0xf4, 0xd1, 0x31, 0xc3, 0xeb, 0xba, 0xb8, 0xec, 0x89, 0x5f, 0x3e,
-	0x3a, 0x80, 0x3a, 0x3b, 0x2b, 0xc3, 0x0a, 0xb4, 0xb8, 0x1a, 0x52, 0x66, 0x4b, 0x8c, 0xd9, 0x8d,
-	0x69, 0x7a, 0x7d, 0xde, 0x75, 0xf1, 0x8a, 0x24, 0x8e, 0xa9, 0x39, 0x67, 0x8b, 0xe2, 0xdc, 0x02,
-	0x4f, 0x37, 0x88, 0x5f, 0x5f, 0x66, 0x0c, 0xef, 0xbd, 0xd2, 0x50, 0xf4, 0x29, 0x3a, 0x8e, 0x9b,
-	0x05, 0x06, 0xf1, 0xd1, 0x27, 0xb0, 0x3a, 0xc1, 0x56, 0x08, 0xc1, 0x2a, 0x53, 0xb0, 0xe5, 0x71,
-	0x1a, 0x2e, 0x0d, 0xdf, 0x81, 0x75, 0x72, 0x4e, 0x8c, 0x51, 0x40, 0x34, 0xcb, 0x76, 0x47, 0x81,
-	0x96, 0x30, 0x36, 0x75, 0x46, 0xba, 0x2a, 0x30, 0x76, 0x28, 0x42, 0x23, 0x66, 0x73, 0x3e, 0x83,
-	0x1b, 0xa2, 0xcb, 0xd4, 0x02, 0x27, 0xd0, 0x07, 0x49, 0xea, 0x35, 0xae, 0xd9, 0x12, 0xa5, 0x4f,
-	0x31, 0xe2, 0xe4, 0xef, 0xc1, 0x22, 0x37, 0x59, 0xd4, 0xb2, 0x52, 0x79, 0x7c, 0x49, 0x2e, 0xea,
-	0xf3, 0xcc, 0xb6, 0x72, 0x9d, 0xed, 0x71, 0xf8, 0x53, 0x72, 0x81, 0xfa, 0x80, 0x98, 0x1c, 0x90,
-	0x50, 0x68, 0xb5, 0xd3, 0x87, 0x75, 0x60, 0x22, 0x75, 0x6f, 0xb6, 0x02, 0x85, 0x02, 0xab, 0x70,
-	0x0e, 0xb2, 0xfd, 0xec, 0x21, 0xf2, 0x61, 0x83, 0xc9, 0x8b, 0x96, 0x9c, 0x87, 0x3e, 0x0a, 0x4e,
-	0x1c, 0xcf, 0x0a, 0x2e, 0xb4, 0xd3, 0x47, 0xf5, 0xdb, 0x6c, 0x8c, 0xaf, 0xcf, 0xb0, 0x35, 0x62,
-	0x9a, 0x0d, 0x49, 0x85, 0x6f, 0x32, 0xa6, 0xa9, 0x7d, 0xcf, 0x1e, 0xa1, 0x1f, 0xa4, 0x2c, 0xe5,
-	0x51, 0xfd, 0xce, 0x2c, 0xed, 0x90, 0x4b, 0x09, 0xd9, 0x4c, 0x5d, 0xd3, 0x23, 0xf5, 0x77, 0xe7,
-	0xa0, 0xc6, 0x86, 0x7e, 0x6e, 0x05, 0x27, 0x98, 0x1c, 0xf9, 0x13, 0x7e, 0xf2, 0x21, 0xe4, 0xd9,
-	0x7a, 0x99, 0x9b, 0x9c, 0x2a, 0xc6, 0xdc, 0x8c, 0x72, 0x4c, 0xa4, 0xc3, 0x5a, 0xaa, 0x32, 0x78,
-	0xe4, 0xc8, 0xaf, 0xcf, 0xcd, 0xb2, 0xc6, 0x09, 0x2f, 0x77, 0xe4, 0xe3, 0xd5, 0x14, 0xbd, 0x60,
-	0xb3, 0xdc, 0x07, 0x65, 0x82, 0x73, 0xee, 0x2a, 0x9c, 0x17, 0x82, 0x31, 0x8e, 0xff, 0x1f, 0x56,
-	0x26, 0x75, 0x82, 0xf1, 0xcd, 0x5f, 0x85, 0xef, 0xd2, 0xb8, 0xe6, 0x30, 0xe6, 0x2a, 0x54, 0xe3,
-	0xfe, 0xac, 0x5e, 0x60, 0xe6, 0x27, 0x01, 0x53, 0xdf, 0x85, 0x85, 0xf1, 0x55, 0xae, 0x40, 0xe1,
-	0x44, 0xf7, 0x4f, 0x88, 0x5f, 0xcf, 0x6c, 0xcc, 0xdd, 0xaf, 0x62, 0xd1, 0x52, 0xb7, 0x61, 0x6d,
-	0xaa, 0x09, 0xa4, 0x81, 0xc0, 0xa4, 0x39, 0xe5, 0xf4, 0x8a, 0x3b, 0x86, 0xac, 0xfe, 0x34, 0x0b,
-	0xab, 0x53, 0x4c, 0x36, 0xba, 0x0f, 0x4a, 0x28, 0x73, 0x03, 0xeb, 0x50, 0xa3, 0xfe, 0x37, 0xc3,
-	0xb4, 0x74, 0x5e, 0xc2, 0x77, 0xad, 0xc3, 0xce, 0x68, 0x48, 0x5d, 0x49, 0x88, 0x49, 0xa7, 0xc8,
-	0x64, 0xa5, 0x8a, 0xab, 0x12, 0xb8, 0xad, 0xfb, 0x27, 0xe8, 0x0b, 0xa8, 0xc4, 0xb5, 0x71, 0xee,
-	0x4a, 0xda, 0x08, 0x7e, 0xa4, 0x87, 0xfb, 0x71, 0x46, 0x8f, 0xc4, 0xb1, 0x5f, 0x59, 0x17, 0x22,
-	0x8e, 0x8f, 0xd4, 0x21, 0x28, 0x13, 0xab, 0xaf, 0x43, 0x91, 0x1d, 0x8d, 0x63, 0x8b, 0x45, 0xcb,
-	0x26, 0x7a, 0x0c, 0x65, 0xe9, 0x54, 0xfd, 0x7a, 0x96, 0xd9, 0xe2, 0xbb, 0xb3, 0x47, 0x7f, 0x4a,
-	0x2e, 0x70, 0x44, 0xa3, 0x7e, 0x1f, 0x2a, 0xb1, 0x1e, 0x74, 0x17, 0xaa, 0xba, 0xc1, 0x8c, 0xa0,
-	0x66, 0xeb, 0x43, 0x22, 0x74, 0xaf, 0x22, 0x60, 0x1d, 0x7d, 0x48, 0xd2, 0x8d, 0x5f, 0x36, 0xd5,
-	0xf8, 0xa9, 0xbf, 0x0d, 0x6b, 0x53, 0x57, 0x3d, 0x63, 0x55, 0xed, 0xc9, 0x55, 0xbd, 0x73, 0xc9,
-	0x3d, 0x8d, 0xaf, 0xed, 0x8f, 0x33, 0xb0, 0x38, 0x81, 0x70, 0x99, 0x25, 0x1a, 0xb0, 0x3a, 0xc5,
-	0xae, 0x0a, 0xcb, 0x73, 0x25, 0xa3, 0xba, 0x7c, 0x98, 0x06, 0x56, 0x0d, 0x58, 0x4e, 0xc5, 0x47,
-	0x8f, 0x21, 0x7b, 0xfa, 0x01, 0x9b, 0xd6, 0xd4, 0xc8, 0x26, 0xdd, 0x42, 0x7f, 0xb0, 0x7d, 0x0d,
-	0x67, 0x4f, 0x3f, 0xd8, 0x2a, 0x43, 0xf1, 0x54, 0xf7, 0x2c, 0xdd, 0x0e, 0xd4, 0x01, 0xac, 0x4e,
-	0xc1, 0xa5, 0x31, 0x48, 0x70, 0xe2, 0x11, 0xff, 0xc4, 0x19, 0x98, 0xe2, 0x00, 0x22, 0x00, 0xfa,
-	0x10, 0x72, 0x2f, 0xc9, 0x85, 0xdc, 0xfd, 0x29, 0x91, 0xf8, 0x53, 0x72, 0xf1, 0x9c, 0x58, 0xc7,
-	0x27, 0x01, 0x66, 0xc8, 0x6a, 0x0f, 0x16, 0xc6, 0x62, 0x58, 0x74, 0x0b, 0xc0, 0x76, 0x4c, 0xe9,
-	0xd1, 0xc5, 0x30, 0x14, 0xc2, 0x3d, 0x29, 0x3b, 0x0c, 0xe6, 0x52, 0x28, 0x8c, 0x0f, 0x57, 0xa5,
-	0x87, 0x41, 0x61, 0x1d, 0x0a, 0x52, 0x0d, 0x58, 0x49, 0x8f, 0x5e, 0x11, 0x82, 0x5c, 0xec, 0x04,
-	0xd9, 0x6f, 0xf4, 0x31, 0xac, 0xb2, 0x68, 0x95, 0x9f, 0x9f, 0x3d, 0x1a, 0x46, 0x01, 0x32, 0xcf,
-	0xad, 0x96, 0x68, 0x37, 0x9b, 0x65, 0x67, 0x34, 0x94, 0xac, 0x54, 0x02, 0xf5, 0x69, 0x61, 0xeb,
-	0x9b, 0x1c, 0xe6, 0x97, 0x59, 0x40, 0x93, 0xd9, 0x93, 0xf0, 0x73, 0xb9, 0xd0, 0xcf, 0x2d, 0x41,
-	0xde, 0xb2, 0x4d, 0x72, 0xce, 0x6c, 0x73, 0x0e, 0xf3, 0x06, 0x7a, 0x0c, 0x05, 0x3f, 0xd0, 0x83,
-	0x91, 0xcf, 0x66, 0x32, 0x3f, 0x4d, 0x25, 0x62, 0xfc, 0x7b, 0x0c, 0x1d, 0x0b, 0x32, 0x3a, 0x69,
-	0xc3, 0x1d, 0x69, 0x23, 0x5f, 0x3f, 0x26, 0xda, 0xd0, 0x32, 0x3c, 0x47, 0xf3, 0x89, 0xe1, 0xd8,
-	0xa6, 0x2f, 0x27, 0x6d, 0xb8, 0xa3, 0x03, 0xda, 0xbb, 0x47, 0x3b, 0x7b, 0xbc, 0x0f, 0xdd, 0x83,
-	0x05, 0x9b, 0x04, 0x82, 0xec, 0xcc, 0xf1, 0x4c, 0xee, 0x38, 0x6b, 0xb8, 0x66, 0x93, 0x80, 0xa1,
-	0x3f, 0xa7, 0x40, 0xf4, 0x0c, 0x90, 0xab, 0x1b, 0x2f, 0x69, 0x48, 0x15, 0x4d, 0x41, 0x78, 0xac,
-	0x69, 0xea, 0xcb, 0xf0, 0xe3, 0x3b, 0xb2, 0xe8, 0x8e, 0x83, 0xd4, 0xbf, 0xa3, 0x6a, 0x3c, 0x0e,
-	0x45, 0xb7, 0x01, 0xc2, 0xbc, 0x96, 0xfb, 0x94, 0x32, 0x8e, 0x41, 0xd0, 0x06, 0x54, 0x0c, 0x67,
-	0xe8, 0x7a, 0xc4, 0x67, 0x16, 0x86, 0x2f, 0x30, 0x0e, 0x42, 0xdf, 0x84, 0xba, 0x98, 0xaf, 0xe1,
-	0xd8, 0x01, 0x39, 0x0f, 0xb4, 0x23, 0x8f, 0x10, 0xcd, 0xd4, 0x03, 0x9d, 0x2d, 0xb0, 0x8a, 0x97,
-	0x79, 0x7f, 0x93, 0x77, 0x3f, 0xf1, 0x08, 0x69, 0xe9, 0x81, 0xce, 0x72, 0xeb, 0xc9, 0x85, 0xe6,
-	0x18, 0x49, 0xca, 0xfc, 0xff, 0x7a, 0x0e, 0x2a, 0xb1, 0x14, 0x1d, 0x7d, 0x0b, 0xca, 0x61, 0x69,
-	0x40, 0xb8, 0x9e, 0xf5, 0x4d, 0x5e, 0x3c, 0xd8, 0x94, 0xc5, 0x83, 0xcd, 0xbe, 0xc4, 0xc0, 0x11,
-	0x32, 0x5a, 0x87, 0x92, 0xb4, 0x6e, 0x42, 0x5a, 0xc2, 0x36, 0x55, 0x67, 0x91, 0x2d, 0x11, 0x93,
-	0x6d, 0x7a, 0x0d, 0x47, 0x00, 0x4e, 0x49, 0x4e, 0x2d, 0x67, 0xe4, 0x33, 0xa1, 0x62, 0x94, 0xbc,
-	0x3d, 0x9e, 0xad, 0x0f, 0x69, 0xc2, 0x59, 0x2f, 0xb2, 0xd5, 0xc4, 0x03, 0x9b, 0x3d, 0x0a, 0x97,
-	0x0a, 0x1b, 0xe2, 0x95, 0x18, 0x5e, 0x25, 0x8e, 0xf2, 0x6e, 0xcc, 0x57, 0x4b, 0x03, 0xcf, 0x73,
-	0xe5, 0x85, 0xd0, 0xcf, 0x09, 0x43, 0xbf, 0x0b, 0x8b, 0xbc, 0x5e, 0x11, 0x4f, 0xfe, 0x2b, 0x97,
-	0x4b, 0xfe, 0x15, 0x4e, 0x19, 0xcb, 0xfe, 0xf7, 0x41, 0xb1, 0xc9, 0x99, 0x16, 0x3a, 0x80, 0xab,
-	0x07, 0xda, 0xf3, 0x36, 0x39, 0x93, 0x40, 0xff, 0xd9, 0x43, 0xf5, 0xf7, 0x00, 0x94, 0xd8, 0x51,
-	0xb6, 0x4f, 0x89, 0x1d, 0x4c, 0x44, 0xa5, 0x6b, 0x50, 0xe2, 0x66, 0xc0, 0x32, 0x85, 0x1f, 0x2c,
-	0xb2, 0xf6, 0x8e, 0x89, 0x6e, 0x40, 0x39, 0xb4, 0x10, 0x42, 0x69, 0x38, 0x2e, 0x8d, 0x54, 0xc6,
-	0x03, 0xb1, 0xdc, 0x64, 0x20, 0x86, 0x08, 0x2c, 0x5a, 0x76, 0x40, 0x3c, 0x9b, 0xa6, 0x28, 0xa6,
-	0x69, 0xc5, 0x54, 0xea, 0x93, 0x57, 0xaa, 0x3f, 0x9b, 0xee, 0x66, 0xc3, 0x34, 0x89, 0xb9, 0x23,
-	0x98, 0x0c, 0x2e, 0xb6, 0xaf, 0x61, 0x45, 0xb2, 0x6c, 0x08, 0x8e, 0xe8, 0x4b, 0x28, 0x85, 0xdc,
-	0x0b, 0x8c, 0xfb, 0x37, 0xae, 0xc2, 0x7d, 0xfb, 0x1a, 0x0e, 0xe9, 0x51, 0x17, 0xca, 0x3c, 0x71,
-	0xa2, 0xcc, 0x8a, 0xb3, 0x02, 0xa2, 0x09, 0x66, 0x6d, 0x91, 0x70, 0x6d, 0x5f, 0xc3, 0x11, 0x0f,
-	0xa4, 0xc1, 0x82, 0x19, 0x78, 0xe7, 0x32, 0xe9, 0xb0, 0xec, 0x63, 0x26, 0x75, 0x95, 0x47, 0x1f,
-	0x5d, 0x92, 0x6d, 0x2b, 0xf0, 0xce, 0xe5, 0x11, 0x53, 0xde, 0xf3, 0x66, 0x04, 0xb0, 0xec, 0x63,
-	0x74, 0x08, 0x8b, 0x6c, 0x00, 0x43, 0xb7, 0x0d, 0x32, 0x18, 0xe8, 0x81, 0x94, 0xd8, 0xca, 0xa3,
-	0x0f, 0xaf, 0x30, 0x44, 0x93, 0x91, 0xb3, 0x11, 0x14, 0x33, 0x6c, 0x73, 0x76, 0xeb, 0xdf, 0x87,
-	0x85, 0xb1, 0x83, 0x40, 0x3b, 0x50, 0x89, 0xdb, 0x8f, 0xcc, 0x2c, 0x43, 0x49, 0xfd, 0x77, 0xd2,
-	0x50, 0xc6, 0x69, 0xd7, 0xff, 0x39, 0x03, 0x79, 0xc6, 0x1e, 0x6d, 0x41, 0xd1, 0xe3, 0x5e, 0x45,
-	0x30, 0xbc, 0x7c, 0x0d, 0x4f, 0x12, 0x8e, 0x4f, 0x2c, 0xfb, 0xfa, 0x13, 0x43, 0x0d, 0xa8, 0xb8,
-	0xa3, 0xc3, 0x81, 0x65, 0x68, 0x2c, 0x9a, 0xe0, 0xd6, 0x6e, 0x63, 0x8a, 0x36, 0x32, 0xc4, 0xa7,
-	0xe4, 0xc2, 0xc7, 0xe0, 0x86, 0xbf, 0xd7, 0x7f, 0x9e, 0x81, 0x92, 0x14, 0x0c, 0xf4, 0x5d, 0xc8,
-	0xb3, 0x6c, 0x48, 0x2c, 0xee, 0xb2, 0x75, 0x07, 0x4e, 0x84, 0x9a, 0x50, 0x39, 0x8c, 0x0c, 0xb1,
-	0x58, 0xd8, 0x25, 0x8a, 0xaa, 0x71, 0xaa, 0xf5, 0x3f, 0xca, 0x40, 0x2d, 0x21, 0x51, 0xe8, 0xff,
-	0x01, 0x18, 0x1e, 0x61, 0xc5, 0xa3, 0xc3, 0x0b, 0x31, 0xb3, 0xe9, 0xe6, 0xab, 0xc5, 0xcb, 0x2c,
-	0x65, 0x41, 0xb2, 0x75, 0xf1, 0x06, 0xf7, 0x7b, 0x7d, 0x1f, 0xaa, 0x71, 0x51, 0x44, 0x9f, 0x43,
-	0xc5, 0x10, 0xbf, 0xaf, 0x30, 0x37, 0x90, 0x34, 0x5b, 0x17, 0x5b, 0x45, 0xc8, 0x13, 0x2a, 0xe2,
-	0xea, 0xfb, 0x00, 0xd1, 0x09, 0xa1, 0x3b, 0xc9, 0x83, 0x15, 0xfe, 0x37, 0x3a, 0x36, 0xf5, 0x17,
-	0x05, 0x58, 0x8a, 0x4d, 0x73, 0xd7, 0x3a, 0x22, 0xc6, 0x85, 0x31, 0x20, 0x13, 0xe6, 0xf3, 0x59,
-	0xb2, 0xae, 0x24, 0x42, 0x9c, 0xec, 0xd5, 0x42, 0x9c, 0xb8, 0x07, 0xe3, 0x20, 0xf4, 0x02, 0xae,
-	0x27, 0xd3, 0x72, 0xae, 0x15, 0x5f, 0xbb, 0xa2, 0x56, 0xa0, 0x60, 0x32, 0x5e, 0x1b, 0x3b, 0x30,
-	0xf8, 0x0d, 0x14, 0x64, 0x6c, 0x1f, 0xaf, 0x8f, 0xef, 0x23, 0xea, 0xc2, 0x42, 0x68, 0x0a, 0x79,
-	0x25, 0x40, 0x14, 0x91, 0x2f, 0x2b, 0xfb, 0xf3, 0x21, 0x39, 0x6b, 0xa3, 0xe7, 0xb0, 0x12, 0x31,
-	0xe4, 0xde, 0x49, 0x5c, 0x32, 0x54, 0x2f, 0xab, 0x0f, 0x4b, 0x21, 0x83, 0x78, 0x5c, 0x93, 0x54,
-	0x83, 0xa5, 0x2b, 0xab, 0xc1, 0x98, 0xac, 0x2e, 0x5f, 0x59, 0x56, 0xd1, 0x87, 0xb0, 0xcc, 0xd8,
-	0xd1, 0x95, 0x25, 0x5c, 0xeb, 0x5d, 0xe6, 0x5a, 0x97, 0x64, 0x67, 0xbc, 0x5c, 0x8f, 0x3e, 0x8e,
-	0xef, 0x47, 0x82, 0x4a, 0x65, 0x54, 0xcb, 0x61, 0x6f, 0x82, 0xec, 0x53, 0xa8, 0xf3, 0x91, 0x53,
-	0x86, 0x7b, 0x8b, 0x11, 0xae, 0xc6, 0xfa, 0xe3, 0xa4, 0x5f, 0xe6, 0x4a, 0x35, 0xe5, 0xfa, 0x97,
-	0xb9, 0xd2, 0x8a, 0x72, 0x57, 0xfd, 0x45, 0x06, 0x16, 0x27, 0x44, 0x84, 0x1a, 0xaa, 0x49, 0xd7,
-	0x70, 0xf7, 0xd5, 0x32, 0x9b, 0x10, 0xad, 0x64, 0x84, 0x9c, 0x9d, 0x88, 0x90, 0xdf, 0x83, 0xc5,
-	0xb4, 0xc0, 0x97, 0x26, 0x60, 0x0b, 0x46, 0x32, 0xe4, 0x55, 0xff, 0x30, 0x0b, 0x95, 0xf8, 0x04,
-	0x1f, 0x87, 0x37, 0x53, 0x33, 0xdd, 0x56, 0x8c, 0x64, 0xec, 0x7e, 0xaa, 0x03, 0x4b, 0x89, 0xc1,
-	0xe5, 0xc5, 0x13, 0xcf, 0x37, 0x6f, 0x4e, 0xaf, 0xb5, 0x3b, 0x36, 0x46, 0xb1, 0xd9, 0x35, 0xc4,
-	0x95, 0xd3, 0x27, 0x50, 0x94, 0x2c, 0xe6, 0x2e, 0xc1, 0x42, 0x22, 0xa3, 0xc7, 0x00, 0xb1, 0xd0,
-	0x33, 0x77, 0xb9, 0xd0, 0x33, 0x46, 0xa2, 0xfe, 0x41, 0x16, 0x16, 0x27, 0x96, 0x89, 0xbe, 0x4d,
-	0xd9, 0xba, 0x96, 0xa7, 0xc7, 0xce, 0x6f, 0x56, 0x90, 0x1f, 0xc3, 0x46, 0x2a, 0xd4, 0x3c, 0x72,
-	0x14, 0xa5, 0x96, 0x32, 0x77, 0xf1, 0xc8, 0x91, 0x4c, 0x28, 0xd1, 0x7d, 0x50, 0x22, 0x1c, 0xd7,
-	0x23, 0x47, 0xd6, 0xb9, 0x88, 0x2f, 0xe7, 0x25, 0xda, 0x3e, 0x83, 0xa2, 0xf7, 0xe1, 0xfa, 0x50,
-	0x3f, 0xd7, 0xc6, 0x33, 0xb8, 0x1c, 0xbf, 0x8d, 0x1b, 0xea, 0xe7, 0x9d, 0x44, 0x12, 0xf7, 0x0e,
-	0x50, 0x98, 0x16, 0xcb, 0x13, 0x7d, 0x91, 0x4d, 0xd4, 0x86, 0xfa, 0x79, 0x53, 0xe6, 0x87, 0x3e,
-	0x0d, 0x6d, 0x4d, 0x32, 0xd0, 0x2f, 0x68, 0x0a, 0xc9, 0x62, 0xc6, 0x1a, 0x2e, 0x31, 0x40, 0x8f,
-	0x18, 0xea, 0xef, 0x97, 0x13, 0x71, 0x33, 0x37, 0x3c, 0xe3, 0x86, 0x3f, 0x11, 0x1c, 0x67, 0x59,
-	0xa6, 0x1b, 0x05, 0xc7, 0x61, 0x0a, 0xbc, 0x1e, 0x4f, 0x81, 0x3f, 0x05, 0xe0, 0x24, 0x34, 0x27,
-	0xba, 0x4c, 0xee, 0xc4, 0xb0, 0x69, 0x9b, 0x4a, 0x7b, 0x78, 0xa5, 0x15, 0x86, 0xeb, 0x3c, 0x89,
-	0x5a, 0x90, 0x1d, 0x5b, 0x22, 0x6c, 0xdf, 0x8e, 0x82, 0x28, 0x1e, 0x6b, 0x6f, 0x5e, 0xd6, 0x5d,
-	0x08, 0x29, 0x0f, 0x43, 0xa9, 0x3a, 0x14, 0xc9, 0x40, 0x77, 0x7d, 0x62, 0xb2, 0x3d, 0x9a, 0xc3,
-	0xb2, 0x49, 0x57, 0x1f, 0x9e, 0x09, 0x0b, 0x93, 0x73, 0xb8, 0x24, 0xf3, 0x69, 0x9a, 0xcc, 0xc9,
-	0x54, 0xc9, 0x64, 0xc1, 0x6e, 0x09, 0x47, 0x00, 0xf4, 0x04, 0x6a, 0xc9, 0x4b, 0x98, 0xf2, 0xac,
-	0xc2, 0x5f, 0x23, 0xe6, 0x0b, 0xaa, 0x89, 0xab, 0x17, 0x0c, 0x8b, 0x47, 0xba, 0x45, 0xcd, 0x2d,
-	0x0b, 0x7f, 0xb9, 0x73, 0x81, 0x2b, 0x39, 0x97, 0x05, 0xce, 0x80, 0xc6, 0x1c, 0xfc, 0x90, 0x3f,
-	0xa3, 0xd1, 0xbf, 0x41, 0x5c, 0x26, 0xf7, 0x0b, 0xb3, 0x4d, 0xb8, 0x40, 0xc3, 0x11, 0x05, 0xba,
-	0x05, 0x40, 0x3c, 0xcf, 0xf1, 0x34, 0x8a, 0xc6, 0x6e, 0xf7, 0x72, 0xb8, 0xcc, 0x20, 0x4d, 0xc7,
-	0x24, 0xe8, 0x21, 0x14, 0xcc, 0x43, 0x76, 0x91, 0xb5, 0xc8, 0x96, 0xbc, 0x9e, 0xce, 0xba, 0xb5,
-	0xd5, 0x75, 0x71, 0xde, 0x3c, 0xec, 0xba, 0x3e, 0xfa, 0x26, 0x94, 0xd8, 0xea, 0x28, 0x11, 0x9a,
-	0x65, 0x19, 0x84, 0x3f, 0x29, 0x52, 0x6c, 0x4a, 0xf8, 0x39, 0x54, 0x44, 0xc9, 0x3a, 0x76, 0x0d,
-	0x37, 0x65, 0x2d, 0xa2, 0x86, 0x4d, 0xdd, 0xd1, 0x91, 0xfc, 0xc9, 0x86, 0x76, 0x89, 0x37, 0x8c,
-	0x5d, 0xbc, 0xdd, 0x9c, 0x76, 0x51, 0xe9, 0x0d, 0xe9, 0xd0, 0x2e, 0xfb, 0xeb, 0xa3, 0x8f, 0xa0,
-	0xe8, 0xe9, 0x9c, 0x6e, 0x79, 0xd6, 0x85, 0x1d, 0x6e, 0xec, 0x75, 0x5d, 0x5c, 0xf0, 0x74, 0x46,
-	0xd5, 0x03, 0x44, 0xa9, 0x0c, 0xc7, 0xf3, 0x48, 0x74, 0xe3, 0xb7, 0xc2, 0x18, 0xbc, 0x3d, 0x95,
-	0x41, 0x33, 0x44, 0xef, 0xba, 0x58, 0xf1, 0xf4, 0x61, 0x1c, 0xe0, 0x8f, 0xdd, 0x45, 0xae, 0x5e,
-	0xf5, 0x2e, 0xf2, 0xdb, 0x50, 0x0e, 0xf4, 0xc3, 0x01, 0xdf, 0xc2, 0x3a, 0xa3, 0xbe, 0x35, 0x45,
-	0xb4, 0x28, 0x5a, 0xd7, 0xc5, 0xa5, 0x80, 0xff, 0xf0, 0xd1, 0x53, 0xa8, 0x85, 0xde, 0x3c, 0xf0,
-	0x08, 0xa9, 0xaf, 0xcd, 0xba, 0x6b, 0x6c, 0x0a, 0xd4, 0x27, 0x03, 0x3d, 0xe8, 0x38, 0x26, 0xc1,
-	0x55, 0x49, 0xdc, 0xf7, 0x08, 0x51, 0x7f, 0x95, 0x81, 0xfa, 0x34, 0x75, 0xfd, 0xbf, 0x5e, 0x59,
-	0x53, 0xff, 0x36, 0x03, 0x05, 0xae, 0xc6, 0xd4, 0xa0, 0x88, 0x4a, 0xb5, 0xb0, 0xa4, 0xb2, 0x19,
-	0x96, 0x29, 0xb3, 0xb1, 0x32, 0xe5, 0x53, 0xa8, 0x89, 0xd2, 0xf5, 0x8f, 0xb9, 0x27, 0x9a, 0x9b,
-	0x25, 0x0d, 0x54, 0x0c, 0x2d, 0x56, 0x1b, 0xdb, 0x25, 0xa7, 0x64, 0x80, 0x93, 0xb4, 0xd4, 0x62,
-	0xfd, 0xd0, 0x77, 0x6c, 0x1e, 0x27, 0x88, 0xf2, 0x13, 0x05, 0xb0, 0x9a, 0xd8, 0x1a, 0x94, 0x3c,
-	0xfd, 0x8c, 0xf7, 0xe5, 0x59, 0x4d, 0xa8, 0xe8, 0xe9, 0x67, 0x2c, 0x76, 0xf8, 0xab, 0x02, 0x54,
-	0x62, 0x46, 0x08, 0xad, 0x43, 0x89, 0x99, 0xc7, 0x53, 0xe2, 0xb1, 0x50, 0xb6, 0x8c, 0xc3, 0x36,
-	0xfa, 0x6c, 0x3c, 0x7d, 0x7d, 0x6b, 0xa6, 0x1b, 0x1f, 0xcf, 0x5c, 0x3f, 0x82, 0x42, 0x22, 0x89,
-	0x9a, 0x1d, 0x04, 0x08, 0x5c, 0x74, 0x17, 0xaa, 0xf1, 0x58, 0x84, 0x9d, 0x41, 0x09, 0x57, 0x62,
-	0x51, 0x46, 0xdc, 0x8e, 0xe7, 0x92, 0x76, 0xbc, 0x0e, 0x45, 0xc3, 0xb1, 0x7d, 0x67, 0x20, 0x5f,
-	0xd7, 0xc8, 0x26, 0x7a, 0x1b, 0xe6, 0xe3, 0x09, 0x88, 0x65, 0x8a, 0xca, 0x5b, 0x2d, 0x06, 0x1d,
-	0xaf, 0x11, 0x15, 0xc7, 0xdc, 0x60, 0xaa, 0xd7, 0x2a, 0xa5, 0x7b, 0xad, 0xa4, 0x73, 0x2c, 0x5f,
-	0xc5, 0x39, 0xf6, 0x00, 0xc9, 0x3b, 0x11, 0x6a, 0x42, 0x4c, 0x32, 0x08, 0x74, 0xbf, 0x0e, 0xb3,
-	0x84, 0xa5, 0xc1, 0xf1, 0x71, 0x63, 0xaf, 0x45, 0xb1, 0xb1, 0x22, 0x18, 0x60, 0x7d, 0xc8, 0x00,
-	0xfe, 0x9b, 0x75, 0x05, 0x4b, 0xe3, 0xae, 0xe0, 0x6d, 0x98, 0x97, 0xef, 0x1a, 0x3c, 0xd3, 0xb2,
-	0xf5, 0x01, 0xf3, 0x16, 0x35, 0x2c, 0x5c, 0x63, 0x97, 0x03, 0xd1, 0x47, 0xb0, 0xc2, 0xec, 0x80,
-	0xe3, 0x69, 0x63, 0xe8, 0x8b, 0x42, 0x31, 0x79, 0x6f, 0x23, 0x41, 0xf5, 0x3d, 0x78, 0xcf, 0x18,
-	0x38, 0x3e, 0xf1, 0x03, 0x6d, 0x64, 0xdb, 0x4e, 0x60, 0x1d, 0x59, 0xc4, 0xd4, 0x68, 0x38, 0xef,
-	0xa7, 0x70, 0x42, 0x8c, 0xd3, 0x3d, 0x41, 0x71, 0x10, 0x12, 0x34, 0x04, 0x7e, 0x92, 0xf7, 0x3b,
-	0xf1, 0x84, 0x8e, 0xc7, 0x38, 0xd7, 0x79, 0xe4, 0x16, 0x25, 0x1a, 0x14, 0xaa, 0xfe, 0x79, 0x16,
-	0x6a, 0x09, 0x39, 0x4f, 0x68, 0x4e, 0x66, 0x4c, 0x73, 0x56, 0xa0, 0x60, 0x5a, 0xc7, 0xc4, 0x0f,
-	0x84, 0x01, 0x10, 0x2d, 0x3a, 0xdc, 0xf1, 0xc0, 0x39, 0xd4, 0x07, 0x9a, 0x4f, 0x7e, 0x34, 0x22,
-	0xb6, 0xc1, 0xe5, 0x3b, 0x87, 0xe7, 0x39, 0xb8, 0x27, 0xa0, 0xe8, 0x0b, 0x6e, 0x2b, 0x22, 0x34,
-	0x1e, 0x0c, 0xab, 0x53, 0x8e, 0x7f, 0x14, 0x9c, 0x48, 0x52, 0x5c, 0xd5, 0x63, 0x2d, 0xf4, 0x16,
-	0x8d, 0x5f, 0x8d, 0xd3, 0x88, 0x51, 0x9e, 0x8d, 0x57, 0xa5, 0xc0, 0x38, 0x12, 0xe5, 0x15, 0x21,
-	0xf1, 0xab, 0x8e, 0x2a, 0x05, 0x86, 0x48, 0x77, 0xa1, 0xaa, 0x1f, 0x5a, 0x11, 0x0e, 0xd7, 0x8e,
-	0x8a, 0x7e, 0x68, 0x49, 0x14, 0x75, 0x0f, 0xaa, 0xf1, 0xa9, 0x5c, 0xe6, 0x76, 0x6f, 0x1d, 0x4a,
-	0x21, 0x47, 0x11, 0x76, 0xca, 0xb6, 0xda, 0x80, 0x85, 0x31, 0xc1, 0x9e, 0x61, 0x71, 0x97, 0x20,
-	0xcf, 0x34, 0x85, 0x71, 0x99, 0xc3, 0xbc, 0xa1, 0x7e, 0x08, 0xe5, 0x30, 0x53, 0xa0, 0x46, 0x39,
-	0xb8, 0x70, 0x89, 0xb8, 0xf8, 0x62, 0xbf, 0x29, 0x8c, 0x99, 0x49, 0x7e, 0x31, 0xcd, 0x7e, 0xab,
-	0x3f, 0xcb, 0x42, 0x9e, 0xc5, 0x1f, 0xa8, 0x09, 0x65, 0xc7, 0x25, 0xb1, 0xc4, 0x61, 0x7e, 0xfa,
-	0x75, 0xff, 0x79, 0xd7, 0xdd, 0xec, 0x4a, 0x64, 0x1c, 0xd1, 0xa5, 0xfa, 0x82, 0x49, 0x73, 0x34,
-	0x97, 0x66, 0x8e, 0xc6, 0x6a, 0x1b, 0xb9, 0xd7, 0xaf, 0x6d, 0xa8, 0xdf, 0x82, 0x72, 0x38, 0x3b,
-	0xb4, 0x0c, 0x8b, 0xdd, 0xfd, 0x36, 0x6e, 0xf4, 0x77, 0xba, 0x1d, 0xed, 0xa0, 0xf3, 0xb4, 0xd3,
-	0x7d, 0xde, 0x51, 0xae, 0xa1, 0x25, 0x50, 0x22, 0x70, 0x13, 0xb7, 0x1b, 0xfd, 0xb6, 0x92, 0x51,
-	0xff, 0x62, 0x0e, 0x72, 0x34, 0x88, 0x43, 0x5b, 0x93, 0xbb, 0xf1, 0xb5, 0xe9, 0x31, 0x5f, 0xfa,
-	0x66, 0x44, 0x57, 0x16, 0x5c, 0xdb, 0x44, 0x3a, 0x25, 0x56, 0xcc, 0xf2, 0x0a, 0x04, 0x39, 0x66,
-	0x65, 0xf8, 0x8e, 0xb0, 0xdf, 0xf4, 0x74, 0x7d, 0xc3, 0x71, 0x89, 0x70, 0x75, 0xbc, 0x41, 0xad,
-	0x12, 0x0f, 0x68, 0xd8, 0xfe, 0x72, 0x8b, 0xcf, 0x43, 0x1c, 0x26, 0x5b, 0x77, 0xa0, 0xe2, 0x7a,
-	0xd6, 0x50, 0xf7, 0x2e, 0xd8, 0xb5, 0x38, 0x37, 0xf8, 0x20, 0x40, 0x4f, 0xc9, 0x05, 0xb5, 0xf6,
-	0xce, 0xc0, 0xd4, 0x5c, 0xfd, 0x82, 0x78, 0x4c, 0x9e, 0xcb, 0xb8, 0xe4, 0x0c, 0xcc, 0x7d, 0xda,
-	0xe6, 0x39, 0xc1, 0x99, 0xe8, 0xe4, 0x56, 0xbe, 0x64, 0x93, 0x33, 0xde, 0xb9, 0x06, 0x14, 0x91,
-	0x7b, 0xd8, 0x32, 0xf7, 0xb0, 0xce, 0xc0, 0x94, 0xce, 0x97, 0xd2, 0xb1, 0x2e, 0xe0, 0x5d, 0x36,
-	0xe1, 0xce, 0xd7, 0xbc, 0xea, 0x19, 0xec, 0x74, 0x7a, 0x6d, 0xdc, 0x57, 0x32, 0x49, 0xe8, 0xc1,
-	0x7e, 0x8b, 0x9e, 0x4c, 0x36, 0x09, 0xc5, 0xed, 0xbd, 0xee, 0xb3, 0xb6, 0x32, 0xa7, 0xfe, 0x7d,
-	0x15, 0xf2, 0x2c, 0x18, 0xbd, 0x82, 0xf8, 0x32, 0xfc, 0xd7, 0x3e, 0xb1, 0x25, 0xc8, 0xf3, 0x6d,
-	0xe2, 0x47, 0xc6, 0x1b, 0x91, 0x46, 0xe6, 0x62, 0x1a, 0x49, 0xa1, 0x3c, 0xcd, 0xe2, 0x86, 0x88,
-	0x37, 0xe8, 0x4c, 0xe9, 0x19, 0xfa, 0xae, 0x2e, 0xac, 0xcf, 0x2b, 0x66, 0xda, 0x91, 0xc8, 0x38,
-	0xa2, 0xa3, 0xe2, 0x30, 0xb2, 0xad, 0x1f, 0x8d, 0x08, 0x3b, 0x6e, 0x7e, 0x64, 0x65, 0x0e, 0xa1,
-	0xa7, 0xfd, 0xed, 0x30, 0x1e, 0x29, 0xb2, 0x01, 0xd4, 0x59, 0x03, 0x24, 0xa3, 0x12, 0xf5, 0x3f,
-	0x0b, 0x97, 0x38, 0xba, 0x75, 0x58, 0x19, 0x57, 0x1f, 0xad, 0xdf, 0xd8, 0xda, 0x6d, 0x2b, 0x19,
-	0x74, 0x1b, 0xd6, 0xa3, 0xbe, 0x56, 0xfb, 0x49, 0x1b, 0xe3, 0x76, 0x4b, 0xeb, 0xe3, 0xaf, 0xb4,
-	0x46, 0xab, 0xa5, 0x64, 0xd1, 0x5d, 0xb8, 0x35, 0xa5, 0xbf, 0xd9, 0xe8, 0x34, 0xdb, 0xbb, 0xca,
-	0xdc, 0x0c, 0x94, 0xfd, 0x83, 0xde, 0x76, 0xbb, 0xa5, 0xe4, 0xd0, 0xbb, 0xf0, 0xf6, 0x14, 0x14,
-	0xdc, 0xd8, 0xd3, 0x9a, 0x5d, 0x8c, 0xdb, 0x4d, 0xda, 0xa7, 0xe4, 0x91, 0x0a, 0xb7, 0xa7, 0xa1,
-	0x32, 0x41, 0x6a, 0x29, 0x05, 0x54, 0x87, 0xa5, 0x38, 0xce, 0x6e, 0xbb, 0xdf, 0x6e, 0x1c, 0xf4,
-	0xb7, 0x95, 0x22, 0x5a, 0x01, 0x14, 0xf5, 0xec, 0xee, 0x74, 0x9e, 0x32, 0x78, 0x29, 0x49, 0xd1,
-	0x69, 0x3f, 0x6f, 0x34, 0x9b, 0xdd, 0x83, 0x4e, 0x5f, 0x29, 0xa3, 0x3b, 0x70, 0x23, 0xea, 0xd9,
-	0xc7, 0x3b, 0x7b, 0x0d, 0xfc, 0x42, 0xdb, 0xe9, 0xb4, 0xda, 0x7c, 0x07, 0x20, 0x39, 0xa1, 0x24,
-	0x82, 0x10, 0xed, 0xca, 0x2c, 0x1c, 0xa1, 0x14, 0x55, 0xf4, 0x01, 0x7c, 0x63, 0x36, 0x0e, 0x1d,
-	0x8f, 0xce, 0x4d, 0xdb, 0x6f, 0xbc, 0x68, 0x63, 0xa5, 0x86, 0x3e, 0x84, 0x07, 0xaf, 0xa0, 0xe0,
-	0x13, 0xd0, 0xba, 0xbb, 0x2d, 0x41, 0x34, 0x9f, 0x3c, 0x6c, 0xd1, 0xcf, 0x0f, 0x7b, 0x21, 0x79,
-	0x52, 0xbd, 0x76, 0xb3, 0xdb, 0x69, 0x25, 0x57, 0xab, 0xa0, 0xaf, 0xc1, 0xc6, 0x74, 0x14, 0xb1,
-	0xde, 0x45, 0xf4, 0x08, 0x36, 0xa7, 0x63, 0xa5, 0xae, 0x06, 0xa1, 0x8f, 0xe1, 0xe1, 0x2b, 0x69,
-	0x26, 0xd6, 0x73, 0x3d, 0x69, 0x4b, 0x7a, 0xed, 0x7e, 0x63, 0x6b, 0x47, 0x59, 0x4a, 0x4a, 0x7a,
-	0xaf, 0xdd, 0x6f, 0x76, 0x5b, 0x6d, 0x65, 0x39, 0x79, 0xcc, 0x07, 0x9d, 0x50, 0x00, 0x56, 0x92,
-	0xc7, 0xcc, 0x47, 0xa3, 0x3d, 0xd2, 0x9b, 0xac, 0x4e, 0x45, 0x10, 0xe7, 0x57, 0x57, 0xff, 0x2b,
-	0x03, 0xe5, 0x50, 0xbd, 0xe9, 0x04, 0x3a, 0x8d, 0xbd, 0x76, 0x6f, 0xbf, 0xd1, 0x6c, 0xc7, 0x54,
-	0x6d, 0x11, 0x6a, 0x11, 0x98, 0x4e, 0x35, 0x93, 0xc4, 0x94, 0x72, 0x97, 0x45, 0x08, 0xe6, 0x63,
-	0x60, 0x3a, 0xc9, 0x39, 0xb4, 0x0a, 0xd7, 0x93, 0x30, 0x26, 0xc2, 0x4a, 0x2e, 0x89, 0xcc, 0xd6,
-	0x9a, 0xa7, 0x07, 0x1d, 0xc1, 0xe2, 0x8a, 0xa2, 0x14, 0xd0, 0x2d, 0x58, 0x8b, 0xfa, 0xc6, 0xf6,
-	0x5a, 0x29, 0xa2, 0xeb, 0xb0, 0x10, 0x75, 0x73, 0xe1, 0x28, 0x25, 0x07, 0x67, 0x40, 0x0d, 0x77,
-	0x9f, 0x2b, 0x65, 0xf5, 0xe7, 0x51, 0x62, 0x89, 0x60, 0xbe, 0xd1, 0x1c, 0xb3, 0x2e, 0xf3, 0x00,
-	0x02, 0x46, 0x25, 0x28, 0x43, 0xb7, 0x40, 0xb4, 0x85, 0x85, 0xc8, 0xd2, 0x2d, 0x90, 0xa0, 0x48,
-	0xd5, 0xe7, 0xd0, 0x02, 0x54, 0x04, 0x98, 0x1a, 0x0a, 0x25, 0x17, 0x23, 0x15, 0x92, 0x96, 0x8f,
-	0x81, 0xc4, 0x41, 0x14, 0xd4, 0xdf, 0xc9, 0xc0, 0xc2, 0x58, 0x4d, 0x82, 0x47, 0x8a, 0x61, 0x49,
-	0x23, 0xac, 0x20, 0x56, 0x23, 0xe0, 0x8e, 0x39, 0x66, 0x87, 0xb3, 0xe3, 0x76, 0xf8, 0x0a, 0xde,
-	0x82, 0x86, 0xdd, 0x45, 0x51, 0x8c, 0x40, 0xed, 0x49, 0x6f, 0xf6, 0xce, 0xcc, 0xf2, 0xc5, 0x1b,
-	0xf6, 0x67, 0x32, 0x2e, 0xc9, 0xa5, 0xc5, 0x25, 0xf9, 0xe9, 0x71, 0x49, 0x61, 0x2c, 0x2e, 0x51,
-	0x3b, 0x6f, 0x26, 0x0c, 0x10, 0x67, 0x97, 0x55, 0xff, 0x29, 0x07, 0x05, 0x5e, 0x30, 0x43, 0xad,
-	0xc9, 0x3d, 0xba, 0x37, 0xab, 0xc2, 0xf6, 0xda, 0x5b, 0xb4, 0x02, 0x05, 0x9f, 0xd8, 0x66, 0xb8,
-	0x47, 0xa2, 0x45, 0xa3, 0x26, 0xfe, 0x2b, 0xaa, 0xe8, 0x96, 0x38, 0x60, 0xc7, 0x8c, 0xf6, 0x35,
-	0x1f, 0xdf, 0xd7, 0xbb, 0x50, 0x65, 0x57, 0x6c, 0xfe, 0x09, 0x4d, 0xeb, 0x02, 0xb1, 0x5f, 0x95,
-	0x10, 0xd6, 0x08, 0x68, 0x24, 0xc7, 0xeb, 0xdb, 0x23, 0x3b, 0xb0, 0x06, 0x22, 0x54, 0x03, 0x06,
-	0x3a, 0xa0, 0x10, 0x2a, 0x97, 0x51, 0xd1, 0x9e, 0x32, 0xe1, 0xde, 0xbf, 0x1a, 0x01, 0x1b, 0x41,
-	0x4a, 0xd0, 0x5d, 0xbe, 0x44, 0xd0, 0xfd, 0x1b, 0x5c, 0x28, 0xaa, 0x7f, 0x93, 0x79, 0xdd, 0xa8,
-	0x1b, 0xad, 0xc1, 0x72, 0xcc, 0x29, 0x1d, 0xf4, 0x42, 0x13, 0x3a, 0x16, 0xf6, 0x3d, 0x69, 0xec,
-	0xec, 0xb6, 0x5b, 0xca, 0xdc, 0x18, 0x1b, 0x6e, 0x12, 0x72, 0xe8, 0x06, 0xac, 0x46, 0xd0, 0xbd,
-	0x6e, 0x6b, 0xe7, 0xc9, 0x0b, 0xd9, 0x99, 0x4f, 0xef, 0xe4, 0xa3, 0x14, 0xd4, 0x5f, 0x67, 0x58,
-	0xee, 0x24, 0x04, 0xeb, 0x11, 0x2c, 0xfb, 0xce, 0xc8, 0x33, 0x88, 0x36, 0xb6, 0x85, 0xdc, 0x00,
-	0x5c, 0xe7, 0x9d, 0xfd, 0xe9, 0xc5, 0x94, 0xf1, 0x3b, 0x85, 0xf8, 0x43, 0x9d, 0xb9, 0xe4, 0x43,
-	0x9d, 0x64, 0xed, 0x24, 0x77, 0x95, 0xda, 0xc9, 0xc7, 0x50, 0x14, 0x05, 0x66, 0x71, 0x59, 0x30,
-	0xbb, 0xbe, 0x5c, 0xe0, 0xf5, 0x65, 0xf5, 0x3f, 0x32, 0x50, 0x0e, 0xcb, 0xc6, 0x54, 0xd1, 0x5f,
-	0x5a, 0xb6, 0x5c, 0x1a, 0xfb, 0x7d, 0x19, 0x95, 0x78, 0x1b, 0xe6, 0x65, 0x8d, 0x5a, 0x24, 0xff,
-	0x22, 0xa7, 0x13, 0xd0, 0x16, 0xaf, 0x01, 0x7c, 0x13, 0x8a, 0x02, 0x20, 0x96, 0x76, 0x6b, 0x66,
-	0x19, 0x1b, 0x4b, 0x6c, 0x75, 0x0b, 0x72, 0x4f, 0xe9, 0x54, 0x14, 0xa8, 0x3e, 0xdd, 0xe9, 0xb4,
-	0x62, 0x12, 0xb4, 0x0c, 0x8b, 0x0c, 0xb2, 0x8f, 0xa9, 0xe7, 0xeb, 0xef, 0x3c, 0xe3, 0x22, 0xb4,
-	0x08, 0x35, 0x06, 0x0e, 0x41, 0x59, 0xf5, 0xc7, 0xa0, 0x8c, 0xd7, 0x66, 0xd1, 0x07, 0xb0, 0x34,
-	0x56, 0x95, 0xe1, 0x4b, 0xa4, 0xcb, 0xcf, 0x63, 0x94, 0xa8, 0xc9, 0xf0, 0x95, 0x7e, 0x14, 0xbf,
-	0xa5, 0x4d, 0xd9, 0x96, 0xe8, 0x4a, 0x3a, 0x46, 0xa5, 0xfe, 0x4b, 0x16, 0x0a, 0xbc, 0xb8, 0x7e,
-	0x05, 0x33, 0xc5, 0x09, 0x5e, 0xdb, 0x4c, 0x35, 0x78, 0x9e, 0xe6, 0x12, 0x6f, 0x28, 0xde, 0x29,
-	0xdd, 0x7b, 0x55, 0xb9, 0xb5, 0x7b, 0xf8, 0x43, 0x62, 0x04, 0x2c, 0x9f, 0xa3, 0x40, 0xca, 0x82,
-	0xe5, 0x81, 0x94, 0x45, 0xf9, 0x6a, 0x2c, 0x68, 0xba, 0x48, 0xbc, 0xe1, 0xff, 0x52, 0xde, 0xf7,
-	0xab, 0x0c, 0x28, 0xe3, 0x73, 0xa0, 0x26, 0xd7, 0x39, 0xb3, 0xc3, 0x12, 0x15, 0x6f, 0xa4, 0x96,
-	0x24, 0x3e, 0x83, 0x2a, 0x7b, 0x45, 0x3b, 0x72, 0xf9, 0x97, 0x50, 0xaf, 0xbe, 0xd0, 0xab, 0x50,
-	0xfc, 0x03, 0x57, 0x7e, 0x27, 0x55, 0x8e, 0x1e, 0x66, 0xe7, 0x66, 0x15, 0x18, 0x63, 0xcf, 0xc3,
-	0x43, 0x0a, 0xf5, 0x27, 0x00, 0xd1, 0xdc, 0x53, 0x5f, 0xf9, 0xae, 0x40, 0xc1, 0xd5, 0x3d, 0x62,
-	0x87, 0x35, 0x35, 0xde, 0x42, 0x2d, 0xa8, 0x79, 0xe4, 0x47, 0x23, 0xcb, 0xa3, 0xde, 0x63, 0x14,
-	0x9c, 0x88, 0x89, 0xbf, 0x72, 0xf0, 0xaa, 0xa4, 0xa2, 0x20, 0xf5, 0xdf, 0x32, 0x50, 0x8e, 0x5e,
-	0x7d, 0xbf, 0xf9, 0xe7, 0xd8, 0xe8, 0x0b, 0x28, 0x89, 0x52, 0x95, 0xbc, 0x14, 0xff, 0xfa, 0xa5,
-	0x0a, 0xff, 0x82, 0x49, 0x48, 0x8c, 0x3e, 0x81, 0xfc, 0x99, 0x6e, 0x05, 0xf2, 0x7e, 0x7c, 0xca,
-	0xfb, 0xad, 0xe7, 0xba, 0x15, 0x08, 0x52, 0x8e, 0xae, 0x6e, 0x41, 0x39, 0x9c, 0x13, 0x8d, 0x50,
-	0xa2, 0x97, 0x2e, 0x62, 0x9b, 0xcb, 0xe1, 0x43, 0x17, 0xba, 0xd7, 0x67, 0x0c, 0x51, 0x7e, 0x03,
-	0xcb, 0x5b, 0xea, 0x17, 0xb0, 0x30, 0x36, 0x3d, 0x2a, 0x60, 0xba, 0x11, 0x38, 0xa1, 0x80, 0xb1,
-	0x06, 0xba, 0x0d, 0xe0, 0x86, 0x88, 0xe2, 0xc0, 0x62, 0x10, 0xf5, 0x14, 0x96, 0x53, 0xd7, 0x89,
-	0xda, 0x09, 0xc2, 0xcc, 0xac, 0x2f, 0x6c, 0xc6, 0x6f, 0x48, 0x62, 0x84, 0x53, 0x17, 0xf0, 0x18,
-	0x20, 0xda, 0x19, 0xea, 0x83, 0xe8, 0xde, 0xb0, 0x5b, 0x73, 0xf1, 0xd5, 0x03, 0x6d, 0xf7, 0x88,
-	0x31, 0x95, 0xc1, 0x3f, 0xcc, 0x41, 0x49, 0xde, 0xad, 0xa1, 0x27, 0x93, 0x66, 0xec, 0xfe, 0xec,
-	0xeb, 0xb8, 0x74, 0x43, 0xf6, 0x29, 0xe4, 0xfd, 0x40, 0x0f, 0xc8, 0xec, 0x47, 0x70, 0x9c, 0x47,
-	0x8f, 0x22, 0x6e, 0x5f, 0xc3, 0x9c, 0x02, 0x7d, 0x17, 0x0a, 0xec, 0x61, 0xf1, 0xb1, 0x10, 0x7b,
-	0x75, 0x16, 0x6d, 0x93, 0x61, 0x6e, 0x5f, 0xc3, 0x82, 0x06, 0x61, 0x98, 0x97, 0x05, 0x5a, 0x86,
-	0x20, 0xbf, 0x95, 0x7a, 0x77, 0x16, 0x17, 0x51, 0x93, 0xdd, 0x65, 0x04, 0xdb, 0xd7, 0x70, 0x4d,
-	0x8f, 0x03, 0x50, 0x17, 0x24, 0x40, 0x8b, 0x0a, 0x3d, 0x95, 0xd9, 0x1b, 0x23, 0x58, 0xb2, 0x5b,
-	0xb6, 0xed, 0x6b, 0x58, 0x56, 0x8d, 0x59, 0xfb, 0x4d, 0x85, 0xcb, 0xd2, 0x7a, 0x6e, 0x15, 0xb8,
-	0x7b, 0x57, 0xff, 0x7b, 0x0e, 0x2a, 0xb1, 0x3d, 0x45, 0x3f, 0x80, 0x55, 0xfd, 0x94, 0x78, 0xfa,
-	0x31, 0x91, 0xcf, 0x3d, 0xc2, 0x27, 0x01, 0x33, 0x1f, 0x38, 0xb2, 0x59, 0x36, 0x0c, 0x63, 0x34,
-	0x1c, 0x0d, 0xa8, 0xa7, 0xc4, 0x4b, 0x82, 0x0d, 0x7f, 0x20, 0x22, 0x9f, 0x11, 0x4c, 0xb0, 0x0f,
-	0x2f, 0x29, 0xc5, 0xb1, 0xbf, 0x16, 0x7b, 0xf9, 0x08, 0x84, 0x5d, 0x4e, 0x89, 0x0f, 0x72, 0xa3,
-	0x79, 0xf3, 0xcb, 0x05, 0xf9, 0x89, 0x6d, 0x38, 0x95, 0x18, 0x6e, 0x34, 0x89, 0x5c, 0x02, 0x37,
-	0xe4, 0x7b, 0x1f, 0x14, 0xfe, 0x51, 0x26, 0xe5, 0x2a, 0x54, 0x82, 0x97, 0xee, 0xe6, 0x19, 0xbc,
-	0x43, 0xa4, 0x36, 0x85, 0x98, 0x94, 0xa7, 0xc0, 0x2c, 0xc4, 0x30, 0x9b, 0xee, 0x48, 0x60, 0xde,
-	0x83, 0x05, 0x8e, 0xe9, 0xe9, 0x43, 0xed, 0xf0, 0x22, 0x20, 0xbe, 0xb8, 0x4d, 0xa8, 0x31, 0x30,
-	0xd6, 0x87, 0x5b, 0x14, 0x48, 0xe7, 0x79, 0x6a, 0x79, 0xc1, 0x48, 0x8c, 0xce, 0xce, 0x8a, 0xb9,
-	0xf1, 0x1c, 0x5e, 0x10, 0x1d, 0x1d, 0xc2, 0xe5, 0x2e, 0x8e, 0x4b, 0xc7, 0xe7, 0xb8, 0xe5, 0x04,
-	0x6e, 0xd3, 0x1d, 0x31, 0x5c, 0xf5, 0x5f, 0xb3, 0x50, 0x8d, 0x6b, 0x04, 0xfa, 0x2d, 0x58, 0x0a,
-	0x89, 0x34, 0x57, 0xf7, 0xf4, 0x21, 0x09, 0x88, 0xe7, 0x8b, 0x73, 0x9f, 0xf2, 0xfc, 0xba, 0x4d,
-	0xdd, 0x9f, 0x65, 0x30, 0x96, 0xfb, 0x21, 0x0d, 0x46, 0x86, 0x18, 0x26, 0x82, 0x51, 0xfe, 0xe1,
-	0x02, 0xe2, 0xfc, 0xb3, 0xaf, 0xc3, 0xdf, 0x16, 0x4b, 0x8e, 0xf1, 0x7f, 0x02, 0x1b, 0x52, 0xe7,
-	0xa2, 0xab, 0x6f, 0x29, 0x6d, 0x67, 0x96, 0x6d, 0x3a, 0x67, 0xe2, 0x32, 0xfb, 0xa6, 0xc0, 0x93,
-	0xe7, 0xdb, 0xe0, 0x48, 0xcf, 0x19, 0x4e, 0x9c, 0x4f, 0x74, 0x17, 0x3e, 0xc6, 0x27, 0x97, 0xe0,
-	0x23, 0x65, 0x2a, 0xc1, 0x47, 0xfd, 0xb3, 0x0c, 0x5c, 0x4f, 0x31, 0x16, 0x53, 0xa2, 0x91, 0x3a,
-	0x14, 0x85, 0xd4, 0xb1, 0x0d, 0x29, 0x61, 0xd9, 0x64, 0x1f, 0x2c, 0x45, 0x62, 0x37, 0xc7, 0x2a,
-	0x03, 0x65, 0x3b, 0x94, 0xb8, 0x5b, 0x00, 0x31, 0x59, 0xe3, 0x85, 0x83, 0xb2, 0x11, 0x8a, 0xd9,
-	0x0d, 0x28, 0x47, 0x02, 0x96, 0x67, 0xbd, 0x25, 0x4f, 0xc8, 0x96, 0xfa, 0x8f, 0x19, 0x40, 0x93,
-	0xc6, 0x67, 0xca, 0x0c, 0x9b, 0xf1, 0xf7, 0x41, 0x57, 0xd3, 0xd6, 0xe8, 0x1d, 0x51, 0x13, 0xca,
-	0x91, 0xb6, 0xcd, 0x5d, 0x8d, 0x89, 0x7c, 0xb1, 0x20, 0xd7, 0x14, 0x57, 0x59, 0xba, 0x26, 0x6e,
-	0x29, 0x07, 0xa0, 0x8c, 0x93, 0xd2, 0x20, 0x99, 0x85, 0x75, 0xf2, 0x7e, 0x94, 0xfb, 0x39, 0x16,
-	0xba, 0xc9, 0x4b, 0xd0, 0x35, 0x28, 0x9d, 0xea, 0x83, 0x11, 0xd1, 0x44, 0x0c, 0x9d, 0xc3, 0x45,
-	0xd6, 0x6e, 0x9f, 0xa3, 0x75, 0x28, 0x19, 0x8e, 0xed, 0x8f, 0x86, 0x22, 0x20, 0xcc, 0xe1, 0xb0,
-	0xad, 0xfe, 0x34, 0x0b, 0x2b, 0xe9, 0x32, 0x4a, 0xbd, 0x67, 0xa0, 0x7b, 0xc7, 0x84, 0xdf, 0xd2,
-	0xe5, 0xb0, 0x68, 0x21, 0x05, 0xe6, 0x86, 0xba, 0x1c, 0x84, 0xfe, 0xe4, 0x67, 0xef, 0x59, 0x4e,
-	0xf8, 0xda, 0x42, 0x36, 0x69, 0x3a, 0x35, 0xd4, 0xcf, 0xb5, 0xe1, 0x68, 0x10, 0x58, 0xee, 0xc0,
-	0x12, 0x5f, 0xd9, 0xf0, 0xa7, 0x6f, 0x7b, 0x21, 0x10, 0x7d, 0xce, 0xfe, 0x5b, 0x41, 0xe0, 0xe9,
-	0x46, 0xa0, 0x79, 0xd4, 0x87, 0xe6, 0x67, 0x7d, 0x8e, 0x8c, 0xa9, 0x17, 0x61, 0xff, 0xca, 0x80,
-	0x51, 0x60, 0xee, 0x42, 0x2b, 0xe4, 0xdc, 0xd5, 0x6d, 0x93, 0xd3, 0x17, 0x5e, 0x4d, 0x0f, 0x1c,
-	0x9f, 0x52, 0xab, 0x5f, 0x40, 0x9e, 0x01, 0x69, 0xcc, 0x68, 0x8f, 0x86, 0xd4, 0x4f, 0x89, 0x60,
-	0x28, 0x87, 0x23, 0x00, 0xda, 0x80, 0x8a, 0x49, 0x6c, 0x67, 0x68, 0xd9, 0xac, 0x9f, 0xef, 0x40,
-	0x1c, 0xa4, 0xfe, 0x65, 0x8e, 0xe6, 0xdb, 0xf2, 0xc2, 0x5d, 0x16, 0x9b, 0x78, 0x12, 0xc6, 0x8b,
-	0x4d, 0x69, 0x51, 0x7b, 0x1d, 0x8a, 0x43, 0xe2, 0x87, 0x22, 0x55, 0xc6, 0xb2, 0x89, 0x3e, 0x67,
-	0x41, 0x85, 0xf1, 0x52, 0xc4, 0x89, 0xef, 0xbd, 0xe2, 0xb6, 0x7f, 0x73, 0xd7, 0x39, 0xde, 0xe3,
-	0xa4, 0x98, 0x13, 0xae, 0xff, 0x04, 0x20, 0x02, 0xa2, 0x16, 0x7b, 0x5b, 0x11, 0x90, 0x73, 0xf9,
-	0x1a, 0xe4, 0x32, 0x1c, 0xc5, 0xd7, 0x5a, 0x58, 0x92, 0x52, 0xc9, 0x38, 0x72, 0xbc, 0xa1, 0x1e,
-	0x46, 0xf1, 0xbc, 0x15, 0xde, 0xc3, 0xe6, 0xa2, 0x7b, 0xd8, 0xf5, 0x3f, 0xcd, 0xb2, 0x09, 0x08,
-	0x1e, 0x54, 0x35, 0x07, 0x34, 0xd0, 0x93, 0xaa, 0xc9, 0x1a, 0x94, 0xf0, 0xc8, 0x1a, 0x84, 0x9b,
-	0x42, 0x7f, 0x53, 0xd8, 0xc0, 0xb2, 0xf9, 0x8e, 0xe4, 0x31, 0xfb, 0x4d, 0x07, 0x1e, 0x92, 0xe0,
-	0xc4, 0x91, 0x55, 0x29, 0xd1, 0xa2, 0x12, 0x7e, 0xe2, 0xf8, 0x41, 0xec, 0x06, 0x31, 0x6c, 0xa3,
-	0x3b, 0x50, 0xa1, 0x51, 0xbf, 0x6e, 0xc6, 0x0b, 0x79, 0xc0, 0x41, 0xec, 0x86, 0x31, 0xf1, 0xf5,
-	0x58, 0xf1, 0x2a, 0x5f, 0x8f, 0xc5, 0x76, 0xb3, 0xf4, 0xda, 0xbb, 0xa9, 0xfe, 0x3a, 0x0b, 0x45,
-	0x51, 0x27, 0x48, 0x29, 0x3f, 0x64, 0xd2, 0xca, 0x0f, 0x04, 0x56, 0xfd, 0x11, 0xcb, 0x0d, 0xad,
-	0x53, 0xa2, 0x79, 0xc4, 0x0f, 0x3c, 0x2b, 0x7c, 0xee, 0x3b, 0xc3, 0x1b, 0xf5, 0x42, 0x22, 0x1c,
-	0xa3, 0xc1, 0x2b, 0x7e, 0x2a, 0x1c, 0xbd, 0x0f, 0xc8, 0x24, 0xbe, 0xe1, 0x59, 0x6c, 0xf2, 0xc9,
-	0x82, 0xc8, 0x62, 0xac, 0x47, 0xcc, 0x4a, 0x85, 0xaa, 0x49, 0xa8, 0xd5, 0x27, 0xb6, 0x61, 0x11,
-	0x9e, 0xdb, 0x94, 0x71, 0x02, 0x86, 0x1e, 0xc1, 0xf2, 0xf8, 0xf7, 0xeb, 0x1a, 0xbb, 0xcf, 0xe7,
-	0xc7, 0x76, 0x7d, 0xec, 0x1b, 0xf6, 0xfe, 0x85, 0x4b, 0xd0, 0x0e, 0xd4, 0x7c, 0x97, 0x18, 0xd6,
-	0x91, 0x65, 0xe8, 0xe2, 0x83, 0xaa, 0xb9, 0xe9, 0x0f, 0x99, 0x7a, 0x71, 0x54, 0x9c, 0xa4, 0x54,
-	0x7f, 0x99, 0x81, 0x95, 0xf4, 0x4d, 0x60, 0x0f, 0x92, 0x6c, 0xfd, 0x70, 0x40, 0x78, 0xb2, 0x58,
-	0xc2, 0xb2, 0x89, 0x3e, 0x86, 0x15, 0xd7, 0x23, 0xe2, 0x7f, 0x8f, 0xf0, 0x2f, 0x1f, 0x78, 0xd2,
-	0x29, 0x3c, 0xdd, 0x72, 0xa2, 0x17, 0x8b, 0x4e, 0xf4, 0x05, 0x6c, 0x10, 0xdd, 0x1b, 0x58, 0xc4,
-	0x0f, 0x34, 0x7d, 0x30, 0x70, 0xce, 0x68, 0x6e, 0x1b, 0x31, 0x09, 0x1f, 0xdc, 0x96, 0xf1, 0x2d,
-	0x89, 0xd7, 0xe0, 0x68, 0x8d, 0x10, 0x8b, 0x8a, 0x9d, 0xfa, 0x29, 0xd4, 0x12, 0x8b, 0x4a, 0xcd,
-	0xac, 0x97, 0x20, 0xcf, 0xec, 0xbd, 0xd0, 0x21, 0xde, 0x50, 0xff, 0x3d, 0x03, 0x48, 0xb8, 0x46,
-	0x59, 0x32, 0xc2, 0xe4, 0x68, 0xc6, 0x0b, 0x8c, 0x3a, 0x14, 0x45, 0xad, 0x48, 0x7e, 0x79, 0x27,
-	0x9a, 0x93, 0x5f, 0xde, 0x4d, 0x2b, 0x04, 0xe6, 0x66, 0x15, 0x02, 0xf3, 0x57, 0x29, 0x04, 0x5e,
-	0xee, 0xbd, 0xd7, 0x7b, 0xbf, 0xca, 0x00, 0xe2, 0x5f, 0x49, 0x8b, 0x2f, 0x02, 0xac, 0x01, 0xcd,
-	0xff, 0x6f, 0xc0, 0xea, 0xd6, 0x6e, 0xb7, 0xf9, 0x14, 0xb7, 0x9f, 0xb5, 0x71, 0x6f, 0x67, 0x6b,
-	0x67, 0x77, 0xa7, 0xff, 0x42, 0xeb, 0x74, 0x3b, 0x6d, 0xe5, 0x1a, 0xba, 0x0b, 0xb7, 0x52, 0x3a,
-	0x65, 0x8b, 0xdd, 0xf6, 0xbe, 0x05, 0x77, 0x52, 0x50, 0x76, 0x70, 0x0c, 0x29, 0x8b, 0x6e, 0x42,
-	0x3d, 0x05, 0xa9, 0xd7, 0x6f, 0xec, 0xb6, 0xf9, 0x6d, 0x6f, 0x4a, 0xef, 0x5e, 0xe3, 0xc5, 0x56,
-	0x9b, 0xa3, 0xe4, 0xde, 0xfb, 0x59, 0xf2, 0xb5, 0xbb, 0xf8, 0xd4, 0x66, 0x1d, 0x56, 0xfa, 0xb8,
-	0xd1, 0xe9, 0xf1, 0xeb, 0x9c, 0x5e, 0xbf, 0xd1, 0x3f, 0xe8, 0xc9, 0xa9, 0xdf, 0x86, 0xf5, 0xc9,
-	0xbe, 0xf6, 0x57, 0xed, 0xe6, 0x41, 0xbf, 0xdd, 0xe2, 0xb7, 0xd4, 0x93, 0xfd, 0xbd, 0xee, 0x93,
-	0xfe, 0x93, 0xc6, 0xce, 0xae, 0x92, 0x4d, 0xef, 0xdf, 0x6e, 0xe0, 0x16, 0xeb, 0x9f, 0x43, 0xb7,
-	0x60, 0x6d, 0xb2, 0xbf, 0xd5, 0xde, 0x6d, 0xbc, 0x60, 0xd7, 0xd3, 0xa9, 0xdd, 0xed, 0xaf, 0xf6,
-	0x77, 0x70, 0xbb, 0xa5, 0xe4, 0xd3, 0xbb, 0x65, 0x8e, 0x57, 0x48, 0x1f, 0x9c, 0xd7, 0xb2, 0xdb,
-	0x2d, 0xa5, 0xb8, 0xd5, 0xf8, 0xde, 0xe3, 0x63, 0x2b, 0x38, 0x19, 0x1d, 0x6e, 0x1a, 0xce, 0xf0,
-	0x01, 0x53, 0xf0, 0xf7, 0x2d, 0x47, 0xfc, 0xe0, 0xff, 0x41, 0xcc, 0x3d, 0x7c, 0x90, 0xf6, 0x0f,
-	0xc5, 0xbe, 0xe3, 0x1e, 0xb2, 0x9f, 0x87, 0x05, 0x26, 0x54, 0x1f, 0xfe, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x17, 0xe3, 0x1f, 0x3c, 0x77, 0x4c, 0x00, 0x00,
+	// 5848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x3c, 0x4d, 0x6f, 0x1c, 0x57,
+	0x72, 0x3b, 0x9f, 0xe4, 0x14, 0x87, 0xe4, 0xb0, 0xc5, 0x8f, 0x11, 0x6d, 0xd9, 0x52, 0xdb, 0x96,
+	0xbf, 0x29, 0x4b, 0xf6, 0xda, 0x6b, 0xaf, 0x1d, 0xef, 0x90, 0x1c, 0x59, 0xb4, 0xf8, 0x85, 0x26,
+	0x29, 0x59, 0x9b, 0xdd, 0x0c, 0x9a, 0x33, 0x4d, 0xb2, 0xd7, 0xf3, 0xb5, 0xdd, 0x3d, 0x94, 0xb8,
+	0x08, 0x02, 0x04, 0xb9, 0x24, 0xc0, 0x2e, 0x16, 0xc8, 0x25, 0x40, 0x72, 0x48, 0x10, 0xec, 0x35,
+	0x87, 0x2c, 0x10, 0x20, 0x71, 0x90, 0x63, 0x80, 0x5c, 0x83, 0xe4, 0x92, 0x43, 0x12, 0x20, 0x87,
+	0x20, 0xfb, 0x07, 0x92, 0x6b, 0xaa, 0xea, 0xbd, 0xd7, 0xfd, 0xba, 0xa7, 0x67, 0xc4, 0xd1, 0x0a,
+	0x41, 0x2e, 0xd2, 0xbc, 0x7a, 0x55, 0xf5, 0xbe, 0xea, 0xfb, 0xbd, 0x26, 0x5c, 0x6f, 0x9d, 0x0c,
+	0x7c, 0xe7, 0x96, 0xd3, 0xf3, 0xdd, 0xde, 0xad, 0x66, 0xaf, 0xe5, 0x34, 0x6f, 0x9d, 0xdf, 0x16,
+	0x3f, 0xd6, 0xfa, 0x5e, 0x2f, 0xe8, 0x19, 0x8b, 0x8c, 0xb1, 0xc6, 0x18, 0x6b, 0xa2, 0xe3, 0xfc,
+	0xf6, 0xea, 0xcb, 0xa7, 0xbd, 0xde, 0x69, 0xdb, 0xb9, 0xc5, 0x38, 0xc7, 0x83, 0x93, 0x5b, 0x81,
+	0xdb, 0x71, 0xfc, 0xc0, 0xee, 0xf4, 0x05, 0x99, 0xf9, 0xcf, 0x73, 0x50, 0x58, 0x6f, 0xf7, 0x9a,
+	0x5f, 0x1b, 0x73, 0x90, 0x75, 0x5b, 0xd5, 0xcc, 0xf5, 0xcc, 0x1b, 0x25, 0x0b, 0x7f, 0x19, 0xcb,
+	0x50, 0xec, 0x0e, 0x3a, 0xc7, 0x8e, 0x57, 0xcd, 0x22, 0x6c, 0xd6, 0x92, 0x2d, 0xe3, 0x63, 0x28,
+	0x9e, 0x39, 0x76, 0x0b, 0xe1, 0x79, 0x84, 0xcf, 0xdc, 0xb9, 0xb1, 0x96, 0x36, 0xf2, 0x1a, 0x33,
+	0xbd, 0xc7, 0x88, 0x96, 0x24, 0x30, 0xde, 0x05, 0x03, 0x47, 0x6d, 0x0d, 0x9a, 0x8e, 0xd7, 0xf0,
+	0xdd, 0xd3, 0xae, 0x1d, 0x0c, 0x3c, 0xa7, 0x5a, 0xe0, 0x21, 0x17, 0x54, 0xcf, 0x81, 0xea, 0x30,
+	0xb6, 0xa1, 0x1c, 0x78, 0x76, 0xd7, 0xb7, 0x9b, 0x81, 0xdb, 0xeb, 0xfa, 0xd5, 0xe2, 0xf5, 0x1c,
+	0x8e, 0xf7, 0x46, 0xfa, 0x78, 0x87, 0x11, 0xa6, 0xe5, 0x34, 0x1d, 0xb7, 0x1f, 0x58, 0x31, 0x6a,
+	0xe3, 0x6d, 0x58, 0xd0, 0xda, 0x8d, 0x66, 0x6f, 0xd0, 0x0d, 0xaa, 0xcb, 0xbc, 0xb4, 0x8a, 0xd6,
+	0xb1, 0x41, 0x70, 0xe3, 0x4b, 0xa8, 0x1c, 0xd3, 0x02, 0x1a, 0xce, 0x93, 0xc0, 0xe9, 0xfa, 0x3c,
+	0xfc, 0x14, 0x0f, 0xff, 0x72, 0xfa, 0xf0, 0x75, 0x85, 0x67, 0xcd, 0x33, 0x61, 0xd8, 0xf6, 0x8d,
+	0x1d, 0x78, 0xa5, 0xd5, 0xef, 0xf9, 0x0d, 0x5c, 0x20, 0xfe, 0xe7, 0xb4, 0x1a, 0xae, 0xe7, 0x39,
+	0xe7, 0x8e, 0xe7, 0xbb, 0xc7, 0x6d, 0xa7, 0xc1, 0xd8, 0xb8, 0xb5, 0xd5, 0x69, 0x9e, 0xca, 0x75,
+	0x42, 0xdd, 0x97, 0x98, 0x5b, 0x1a, 0xe2, 0xba, 0xc4, 0x33, 0x3e, 0x85, 0x55, 0x66, 0x97, 0xce,
+	0xa5, 0xc4, 0x5c, 0xaa, 0x84, 0x91, 0x4a, 0xbd, 0x2f, 0x17, 0xe6, 0xf5, 0x7a, 0x41, 0xa3, 0xe3,
+	0x78, 0x5f, 0xb7, 0x9d, 0xea, 0x0c, 0x9f, 0xe3, 0x6b, 0x63, 0xce, 0xd1, 0x42, 0xec, 0x1d, 0x46,
+	0x96, 0xcb, 0xf3, 0x42, 0x80, 0x71, 0x0a, 0x57, 0xc3, 0x43, 0x0d, 0x7a, 0x8d, 0xb6, 0xed, 0x07,
+	0x0d, 0x09, 0x68, 0x55, 0xcb, 0xbc, 0x67, 0xef, 0xa4, 0xb3, 0xde, 0x97, 0x64, 0x87, 0xbd, 0x6d,
+	0x24, 0x92, 0xad, 0x96, 0xb5, 0xdc, 0x4f, 0x85, 0x1b, 0x5d, 0x78, 0x71, 0x68, 0x20, 0xb7, 0xd3,
+	0x6f, 0xbb, 0xbc, 0xa5, 0xc7, 0xd5, 0x59, 0x1e, 0x6b, 0xed, 0x32, 0x63, 0x6d, 0x09, 0xb2, 0x2d,
+	0x6b, 0xdd, 0xaa, 0xf6, 0x53, 0x7b, 0xbc, 0x63, 0xe3, 0x15, 0x98, 0x6d, 0xf6, 0xba, 0x27, 0xae,
+	0xd7, 0x91, 0xc2, 0x32, 0x8f, 0x03, 0xcc, 0x5a, 0x65, 0x09, 0x14, 0x82, 0xf2, 0x15, 0x54, 0xfa,
+	0x4e, 0xb7, 0xe5, 0x76, 0x4f, 0x1b, 0x7e, 0xf3, 0xcc, 0x69, 0x0d, 0x70, 0x3f, 0x2b, 0xbc, 0x9f,
+	0xef, 0x8e, 0x98, 0x88, 0xc0, 0x56, 0xf3, 0x39, 0x90, 0x44, 0xd6, 0xbc, 0x64, 0xa3, 0x00, 0x46,
+	0x0f, 0x5e, 0x20, 0x89, 0x3c, 0xb7, 0x03, 0x5c, 0x1f, 0x2b, 0x6b, 0xb3, 0xd7, 0x6e, 0x9c, 0x38,
+	0xac, 0x1b, 0x7e, 0x75, 0x81, 0x07, 0xb9, 0x95, 0x3e, 0x48, 0x4d, 0x11, 0xee, 0x4b, 0xba, 0xbb,
+	0x92, 0xcc, 0xba, 0x6a, 0x8f, 0xea, 0x32, 0x5e, 0x84, 0xd2, 0xb9, 0xdd, 0x76, 0x5b, 0xd4, 0x59,
+	0x35, 0x90, 0xfd, 0xb4, 0x15, 0x01, 0x8c, 0xcf, 0x00, 0xbc, 0xb6, 0xdb, 0x71, 0x83, 0x46, 0xaf,
+	0xef, 0x57, 0xaf, 0xf0, 0x5e, 0xbf, 0x94, 0x3e, 0xba, 0xc5, 0x78, 0x7b, 0x7d, 0xab, 0xe4, 0xc9,
+	0x5f, 0xbe, 0x71, 0x04, 0x55, 0x3e, 0xab, 0x26, 0x32, 0xd0, 0xd5, 0x90, 0x98, 0x2d, 0x32, 0xb3,
+	0x17, 0x46, 0xe9, 0xf5, 0x13, 0xe4, 0xb4, 0xac, 0x88, 0x35, 0x35, 0x17, 0x6c, 0x0d, 0x9d, 0x1b,
+	0xfe, 0x6e, 0xe2, 0xde, 0x2c, 0x31, 0xc3, 0x9b, 0x4f, 0x35, 0x14, 0x87, 0x84, 0x6e, 0xe9, 0x66,
+	0x81, 0x21, 0xbe, 0xf1, 0x21, 0xac, 0x0c, 0xb1, 0x95, 0x42, 0xb0, 0xc2, 0x0a, 0xb6, 0x94, 0xa4,
+	0x11, 0xd2, 0xf0, 0x5d, 0x58, 0x75, 0x9e, 0x38, 0xcd, 0x41, 0xe0, 0x34, 0xdc, 0x6e, 0x7f, 0x10,
+	0x34, 0x62, 0xc6, 0xa6, 0xca, 0xa4, 0x2b, 0x12, 0x63, 0x8b, 0x10, 0x6a, 0x9a, 0xcd, 0xf9, 0x0c,
+	0x5e, 0x90, 0x5d, 0x2d, 0x94, 0xef, 0xc0, 0x6e, 0xc7, 0xa9, 0xaf, 0x0a, 0xcd, 0x56, 0x28, 0x87,
+	0x84, 0xa1, 0x93, 0xbf, 0x05, 0x0b, 0xc2, 0x64, 0x91, 0x65, 0x25, 0x79, 0xfc, 0xda, 0xb9, 0xa8,
+	0xce, 0xb1, 0x6d, 0x15, 0x3a, 0x7b, 0x20, 0xe0, 0xf7, 0x9d, 0x0b, 0xe3, 0x10, 0x0c, 0x96, 0x03,
+	0x27, 0x14, 0xda, 0xc6, 0xf9, 0xed, 0x2a, 0xb0, 0x48, 0xdd, 0x1c, 0xaf, 0x40, 0xa1, 0xc0, 0x56,
+	0x04, 0x07, 0xd5, 0x7e, 0x70, 0xdb, 0xf0, 0xe1, 0x3a, 0xcb, 0x4b, 0x23, 0x3e, 0x0f, 0x7b, 0x10,
+	0x9c, 0xf5, 0x3c, 0x37, 0xb8, 0x68, 0x9c, 0xdf, 0xa9, 0xbe, 0xc4, 0x63, 0xbc, 0x3d, 0xc6, 0xd6,
+	0xc8, 0x69, 0xd6, 0x14, 0x95, 0xf5, 0x22, 0x33, 0x4d, 0xed, 0x7b, 0x70, 0xc7, 0xf8, 0x61, 0xca,
+	0x52, 0xee, 0x54, 0x5f, 0x1e, 0xa7, 0x1d, 0x6a, 0x29, 0x21, 0x9b, 0x91, 0x6b, 0xba, 0x63, 0x7c,
+	0x1b, 0x96, 0xfb, 0xb6, 0x17, 0xb8, 0x76, 0xbb, 0x7d, 0x81, 0x67, 0xea, 0x07, 0xde, 0xa0, 0xe3,
+	0x74, 0x49, 0x43, 0xae, 0xb3, 0x86, 0x2c, 0x85, 0xbd, 0x5b, 0x5a, 0xa7, 0xf9, 0xfb, 0x39, 0x98,
+	0xe5, 0x19, 0x3f, 0x74, 0x83, 0x33, 0xcb, 0x39, 0xf1, 0x87, 0xdc, 0xeb, 0x6d, 0x28, 0xf0, 0x36,
+	0xb1, 0x77, 0x1d, 0x29, 0xfd, 0xc2, 0xfa, 0x0a, 0x4c, 0xc3, 0x86, 0xab, 0xa9, 0x3a, 0xe4, 0x21,
+	0xff, 0x6a, 0x6e, 0x9c, 0x11, 0x8f, 0x39, 0xc7, 0x13, 0xdf, 0x5a, 0x49, 0x51, 0x27, 0x9e, 0x25,
+	0xba, 0x87, 0x21, 0xce, 0xf9, 0x49, 0x38, 0xcf, 0x07, 0x09, 0x8e, 0xbf, 0x09, 0xcb, 0xc3, 0xaa,
+	0xc4, 0x7c, 0x0b, 0x93, 0xf0, 0x5d, 0x4c, 0x2a, 0x1c, 0x33, 0x37, 0xa1, 0xac, 0xbb, 0x41, 0x8c,
+	0x10, 0xe8, 0x4c, 0x62, 0x30, 0xf3, 0x4d, 0x98, 0x4f, 0xae, 0x12, 0x43, 0x9b, 0x33, 0xdb, 0x3f,
+	0x43, 0x4b, 0x91, 0x41, 0x4b, 0x51, 0xb6, 0x64, 0xcb, 0xbc, 0x07, 0x57, 0x47, 0x5a, 0x4e, 0x8a,
+	0x1f, 0x86, 0xad, 0xb0, 0xa0, 0xaf, 0xf4, 0x13, 0xc8, 0xe6, 0xef, 0x65, 0x61, 0x65, 0x84, 0xa5,
+	0x37, 0xde, 0x80, 0x4a, 0x28, 0xaa, 0x6d, 0xf7, 0xb8, 0x41, 0x6e, 0x3b, 0xc3, 0xca, 0x3d, 0xa7,
+	0xe0, 0xdb, 0xee, 0xf1, 0x2e, 0x3a, 0x6b, 0xf4, 0x40, 0x21, 0x26, 0x4d, 0x91, 0x65, 0xa5, 0x6c,
+	0x95, 0x15, 0xf0, 0x1e, 0xc2, 0x8c, 0x2f, 0x60, 0x46, 0x57, 0xe2, 0xdc, 0x44, 0x4a, 0x0c, 0x7e,
+	0xa4, 0xbe, 0xfb, 0x3a, 0xa3, 0x3b, 0xf2, 0xd8, 0x27, 0x56, 0xa1, 0x88, 0xe3, 0x1d, 0xb3, 0x03,
+	0x95, 0xa1, 0xd5, 0x57, 0x61, 0x8a, 0x8f, 0xa6, 0xd7, 0x95, 0x8b, 0x56, 0x4d, 0xe3, 0x73, 0x28,
+	0x29, 0x5f, 0xec, 0xe3, 0x4a, 0x73, 0xa3, 0x63, 0x4b, 0xc5, 0x14, 0x4d, 0x99, 0x15, 0xd1, 0x98,
+	0x3f, 0x80, 0x19, 0xad, 0xc7, 0xb8, 0x01, 0x65, 0xbb, 0xc9, 0xb6, 0xb3, 0xd1, 0xb5, 0x3b, 0x8e,
+	0xd4, 0xbd, 0x19, 0x09, 0xdb, 0x45, 0x50, 0xba, 0xcd, 0xcc, 0xa6, 0xda, 0x4c, 0xf3, 0xb7, 0xe1,
+	0xea, 0xc8, 0x55, 0x8f, 0x59, 0x55, 0x7d, 0x78, 0x55, 0xaf, 0x5f, 0x72, 0x4f, 0xf5, 0xb5, 0xfd,
+	0x49, 0x06, 0x16, 0x86, 0x10, 0x2e, 0xb3, 0xc4, 0x26, 0xac, 0x8c, 0x30, 0xc7, 0xd2, 0xf2, 0x4c,
+	0x64, 0x8b, 0x97, 0x8e, 0xd3, 0xc0, 0x66, 0x13, 0x96, 0x52, 0xf1, 0xf1, 0x4c, 0xb3, 0xe7, 0xef,
+	0xf1, 0xb4, 0x46, 0x06, 0x44, 0xe9, 0x86, 0xfd, 0xbd, 0x7b, 0xdf, 0xb2, 0x90, 0x74, 0xbd, 0x84,
+	0x1b, 0x6b, 0x7b, 0xae, 0xdd, 0x0d, 0xcc, 0x36, 0xac, 0x8c, 0xc0, 0xa5, 0xd0, 0x25, 0x38, 0x43,
+	0xbd, 0x3b, 0xeb, 0xb5, 0x5b, 0xf2, 0x00, 0x22, 0x80, 0xf1, 0x3e, 0xe4, 0xf1, 0x5c, 0xd5, 0xee,
+	0x8f, 0x08, 0xe0, 0xf1, 0x88, 0x1f, 0x3a, 0xee, 0xe9, 0x59, 0x60, 0x31, 0xb2, 0x79, 0x00, 0xf3,
+	0x89, 0xd0, 0xd7, 0xb8, 0x06, 0xd0, 0x45, 0x74, 0xe9, 0x8f, 0xe5, 0x30, 0x04, 0x11, 0x0e, 0x98,
+	0x0f, 0x83, 0x3d, 0x11, 0xc1, 0xc4, 0x70, 0x65, 0x3a, 0x0c, 0x82, 0xed, 0x12, 0x08, 0xf7, 0x69,
+	0x39, 0x3d, 0xe8, 0x35, 0x0c, 0xc8, 0x6b, 0x27, 0xc8, 0xbf, 0xd1, 0xf7, 0xac, 0x70, 0x90, 0x2b,
+	0xce, 0x0f, 0xcd, 0x44, 0x14, 0x57, 0x8b, 0x94, 0x6c, 0x91, 0xba, 0x79, 0x96, 0x68, 0x2d, 0x14,
+	0x2b, 0xd3, 0x81, 0xea, 0xa8, 0x68, 0xf7, 0x79, 0x0e, 0xf3, 0xcb, 0x2c, 0x18, 0xc3, 0x49, 0x97,
+	0xf4, 0x73, 0xf9, 0xd0, 0xcf, 0x2d, 0x42, 0xc1, 0xed, 0xb6, 0x9c, 0x27, 0x6c, 0x9b, 0xf3, 0x96,
+	0x68, 0xa0, 0x5c, 0x14, 0x31, 0x0b, 0x0d, 0x06, 0x3e, 0xcf, 0x64, 0x6e, 0x94, 0x4a, 0x68, 0xfc,
+	0x0f, 0x18, 0xdd, 0x92, 0x64, 0x34, 0xe9, 0x66, 0x7f, 0xd0, 0x18, 0xf8, 0xf6, 0xa9, 0xd3, 0xe8,
+	0xb8, 0x4d, 0xaf, 0xd7, 0xf0, 0x1d, 0x8c, 0xcc, 0x5b, 0xbe, 0x9a, 0x34, 0x76, 0x1f, 0x51, 0xef,
+	0x0e, 0x75, 0x1e, 0x88, 0x3e, 0xe3, 0x26, 0xcc, 0x77, 0x9d, 0x40, 0x92, 0x3d, 0xee, 0x79, 0x2d,
+	0xe1, 0x38, 0x67, 0xad, 0x59, 0x04, 0x33, 0xfa, 0x43, 0x02, 0x1a, 0x0f, 0x30, 0x53, 0xb5, 0x9b,
+	0x5f, 0x53, 0x24, 0x16, 0x4d, 0x41, 0x7a, 0xac, 0x51, 0xea, 0xcb, 0xf8, 0xfa, 0x8e, 0x2c, 0xf4,
+	0x93, 0x20, 0xf3, 0xef, 0x48, 0x8d, 0x93, 0x50, 0xe3, 0x25, 0x80, 0x30, 0x1d, 0x16, 0x3e, 0xa5,
+	0x64, 0x69, 0x10, 0xe3, 0x3a, 0xcc, 0x34, 0x7b, 0x9d, 0x3e, 0xfe, 0x64, 0x0b, 0x23, 0x16, 0xa8,
+	0x83, 0x8c, 0x8f, 0xa0, 0x2a, 0xe7, 0x8b, 0xeb, 0x0c, 0x30, 0x69, 0x6d, 0x9c, 0x78, 0x8e, 0xd3,
+	0xc0, 0xd0, 0xdd, 0xe6, 0x05, 0x96, 0x29, 0x50, 0xa1, 0xfe, 0x0d, 0xd1, 0x7d, 0x17, 0x7b, 0x37,
+	0xb1, 0x93, 0x53, 0xf2, 0xe1, 0x85, 0xe6, 0x99, 0x24, 0x65, 0xfe, 0x7f, 0x9d, 0x83, 0x19, 0x2d,
+	0xb3, 0x37, 0xbe, 0x83, 0x8a, 0xa7, 0x2a, 0x0a, 0xd2, 0xf5, 0xac, 0xae, 0x89, 0x9a, 0xc3, 0x9a,
+	0xaa, 0x39, 0xac, 0x1d, 0x2a, 0x0c, 0x2b, 0x42, 0x36, 0x56, 0x61, 0x5a, 0x59, 0x37, 0x29, 0x2d,
+	0x61, 0x9b, 0xd4, 0x59, 0x26, 0x59, 0x28, 0x83, 0x05, 0xa1, 0x67, 0x21, 0x40, 0x50, 0x3a, 0xe7,
+	0x6e, 0x6f, 0xe0, 0xb3, 0x50, 0x31, 0xa5, 0x68, 0x27, 0x93, 0xfc, 0x0e, 0xe5, 0xa9, 0x98, 0xb8,
+	0xd3, 0x6a, 0xf4, 0xc0, 0x66, 0x87, 0xe0, 0x4a, 0x61, 0x43, 0xbc, 0x69, 0xc6, 0x9b, 0xd1, 0x51,
+	0xde, 0xd4, 0x7c, 0xb5, 0x32, 0xf0, 0x22, 0xc5, 0x9e, 0x0f, 0xfd, 0x9c, 0x34, 0xf4, 0xdb, 0xb0,
+	0x20, 0xca, 0x1c, 0x7a, 0xcd, 0x60, 0xe6, 0x72, 0x35, 0x83, 0x8a, 0xa0, 0xd4, 0x8a, 0x06, 0x18,
+	0x88, 0x75, 0x9d, 0xc7, 0x8d, 0xd0, 0x01, 0x4c, 0x1e, 0x9f, 0xcf, 0x21, 0xbd, 0x02, 0xfa, 0x0f,
+	0x6e, 0x9b, 0x7f, 0x00, 0x50, 0xd1, 0x8e, 0xb2, 0x7e, 0x8e, 0x91, 0xea, 0x50, 0x54, 0x7a, 0x15,
+	0xa6, 0x85, 0x19, 0x70, 0x5b, 0xd2, 0x0f, 0x4e, 0x71, 0x7b, 0xab, 0x65, 0xbc, 0x00, 0xa5, 0xd0,
+	0x42, 0x48, 0xa5, 0x11, 0xb8, 0x14, 0xa9, 0x24, 0x03, 0xb1, 0xfc, 0x70, 0x20, 0x66, 0x38, 0xb0,
+	0xe0, 0xa2, 0xf0, 0x79, 0x5d, 0xca, 0x6c, 0x5a, 0x2d, 0x57, 0x53, 0xa9, 0x0f, 0x9f, 0xaa, 0xfe,
+	0x3c, 0xdd, 0xb5, 0x5a, 0xab, 0x85, 0x76, 0x4c, 0x32, 0x69, 0x5f, 0xa0, 0x8f, 0xa8, 0x28, 0x96,
+	0x35, 0xc9, 0xd1, 0xf8, 0x12, 0xa6, 0x43, 0xee, 0x45, 0xe6, 0xfe, 0xce, 0x24, 0xdc, 0x91, 0x67,
+	0x48, 0x6f, 0xec, 0x41, 0x49, 0xe4, 0x5b, 0xc4, 0x6c, 0x6a, 0x5c, 0x40, 0x34, 0xc4, 0xac, 0x2e,
+	0xf3, 0x34, 0xe4, 0x17, 0xf1, 0x30, 0x1a, 0x30, 0xdf, 0x0a, 0xbc, 0x27, 0x2a, 0x57, 0x41, 0x37,
+	0xc6, 0x52, 0x37, 0x73, 0xe7, 0x83, 0x4b, 0xb2, 0xdd, 0x44, 0x6a, 0x75, 0xc4, 0xc4, 0x7b, 0xae,
+	0x15, 0x01, 0x90, 0x9b, 0x71, 0x0c, 0x0b, 0x3c, 0x40, 0xd3, 0xee, 0x36, 0x9d, 0x76, 0xdb, 0x0e,
+	0x94, 0xc4, 0xce, 0xdc, 0x79, 0x7f, 0x82, 0x21, 0x36, 0x98, 0x9c, 0x47, 0xa8, 0xb4, 0xc2, 0xb6,
+	0x60, 0xb7, 0xfa, 0x03, 0x98, 0x4f, 0x1c, 0x84, 0xb1, 0x05, 0x33, 0xba, 0xfd, 0xc8, 0x8c, 0x33,
+	0x94, 0xe4, 0xbf, 0xe3, 0x86, 0x52, 0xa7, 0x5d, 0xfd, 0x97, 0x0c, 0x14, 0x98, 0xbd, 0xb1, 0x0e,
+	0x53, 0x9e, 0xf0, 0x2a, 0x92, 0xe1, 0xe5, 0x4b, 0x7f, 0x8a, 0x30, 0x39, 0xb1, 0xec, 0xb3, 0x4f,
+	0xcc, 0xa8, 0xc1, 0x4c, 0x7f, 0x70, 0x8c, 0x69, 0x53, 0x83, 0xa3, 0x09, 0x61, 0xed, 0xae, 0x8f,
+	0xd0, 0x46, 0x46, 0xc4, 0x98, 0xc2, 0xb7, 0xa0, 0x1f, 0xfe, 0x5e, 0xfd, 0x59, 0x06, 0xa6, 0x95,
+	0x60, 0x18, 0x9f, 0x42, 0x81, 0xb3, 0x21, 0xb9, 0xb8, 0xcb, 0x96, 0x2b, 0x04, 0x91, 0xb1, 0x01,
+	0x33, 0xc7, 0x91, 0x21, 0x96, 0x0b, 0xbb, 0x44, 0x2d, 0x56, 0xa7, 0x5a, 0xfd, 0xe3, 0x0c, 0xcc,
+	0xc6, 0x24, 0xca, 0xf8, 0x0d, 0x80, 0xa6, 0xe7, 0x70, 0xcd, 0xe9, 0xf8, 0x42, 0xce, 0x6c, 0xb4,
+	0xf9, 0xda, 0x14, 0xd5, 0x99, 0x92, 0x24, 0x59, 0xbf, 0x78, 0x8e, 0xfb, 0xbd, 0xba, 0x0f, 0x65,
+	0x5d, 0x14, 0x8d, 0xef, 0xa1, 0x17, 0x94, 0xbf, 0x27, 0x98, 0x1b, 0x28, 0x9a, 0xf5, 0x8b, 0xf5,
+	0x29, 0x28, 0x38, 0x24, 0xe2, 0xe6, 0xbb, 0x00, 0xd1, 0x09, 0x19, 0x2f, 0xc7, 0x0f, 0x56, 0xfa,
+	0xdf, 0xe8, 0xd8, 0xcc, 0x5f, 0x14, 0x61, 0x51, 0x9b, 0xe6, 0xb6, 0x7b, 0xe2, 0x34, 0x2f, 0x9a,
+	0x68, 0xd2, 0x92, 0xe6, 0xf3, 0x41, 0xbc, 0x1c, 0x25, 0x43, 0x9c, 0xec, 0x64, 0x21, 0x8e, 0xee,
+	0xc1, 0x04, 0xc8, 0x78, 0x04, 0x57, 0xe2, 0x69, 0xb9, 0xd0, 0x8a, 0x57, 0x27, 0xd4, 0x0a, 0x23,
+	0x18, 0x8e, 0xd7, 0x12, 0x07, 0x06, 0xbf, 0x86, 0x82, 0x24, 0xf6, 0xf1, 0x4a, 0x72, 0x1f, 0xd1,
+	0x9c, 0xce, 0x87, 0xa6, 0x50, 0x54, 0x02, 0x64, 0xed, 0xf9, 0xb2, 0xb2, 0x3f, 0x17, 0x92, 0x73,
+	0xdb, 0x78, 0x08, 0xcb, 0x11, 0x43, 0xe1, 0x9d, 0xe4, 0xdd, 0x44, 0xf9, 0xb2, 0xfa, 0xb0, 0x18,
+	0x32, 0xd0, 0xe3, 0x9a, 0xb8, 0x1a, 0x2c, 0x4e, 0xac, 0x06, 0x09, 0x59, 0x5d, 0x9a, 0x58, 0x56,
+	0x31, 0x69, 0x59, 0x62, 0x76, 0xb4, 0xb2, 0x98, 0x6b, 0xbd, 0xc1, 0xae, 0x75, 0x51, 0x75, 0xea,
+	0x55, 0x7e, 0xaa, 0x56, 0x45, 0xfb, 0x11, 0xa3, 0x32, 0x45, 0xb5, 0x2a, 0xec, 0x8d, 0x91, 0x7d,
+	0x0c, 0x55, 0x31, 0x72, 0xca, 0x70, 0xaf, 0x30, 0xe1, 0x8a, 0xd6, 0xaf, 0x93, 0x7e, 0x99, 0x9f,
+	0x9e, 0xad, 0x5c, 0xc1, 0x7f, 0x97, 0x2b, 0x37, 0xcc, 0x5f, 0x60, 0x70, 0x3b, 0x24, 0x22, 0x64,
+	0xa8, 0x86, 0x5d, 0xc3, 0x8d, 0xa7, 0xcb, 0x6c, 0x4c, 0xb4, 0xe2, 0x11, 0x72, 0x76, 0x28, 0x42,
+	0xc6, 0x44, 0x3e, 0x2d, 0xf0, 0xa5, 0x04, 0x6c, 0xbe, 0x19, 0x0f, 0x79, 0xcd, 0x3f, 0xca, 0xc2,
+	0x8c, 0x3e, 0xc1, 0xcf, 0xc3, 0x0b, 0xad, 0xb1, 0x6e, 0x4b, 0x23, 0x49, 0x5c, 0x6b, 0xed, 0xc2,
+	0x62, 0x6c, 0x70, 0x75, 0x5f, 0x25, 0xf2, 0xcd, 0x17, 0x47, 0x97, 0xe8, 0x71, 0x95, 0x86, 0x36,
+	0xbb, 0x9a, 0xbc, 0xa9, 0xfa, 0x10, 0xa6, 0x14, 0x8b, 0xdc, 0x25, 0x58, 0x28, 0x64, 0x5c, 0x08,
+	0x68, 0xa1, 0x67, 0xfe, 0x72, 0xa1, 0xa7, 0x46, 0x62, 0xfe, 0x61, 0x16, 0x16, 0x86, 0x96, 0x69,
+	0x7c, 0x42, 0x6c, 0xfb, 0xae, 0x67, 0x6b, 0xe7, 0x37, 0x2e, 0xc8, 0xd7, 0xb0, 0x31, 0x2e, 0x9c,
+	0xf5, 0x9c, 0x93, 0x28, 0xb5, 0x54, 0xb9, 0x0b, 0x02, 0x55, 0x42, 0x49, 0xf5, 0xb0, 0x08, 0x07,
+	0x23, 0xf9, 0x13, 0xf7, 0x89, 0x8c, 0x2f, 0xe7, 0x14, 0xda, 0x3e, 0x43, 0x31, 0x59, 0xb9, 0xd2,
+	0xb1, 0x9f, 0x34, 0x92, 0x19, 0x5c, 0x5e, 0x5c, 0xe2, 0x61, 0xd7, 0x6e, 0x2c, 0x89, 0x7b, 0x1d,
+	0x08, 0xd6, 0xd0, 0xf2, 0x44, 0x5f, 0x66, 0x13, 0xb3, 0x08, 0xdf, 0x50, 0xf9, 0xa1, 0x4f, 0xa1,
+	0x6d, 0x0b, 0x85, 0xfb, 0x82, 0x52, 0x48, 0x8e, 0x19, 0x31, 0xb4, 0x65, 0x00, 0xa6, 0x8d, 0xe6,
+	0xcf, 0x4b, 0xb1, 0xb8, 0x59, 0x18, 0x9e, 0xa4, 0xe1, 0x8f, 0x05, 0xc7, 0x59, 0xce, 0x74, 0xa3,
+	0xe0, 0x38, 0x4c, 0x81, 0x57, 0xf5, 0x14, 0xf8, 0x63, 0x00, 0x41, 0x42, 0x39, 0xd1, 0x65, 0x72,
+	0x27, 0xc6, 0xa6, 0x36, 0x49, 0x7b, 0x78, 0x13, 0x16, 0x86, 0xeb, 0x22, 0x89, 0x9a, 0x57, 0x1d,
+	0xeb, 0x32, 0x6c, 0xbf, 0x17, 0x05, 0x51, 0x22, 0xd6, 0x5e, 0xbb, 0xac, 0xbb, 0x90, 0x52, 0x1e,
+	0x86, 0x52, 0x55, 0x98, 0xc2, 0x3d, 0xe9, 0xfb, 0x98, 0x93, 0xd1, 0x1e, 0xe5, 0x2c, 0xd5, 0xa4,
+	0xd5, 0x87, 0x67, 0xc2, 0x61, 0x32, 0xae, 0x5e, 0xe5, 0xd3, 0x94, 0xcc, 0xa9, 0x54, 0xa9, 0xc5,
+	0xc1, 0xee, 0xb4, 0x15, 0x01, 0x8c, 0xbb, 0x30, 0x1b, 0xbf, 0xbb, 0x29, 0x8d, 0x2b, 0xfc, 0xd5,
+	0x34, 0x5f, 0x50, 0x8e, 0xdd, 0xd8, 0x58, 0xb0, 0x70, 0x62, 0xbb, 0x64, 0x6e, 0x39, 0xfc, 0x15,
+	0xce, 0x05, 0x26, 0x72, 0x2e, 0xf3, 0x82, 0x01, 0xc5, 0x1c, 0xe2, 0x90, 0x3f, 0xa3, 0xe8, 0xbf,
+	0xe9, 0xf4, 0x59, 0xee, 0xe7, 0xc7, 0x9b, 0x70, 0x89, 0x66, 0x45, 0x14, 0x54, 0x2e, 0x72, 0x3c,
+	0xaf, 0xe7, 0x35, 0x08, 0x8d, 0x2f, 0x05, 0xf3, 0xd8, 0x4d, 0x90, 0x0d, 0x04, 0x18, 0xb7, 0xa1,
+	0xd8, 0x3a, 0xe6, 0xfb, 0xaf, 0x05, 0x5e, 0xf2, 0x6a, 0x3a, 0xeb, 0xcd, 0x75, 0x74, 0x0c, 0x85,
+	0xd6, 0x31, 0xdd, 0x76, 0x7d, 0x04, 0xd3, 0xbc, 0x3a, 0x22, 0x32, 0xc6, 0x59, 0x06, 0xe9, 0x4f,
+	0xa6, 0x08, 0x9b, 0x08, 0xd1, 0x1d, 0xc9, 0x92, 0xb5, 0x76, 0x7b, 0x37, 0x62, 0x2d, 0xb2, 0x86,
+	0x4d, 0xee, 0xe8, 0x44, 0xfd, 0xe4, 0xa1, 0xfb, 0x8e, 0xd7, 0xd1, 0xee, 0xeb, 0x5e, 0x1c, 0x75,
+	0xbf, 0xe9, 0x75, 0x68, 0xe8, 0x3e, 0xff, 0xef, 0x1b, 0x1f, 0xa0, 0xfc, 0xd9, 0x82, 0x6e, 0x69,
+	0xdc, 0x3d, 0x9f, 0x55, 0xdb, 0x41, 0xb2, 0x22, 0xe2, 0x12, 0xd5, 0x01, 0x18, 0x44, 0xd5, 0xec,
+	0xa1, 0xaf, 0x89, 0x2e, 0x0a, 0x97, 0x99, 0xc1, 0x6b, 0x23, 0x19, 0x6c, 0x84, 0xe8, 0xc8, 0xaa,
+	0x82, 0x0c, 0x74, 0x80, 0x9f, 0xb8, 0xc2, 0x5c, 0x99, 0xf4, 0x0a, 0xf3, 0x13, 0x28, 0x05, 0x36,
+	0x5d, 0xb6, 0x13, 0x75, 0x95, 0xa9, 0xaf, 0x8d, 0x10, 0x2d, 0x42, 0x43, 0xe2, 0xe9, 0x40, 0xfc,
+	0xf0, 0x8d, 0xfb, 0x30, 0x1b, 0x7a, 0xf3, 0x00, 0x4d, 0x7d, 0xf5, 0xea, 0xb8, 0x2b, 0xca, 0x0d,
+	0x89, 0x7a, 0x17, 0x1d, 0x2e, 0x15, 0x0e, 0xad, 0xb2, 0x22, 0x3e, 0x44, 0x5a, 0xf3, 0x9b, 0x0c,
+	0x54, 0x47, 0xa9, 0xeb, 0xff, 0xf7, 0xca, 0x9a, 0xf9, 0xb7, 0x19, 0x28, 0x0a, 0x35, 0x26, 0x83,
+	0x22, 0x2b, 0xd5, 0xd2, 0x92, 0xaa, 0x66, 0x58, 0xa6, 0xcc, 0x6a, 0x65, 0x4a, 0xdc, 0x42, 0x59,
+	0xba, 0xfe, 0x89, 0xf0, 0x44, 0xb9, 0x71, 0xd2, 0x40, 0x62, 0xe8, 0x72, 0x6d, 0x6c, 0x1b, 0x83,
+	0x95, 0xb6, 0x15, 0xa7, 0x25, 0x8b, 0xf5, 0x23, 0x1f, 0xcf, 0x82, 0xe3, 0x04, 0x59, 0x7e, 0x22,
+	0x00, 0xd7, 0xc4, 0xae, 0xc2, 0xb4, 0x67, 0x3f, 0x16, 0x7d, 0x05, 0xae, 0x09, 0xa1, 0x08, 0x3f,
+	0xe6, 0xd8, 0xe1, 0xaf, 0x8a, 0x30, 0xa3, 0x19, 0x21, 0xaa, 0x45, 0xb1, 0x79, 0xc4, 0x78, 0x88,
+	0x43, 0x59, 0x64, 0xa3, 0xda, 0x28, 0x6e, 0x89, 0xf4, 0xf5, 0x95, 0xb1, 0x6e, 0x3c, 0x99, 0xb9,
+	0x7e, 0x00, 0xc5, 0x58, 0x12, 0x35, 0x3e, 0x08, 0x90, 0xb8, 0x54, 0xd3, 0xd2, 0x63, 0x11, 0x3e,
+	0x83, 0x69, 0xaa, 0x15, 0x86, 0x51, 0x86, 0x6e, 0xc7, 0xf3, 0x71, 0x3b, 0x8e, 0x3d, 0x88, 0xe8,
+	0xf7, 0xda, 0xea, 0x51, 0x8e, 0x6a, 0x1a, 0xaf, 0xc1, 0x9c, 0x9e, 0x80, 0xb8, 0x2d, 0x59, 0x79,
+	0x9b, 0xd5, 0xa0, 0xc9, 0x1a, 0xd1, 0x54, 0xc2, 0x0d, 0xa6, 0x7a, 0xad, 0xe9, 0x74, 0xaf, 0x15,
+	0x77, 0x8e, 0xa5, 0x49, 0x9c, 0xe3, 0x01, 0x5d, 0x08, 0x8b, 0x3b, 0x11, 0x32, 0x21, 0xe8, 0xc7,
+	0x03, 0xdb, 0x47, 0x57, 0x30, 0x46, 0x58, 0x6a, 0x02, 0x1f, 0x2d, 0xc8, 0x26, 0x61, 0xd3, 0x35,
+	0xb0, 0x00, 0xd8, 0x1d, 0x06, 0xf8, 0xcf, 0xd7, 0x15, 0x2c, 0x26, 0x5d, 0x01, 0xee, 0xae, 0x7a,
+	0x0e, 0xe1, 0xb5, 0xdc, 0xae, 0xdd, 0x66, 0x6f, 0x81, 0xaa, 0x23, 0x1f, 0x3a, 0x08, 0x20, 0x4a,
+	0xc4, 0x32, 0xdb, 0x01, 0xe4, 0x93, 0x40, 0x5f, 0x90, 0x8a, 0x29, 0x7a, 0x6b, 0x31, 0xaa, 0xef,
+	0xc3, 0x5b, 0xcd, 0x76, 0xcf, 0xc7, 0x8d, 0x6a, 0x0c, 0xba, 0xdd, 0x5e, 0xe0, 0x9e, 0xd0, 0x83,
+	0x19, 0x0a, 0xe7, 0xfd, 0x14, 0x4e, 0x06, 0x73, 0xba, 0x29, 0x29, 0x8e, 0x42, 0x82, 0x9a, 0xc4,
+	0x8f, 0xf3, 0x7e, 0x5d, 0x4f, 0xe8, 0x44, 0x8c, 0x73, 0x45, 0x44, 0x6e, 0x51, 0xa2, 0x41, 0x50,
+	0xf3, 0xcf, 0xb3, 0x30, 0x1b, 0x93, 0xf3, 0x98, 0xe6, 0x64, 0x12, 0x9a, 0xb3, 0x8c, 0xae, 0xd1,
+	0x3d, 0xc5, 0xf1, 0xa4, 0x01, 0x90, 0x2d, 0x1a, 0xee, 0xb4, 0xdd, 0x3b, 0xb6, 0xdb, 0x68, 0x91,
+	0x7e, 0x3c, 0x70, 0x70, 0x4a, 0x2c, 0xdf, 0x79, 0x6b, 0x4e, 0x80, 0x0f, 0x24, 0xd4, 0xf8, 0x42,
+	0xd8, 0x8a, 0x08, 0x4d, 0x04, 0xc3, 0xe6, 0x88, 0xe3, 0x47, 0x54, 0x45, 0x8a, 0x61, 0x85, 0xd6,
+	0xa2, 0x1b, 0x58, 0x9c, 0xd5, 0x79, 0xc4, 0xa8, 0xc0, 0xe3, 0x95, 0x09, 0xa8, 0x23, 0x11, 0xaf,
+	0x08, 0x49, 0x5c, 0x75, 0x94, 0x09, 0x18, 0x22, 0x51, 0xb1, 0xf9, 0xd8, 0x8d, 0x70, 0x84, 0x76,
+	0xcc, 0x20, 0x4c, 0xa1, 0x98, 0x3b, 0x50, 0xd6, 0xa7, 0x72, 0x99, 0xdb, 0x3d, 0xdc, 0xc5, 0x90,
+	0xa3, 0x0c, 0x3b, 0x55, 0xdb, 0xac, 0xc1, 0x7c, 0x42, 0xb0, 0xc7, 0x58, 0x5c, 0x8c, 0x51, 0x59,
+	0x53, 0x98, 0x4b, 0xce, 0x12, 0x0d, 0xf3, 0x7d, 0x28, 0x85, 0x99, 0x02, 0x19, 0xe5, 0xe0, 0xa2,
+	0xef, 0xc8, 0x8b, 0x2f, 0xfe, 0x4d, 0x30, 0x36, 0x93, 0xe2, 0x62, 0x9a, 0x7f, 0x9b, 0x3f, 0xcd,
+	0x42, 0x81, 0xe3, 0x0f, 0x4c, 0xfd, 0x4a, 0x3d, 0x8c, 0x03, 0xa2, 0xc4, 0x61, 0x6e, 0xf4, 0x75,
+	0x3f, 0xe2, 0xaf, 0xed, 0x29, 0x64, 0x2b, 0xa2, 0x4b, 0xf5, 0x05, 0xc3, 0xe6, 0x28, 0x97, 0x66,
+	0x8e, 0x12, 0xb5, 0x8d, 0xfc, 0xb3, 0xd7, 0x36, 0xcc, 0xef, 0x40, 0x29, 0x9c, 0x9d, 0xb1, 0x04,
+	0x0b, 0x7b, 0xfb, 0x75, 0xab, 0x76, 0xb8, 0xb5, 0xb7, 0xdb, 0x38, 0xda, 0xbd, 0xbf, 0xbb, 0xf7,
+	0x70, 0xb7, 0xf2, 0x2d, 0xdc, 0xc3, 0x4a, 0x04, 0xde, 0xb0, 0xea, 0xb5, 0xc3, 0x7a, 0x25, 0x63,
+	0xfe, 0x45, 0x0e, 0xf2, 0x14, 0xc4, 0x19, 0xeb, 0xc3, 0xbb, 0xf1, 0xea, 0xe8, 0x98, 0x2f, 0x7d,
+	0x33, 0xa2, 0x2b, 0x0b, 0xa1, 0x6d, 0x32, 0x9d, 0x92, 0x2b, 0xe6, 0xbc, 0x02, 0xf7, 0x8b, 0xad,
+	0x8c, 0xd8, 0x11, 0xfe, 0x4d, 0xa7, 0xeb, 0x37, 0x91, 0x8b, 0x74, 0x75, 0xa2, 0x41, 0x56, 0x49,
+	0x04, 0x34, 0xbc, 0xbf, 0xc2, 0xe2, 0x8b, 0x10, 0x87, 0x65, 0x8b, 0xca, 0x39, 0x9e, 0xdb, 0xb1,
+	0xbd, 0x0b, 0xbe, 0x16, 0x17, 0x06, 0x1f, 0x24, 0x88, 0x2e, 0xd8, 0xd1, 0xda, 0xf7, 0xda, 0xad,
+	0x46, 0xdf, 0xbe, 0x40, 0x1d, 0x9e, 0x12, 0x3a, 0x8c, 0x80, 0x7d, 0x6a, 0x8b, 0x9c, 0xe0, 0xb1,
+	0xec, 0x14, 0x56, 0x7e, 0x9a, 0x6e, 0x24, 0xb8, 0x13, 0x3d, 0x2c, 0x51, 0xb2, 0xe8, 0x94, 0x84,
+	0x87, 0xc5, 0xb6, 0x72, 0xbe, 0x44, 0xc7, 0x5d, 0x20, 0xba, 0xb0, 0xcd, 0xce, 0xb7, 0x35, 0xe9,
+	0x19, 0x6c, 0xed, 0x1e, 0xd4, 0xad, 0xc3, 0x4a, 0x26, 0x0e, 0x3d, 0xda, 0xdf, 0xa4, 0x93, 0xc9,
+	0xc6, 0xa1, 0x56, 0x7d, 0x67, 0xef, 0x41, 0xbd, 0x92, 0x33, 0xff, 0xbe, 0x0c, 0x05, 0x0e, 0x46,
+	0x27, 0x10, 0x5f, 0xc6, 0x7f, 0xe6, 0x13, 0xc3, 0xd3, 0x11, 0xdb, 0x24, 0x8e, 0x4c, 0x34, 0x22,
+	0x8d, 0xcc, 0x6b, 0x1a, 0x49, 0x50, 0x91, 0x66, 0x09, 0x43, 0x24, 0x1a, 0x34, 0x53, 0x3a, 0x43,
+	0xbf, 0x6f, 0x4b, 0xeb, 0xf3, 0x94, 0x99, 0xee, 0x2a, 0x64, 0x2b, 0xa2, 0x23, 0x71, 0x18, 0x74,
+	0x5d, 0x34, 0x1e, 0x7c, 0xdc, 0xe2, 0xc8, 0x4a, 0x02, 0x42, 0xa7, 0xfd, 0x49, 0x18, 0x8f, 0x4c,
+	0xf1, 0x00, 0xe6, 0xb8, 0x01, 0xe2, 0x51, 0x89, 0xf9, 0x5f, 0xc5, 0x4b, 0x1c, 0xdd, 0x2a, 0x2c,
+	0x27, 0xd5, 0xa7, 0x71, 0x58, 0x5b, 0xdf, 0x46, 0x25, 0x32, 0x5e, 0x82, 0xd5, 0xa8, 0x6f, 0xb3,
+	0x7e, 0xb7, 0x6e, 0x59, 0xf5, 0xcd, 0xc6, 0xa1, 0xf5, 0x55, 0xa3, 0xb6, 0xb9, 0x89, 0x47, 0x79,
+	0x03, 0xae, 0x8d, 0xe8, 0xdf, 0xa8, 0xed, 0x6e, 0xd4, 0xb7, 0x2b, 0xb9, 0x31, 0x28, 0xfb, 0x47,
+	0x07, 0xf7, 0xea, 0x9b, 0x95, 0xbc, 0xf1, 0x26, 0xbc, 0x36, 0x02, 0x05, 0x57, 0xd5, 0xd8, 0xd8,
+	0xc3, 0xe6, 0x06, 0xf5, 0x55, 0x0a, 0x86, 0x09, 0x2f, 0x8d, 0x42, 0x65, 0x41, 0xda, 0xac, 0x14,
+	0xd1, 0xda, 0x2e, 0xea, 0x38, 0xdb, 0xf5, 0xc3, 0x7a, 0xed, 0xe8, 0xf0, 0x5e, 0x65, 0x0a, 0x1d,
+	0x9c, 0x11, 0xf5, 0x6c, 0x6f, 0xed, 0xde, 0x67, 0xf8, 0x74, 0x9c, 0x62, 0xb7, 0xfe, 0xb0, 0xb6,
+	0xb1, 0xb1, 0x77, 0xb4, 0x7b, 0x58, 0x29, 0xa1, 0x32, 0xbe, 0x10, 0xf5, 0xec, 0x5b, 0x5b, 0x3b,
+	0x35, 0xeb, 0x11, 0xca, 0xf7, 0x66, 0x5d, 0xec, 0x00, 0xc4, 0x27, 0x14, 0x47, 0x90, 0xa2, 0x3d,
+	0x33, 0x0e, 0x47, 0x2a, 0x45, 0xd9, 0x78, 0x0f, 0xde, 0x19, 0x8f, 0x43, 0xe3, 0xd1, 0xdc, 0x1a,
+	0xfb, 0xb5, 0x47, 0x75, 0xab, 0x32, 0x6b, 0xbc, 0x0f, 0xb7, 0x9e, 0x42, 0x21, 0x26, 0xd0, 0xd8,
+	0xdb, 0xde, 0x94, 0x44, 0x73, 0xf1, 0xc3, 0x96, 0xfd, 0xe2, 0xb0, 0xe7, 0xe3, 0x27, 0x75, 0x50,
+	0xdf, 0xd8, 0xdb, 0xdd, 0x8c, 0xaf, 0xb6, 0x62, 0xbc, 0x0a, 0xd7, 0x47, 0xa3, 0xc8, 0xf5, 0x2e,
+	0x18, 0x77, 0x60, 0x6d, 0x34, 0x56, 0xea, 0x6a, 0x0c, 0x4c, 0x7f, 0x6e, 0x3f, 0x95, 0x66, 0x68,
+	0x3d, 0x57, 0xe2, 0xb6, 0xe4, 0xa0, 0x8e, 0x4b, 0xd9, 0xaa, 0x2c, 0xc6, 0x25, 0x1d, 0xa1, 0x1b,
+	0x7b, 0x9b, 0xf5, 0xca, 0x52, 0xfc, 0x98, 0x8f, 0x76, 0x43, 0x01, 0x58, 0x8e, 0x1f, 0xb3, 0x18,
+	0x8d, 0x7a, 0x94, 0x37, 0x59, 0x19, 0x89, 0x20, 0xcf, 0xaf, 0x6a, 0xfe, 0x77, 0x06, 0x4a, 0xa1,
+	0x7a, 0xd3, 0x04, 0x76, 0x6b, 0x3b, 0xf5, 0x83, 0xfd, 0xda, 0x46, 0x5d, 0x53, 0xb5, 0x05, 0x98,
+	0x8d, 0xc0, 0x34, 0xd5, 0x4c, 0x1c, 0x53, 0xc9, 0x5d, 0x16, 0xbd, 0xc9, 0x9c, 0x06, 0xa6, 0x49,
+	0xe6, 0x8c, 0x15, 0xb8, 0x12, 0x87, 0xb1, 0x08, 0xa3, 0xfe, 0xc4, 0x90, 0x79, 0xad, 0x05, 0x3a,
+	0xe8, 0x08, 0xa6, 0x2b, 0x0a, 0x2a, 0xc8, 0x35, 0xb8, 0x1a, 0xf5, 0x25, 0xf6, 0x1a, 0xb5, 0xe4,
+	0x0a, 0xcc, 0x47, 0xdd, 0x42, 0x38, 0xa6, 0xe3, 0x83, 0x33, 0xb0, 0x61, 0xed, 0x3d, 0xac, 0x94,
+	0xcc, 0x9f, 0x45, 0x89, 0x25, 0xce, 0xa3, 0xb6, 0x91, 0xb0, 0x2e, 0x73, 0x00, 0x12, 0x46, 0x12,
+	0x94, 0xa1, 0x2d, 0x90, 0x6d, 0x69, 0x21, 0xb2, 0xb4, 0x05, 0x0a, 0x14, 0xa9, 0x7a, 0xce, 0x98,
+	0xc7, 0x94, 0x4f, 0x80, 0xc9, 0x50, 0xe0, 0x32, 0x23, 0x52, 0x29, 0x69, 0x05, 0x0d, 0x24, 0x0f,
+	0xa2, 0x68, 0xfe, 0x6e, 0x06, 0xe6, 0x13, 0x35, 0x09, 0x11, 0x29, 0x86, 0x25, 0x8d, 0xb0, 0x82,
+	0x58, 0x8e, 0x80, 0x18, 0xb5, 0xc4, 0xed, 0x70, 0x36, 0x69, 0x87, 0x27, 0xf0, 0x16, 0x14, 0x76,
+	0x4f, 0xc9, 0x62, 0x04, 0x3d, 0x44, 0x4b, 0x7a, 0xb3, 0xd7, 0xc7, 0x96, 0x2f, 0x9e, 0xb3, 0x3f,
+	0x53, 0x71, 0x49, 0x3e, 0x2d, 0x2e, 0x29, 0x8c, 0x8e, 0x4b, 0x8a, 0x89, 0xb8, 0xc4, 0xdc, 0x7d,
+	0x3e, 0x61, 0x80, 0x3c, 0xbb, 0xac, 0xf9, 0x4f, 0x79, 0x28, 0x8a, 0x82, 0x99, 0xb1, 0x39, 0xbc,
+	0x47, 0x37, 0xc7, 0x55, 0xd8, 0x9e, 0x79, 0x8b, 0x30, 0xc3, 0xf1, 0x9d, 0x6e, 0x2b, 0xdc, 0x23,
+	0xd9, 0xa2, 0xa8, 0x49, 0xfc, 0x8a, 0x2a, 0xba, 0xd3, 0x02, 0xb0, 0xd5, 0x8a, 0xf6, 0xb5, 0xa0,
+	0xef, 0x2b, 0x8e, 0xc6, 0x57, 0x6c, 0xfe, 0x19, 0xa5, 0x75, 0x81, 0xdc, 0xaf, 0x99, 0x10, 0x56,
+	0x0b, 0x28, 0x92, 0x13, 0xf5, 0x6d, 0x8c, 0xf4, 0xdd, 0xb6, 0x0c, 0xd5, 0x80, 0x41, 0x47, 0x04,
+	0x21, 0xb9, 0x8c, 0x8a, 0xf6, 0xc4, 0x44, 0x78, 0xff, 0x72, 0x04, 0x44, 0x2e, 0xc3, 0x41, 0x77,
+	0xe9, 0x12, 0x41, 0xf7, 0xaf, 0x71, 0xa1, 0x68, 0xfe, 0x4d, 0xe6, 0x59, 0xa3, 0x6e, 0x0c, 0x23,
+	0x97, 0x34, 0xa7, 0x84, 0x7a, 0xab, 0xba, 0x12, 0x61, 0xdf, 0xdd, 0xda, 0xd6, 0x36, 0x3a, 0xeb,
+	0x5c, 0x82, 0x8d, 0x30, 0x09, 0x79, 0x3c, 0x8f, 0x95, 0x08, 0xba, 0xb3, 0xb7, 0xb9, 0x75, 0xf7,
+	0x91, 0xea, 0x2c, 0xa4, 0x77, 0x8a, 0x51, 0x8a, 0xe6, 0xaf, 0x32, 0x9c, 0x3b, 0x49, 0xc1, 0xba,
+	0x03, 0x4b, 0x7e, 0x6f, 0xe0, 0x35, 0x9d, 0x46, 0x62, 0x0b, 0x85, 0x01, 0xb8, 0x22, 0x3a, 0x0f,
+	0x47, 0x17, 0x53, 0x92, 0x77, 0x0a, 0xfa, 0x43, 0x9d, 0x5c, 0xfc, 0xa1, 0x4e, 0xbc, 0x76, 0x92,
+	0x9f, 0xa4, 0x76, 0xf2, 0x6d, 0x98, 0x92, 0x05, 0x66, 0x79, 0x59, 0x30, 0xbe, 0xbe, 0x5c, 0x14,
+	0xf5, 0x65, 0xf3, 0x3f, 0x71, 0xad, 0x61, 0xd9, 0x98, 0x14, 0xfd, 0x6b, 0x14, 0x7c, 0xf5, 0xc6,
+	0x90, 0x7e, 0x5f, 0x46, 0x25, 0x50, 0xbc, 0x54, 0x8d, 0x5a, 0x26, 0xff, 0x32, 0xa7, 0x93, 0xd0,
+	0x4d, 0x51, 0x03, 0xf8, 0x08, 0xa6, 0x24, 0x40, 0x2e, 0xed, 0xda, 0xd8, 0x32, 0xb6, 0xa5, 0xb0,
+	0xcd, 0x75, 0xc8, 0xdf, 0xa7, 0xa9, 0x54, 0xa0, 0x7c, 0x1f, 0x3d, 0x8c, 0x26, 0x41, 0x28, 0x58,
+	0x0c, 0xd9, 0xb7, 0xc8, 0xf3, 0x1d, 0x6e, 0x3d, 0x10, 0x22, 0x84, 0x36, 0x9d, 0xc1, 0x21, 0x28,
+	0x6b, 0xfe, 0x04, 0x2a, 0xc9, 0xda, 0x2c, 0x06, 0x4c, 0x8b, 0x89, 0xaa, 0x8c, 0x58, 0x22, 0x2d,
+	0xbf, 0x60, 0x19, 0xb1, 0x9a, 0x8c, 0x58, 0xe9, 0x07, 0xfa, 0x2d, 0x6d, 0xca, 0xb6, 0x44, 0x57,
+	0xd2, 0x1a, 0x95, 0xf9, 0xaf, 0x59, 0x28, 0x8a, 0xe2, 0xfa, 0x04, 0x66, 0x4a, 0x10, 0x3c, 0xb3,
+	0x99, 0xaa, 0x89, 0x3c, 0x8d, 0x6a, 0xf9, 0xf2, 0x9d, 0xd2, 0xcd, 0xa7, 0x95, 0x5b, 0xf7, 0x8e,
+	0x7f, 0x84, 0x9e, 0x8c, 0xf3, 0x39, 0x02, 0x12, 0x0b, 0xce, 0x03, 0x89, 0x45, 0x69, 0x32, 0x16,
+	0x94, 0x2e, 0x22, 0xf0, 0xff, 0x28, 0xef, 0xfb, 0x26, 0x03, 0x95, 0xe4, 0x1c, 0xc8, 0xe4, 0xf6,
+	0x1e, 0x77, 0xc3, 0x12, 0x95, 0x68, 0xa4, 0x96, 0x24, 0x3e, 0x83, 0x32, 0xbf, 0xa2, 0x1d, 0xf4,
+	0xc5, 0x07, 0x54, 0x4f, 0xbf, 0xd0, 0x9b, 0x21, 0xfc, 0xa3, 0xbe, 0xfa, 0xbc, 0xaa, 0x14, 0x3d,
+	0xcc, 0xce, 0x8f, 0x2b, 0x30, 0x6a, 0xcf, 0xc3, 0x43, 0x0a, 0xf3, 0x77, 0x00, 0xa2, 0xb9, 0xa7,
+	0xbe, 0xf2, 0x45, 0x8f, 0xd3, 0xb7, 0x3d, 0xa7, 0x1b, 0xd6, 0xd4, 0x44, 0x0b, 0x65, 0x69, 0xd6,
+	0x73, 0x7e, 0x3c, 0x70, 0x3d, 0xf2, 0x1e, 0xc8, 0x4f, 0x4e, 0xfc, 0xa9, 0x83, 0x97, 0x15, 0x15,
+	0x81, 0xcc, 0x7f, 0x47, 0x0b, 0x10, 0xbd, 0xfa, 0x7e, 0xfe, 0xcf, 0xb1, 0x8d, 0x2f, 0x60, 0x5a,
+	0x96, 0xaa, 0xd4, 0xa5, 0xf8, 0xdb, 0x97, 0x2a, 0xfc, 0x4b, 0x26, 0x21, 0xb1, 0xf1, 0x21, 0x14,
+	0x1e, 0xdb, 0x6e, 0xa0, 0xee, 0xc7, 0x47, 0xbc, 0xdf, 0x7a, 0x88, 0x28, 0x92, 0x54, 0xa0, 0xa3,
+	0xf9, 0x28, 0x85, 0x73, 0xa2, 0x08, 0x25, 0x7a, 0xe9, 0x22, 0xb7, 0xb9, 0x14, 0x3e, 0x74, 0xa1,
+	0xbd, 0x7e, 0xcc, 0x88, 0xea, 0xd3, 0x59, 0xd1, 0x32, 0xbf, 0x80, 0xf9, 0xc4, 0xf4, 0x48, 0xc0,
+	0x50, 0xe1, 0x7a, 0xa1, 0x80, 0x71, 0x83, 0x9e, 0x3b, 0xf4, 0x43, 0x44, 0x79, 0x60, 0x1a, 0xc4,
+	0x3c, 0x87, 0xa5, 0xd4, 0x75, 0x62, 0x90, 0xa7, 0x13, 0x66, 0xc6, 0x7d, 0x61, 0x93, 0xbc, 0x21,
+	0xd1, 0x08, 0x47, 0x2e, 0xe0, 0x73, 0x80, 0x68, 0x67, 0xc8, 0x07, 0xd1, 0xde, 0xf0, 0xad, 0xb9,
+	0xfc, 0xea, 0x81, 0xda, 0x07, 0x4e, 0x73, 0x24, 0x83, 0x7f, 0xc8, 0xc1, 0xb4, 0xba, 0x5b, 0x33,
+	0xee, 0x0e, 0x9b, 0xb1, 0x37, 0xc6, 0x5f, 0xc7, 0xa5, 0x1b, 0xb2, 0x8f, 0x31, 0x8a, 0x0c, 0x50,
+	0x8b, 0xc6, 0x3f, 0x82, 0x13, 0x3c, 0xe8, 0xb2, 0xcb, 0xb9, 0xf7, 0x2d, 0x4b, 0x50, 0x18, 0x9f,
+	0x42, 0x91, 0x1f, 0x16, 0x9f, 0x4a, 0xb1, 0x37, 0xc7, 0xd1, 0x6e, 0x30, 0x26, 0x12, 0x4b, 0x1a,
+	0xc3, 0xa2, 0xba, 0xbd, 0x28, 0xd0, 0x32, 0x82, 0xfa, 0x56, 0xea, 0xcd, 0x71, 0x5c, 0x64, 0x4d,
+	0x76, 0x9b, 0x09, 0x90, 0xd9, 0xac, 0xad, 0x03, 0x8c, 0x3d, 0x50, 0x80, 0x46, 0x54, 0xe8, 0x99,
+	0x19, 0xbf, 0x31, 0x92, 0x25, 0xdf, 0xb2, 0x21, 0x47, 0x55, 0x35, 0xe6, 0xf6, 0xf3, 0x0a, 0x97,
+	0x95, 0xf5, 0x5c, 0x2f, 0x0a, 0xf7, 0x6e, 0xfe, 0x4f, 0x0e, 0x66, 0xb4, 0x3d, 0x35, 0x7e, 0x08,
+	0x2b, 0xf6, 0x39, 0x8e, 0x73, 0xea, 0xa8, 0xe7, 0x1e, 0xe1, 0x93, 0x80, 0xb1, 0x0f, 0x1c, 0x79,
+	0x96, 0xb8, 0x82, 0x41, 0x67, 0xd0, 0x26, 0x4f, 0x69, 0x2d, 0x4a, 0x36, 0xe2, 0x81, 0x88, 0x7a,
+	0x46, 0x30, 0xc4, 0x3e, 0xbc, 0xa4, 0x94, 0xc7, 0xfe, 0x4c, 0xec, 0xd5, 0x23, 0x10, 0xbe, 0x9c,
+	0x92, 0xdf, 0xf1, 0x46, 0xf3, 0x16, 0x97, 0x0b, 0xea, 0xcb, 0xdc, 0x70, 0x2a, 0x1a, 0x6e, 0x34,
+	0x89, 0x7c, 0x0c, 0x37, 0xe4, 0xfb, 0x06, 0x54, 0xc4, 0xb7, 0x9c, 0xc4, 0x55, 0xaa, 0x84, 0x28,
+	0xdd, 0xcd, 0x31, 0x1c, 0x99, 0x4a, 0x6d, 0x0a, 0x31, 0x89, 0xa7, 0xc4, 0x2c, 0x6a, 0x98, 0xc8,
+	0x52, 0x62, 0xde, 0x84, 0x79, 0x81, 0x49, 0xf7, 0x5b, 0xc7, 0x17, 0x81, 0xe3, 0xcb, 0xdb, 0x84,
+	0x59, 0x06, 0x5b, 0x76, 0x67, 0x9d, 0x80, 0x34, 0xcf, 0x73, 0xd7, 0x0b, 0x06, 0x72, 0x74, 0x3e,
+	0x2b, 0x76, 0xe3, 0x38, 0x4f, 0xd9, 0x81, 0xc3, 0xb3, 0xdc, 0xe9, 0xb8, 0x34, 0xbe, 0xc0, 0x2d,
+	0xc5, 0x70, 0x71, 0x02, 0x8c, 0x6b, 0xfe, 0x5b, 0x16, 0xca, 0xba, 0x46, 0x18, 0xbf, 0x05, 0x8b,
+	0x21, 0x51, 0x03, 0xfd, 0x0a, 0xfa, 0x9b, 0x80, 0x3e, 0x77, 0xca, 0x8c, 0x7b, 0x7e, 0x5d, 0x27,
+	0xf7, 0xe7, 0x36, 0x99, 0xe5, 0x7e, 0x48, 0x83, 0x01, 0x93, 0x1c, 0x26, 0x82, 0x11, 0xff, 0x70,
+	0x01, 0x3a, 0xff, 0xec, 0xb3, 0xf0, 0xef, 0xca, 0x25, 0x6b, 0xfc, 0xef, 0xc2, 0x75, 0xa5, 0x73,
+	0xd1, 0xd5, 0xb7, 0x92, 0xb6, 0xc7, 0x28, 0xec, 0xbd, 0xc7, 0xf2, 0x32, 0xfb, 0x45, 0x89, 0xa7,
+	0xce, 0xb7, 0x26, 0x90, 0x1e, 0x32, 0x8e, 0xce, 0x27, 0xba, 0x0b, 0x4f, 0xf0, 0xc9, 0xc7, 0xf8,
+	0x28, 0x99, 0x8a, 0xf1, 0x31, 0xff, 0x2c, 0x03, 0x57, 0x52, 0x8c, 0xc5, 0x88, 0x68, 0xa4, 0x0a,
+	0x53, 0x52, 0xea, 0x78, 0x43, 0xa6, 0x2d, 0xd5, 0xe4, 0x0f, 0x96, 0x22, 0xb1, 0xcb, 0x71, 0x65,
+	0x80, 0x5e, 0xea, 0x44, 0x5e, 0x4c, 0x93, 0x35, 0x51, 0x38, 0x28, 0x35, 0x43, 0x31, 0xc3, 0xfc,
+	0x23, 0x12, 0xb0, 0x02, 0xf7, 0x4e, 0x7b, 0x52, 0xb6, 0xcc, 0x7f, 0xcc, 0x80, 0x31, 0x6c, 0x7c,
+	0x46, 0xcc, 0x70, 0x43, 0x7f, 0x1f, 0x34, 0x99, 0xb6, 0x46, 0xef, 0x88, 0x90, 0x49, 0xa4, 0x6d,
+	0xb9, 0xc9, 0x98, 0xa8, 0x17, 0x0b, 0x6a, 0x4d, 0xba, 0xca, 0xd2, 0x9a, 0x84, 0xa5, 0x6c, 0x43,
+	0x25, 0x49, 0x4a, 0x41, 0x32, 0x87, 0x75, 0xea, 0x7e, 0x54, 0xf8, 0x39, 0x0e, 0xdd, 0xd4, 0x25,
+	0x28, 0xba, 0xc1, 0x73, 0xbb, 0x3d, 0x70, 0x1a, 0x32, 0x86, 0xce, 0x5b, 0x53, 0xdc, 0xae, 0x3f,
+	0xa1, 0xeb, 0x39, 0xba, 0x41, 0x1f, 0x74, 0x64, 0x40, 0x88, 0xa3, 0xa9, 0x36, 0x7d, 0x22, 0xba,
+	0x9c, 0x2e, 0xa3, 0xe4, 0x3d, 0x03, 0xdb, 0x3b, 0x75, 0xc4, 0x2d, 0x5d, 0xde, 0x92, 0x2d, 0x4c,
+	0x5d, 0x72, 0x1d, 0x5b, 0x0d, 0x42, 0x3f, 0xc5, 0xd9, 0x7b, 0x6e, 0x2f, 0x7c, 0x6d, 0xa1, 0x9a,
+	0x94, 0x4e, 0xd1, 0xe3, 0x37, 0x5c, 0x47, 0xe0, 0xd2, 0xe7, 0x5f, 0x9e, 0x94, 0x3c, 0x7a, 0xfa,
+	0xb6, 0x13, 0x02, 0x8d, 0xef, 0xf1, 0x1f, 0x39, 0xa0, 0xe7, 0x52, 0x74, 0x5d, 0x1e, 0x28, 0x77,
+	0x33, 0xea, 0x91, 0x0e, 0x79, 0x11, 0xfe, 0x0b, 0x08, 0x4c, 0x61, 0x09, 0x17, 0x3a, 0xe3, 0x3c,
+	0xe9, 0xdb, 0xdd, 0x96, 0xa0, 0x2f, 0x3e, 0x9d, 0x1e, 0x04, 0x3e, 0x51, 0x63, 0x48, 0x54, 0x60,
+	0x20, 0xc5, 0x8c, 0x98, 0xe7, 0x92, 0x9f, 0x92, 0xc1, 0x50, 0xde, 0x8a, 0x00, 0xf4, 0x05, 0x54,
+	0xcb, 0xe9, 0xf6, 0x3a, 0xb8, 0xe1, 0xd4, 0x2f, 0x76, 0x40, 0x07, 0x99, 0x7f, 0x99, 0xa7, 0x7c,
+	0x5b, 0x5d, 0xb8, 0xab, 0x62, 0x93, 0x48, 0xc2, 0x44, 0xb1, 0x29, 0x2d, 0x6a, 0xc7, 0xfd, 0xc3,
+	0x70, 0x3c, 0x14, 0x29, 0xcc, 0xa2, 0x65, 0x13, 0x37, 0x86, 0x42, 0x84, 0xe6, 0xd7, 0x32, 0x4e,
+	0x7c, 0xeb, 0x29, 0xb7, 0xfd, 0x6b, 0xdb, 0xbd, 0xd3, 0x1d, 0x41, 0x6a, 0x09, 0xc2, 0x55, 0x8c,
+	0xc9, 0x23, 0x20, 0xc6, 0xd9, 0x53, 0xf2, 0x11, 0x86, 0x34, 0x8b, 0x97, 0xe1, 0x28, 0xbf, 0xd6,
+	0xb2, 0x14, 0x29, 0x49, 0xc6, 0x49, 0xcf, 0xeb, 0xd8, 0x61, 0x14, 0x2f, 0x5a, 0xe1, 0x3d, 0x6c,
+	0x3e, 0xba, 0x87, 0x5d, 0xfd, 0xd3, 0x2c, 0x4f, 0x40, 0xf2, 0x20, 0xd5, 0x6c, 0x53, 0xa0, 0xa7,
+	0x54, 0x93, 0x1b, 0x44, 0x78, 0xe2, 0xb6, 0xc3, 0x4d, 0xa1, 0xdf, 0x04, 0x6b, 0xbb, 0x5d, 0xb1,
+	0x23, 0xb8, 0x79, 0xf4, 0x9b, 0x06, 0x46, 0xe1, 0x3c, 0xeb, 0xa9, 0xaa, 0x94, 0x6c, 0x91, 0x84,
+	0x9f, 0xf5, 0xfc, 0x40, 0xbb, 0x41, 0x0c, 0xdb, 0x54, 0x76, 0xa2, 0xa8, 0xdf, 0x6e, 0xe9, 0x85,
+	0x3c, 0x10, 0x20, 0xbe, 0x61, 0x8c, 0x7d, 0x3d, 0x36, 0x35, 0xc9, 0xd7, 0x63, 0xda, 0x6e, 0x4e,
+	0x3f, 0xf3, 0x6e, 0x9a, 0xbf, 0xca, 0xc2, 0x94, 0xac, 0x13, 0xa4, 0x94, 0x1f, 0x32, 0x69, 0xe5,
+	0x07, 0x07, 0x56, 0xfc, 0x01, 0xe7, 0x86, 0xf4, 0xa1, 0x27, 0xa6, 0x34, 0x81, 0xe7, 0x86, 0xcf,
+	0x7d, 0xc7, 0x78, 0xa3, 0x83, 0x90, 0xc8, 0xd2, 0x68, 0xac, 0x65, 0x3f, 0x15, 0x4e, 0x9f, 0xe5,
+	0xb5, 0x1c, 0xbf, 0xe9, 0xb9, 0x3c, 0xf9, 0x78, 0x41, 0x64, 0x41, 0xeb, 0x91, 0xb3, 0x32, 0xa1,
+	0xdc, 0x72, 0xc8, 0xea, 0x3b, 0xdd, 0xa6, 0xeb, 0x88, 0xdc, 0xa6, 0x64, 0xc5, 0x60, 0x54, 0x82,
+	0x4a, 0x7e, 0xbf, 0xde, 0xe0, 0xfb, 0x7c, 0x71, 0x6c, 0x57, 0x12, 0xdf, 0xb0, 0x1f, 0xd2, 0xf5,
+	0xfe, 0x16, 0xcc, 0xfa, 0x7d, 0xa7, 0xe9, 0x9e, 0xb8, 0x4d, 0x5b, 0x7e, 0x50, 0x95, 0x1b, 0xfd,
+	0x90, 0xe9, 0x40, 0x47, 0xb5, 0xe2, 0x94, 0xe6, 0x2f, 0x33, 0xb0, 0x9c, 0xbe, 0x09, 0xfc, 0x20,
+	0xa9, 0x4b, 0xe5, 0x5d, 0x91, 0x2c, 0xa2, 0x03, 0x93, 0x4d, 0xfe, 0xeb, 0x0b, 0x28, 0x2d, 0xe2,
+	0x9b, 0x7c, 0xf1, 0xe5, 0x83, 0x48, 0x3a, 0xa5, 0xa7, 0x5b, 0x8a, 0xf5, 0x5a, 0xb2, 0x13, 0x93,
+	0xc5, 0xeb, 0x8e, 0xed, 0xa1, 0x7d, 0x43, 0xc3, 0x6d, 0xb7, 0xdb, 0xbd, 0xc7, 0x94, 0xdb, 0x46,
+	0x4c, 0xc2, 0x07, 0xb7, 0x25, 0xeb, 0x9a, 0xc2, 0xab, 0x09, 0xb4, 0x5a, 0x88, 0x45, 0x62, 0x67,
+	0x7e, 0x0c, 0xb3, 0xb1, 0x45, 0xa5, 0x66, 0xd6, 0xa8, 0x58, 0x6c, 0xef, 0xa5, 0x0e, 0x89, 0x86,
+	0xf9, 0x1f, 0xe8, 0x20, 0xa5, 0x6b, 0x54, 0x25, 0x23, 0xcb, 0x39, 0x19, 0xf3, 0x02, 0x83, 0x1e,
+	0x5f, 0x89, 0x5a, 0x91, 0xfa, 0xf2, 0x4e, 0x36, 0x87, 0xbf, 0xbc, 0x1b, 0x55, 0x08, 0xcc, 0x8f,
+	0x2b, 0x04, 0x16, 0x26, 0x29, 0x04, 0x5e, 0xee, 0xbd, 0xd7, 0x5b, 0xdf, 0xe0, 0x22, 0xc5, 0x57,
+	0xd2, 0xf2, 0x8b, 0x00, 0xb7, 0x4d, 0xf9, 0xff, 0x0b, 0xb0, 0xb2, 0xbe, 0xbd, 0xb7, 0x71, 0xdf,
+	0xaa, 0x3f, 0xa8, 0x5b, 0x07, 0x5b, 0xeb, 0x5b, 0xdb, 0x5b, 0x87, 0x8f, 0x1a, 0xbb, 0x7b, 0xbb,
+	0x75, 0xcc, 0x35, 0x6e, 0xc0, 0xb5, 0x94, 0x4e, 0xd5, 0xe2, 0xdb, 0xde, 0x57, 0xe0, 0xe5, 0x14,
+	0x94, 0x2d, 0x4b, 0x43, 0xca, 0xa2, 0xc3, 0xa8, 0xa6, 0x20, 0x1d, 0x1c, 0xd6, 0xb0, 0x37, 0x37,
+	0x62, 0x94, 0x9d, 0xda, 0xa3, 0xf5, 0xba, 0x40, 0xc9, 0xbf, 0xf5, 0xd3, 0xf8, 0x6b, 0x77, 0xf9,
+	0xa9, 0xcd, 0x2a, 0x2c, 0x1f, 0x5a, 0xb5, 0xdd, 0x03, 0x71, 0x9d, 0x83, 0xb8, 0x87, 0x47, 0x07,
+	0x6a, 0xea, 0x2f, 0xc1, 0xea, 0x70, 0x5f, 0xfd, 0xab, 0xfa, 0xc6, 0xd1, 0x61, 0x7d, 0x53, 0xdc,
+	0x52, 0x0f, 0xf7, 0x1f, 0xec, 0xdd, 0x3d, 0xa4, 0x2a, 0x33, 0x4e, 0x39, 0xb5, 0xff, 0x5e, 0xcd,
+	0xda, 0xe4, 0xfe, 0x1c, 0xdd, 0x87, 0x0d, 0xf7, 0x6f, 0xd6, 0xb7, 0x6b, 0x8f, 0xf8, 0x7a, 0x3a,
+	0xb5, 0xbb, 0xfe, 0xd5, 0xfe, 0x96, 0x85, 0xdd, 0x85, 0xf4, 0x6e, 0x95, 0xe3, 0x15, 0xd3, 0x07,
+	0x17, 0xb5, 0x6c, 0x24, 0x9f, 0x5a, 0xaf, 0x7d, 0xff, 0xf3, 0x53, 0x37, 0x38, 0x1b, 0x1c, 0xa3,
+	0x42, 0x77, 0x6e, 0xb1, 0x82, 0xbf, 0xeb, 0xf6, 0xe4, 0x0f, 0xf1, 0x87, 0xc7, 0xfa, 0xc7, 0xb7,
+	0xd2, 0xfe, 0x0e, 0xd9, 0x77, 0xfb, 0xc7, 0xfc, 0xf3, 0xb8, 0xc8, 0x42, 0xf5, 0xfe, 0xff, 0x02,
+	0x23, 0xe4, 0x9c, 0x2e, 0xae, 0x4c, 0x00, 0x00,
 }
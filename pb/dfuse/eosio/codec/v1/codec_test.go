@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_RAMOp_LegacyOperation(t *testing.T) {
@@ -62,3 +64,19 @@ func Test_RAMOp_LegacyOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestBlock_MarkPartiallyInstrumented(t *testing.T) {
+	block := &Block{Number: 10}
+	assert.False(t, block.IsPartiallyInstrumented())
+
+	block.MarkPartiallyInstrumented()
+	assert.True(t, block.IsPartiallyInstrumented())
+
+	data, err := proto.Marshal(block)
+	require.NoError(t, err)
+
+	decoded := &Block{}
+	require.NoError(t, proto.Unmarshal(data, decoded))
+	assert.True(t, decoded.IsPartiallyInstrumented())
+	assert.Equal(t, uint32(10), decoded.Number)
+}
@@ -0,0 +1,74 @@
+package searchclient
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// batchSizer adapts the amount of transactions fetched from trxdb in a single
+// request to trxdb's latency. The size is halved when a request takes longer
+// than the target latency and grows by a quarter when a full batch completes
+// in less than half of it, staying between the minimum and maximum sizes.
+type batchSizer struct {
+	lock          sync.Mutex
+	size          int
+	minSize       int
+	maxSize       int
+	targetLatency time.Duration
+}
+
+func newBatchSizer(initialSize, minSize, maxSize int, targetLatency time.Duration) *batchSizer {
+	if initialSize < minSize {
+		initialSize = minSize
+	}
+
+	if initialSize > maxSize {
+		initialSize = maxSize
+	}
+
+	return &batchSizer{
+		size:          initialSize,
+		minSize:       minSize,
+		maxSize:       maxSize,
+		targetLatency: targetLatency,
+	}
+}
+
+func (b *batchSizer) current() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.size
+}
+
+// observe records that fetching `count` transactions took `elapsed`.
+func (b *batchSizer) observe(count int, elapsed time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	previous := b.size
+	switch {
+	case elapsed > b.targetLatency:
+		b.size = b.size / 2
+		if b.size < b.minSize {
+			b.size = b.minSize
+		}
+
+	case elapsed < b.targetLatency/2 && count >= b.size:
+		b.size += (b.size + 3) / 4
+		if b.size > b.maxSize {
+			b.size = b.maxSize
+		}
+	}
+
+	if b.size != previous {
+		zlog.Debug("adapted trxdb batch size",
+			zap.Int("previous_size", previous),
+			zap.Int("size", b.size),
+			zap.Int("count", count),
+			zap.Duration("elapsed", elapsed),
+		)
+	}
+}
@@ -0,0 +1,34 @@
+package searchclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchSizer(t *testing.T) {
+	sizer := newBatchSizer(20, 5, 30, 100*time.Millisecond)
+
+	sizer.observe(20, 200*time.Millisecond)
+	assert.Equal(t, 10, sizer.current(), "halved when slower than target")
+
+	sizer.observe(5, 10*time.Millisecond)
+	assert.Equal(t, 10, sizer.current(), "partial batches do not grow the size")
+
+	sizer.observe(10, 10*time.Millisecond)
+	assert.Equal(t, 13, sizer.current(), "grows when a full batch is fast")
+
+	sizer.observe(13, 70*time.Millisecond)
+	assert.Equal(t, 13, sizer.current(), "kept when close to target")
+
+	for i := 0; i < 10; i++ {
+		sizer.observe(sizer.current(), time.Millisecond)
+	}
+	assert.Equal(t, 30, sizer.current())
+
+	for i := 0; i < 10; i++ {
+		sizer.observe(sizer.current(), time.Second)
+	}
+	assert.Equal(t, 5, sizer.current())
+}
@@ -0,0 +1,101 @@
+package searchclient
+
+import (
+	"container/list"
+	"sync"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+)
+
+// transactionEventsCache is a least recently used cache of the events of
+// irreversible transactions, keyed by the transaction ID prefix found in
+// search matches. Irreversible events never change, entries are thus only
+// ever evicted to honor the cache's size.
+//
+// A nil cache is valid and caches nothing.
+type transactionEventsCache struct {
+	lock    sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+
+	hits   uint64
+	misses uint64
+}
+
+type transactionEventsEntry struct {
+	trxIDPrefix string
+	events      []*pbcodec.TransactionEvent
+}
+
+func newTransactionEventsCache(size int) *transactionEventsCache {
+	if size <= 0 {
+		return nil
+	}
+
+	return &transactionEventsCache{
+		size:    size,
+		order:   list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+func (c *transactionEventsCache) get(trxIDPrefix string) ([]*pbcodec.TransactionEvent, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	element, found := c.entries[trxIDPrefix]
+	if !found {
+		c.misses++
+		TransactionCacheMissCount.Inc()
+		return nil, false
+	}
+
+	c.hits++
+	TransactionCacheHitCount.Inc()
+	c.order.MoveToFront(element)
+
+	return element.Value.(*transactionEventsEntry).events, true
+}
+
+func (c *transactionEventsCache) add(trxIDPrefix string, events []*pbcodec.TransactionEvent) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if element, found := c.entries[trxIDPrefix]; found {
+		element.Value.(*transactionEventsEntry).events = events
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[trxIDPrefix] = c.order.PushFront(&transactionEventsEntry{trxIDPrefix, events})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*transactionEventsEntry).trxIDPrefix)
+	}
+}
+
+// hitRatio is the ratio of lookups found in the cache since its creation.
+func (c *transactionEventsCache) hitRatio() float64 {
+	if c == nil {
+		return 0
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.hits+c.misses == 0 {
+		return 0
+	}
+
+	return float64(c.hits) / float64(c.hits+c.misses)
+}
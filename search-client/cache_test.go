@@ -0,0 +1,43 @@
+package searchclient
+
+import (
+	"testing"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionEventsCache(t *testing.T) {
+	cache := newTransactionEventsCache(2)
+	events := func(id string) []*pbcodec.TransactionEvent {
+		return []*pbcodec.TransactionEvent{{Id: id}}
+	}
+
+	cache.add("trx1", events("trx1"))
+	cache.add("trx2", events("trx2"))
+
+	found, ok := cache.get("trx1")
+	assert.True(t, ok)
+	assert.Equal(t, events("trx1"), found)
+
+	// trx2 is now the least recently used entry
+	cache.add("trx3", events("trx3"))
+
+	_, ok = cache.get("trx2")
+	assert.False(t, ok)
+
+	_, ok = cache.get("trx3")
+	assert.True(t, ok)
+
+	assert.Equal(t, 2.0/3.0, cache.hitRatio())
+}
+
+func TestTransactionEventsCache_Disabled(t *testing.T) {
+	cache := newTransactionEventsCache(0)
+	assert.Nil(t, cache)
+
+	cache.add("trx1", []*pbcodec.TransactionEvent{{Id: "trx1"}})
+	_, ok := cache.get("trx1")
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, cache.hitRatio())
+}
@@ -0,0 +1,108 @@
+package searchclient
+
+import (
+	"fmt"
+
+	pbsearch "github.com/dfuse-io/pbgo/dfuse/search/v1"
+	"go.uber.org/zap"
+)
+
+// deliveryGuard makes sure each match of a stream reaches the consumer exactly
+// once when the router hands the stream over between the archive and the live
+// backends, or when a backend is reconnected and resumes slightly before the
+// last match sent.
+//
+// In forward streams, every match below the boundary (the highest block known
+// to be irreversible, either because an archive match was received for it or
+// because a live match reported it as the last irreversible block) is final,
+// so a match received below it can only be a duplicate. Matches at or above the
+// boundary are deduplicated by block number and transaction prefix, an undo
+// removing its match so that the same transaction can be delivered again in
+// the block replacing the forked out one. An undo for a match that was never
+// delivered is dropped.
+//
+// In backward streams, the boundary is the lowest block delivered so far and
+// any match above it is a duplicate.
+//
+// Live markers, which have no transaction prefix, are always delivered.
+type deliveryGuard struct {
+	descending bool
+	boundary   uint64
+	delivered  map[string]uint64
+}
+
+func newDeliveryGuard(descending bool) *deliveryGuard {
+	return &deliveryGuard{
+		descending: descending,
+		delivered:  map[string]uint64{},
+	}
+}
+
+// accept returns whether the match should be delivered to the consumer,
+// `irreversible` being true when the match comes from the archive backend.
+func (g *deliveryGuard) accept(match *pbsearch.SearchMatch, irreversible bool) bool {
+	if match.TrxIdPrefix == "" {
+		return true
+	}
+
+	key := fmt.Sprintf("%d:%s", match.BlockNum, match.TrxIdPrefix)
+	if match.Undo {
+		if _, found := g.delivered[key]; !found {
+			zlog.Debug("dropping undo of a match that was never delivered", zap.String("key", key))
+			return false
+		}
+
+		delete(g.delivered, key)
+		return true
+	}
+
+	if g.descending {
+		return g.acceptBackward(key, match.BlockNum)
+	}
+
+	return g.acceptForward(key, match, irreversible)
+}
+
+func (g *deliveryGuard) acceptForward(key string, match *pbsearch.SearchMatch, irreversible bool) bool {
+	if _, found := g.delivered[key]; found || match.BlockNum < g.boundary {
+		zlog.Debug("dropping duplicate forward match", zap.String("key", key), zap.Uint64("boundary", g.boundary))
+		return false
+	}
+
+	g.delivered[key] = match.BlockNum
+
+	boundary := match.IrrBlockNum
+	if irreversible {
+		boundary = match.BlockNum
+	}
+
+	if boundary > g.boundary {
+		g.boundary = boundary
+		for k, blockNum := range g.delivered {
+			if blockNum < boundary {
+				delete(g.delivered, k)
+			}
+		}
+	}
+
+	return true
+}
+
+func (g *deliveryGuard) acceptBackward(key string, blockNum uint64) bool {
+	if _, found := g.delivered[key]; found || (g.boundary != 0 && blockNum > g.boundary) {
+		zlog.Debug("dropping duplicate backward match", zap.String("key", key), zap.Uint64("boundary", g.boundary))
+		return false
+	}
+
+	if g.boundary == 0 || blockNum < g.boundary {
+		g.boundary = blockNum
+		for k, deliveredNum := range g.delivered {
+			if deliveredNum > blockNum {
+				delete(g.delivered, k)
+			}
+		}
+	}
+
+	g.delivered[key] = blockNum
+	return true
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
@@ -17,10 +18,59 @@ import (
 	"google.golang.org/grpc"
 )
 
+const (
+	DefaultTransactionCacheSize = 1000
+	DefaultBatchSize            = 30
+	DefaultMinBatchSize         = 5
+	DefaultMaxBatchSize         = 100
+	DefaultBatchTargetLatency   = 250 * time.Millisecond
+)
+
+// Hydration controls how much of the transaction trace of each match is
+// returned, the zero value returning all of it. Flags can be combined.
+type Hydration uint8
+
+const (
+	// HydrateMatchingActionsOnly keeps only the matching action traces in the
+	// transaction trace.
+	HydrateMatchingActionsOnly Hydration = 1 << iota
+
+	// HydrateWithoutDBOps strips the database operations from the
+	// transaction trace.
+	HydrateWithoutDBOps
+)
+
 type EOSClient struct {
 	*searchclient.CommonClient
 
-	dbReader trxdb.DBReader
+	dbReader   trxdb.DBReader
+	cache      *transactionEventsCache
+	batchSizer *batchSizer
+	hydration  Hydration
+}
+
+type EOSClientOption func(c *EOSClient)
+
+// WithTransactionCacheSize sets the amount of irreversible transactions kept
+// in memory across requests, 0 disabling the cache.
+func WithTransactionCacheSize(size int) EOSClientOption {
+	return func(c *EOSClient) {
+		c.cache = newTransactionEventsCache(size)
+	}
+}
+
+// WithAdaptiveBatching sets the bounds of the amount of transactions fetched
+// from trxdb in a single request and the latency the size is adapted to.
+func WithAdaptiveBatching(minSize, maxSize int, targetLatency time.Duration) EOSClientOption {
+	return func(c *EOSClient) {
+		c.batchSizer = newBatchSizer(DefaultBatchSize, minSize, maxSize, targetLatency)
+	}
+}
+
+func WithHydration(hydration Hydration) EOSClientOption {
+	return func(c *EOSClient) {
+		c.hydration = hydration
+	}
 }
 
 type EOSStreamMatchesClient interface {
@@ -34,20 +84,42 @@ type EOSSearchMatch struct {
 	BlockHeader      *pbcodec.BlockHeader
 	TransactionTrace *pbcodec.TransactionTrace
 	MatchingActions  []*pbcodec.ActionTrace
+
+	irreversible bool
+}
+
+func NewEOSClient(cc *grpc.ClientConn, dbReader trxdb.DBReader, opts ...EOSClientOption) *EOSClient {
+	client := &EOSClient{
+		CommonClient: searchclient.NewCommonClient(cc),
+		dbReader:     dbReader,
+		cache:        newTransactionEventsCache(DefaultTransactionCacheSize),
+		batchSizer:   newBatchSizer(DefaultBatchSize, DefaultMinBatchSize, DefaultMaxBatchSize, DefaultBatchTargetLatency),
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
 }
 
-func NewEOSClient(cc *grpc.ClientConn, dbReader trxdb.DBReader) *EOSClient {
-	return &EOSClient{searchclient.NewCommonClient(cc), dbReader}
+// TransactionCacheHitRatio is the ratio of irreversible transactions found in
+// the cache instead of being fetched from trxdb, since the client's creation.
+func (e *EOSClient) TransactionCacheHitRatio() float64 {
+	return e.cache.hitRatio()
 }
 
 func (e *EOSClient) StreamMatches(callerCtx context.Context, req *pbsearch.RouterRequest) (EOSStreamMatchesClient, error) {
-	hammer := dhammer.NewHammer(30, 20, e.hammerBatchProcessor)
+	// The batches of a stream are sized once, trxdb requests being further
+	// split when the adapted size shrinks while the stream is running.
+	hammer := dhammer.NewHammer(e.batchSizer.current(), 20, e.hammerBatchProcessor)
 	hammer.Start(callerCtx)
 
 	go e.StreamSearchToHammer(callerCtx, hammer, req)
 
 	esm := &eosStreamMatches{
 		ctx:     callerCtx,
+		guard:   newDeliveryGuard(req.Descending),
 		errors:  make(chan error),
 		matches: make(chan *EOSSearchMatch),
 	}
@@ -61,20 +133,29 @@ func (e *EOSClient) hammerBatchProcessor(ctx context.Context, items []interface{
 	zlogger := logging.Logger(ctx, zlog)
 	zlogger.Debug("processing hammer batch", zap.Int("item_count", len(items)))
 
-	prefixes, prefixToIndex := searchclient.GatherTransactionPrefixesToFetch(items, isIrreversibleEOSMatch)
+	prefixes, _ := searchclient.GatherTransactionPrefixesToFetch(items, isIrreversibleEOSMatch)
 
-	var rows [][]*pbcodec.TransactionEvent
-	if len(prefixes) > 0 {
-		zlogger.Debug("performing retrieval of transaction traces", zap.Int("prefix_count", len(prefixes)))
-		rows, err = e.dbReader.GetTransactionTracesBatch(ctx, prefixes)
-		if err != nil {
-			return nil, fmt.Errorf("unable to fetch transaction traces batch: %w", err)
+	events := make(map[string][]*pbcodec.TransactionEvent, len(prefixes))
+	var missing []string
+	for _, prefix := range prefixes {
+		if cached, found := e.cache.get(prefix); found {
+			events[prefix] = cached
+			continue
+		}
+
+		missing = append(missing, prefix)
+	}
+
+	if len(missing) > 0 {
+		zlogger.Debug("performing retrieval of transaction traces", zap.Int("prefix_count", len(missing)), zap.Int("cached_count", len(prefixes)-len(missing)))
+		if err := e.fetchTransactionEvents(ctx, missing, events); err != nil {
+			return nil, err
 		}
 	}
 
 	for _, v := range items {
 		m := v.(*searchclient.MatchOrError)
-		resp, err := processEOSHammerItem(ctx, m, rows, prefixToIndex)
+		resp, err := processEOSHammerItem(ctx, m, events, e.hydration)
 		if err != nil {
 			return out, err
 		}
@@ -85,7 +166,42 @@ func (e *EOSClient) hammerBatchProcessor(ctx context.Context, items []interface{
 	return out, nil
 }
 
-func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, rows [][]*pbcodec.TransactionEvent, rowMap map[string]int) (*EOSSearchMatch, error) {
+// fetchTransactionEvents reads the events of the transactions from trxdb,
+// in batches sized from trxdb's latency, adding them to `events` and to the
+// cache.
+func (e *EOSClient) fetchTransactionEvents(ctx context.Context, prefixes []string, events map[string][]*pbcodec.TransactionEvent) error {
+	for len(prefixes) > 0 {
+		count := e.batchSizer.current()
+		if count > len(prefixes) {
+			count = len(prefixes)
+		}
+
+		batch := prefixes[:count]
+		prefixes = prefixes[count:]
+
+		start := time.Now()
+		rows, err := e.dbReader.GetTransactionTracesBatch(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("unable to fetch transaction traces batch: %w", err)
+		}
+
+		TransactionFetchCount.Inc()
+		e.batchSizer.observe(len(batch), time.Since(start))
+
+		for i, prefix := range batch {
+			if rows[i] == nil {
+				continue
+			}
+
+			events[prefix] = rows[i]
+			e.cache.add(prefix, rows[i])
+		}
+	}
+
+	return nil
+}
+
+func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, eventsByPrefix map[string][]*pbcodec.TransactionEvent, hydration Hydration) (*EOSSearchMatch, error) {
 	if m.Err != nil {
 		return nil, m.Err
 	}
@@ -104,17 +220,13 @@ func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, row
 	var blockHeader *pbcodec.BlockHeader
 	var trace *pbcodec.TransactionTrace
 
-	if eosMatch.Block != nil {
+	irreversible := eosMatch.Block == nil
+	if !irreversible {
 		blockID = eosMatch.Block.BlockID
 		blockHeader = eosMatch.Block.BlockHeader
 		trace = eosMatch.Block.Trace
 	} else {
-		idx, ok := rowMap[trxIDPrefix]
-		if !ok {
-			return nil, fmt.Errorf("no transaction events row pointer for trx prefix %q", trxIDPrefix)
-		}
-
-		events := rows[idx]
+		events := eventsByPrefix[trxIDPrefix]
 		if events == nil {
 			return nil, fmt.Errorf("transaction events for trx prefix %q are missing", trxIDPrefix)
 		}
@@ -144,11 +256,31 @@ func processEOSHammerItem(ctx context.Context, m *searchclient.MatchOrError, row
 		SearchMatch:      m.Match,
 		BlockID:          blockID,
 		BlockHeader:      blockHeader,
-		TransactionTrace: trace,
+		TransactionTrace: hydrate(trace, matchingActions, hydration),
 		MatchingActions:  matchingActions,
+		irreversible:     irreversible,
 	}, nil
 }
 
+// hydrate returns the parts of the trace requested by `hydration`. The trace
+// is shared with the cache, it is copied instead of being modified.
+func hydrate(trace *pbcodec.TransactionTrace, matchingActions []*pbcodec.ActionTrace, hydration Hydration) *pbcodec.TransactionTrace {
+	if trace == nil || hydration == 0 {
+		return trace
+	}
+
+	hydrated := *trace
+	if hydration&HydrateMatchingActionsOnly != 0 {
+		hydrated.ActionTraces = matchingActions
+	}
+
+	if hydration&HydrateWithoutDBOps != 0 {
+		hydrated.DbOps = nil
+	}
+
+	return &hydrated
+}
+
 func isIrreversibleEOSMatch(match *pbsearch.SearchMatch) bool {
 	// This sucks really hard. This was before a simple check if a variable was nil, now, it requires
 	// a full decoding of the any message to the correct type. This is probably a performance hit here
@@ -168,6 +300,7 @@ func isIrreversibleEOSMatch(match *pbsearch.SearchMatch) bool {
 
 type eosStreamMatches struct {
 	ctx     context.Context
+	guard   *deliveryGuard
 	errors  chan error
 	matches chan *EOSSearchMatch
 }
@@ -195,10 +328,17 @@ func (e *eosStreamMatches) onError(err error) {
 	}
 }
 
+// onItem is called in stream order, the guard thus sees the matches in the
+// order the router sent them.
 func (e *eosStreamMatches) onItem(v interface{}) {
+	match := v.(*EOSSearchMatch)
+	if !e.guard.accept(match.SearchMatch, match.irreversible) {
+		return
+	}
+
 	select {
 	case <-e.ctx.Done():
 		return
-	case e.matches <- v.(*EOSSearchMatch):
+	case e.matches <- match:
 	}
 }
@@ -0,0 +1,24 @@
+package searchclient
+
+import (
+	"testing"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHydrate(t *testing.T) {
+	actions := []*pbcodec.ActionTrace{{ExecutionIndex: 0}, {ExecutionIndex: 1}, {ExecutionIndex: 2}}
+	trace := &pbcodec.TransactionTrace{Id: "trx1", ActionTraces: actions, DbOps: []*pbcodec.DBOp{{ActionIndex: 1}}}
+	matching := []*pbcodec.ActionTrace{actions[1]}
+
+	assert.True(t, trace == hydrate(trace, matching, 0))
+
+	hydrated := hydrate(trace, matching, HydrateMatchingActionsOnly|HydrateWithoutDBOps)
+	assert.Equal(t, "trx1", hydrated.Id)
+	assert.Equal(t, matching, hydrated.ActionTraces)
+	assert.Nil(t, hydrated.DbOps)
+
+	assert.Len(t, trace.ActionTraces, 3, "shared trace is left untouched")
+	assert.Len(t, trace.DbOps, 1)
+}
@@ -0,0 +1,721 @@
+package searchclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	_ "github.com/dfuse-io/dfuse-eosio/codec"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dfuse-eosio/trxdb"
+	"github.com/dfuse-io/dmesh"
+	pbbstream "github.com/dfuse-io/pbgo/dfuse/bstream/v1"
+	pbsearch "github.com/dfuse-io/pbgo/dfuse/search/v1"
+	"github.com/dfuse-io/search"
+	searchArchive "github.com/dfuse-io/search/archive"
+	searchLive "github.com/dfuse-io/search/live"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	handoffQuery = "account:eosio.token"
+
+	// Blocks up to `handoffIrrBlockNum` are indexed in the archive shards,
+	// blocks from `handoffLiveTailBlockNum` up to `handoffHeadBlockNum` are
+	// seen by the live backend, the two backends overlapping on blocks 7 to 9.
+	handoffIrrBlockNum      = 9
+	handoffLiveTailBlockNum = 7
+	handoffHeadBlockNum     = 12
+	handoffShardSize        = 5
+)
+
+// handoffChain is a synthetic chain where each block holds one
+// `eosio.token` transfer, blocks 3 and 10 holding an extra `eosio` action that
+// the query does not match. Block 11a, holding `trx11a` and `trxf`, and block
+// 12a are forked out by blocks 11b, holding `trxf` again, and 12b.
+type handoffChain struct {
+	blocks        map[string]*pbcodec.Block
+	bstreamBlocks map[string]*bstream.Block
+	canonical     []*pbcodec.Block
+	mapper        *eosSearch.EOSBlockMapper
+	events        map[string][]*pbcodec.TransactionEvent
+}
+
+// handoffLiveSteps are the steps the live backend's forkable goes through,
+// each block reporting its last irreversible block, 3 blocks behind.
+var handoffLiveSteps = []struct {
+	blockID string
+	step    forkable.StepType
+}{
+	{handoffBlockID(7, "a"), forkable.StepNew},
+	{handoffBlockID(8, "a"), forkable.StepNew},
+	{handoffBlockID(9, "a"), forkable.StepNew},
+	{handoffBlockID(10, "a"), forkable.StepNew},
+	{handoffBlockID(11, "a"), forkable.StepNew},
+	{handoffBlockID(12, "a"), forkable.StepNew},
+	{handoffBlockID(12, "a"), forkable.StepUndo},
+	{handoffBlockID(11, "a"), forkable.StepUndo},
+	{handoffBlockID(11, "b"), forkable.StepNew},
+	{handoffBlockID(12, "b"), forkable.StepNew},
+}
+
+func newHandoffChain(t *testing.T) *handoffChain {
+	mapper, err := eosSearch.NewEOSBlockMapper("", false, "", "")
+	require.NoError(t, err)
+
+	c := &handoffChain{
+		blocks:        map[string]*pbcodec.Block{},
+		bstreamBlocks: map[string]*bstream.Block{},
+		mapper:        mapper,
+		events:        map[string][]*pbcodec.TransactionEvent{},
+	}
+
+	previous := handoffBlockID(0, "a")
+	for i := uint32(1); i <= 10; i++ {
+		trxs := []string{fmt.Sprintf("trx%02d", i)}
+		if i == 3 || i == 10 {
+			trxs = append(trxs, fmt.Sprintf("noop%02d", i))
+		}
+
+		previous = c.addBlock(i, "a", previous, trxs...)
+	}
+
+	c.addBlock(11, "a", handoffBlockID(10, "a"), "trx11a", "trxf")
+	c.addBlock(12, "a", handoffBlockID(11, "a"), "trx12a")
+	c.addBlock(11, "b", handoffBlockID(10, "a"), "trxf")
+	c.addBlock(12, "b", handoffBlockID(11, "b"), "trx12b")
+
+	for id, blk := range c.blocks {
+		c.bstreamBlocks[id] = handoffBStreamBlock(t, blk)
+	}
+
+	for i := uint32(1); i <= handoffHeadBlockNum; i++ {
+		fork := "a"
+		if i > 10 {
+			fork = "b"
+		}
+		c.canonical = append(c.canonical, c.blocks[handoffBlockID(i, fork)])
+	}
+
+	for _, blk := range c.canonical[:handoffIrrBlockNum] {
+		for _, trace := range blk.TransactionTraces {
+			c.events[trace.Id] = []*pbcodec.TransactionEvent{{
+				Id:           trace.Id,
+				BlockId:      blk.Id,
+				Irreversible: true,
+				Event: &pbcodec.TransactionEvent_Execution{Execution: &pbcodec.TransactionEvent_Executed{
+					BlockHeader: blk.Header,
+					Trace:       trace,
+				}},
+			}}
+		}
+	}
+
+	return c
+}
+
+func (c *handoffChain) addBlock(num uint32, fork string, previous string, trxs ...string) string {
+	blk := &pbcodec.Block{
+		Id:                       handoffBlockID(num, fork),
+		Number:                   num,
+		DposIrreversibleBlocknum: handoffLIBNum(num),
+		Header: &pbcodec.BlockHeader{
+			Previous:  previous,
+			Timestamp: &timestamp.Timestamp{Seconds: int64(num)},
+		},
+	}
+
+	for _, trx := range trxs {
+		account := "eosio.token"
+		if strings.HasPrefix(trx, "noop") {
+			account = "eosio"
+		}
+
+		blk.TransactionTraces = append(blk.TransactionTraces, &pbcodec.TransactionTrace{
+			Id:              handoffTrxID(trx),
+			BlockNum:        uint64(num),
+			ProducerBlockId: blk.Id,
+			Receipt: &pbcodec.TransactionReceiptHeader{
+				Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED,
+			},
+			ActionTraces: []*pbcodec.ActionTrace{{
+				Receipt: &pbcodec.ActionReceipt{Receiver: account},
+				Action:  &pbcodec.Action{Account: account, Name: "transfer"},
+			}},
+		})
+	}
+
+	c.blocks[blk.Id] = blk
+	return blk.Id
+}
+
+func handoffBlockID(num uint32, fork string) string {
+	return fmt.Sprintf("%08x%s", num, fork)
+}
+
+func handoffLIBNum(num uint32) uint32 {
+	if num <= 3 {
+		return 0
+	}
+	return num - 3
+}
+
+// handoffTrxID pads the name to the 32 characters of a transaction ID prefix,
+// the prefix being the whole ID.
+func handoffTrxID(name string) string {
+	return name + strings.Repeat(".", 32-len(name))
+}
+
+func handoffBStreamBlock(t *testing.T, blk *pbcodec.Block) *bstream.Block {
+	payload, err := proto.Marshal(blk)
+	require.NoError(t, err)
+
+	blockTime, err := ptypes.Timestamp(blk.Header.Timestamp)
+	require.NoError(t, err)
+
+	return &bstream.Block{
+		Id:             blk.Id,
+		Number:         blk.Num(),
+		PreviousId:     blk.PreviousID(),
+		Timestamp:      blockTime,
+		LibNum:         blk.LIBNum(),
+		PayloadKind:    pbbstream.Protocol_EOS,
+		PayloadVersion: 1,
+		PayloadBuffer:  payload,
+	}
+}
+
+// archiveBackend indexes the irreversible blocks in shards of
+// `handoffShardSize` blocks and serves them the way the archive backend does.
+func (c *handoffChain) archiveBackend(t *testing.T, dir string) (pbsearch.BackendClient, func()) {
+	irrBlockID := handoffBlockID(handoffIrrBlockNum, "a")
+	pool := &searchArchive.IndexPool{
+		IndexesPath:     filepath.Join(dir, "indexes"),
+		ShardSize:       handoffShardSize,
+		PerQueryThreads: 1,
+		SearchPeer: &dmesh.SearchPeer{
+			BlockRangeData: dmesh.BlockRangeData{
+				TailBlock:   0,
+				TailBlockID: handoffBlockID(0, "a"),
+				HeadBlockData: dmesh.HeadBlockData{
+					IrrBlock:    handoffIrrBlockNum,
+					IrrBlockID:  irrBlockID,
+					HeadBlock:   handoffIrrBlockNum,
+					HeadBlockID: irrBlockID,
+				},
+			},
+		},
+	}
+
+	for start := uint64(0); start <= handoffIrrBlockNum; start += handoffShardSize {
+		pool.ReadPool = append(pool.ReadPool, c.shard(t, dir, start, start+handoffShardSize-1))
+	}
+
+	client, cleanup := searchArchive.TestNewClient(t, &searchArchive.ArchiveBackend{
+		Pool:            pool,
+		MaxQueryThreads: 2,
+		SearchPeer:      pool.SearchPeer,
+	})
+
+	return client, func() {
+		cleanup()
+		pool.CloseIndexes()
+	}
+}
+
+func (c *handoffChain) shard(t *testing.T, dir string, start, end uint64) *search.ShardIndex {
+	idxer, err := scorch.NewScorch("eos", map[string]interface{}{
+		"path":         filepath.Join(dir, fmt.Sprintf("%010d.bleve", start)),
+		"unsafe_batch": true,
+	}, index.NewAnalysisQueue(2))
+	require.NoError(t, err)
+	require.NoError(t, idxer.Open())
+
+	now := time.Now().UTC().Format(search.TimeFormatBleveID)
+	batch := index.NewBatch()
+	for _, id := range []string{
+		fmt.Sprintf("meta:boundary:start_num:%d", start),
+		fmt.Sprintf("meta:boundary:start_id:%s", handoffBlockID(uint32(start), "a")),
+		fmt.Sprintf("meta:boundary:start_time:%s", now),
+		fmt.Sprintf("meta:boundary:end_num:%d", end),
+		fmt.Sprintf("meta:boundary:end_id:%s", handoffBlockID(uint32(end), "a")),
+		fmt.Sprintf("meta:boundary:end_time:%s", now),
+	} {
+		batch.Update(document.NewDocument(id))
+	}
+
+	for _, blk := range c.canonical {
+		if blk.Num() < start || blk.Num() > end {
+			continue
+		}
+
+		docs, err := c.mapper.Map(c.mapper.IndexMapping(), c.bstreamBlocks[blk.Id])
+		require.NoError(t, err)
+
+		for _, doc := range docs {
+			batch.Update(doc)
+		}
+	}
+	require.NoError(t, idxer.Batch(batch))
+
+	shard, err := search.NewShardIndexWithAnalysisQueue(start, end-start+1, idxer, handoffShardFile, nil)
+	require.NoError(t, err)
+
+	return shard
+}
+
+func handoffShardFile(baseBlockNum uint64, suffix string) string {
+	return fmt.Sprintf("%010d.%s", baseBlockNum, suffix)
+}
+
+// liveIndexes pre-indexes each reversible block, forked out ones included,
+// the way the live backend does.
+func (c *handoffChain) liveIndexes(t *testing.T, dir string) map[string]*search.SingleIndex {
+	preIndexer := search.NewPreIndexer(c.mapper, dir)
+
+	out := map[string]*search.SingleIndex{}
+	for id, blk := range c.bstreamBlocks {
+		if blk.Num() < handoffLiveTailBlockNum {
+			continue
+		}
+
+		obj, err := preIndexer.Preprocess(blk)
+		require.NoError(t, err)
+		out[id] = obj.(*search.SingleIndex)
+	}
+
+	return out
+}
+
+// handoffRouter stands in for the search router, which needs dmesh and
+// blockmeta: forward requests are served by the archive backend up to the
+// last irreversible block then by the live backend, and backward requests the
+// other way around, the backends overlapping on the blocks both of them
+// serve. Matches get a `<block num>:<trx prefix>` cursor, a request with a
+// cursor restarting each backend at the cursor's block, right after its match.
+type handoffRouter struct {
+	chain   *handoffChain
+	archive pbsearch.BackendClient
+	indexes map[string]*search.SingleIndex
+}
+
+func (r *handoffRouter) StreamMatches(req *pbsearch.RouterRequest, stream pbsearch.Router_StreamMatchesServer) error {
+	ctx := stream.Context()
+
+	low, high := uint64(req.LowBlockNum), uint64(req.HighBlockNum)
+	if req.HighBlockUnbounded {
+		high = handoffHeadBlockNum
+	}
+
+	resume, err := newHandoffResume(req.Cursor)
+	if err != nil {
+		return err
+	}
+
+	if resume != nil {
+		if req.Descending {
+			high = resume.blockNum
+		} else {
+			low = resume.blockNum
+		}
+	}
+
+	// Each backend resumes on its own, both replaying the cursor's block when
+	// it is served by both of them.
+	sender := func() func(*pbsearch.SearchMatch) error {
+		backendResume := resume.restart()
+		return func(match *pbsearch.SearchMatch) error {
+			if backendResume.skip(match) {
+				return nil
+			}
+
+			match.Cursor = fmt.Sprintf("%d:%s", match.BlockNum, match.TrxIdPrefix)
+			return stream.Send(match)
+		}
+	}
+
+	if req.Descending {
+		if high >= handoffLiveTailBlockNum {
+			if err := r.streamLiveBackward(ctx, req.Query, max64(low, handoffLiveTailBlockNum), high, sender()); err != nil {
+				return err
+			}
+		}
+
+		return r.streamArchive(ctx, req.Query, low, min64(high, handoffIrrBlockNum), true, sender())
+	}
+
+	if low <= handoffIrrBlockNum {
+		if err := r.streamArchive(ctx, req.Query, low, min64(high, handoffIrrBlockNum), false, sender()); err != nil {
+			return err
+		}
+	}
+
+	return r.streamLiveForward(ctx, req.Query, max64(low, handoffLiveTailBlockNum), high, sender())
+}
+
+func (r *handoffRouter) streamArchive(ctx context.Context, query string, low, high uint64, descending bool, send func(*pbsearch.SearchMatch) error) error {
+	if low > high {
+		return nil
+	}
+
+	stream, err := r.archive.StreamMatches(ctx, &pbsearch.BackendRequest{
+		Query:        query,
+		LowBlockNum:  low,
+		HighBlockNum: high,
+		Descending:   descending,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		match, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := send(match); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *handoffRouter) streamLiveForward(ctx context.Context, query string, low, high uint64, send func(*pbsearch.SearchMatch) error) error {
+	bleveQuery, err := search.NewParsedQuery(query)
+	if err != nil {
+		return err
+	}
+
+	incomingMatches := make(chan *pbsearch.SearchMatch, 16)
+	q := &searchLive.LiveQuery{
+		BleveQuery:      bleveQuery,
+		Request:         &pbsearch.BackendRequest{Query: query, LowBlockNum: low, HighBlockNum: high},
+		Ctx:             ctx,
+		MatchCollector:  search.GetMatchCollector,
+		IncomingMatches: incomingMatches,
+	}
+
+	for _, s := range handoffLiveSteps {
+		blk := r.chain.blocks[s.blockID]
+		if blk.Num() < low || blk.Num() > high {
+			continue
+		}
+
+		err := q.ForwardProcessBlock(r.chain.bstreamBlocks[s.blockID], &forkable.ForkableObject{Step: s.step, Obj: r.indexes[s.blockID]})
+		if err != nil {
+			return err
+		}
+
+		if err := drainHandoffMatches(incomingMatches, send); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *handoffRouter) streamLiveBackward(ctx context.Context, query string, low, high uint64, send func(*pbsearch.SearchMatch) error) error {
+	bleveQuery, err := search.NewParsedQuery(query)
+	if err != nil {
+		return err
+	}
+
+	incomingMatches := make(chan *pbsearch.SearchMatch, 16)
+	q := &searchLive.LiveQuery{
+		BleveQuery: bleveQuery,
+		Request:    &pbsearch.BackendRequest{Query: query, LowBlockNum: low, HighBlockNum: high, Descending: true},
+	}
+
+	for i := len(r.chain.canonical) - 1; i >= 0; i-- {
+		blk := r.chain.canonical[i]
+		if blk.Num() < low || blk.Num() > high {
+			continue
+		}
+
+		idxBlk := &searchLive.IndexedBlock{Idx: r.indexes[blk.Id], Blk: r.chain.bstreamBlocks[blk.Id]}
+		if err := q.ProcessSingleBlocks(ctx, idxBlk, search.GetMatchCollector, incomingMatches); err != nil {
+			return err
+		}
+
+		if err := drainHandoffMatches(incomingMatches, send); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func drainHandoffMatches(matches chan *pbsearch.SearchMatch, send func(*pbsearch.SearchMatch) error) error {
+	for {
+		select {
+		case match := <-matches:
+			if err := send(match); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+	}
+}
+
+// handoffResume drops the matches of the cursor's block a backend sends up
+// to, and including, the match of the cursor.
+type handoffResume struct {
+	blockNum    uint64
+	trxIDPrefix string
+	reached     bool
+}
+
+func newHandoffResume(cursor string) (*handoffResume, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	chunks := strings.SplitN(cursor, ":", 2)
+	if len(chunks) != 2 {
+		return nil, fmt.Errorf("invalid cursor %q", cursor)
+	}
+
+	blockNum, err := strconv.ParseUint(chunks[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", cursor, err)
+	}
+
+	return &handoffResume{blockNum: blockNum, trxIDPrefix: chunks[1]}, nil
+}
+
+func (r *handoffResume) restart() *handoffResume {
+	if r == nil {
+		return nil
+	}
+
+	return &handoffResume{blockNum: r.blockNum, trxIDPrefix: r.trxIDPrefix}
+}
+
+func (r *handoffResume) skip(match *pbsearch.SearchMatch) bool {
+	if r == nil || r.reached || match.BlockNum != r.blockNum {
+		return false
+	}
+
+	r.reached = match.TrxIdPrefix == r.trxIDPrefix
+	return true
+}
+
+func min64(a, b uint64) uint64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+type handoffDB struct {
+	trxdb.DBReader
+	reader *trxdb.TestTransactionsReader
+}
+
+func (d *handoffDB) GetTransactionTracesBatch(ctx context.Context, idPrefixes []string) ([][]*pbcodec.TransactionEvent, error) {
+	return d.reader.GetTransactionTracesBatch(ctx, idPrefixes)
+}
+
+type handoffFixture struct {
+	chain *handoffChain
+	conn  *grpc.ClientConn
+}
+
+// newHandoffFixture indexes the chain and serves it through the router over
+// gRPC, the way the client reaches it in production.
+func newHandoffFixture(t *testing.T) (*handoffFixture, func()) {
+	dir, err := ioutil.TempDir("", "handoff")
+	require.NoError(t, err)
+
+	chain := newHandoffChain(t)
+	archive, cleanupArchive := chain.archiveBackend(t, filepath.Join(dir, "archive"))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	pbsearch.RegisterRouterServer(server, &handoffRouter{
+		chain:   chain,
+		archive: archive,
+		indexes: chain.liveIndexes(t, filepath.Join(dir, "live")),
+	})
+	go server.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+
+	return &handoffFixture{chain: chain, conn: conn}, func() {
+		conn.Close()
+		server.Stop()
+		cleanupArchive()
+		os.RemoveAll(dir)
+	}
+}
+
+// stream runs the request through the client and returns what the consumer
+// receives, stopping after `limit` matches when it is not 0, along with the
+// cursor of the last match.
+func (f *handoffFixture) stream(t *testing.T, req *pbsearch.RouterRequest, limit int) (out []string, cursor string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := NewEOSClient(f.conn, &handoffDB{reader: trxdb.NewTestTransactionsReader(f.chain.events)}, WithTransactionCacheSize(0))
+	stream, err := client.StreamMatches(ctx, req)
+	require.NoError(t, err)
+
+	for limit == 0 || len(out) < limit {
+		match, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.NotNil(t, match.TransactionTrace, match.TrxIdPrefix)
+		assert.Equal(t, match.TrxIdPrefix, match.TransactionTrace.Id)
+
+		entry := fmt.Sprintf("%s@%d%s", strings.TrimRight(match.TrxIdPrefix, "."), match.BlockNum, match.BlockID[8:])
+		if match.Undo {
+			entry = "undo " + entry
+		}
+
+		out = append(out, entry)
+		cursor = match.Cursor
+	}
+
+	return
+}
+
+// canonical replays the delivered entries and returns the transactions that
+// remain, failing when a transaction is delivered twice or undone without
+// having been delivered.
+func canonical(t *testing.T, entries []string) (out []string) {
+	live := map[string]bool{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, "undo ") {
+			entry = strings.TrimPrefix(entry, "undo ")
+			require.True(t, live[entry], "undo of %s which is not delivered", entry)
+			delete(live, entry)
+			out = remove(out, entry)
+			continue
+		}
+
+		require.False(t, live[entry], "%s delivered twice", entry)
+		live[entry] = true
+		out = append(out, entry)
+	}
+
+	return
+}
+
+func remove(in []string, entry string) (out []string) {
+	for _, e := range in {
+		if e != entry {
+			out = append(out, e)
+		}
+	}
+	return
+}
+
+var handoffForward = []string{
+	"trx01@1a", "trx02@2a", "trx03@3a", "trx04@4a", "trx05@5a",
+	"trx06@6a", "trx07@7a", "trx08@8a", "trx09@9a", "trx10@10a",
+	"trxf@11b", "trx12b@12b",
+}
+
+func handoffBackward() (out []string) {
+	for i := len(handoffForward) - 1; i >= 0; i-- {
+		out = append(out, handoffForward[i])
+	}
+	return
+}
+
+func TestHandoff_Forward(t *testing.T) {
+	f, cleanup := newHandoffFixture(t)
+	defer cleanup()
+
+	entries, _ := f.stream(t, &pbsearch.RouterRequest{Query: handoffQuery, LowBlockNum: 1, HighBlockUnbounded: true, WithReversible: true}, 0)
+
+	assert.Contains(t, entries, "trx11a@11a")
+	assert.Contains(t, entries, "undo trx11a@11a")
+	assert.Contains(t, entries, "undo trx12a@12a")
+	assert.Equal(t, handoffForward, canonical(t, entries))
+}
+
+func TestHandoff_Backward(t *testing.T) {
+	f, cleanup := newHandoffFixture(t)
+	defer cleanup()
+
+	entries, _ := f.stream(t, &pbsearch.RouterRequest{Query: handoffQuery, LowBlockNum: 1, HighBlockUnbounded: true, Descending: true, WithReversible: true}, 0)
+
+	assert.Equal(t, handoffBackward(), entries)
+}
+
+func TestHandoff_CursorResumption(t *testing.T) {
+	f, cleanup := newHandoffFixture(t)
+	defer cleanup()
+
+	tests := []struct {
+		name       string
+		descending bool
+		limit      int
+		expected   []string
+	}{
+		{"forward in archive", false, 4, handoffForward},
+		{"forward at handoff", false, 9, handoffForward},
+		{"forward in live", false, 10, handoffForward},
+		{"backward in live", true, 3, handoffBackward()},
+		{"backward at handoff", true, 6, handoffBackward()},
+		{"backward in archive", true, 9, handoffBackward()},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := &pbsearch.RouterRequest{Query: handoffQuery, LowBlockNum: 1, HighBlockUnbounded: true, Descending: test.descending, WithReversible: true}
+
+			first, cursor := f.stream(t, req, test.limit)
+			require.Len(t, first, test.limit)
+
+			req.Cursor = cursor
+			rest, _ := f.stream(t, req, 0)
+
+			assert.Equal(t, test.expected, canonical(t, append(first, rest...)))
+		})
+	}
+}
+
+func TestDeliveryGuard_LiveMarkers(t *testing.T) {
+	guard := newDeliveryGuard(false)
+
+	marker := &pbsearch.SearchMatch{BlockNum: 10}
+	assert.True(t, guard.accept(marker, false))
+	assert.True(t, guard.accept(marker, false))
+}
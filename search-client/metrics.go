@@ -0,0 +1,11 @@
+package searchclient
+
+import (
+	"github.com/dfuse-io/dmetrics"
+)
+
+var Metricset = dmetrics.NewSet()
+
+var TransactionCacheHitCount = Metricset.NewCounter("search_client_trx_cache_hit_count", "Number of irreversible transactions found in the search client cache, the hit ratio being hits / (hits + misses)")
+var TransactionCacheMissCount = Metricset.NewCounter("search_client_trx_cache_miss_count", "Number of irreversible transactions not found in the search client cache and fetched from trxdb")
+var TransactionFetchCount = Metricset.NewCounter("search_client_trxdb_fetch_count", "Number of batch requests performed against trxdb to hydrate search matches")
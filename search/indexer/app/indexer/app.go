@@ -0,0 +1,87 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dfuse-io/dfuse-eosio/search/indexer"
+	"github.com/dfuse-io/dstore"
+	"github.com/dfuse-io/shutter"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	BlocksStoreURL     string // GS path to read merged blocks files from
+	IndicesStoreURL    string // GS path where the finished shards are uploaded
+	WritablePath       string // Local path where shards are built before being uploaded
+	StartBlockNum      uint64 // First block to index
+	StopBlockNum       uint64 // Block at which indexing stops (exclusive)
+	ShardSize          uint64 // Number of blocks in each shard
+	Workers            int    // Number of shards indexed concurrently
+	LookbackBlockCount uint64 // Blocks read before each shard to determine irreversibility
+}
+
+type App struct {
+	*shutter.Shutter
+	config *Config
+	mapper indexer.BlockMapper
+}
+
+func New(config *Config, mapper indexer.BlockMapper) *App {
+	return &App{
+		Shutter: shutter.New(),
+		config:  config,
+		mapper:  mapper,
+	}
+}
+
+func (a *App) Run() error {
+	zlog.Info("launching batch search indexer", zap.Reflect("config", a.config))
+
+	blocksStore, err := dstore.NewDBinStore(a.config.BlocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("setting up blocks store: %w", err)
+	}
+
+	indicesStore, err := dstore.NewStore(a.config.IndicesStoreURL, indexer.ShardsStoreExtension, "zstd", true)
+	if err != nil {
+		return fmt.Errorf("setting up indices store: %w", err)
+	}
+
+	batchIndexer, err := indexer.NewBatchIndexer(&indexer.BatchConfig{
+		StartBlockNum:      a.config.StartBlockNum,
+		StopBlockNum:       a.config.StopBlockNum,
+		ShardSize:          a.config.ShardSize,
+		Workers:            a.config.Workers,
+		WritablePath:       a.config.WritablePath,
+		LookbackBlockCount: a.config.LookbackBlockCount,
+	}, a.mapper, blocksStore, indicesStore)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.OnTerminating(func(_ error) {
+		cancel()
+	})
+
+	go func() {
+		a.Shutdown(batchIndexer.Run(ctx))
+	}()
+
+	return nil
+}
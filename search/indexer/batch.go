@@ -0,0 +1,199 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/dfuse-io/bstream"
+	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dstore"
+	"go.uber.org/zap"
+)
+
+// mergedBlocksFileSize is the amount of blocks in each merged blocks file.
+const mergedBlocksFileSize = 100
+
+// ShardsStoreExtension is the extension of the shards objects in the indices
+// store, the store compressing them with zstd.
+const ShardsStoreExtension = "bleve.tar.zst"
+
+// BlockMapper turns blocks into the documents of the index, implemented by
+// `search.EOSBlockMapper`.
+type BlockMapper interface {
+	IndexMapping() *mapping.IndexMappingImpl
+	Map(mapper *mapping.IndexMappingImpl, block *bstream.Block) ([]*document.Document, error)
+}
+
+// configurableMapper is implemented by mappers whose configuration is
+// recorded in the shards, like `search.EOSBlockMapper`.
+type configurableMapper interface {
+	Config() eosSearch.MapperConfig
+}
+
+// mapperConfigKey is the internal key of the shards holding the JSON
+// configuration of the mapper that built them.
+const mapperConfigKey = "mapper_config"
+
+type BatchConfig struct {
+	StartBlockNum      uint64 // First block to index, rounded down to the start of its shard
+	StopBlockNum       uint64 // Block at which indexing stops (exclusive), rounded up to the end of its shard
+	ShardSize          uint64 // Number of blocks in each shard, a multiple of the merged blocks files size
+	Workers            int    // Number of shards indexed concurrently
+	WritablePath       string // Local directory where shards are built before being uploaded
+	LookbackBlockCount uint64 // Blocks read before each shard so its first blocks can be deemed irreversible
+}
+
+func (c *BatchConfig) Validate() error {
+	if c.ShardSize == 0 || c.ShardSize%mergedBlocksFileSize != 0 {
+		return fmt.Errorf("shard size must be a multiple of %d, got %d", mergedBlocksFileSize, c.ShardSize)
+	}
+
+	if c.StopBlockNum <= c.StartBlockNum {
+		return fmt.Errorf("stop block %d must be greater than start block %d", c.StopBlockNum, c.StartBlockNum)
+	}
+
+	if c.Workers <= 0 {
+		return fmt.Errorf("workers must be greater than 0, got %d", c.Workers)
+	}
+
+	if c.WritablePath == "" {
+		return fmt.Errorf("writable path is required")
+	}
+
+	return nil
+}
+
+// BatchIndexer builds the archive shards of a block range out of the merged
+// blocks files. The range is split in shard-sized jobs processed by parallel
+// workers, each shard being uploaded once complete under a name derived only
+// from its range. Shards already present in the indices store are skipped, a
+// new run over the same range thus resumes where an interrupted one stopped.
+type BatchIndexer struct {
+	config       *BatchConfig
+	mapper       BlockMapper
+	blocksStore  dstore.Store
+	indicesStore dstore.Store
+}
+
+func NewBatchIndexer(config *BatchConfig, mapper BlockMapper, blocksStore, indicesStore dstore.Store) (*BatchIndexer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &BatchIndexer{
+		config:       config,
+		mapper:       mapper,
+		blocksStore:  blocksStore,
+		indicesStore: indicesStore,
+	}, nil
+}
+
+// ShardFilename is the name, without extension, of the shard starting at
+// `baseBlockNum` in the indices store.
+func ShardFilename(shardSize, baseBlockNum uint64) string {
+	return fmt.Sprintf("shards-%d/%010d", shardSize, baseBlockNum)
+}
+
+// PendingShards returns the base block of every shard of the range not yet
+// found in the indices store, in increasing order.
+func (b *BatchIndexer) PendingShards(ctx context.Context) (out []uint64, err error) {
+	shardSize := b.config.ShardSize
+	for baseNum := b.config.StartBlockNum - b.config.StartBlockNum%shardSize; baseNum < b.config.StopBlockNum; baseNum += shardSize {
+		exists, err := b.indicesStore.FileExists(ctx, ShardFilename(shardSize, baseNum))
+		if err != nil {
+			return nil, fmt.Errorf("checking shard %d: %w", baseNum, err)
+		}
+
+		if exists {
+			zlog.Debug("shard already indexed, skipping", zap.Uint64("base_block_num", baseNum))
+			continue
+		}
+
+		out = append(out, baseNum)
+	}
+
+	return out, nil
+}
+
+// Run indexes every pending shard of the range, returning the first error a
+// worker encountered once all workers stopped.
+func (b *BatchIndexer) Run(ctx context.Context) error {
+	pending, err := b.PendingShards(ctx)
+	if err != nil {
+		return err
+	}
+
+	zlog.Info("starting batch indexing",
+		zap.Uint64("start_block_num", b.config.StartBlockNum),
+		zap.Uint64("stop_block_num", b.config.StopBlockNum),
+		zap.Int("pending_shards", len(pending)),
+		zap.Int("workers", b.config.Workers),
+	)
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan uint64)
+	errs := make(chan error, b.config.Workers)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < b.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for baseNum := range jobs {
+				if err := b.indexShard(workerCtx, baseNum); err != nil {
+					errs <- fmt.Errorf("indexing shard %d: %w", baseNum, err)
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for _, baseNum := range pending {
+		select {
+		case jobs <- baseNum:
+		case <-workerCtx.Done():
+			break dispatch
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	zlog.Info("batch indexing completed", zap.Int("indexed_shards", len(pending)))
+	return nil
+}
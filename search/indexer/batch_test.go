@@ -0,0 +1,263 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dstore"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMapper produces one action document per block, from account
+// `account` in transaction `testTrxID(blockNum)`.
+type testMapper struct {
+	account string
+}
+
+func (m testMapper) IndexMapping() *mapping.IndexMappingImpl {
+	return bleve.NewIndexMapping()
+}
+
+func (m testMapper) Map(mapper *mapping.IndexMappingImpl, block *bstream.Block) ([]*document.Document, error) {
+	doc := document.NewDocument(eosSearch.EOSDocumentID(block.Num(), testTrxID(block.Num()), 0))
+	if err := mapper.MapDocument(doc, map[string]interface{}{"account": m.account}); err != nil {
+		return nil, err
+	}
+
+	return []*document.Document{doc}, nil
+}
+
+func (m testMapper) Config() eosSearch.MapperConfig {
+	return eosSearch.MapperConfig{FilterOn: fmt.Sprintf("account == %q", m.account)}
+}
+
+func testTrxID(blockNum uint64) string {
+	return fmt.Sprintf("%064x", blockNum)
+}
+
+func TestBatchIndexer_PendingShards(t *testing.T) {
+	_, indicesStore, dir := newTestStores(t)
+	defer os.RemoveAll(dir)
+	require.NoError(t, indicesStore.WriteObject(context.Background(), ShardFilename(200, 200), strings.NewReader("done")))
+
+	batchIndexer, err := NewBatchIndexer(&BatchConfig{StartBlockNum: 150, StopBlockNum: 601, ShardSize: 200, Workers: 1, WritablePath: "/tmp"}, testMapper{account: "eosio"}, nil, indicesStore)
+	require.NoError(t, err)
+
+	pending, err := batchIndexer.PendingShards(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 400, 600}, pending)
+}
+
+func TestBatchConfig_Validate(t *testing.T) {
+	valid := BatchConfig{StartBlockNum: 0, StopBlockNum: 200, ShardSize: 200, Workers: 2, WritablePath: "/tmp"}
+	assert.NoError(t, valid.Validate())
+
+	invalid := valid
+	invalid.ShardSize = 150
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.StopBlockNum = 0
+	assert.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Workers = 0
+	assert.Error(t, invalid.Validate())
+}
+
+func TestBatchIndexer_Run(t *testing.T) {
+	blocksStore, indicesStore, dir := newTestStores(t)
+	defer os.RemoveAll(dir)
+	writeMergedBlocks(t, blocksStore, testChain(t, 450))
+
+	// Already indexed by a previous run, must be left untouched
+	require.NoError(t, indicesStore.WriteObject(context.Background(), ShardFilename(200, 0), strings.NewReader("done")))
+
+	config := &BatchConfig{
+		StartBlockNum:      0,
+		StopBlockNum:       400,
+		ShardSize:          200,
+		Workers:            2,
+		WritablePath:       filepath.Join(dir, "writable"),
+		LookbackBlockCount: 100,
+	}
+
+	batchIndexer, err := NewBatchIndexer(config, testMapper{account: "eosio"}, blocksStore, indicesStore)
+	require.NoError(t, err)
+	require.NoError(t, batchIndexer.Run(context.Background()))
+
+	assert.Equal(t, "done", readObject(t, indicesStore, ShardFilename(200, 0)))
+
+	idx := openShard(t, indicesStore, ShardFilename(200, 200), filepath.Join(dir, "extracted"))
+	defer idx.Close()
+
+	reader, err := idx.Reader()
+	require.NoError(t, err)
+	defer reader.Close()
+
+	count, err := reader.DocCount()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200+6), count, "one document per block plus the boundary documents")
+
+	for _, id := range []string{eosSearch.EOSDocumentID(200, testTrxID(200), 0), eosSearch.EOSDocumentID(399, testTrxID(399), 0), "meta:boundary:start_num:200", "meta:boundary:end_num:399", "meta:boundary:end_id:0000018faa"} {
+		doc, err := reader.Document(id)
+		require.NoError(t, err)
+		assert.NotNil(t, doc, id)
+	}
+
+	// Local files are cleaned up once the shard is uploaded
+	files, err := ioutil.ReadDir(config.WritablePath)
+	require.NoError(t, err)
+	assert.Len(t, files, 0)
+}
+
+func TestBatchIndexer_RunMissingBlocks(t *testing.T) {
+	blocksStore, indicesStore, dir := newTestStores(t)
+	defer os.RemoveAll(dir)
+	writeMergedBlocks(t, blocksStore, testChain(t, 450))
+
+	config := &BatchConfig{StopBlockNum: 600, ShardSize: 200, Workers: 1, WritablePath: filepath.Join(dir, "writable"), LookbackBlockCount: 100}
+	batchIndexer, err := NewBatchIndexer(config, testMapper{account: "eosio"}, blocksStore, indicesStore)
+	require.NoError(t, err)
+
+	err = batchIndexer.Run(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "shard 400")
+
+	pending, err := batchIndexer.PendingShards(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{400}, pending, "complete shards are kept, the next run resumes with the missing one")
+}
+
+func newTestStores(t *testing.T) (blocksStore, indicesStore dstore.Store, dir string) {
+	dir, err := ioutil.TempDir("", "indexer")
+	require.NoError(t, err)
+
+	blocksStore, err = dstore.NewDBinStore("file://" + filepath.Join(dir, "blocks"))
+	require.NoError(t, err)
+	blocksStore.SetOverwrite(true)
+
+	indicesStore, err = dstore.NewStore("file://"+filepath.Join(dir, "indices"), ShardsStoreExtension, "zstd", true)
+	require.NoError(t, err)
+
+	return blocksStore, indicesStore, dir
+}
+
+func testChain(t *testing.T, count int) (out []*pbcodec.Block) {
+	for num := uint32(1); num <= uint32(count); num++ {
+		blockTime, err := ptypes.TimestampProto(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(num) * 500 * time.Millisecond))
+		require.NoError(t, err)
+
+		out = append(out, &pbcodec.Block{
+			Id:                       fmt.Sprintf("%08xaa", num),
+			Number:                   num,
+			DposIrreversibleBlocknum: num - 1,
+			Header: &pbcodec.BlockHeader{
+				Previous:  fmt.Sprintf("%08xaa", num-1),
+				Producer:  "eosio",
+				Timestamp: blockTime,
+			},
+		})
+	}
+
+	return
+}
+
+func writeMergedBlocks(t *testing.T, store dstore.Store, blocks []*pbcodec.Block) {
+	buffers := map[uint32]*bytes.Buffer{}
+	writers := map[uint32]*codec.BlockWriter{}
+
+	for _, block := range blocks {
+		baseNum := block.Number - block.Number%mergedBlocksFileSize
+		if writers[baseNum] == nil {
+			buffers[baseNum] = &bytes.Buffer{}
+
+			writer, err := codec.NewBlockWriter(buffers[baseNum])
+			require.NoError(t, err)
+			writers[baseNum] = writer
+		}
+
+		blk, err := codec.BlockFromProto(block)
+		require.NoError(t, err)
+		require.NoError(t, writers[baseNum].Write(blk))
+	}
+
+	for baseNum, buffer := range buffers {
+		require.NoError(t, store.WriteObject(context.Background(), fmt.Sprintf("%010d", baseNum), buffer))
+	}
+}
+
+func readObject(t *testing.T, store dstore.Store, filename string) string {
+	reader, err := store.OpenObject(context.Background(), filename)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	content, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+
+	return string(content)
+}
+
+func openShard(t *testing.T, store dstore.Store, filename string, dir string) index.Index {
+	reader, err := store.OpenObject(context.Background(), filename)
+	require.NoError(t, err)
+	defer reader.Close()
+
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		path := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if header.Typeflag == tar.TypeDir {
+			require.NoError(t, os.MkdirAll(path, 0755))
+			continue
+		}
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		content, err := ioutil.ReadAll(tarReader)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(path, content, 0644))
+	}
+
+	idx, err := OpenShard(dir)
+	require.NoError(t, err)
+
+	return idx
+}
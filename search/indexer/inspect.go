@@ -0,0 +1,474 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/dfuse-io/bstream"
+	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dstore"
+)
+
+const boundaryPrefix = "meta:boundary:"
+
+// ShardInfo is the metadata of a shard, as recorded by its boundary
+// documents and, for shards built in batch mode, its mapper configuration.
+type ShardInfo struct {
+	StartBlockNum  uint64                  `json:"start_block_num"`
+	StartBlockID   string                  `json:"start_block_id"`
+	StartBlockTime string                  `json:"start_block_time"`
+	EndBlockNum    uint64                  `json:"end_block_num"`
+	EndBlockID     string                  `json:"end_block_id"`
+	EndBlockTime   string                  `json:"end_block_time"`
+	DocumentCount  uint64                  `json:"document_count"`
+	Fields         []string                `json:"fields"`
+	MapperConfig   *eosSearch.MapperConfig `json:"mapper_config,omitempty"`
+}
+
+// DocumentTerms are the terms indexed for each field of a document, terms
+// that are not printable (numeric fields) being hex encoded.
+type DocumentTerms struct {
+	ID     string              `json:"id"`
+	Fields map[string][]string `json:"fields"`
+}
+
+// FieldTerm is a term of a field along with the amount of documents
+// containing it.
+type FieldTerm struct {
+	Term  string `json:"term"`
+	Count uint64 `json:"count"`
+}
+
+// OpenShard opens the local bleve shard at `path` in read-only mode.
+func OpenShard(path string) (index.Index, error) {
+	idx, err := scorch.NewScorch("eos", map[string]interface{}{
+		"path":      path,
+		"read_only": true,
+	}, index.NewAnalysisQueue(1))
+	if err != nil {
+		return nil, fmt.Errorf("creating index: %w", err)
+	}
+
+	if err := idx.Open(); err != nil {
+		return nil, fmt.Errorf("opening index %q: %w", path, err)
+	}
+
+	return idx, nil
+}
+
+func ReadShardInfo(reader index.IndexReader) (*ShardInfo, error) {
+	info := &ShardInfo{}
+
+	ids, err := documentIDs(reader, func(id string) bool { return strings.HasPrefix(id, boundaryPrefix) })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		chunks := strings.SplitN(strings.TrimPrefix(id, boundaryPrefix), ":", 2)
+		if len(chunks) != 2 {
+			continue
+		}
+
+		switch chunks[0] {
+		case "start_num":
+			info.StartBlockNum, err = strconv.ParseUint(chunks[1], 10, 64)
+		case "end_num":
+			info.EndBlockNum, err = strconv.ParseUint(chunks[1], 10, 64)
+		case "start_id":
+			info.StartBlockID = chunks[1]
+		case "end_id":
+			info.EndBlockID = chunks[1]
+		case "start_time":
+			info.StartBlockTime = chunks[1]
+		case "end_time":
+			info.EndBlockTime = chunks[1]
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid boundary document %q: %w", id, err)
+		}
+	}
+
+	if info.DocumentCount, err = reader.DocCount(); err != nil {
+		return nil, fmt.Errorf("counting documents: %w", err)
+	}
+
+	if info.Fields, err = indexedFields(reader); err != nil {
+		return nil, err
+	}
+
+	config, err := reader.GetInternal([]byte(mapperConfigKey))
+	if err != nil {
+		return nil, fmt.Errorf("reading mapper config: %w", err)
+	}
+
+	if len(config) != 0 {
+		info.MapperConfig = &eosSearch.MapperConfig{}
+		if err := json.Unmarshal(config, info.MapperConfig); err != nil {
+			return nil, fmt.Errorf("decoding mapper config: %w", err)
+		}
+	}
+
+	return info, nil
+}
+
+// DocumentMatcher selects the action documents of a block, of a transaction
+// (by prefix) or both, `0` and empty meaning any. The `meta:blknum`
+// document of a selected block is selected too.
+func DocumentMatcher(blockNum uint64, trxIDPrefix string) func(id string) bool {
+	return func(id string) bool {
+		if strings.HasPrefix(id, "meta:blknum:") {
+			return trxIDPrefix == "" && blockNum != 0 && id == fmt.Sprintf("meta:blknum:%d", blockNum)
+		}
+
+		docBlockNum, trxID, _, skip := eosSearch.ExplodeEOSDocumentID(id)
+		if skip {
+			return false
+		}
+
+		if blockNum != 0 && docBlockNum != blockNum {
+			return false
+		}
+
+		return trxIDPrefix == "" || strings.HasPrefix(trxID, trxIDPrefix) || strings.HasPrefix(trxIDPrefix, trxID)
+	}
+}
+
+// ShardDocuments returns the indexed terms of the documents selected by
+// `match`, sorted by ID. Terms are gathered by walking the dictionary and
+// postings of every field, which reads the whole shard.
+func ShardDocuments(reader index.IndexReader, match func(id string) bool) ([]*DocumentTerms, error) {
+	internalIDs := map[string]string{}
+	err := visitDocumentIDs(reader, func(internalID index.IndexInternalID, id string) {
+		if match(id) {
+			internalIDs[string(internalID)] = id
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	documents := map[string]*DocumentTerms{}
+	for _, id := range internalIDs {
+		documents[id] = &DocumentTerms{ID: id, Fields: map[string][]string{}}
+	}
+
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	fields, err := indexedFields(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		err := visitFieldTerms(reader, field, func(term string, count uint64) error {
+			termReader, err := reader.TermFieldReader([]byte(term), field, false, false, false)
+			if err != nil {
+				return fmt.Errorf("reading postings of %s:%q: %w", field, term, err)
+			}
+			defer termReader.Close()
+
+			for {
+				posting, err := termReader.Next(nil)
+				if err != nil {
+					return fmt.Errorf("reading postings of %s:%q: %w", field, term, err)
+				}
+
+				if posting == nil {
+					return nil
+				}
+
+				if id, found := internalIDs[string(posting.ID)]; found {
+					documents[id].Fields[field] = append(documents[id].Fields[field], printableTerm([]byte(term)))
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sortedDocuments(documents), nil
+}
+
+// FieldTerms returns up to `limit` terms of `field` (all of them when 0)
+// with their document count.
+func FieldTerms(reader index.IndexReader, field string, limit int) (out []*FieldTerm, err error) {
+	err = visitFieldTerms(reader, field, func(term string, count uint64) error {
+		if limit > 0 && len(out) >= limit {
+			return nil
+		}
+
+		out = append(out, &FieldTerm{Term: printableTerm([]byte(term)), Count: count})
+		return nil
+	})
+
+	return out, err
+}
+
+// MappedDocuments returns the terms of documents produced by a mapper, as
+// they would be indexed.
+func MappedDocuments(docs []*document.Document) []*DocumentTerms {
+	documents := map[string]*DocumentTerms{}
+	for _, doc := range docs {
+		terms := &DocumentTerms{ID: doc.ID, Fields: map[string][]string{}}
+		for _, field := range doc.Fields {
+			if !field.Options().IsIndexed() {
+				continue
+			}
+
+			_, frequencies := field.Analyze()
+			for _, frequency := range frequencies {
+				terms.Fields[field.Name()] = append(terms.Fields[field.Name()], printableTerm(frequency.Term))
+			}
+		}
+
+		documents[doc.ID] = terms
+	}
+
+	return sortedDocuments(documents)
+}
+
+// VerifyShard maps again the irreversible blocks of the shard's range from
+// the merged blocks files and returns the differences between the produced
+// documents and the ones stored in the shard, none meaning the shard is
+// consistent with the mapper.
+func VerifyShard(ctx context.Context, reader index.IndexReader, mapper BlockMapper, blocksStore dstore.Store, lookbackBlockCount uint64) ([]string, error) {
+	info, err := ReadShardInfo(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.EndBlockNum == 0 {
+		return nil, fmt.Errorf("shard has no boundary documents, unable to determine its range")
+	}
+
+	mapping := mapper.IndexMapping()
+
+	var mapped []*document.Document
+	blocks := &blockRange{
+		startBlockNum: info.StartBlockNum,
+		endBlockNum:   info.EndBlockNum,
+		process: func(blk *bstream.Block) error {
+			docs, err := mapper.Map(mapping, blk)
+			if err != nil {
+				return fmt.Errorf("mapping block %s: %w", blk, err)
+			}
+
+			mapped = append(mapped, docs...)
+			return nil
+		},
+	}
+
+	if err := readIrreversibleBlocks(ctx, blocksStore, lookbackBlockCount, blocks); err != nil {
+		return nil, err
+	}
+
+	stored, err := ShardDocuments(reader, func(id string) bool { return !strings.HasPrefix(id, boundaryPrefix) })
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffDocuments(MappedDocuments(mapped), stored), nil
+}
+
+// DiffDocuments describes the documents missing from `actual`, the
+// unexpected ones and, for documents in both, the terms differing per field.
+func DiffDocuments(expected, actual []*DocumentTerms) (out []string) {
+	actualByID := map[string]*DocumentTerms{}
+	for _, doc := range actual {
+		actualByID[doc.ID] = doc
+	}
+
+	for _, expectedDoc := range expected {
+		actualDoc, found := actualByID[expectedDoc.ID]
+		if !found {
+			out = append(out, fmt.Sprintf("document %s: missing from shard", expectedDoc.ID))
+			continue
+		}
+		delete(actualByID, expectedDoc.ID)
+
+		for _, field := range unionFields(expectedDoc, actualDoc) {
+			missing, unexpected := diffTerms(expectedDoc.Fields[field], actualDoc.Fields[field])
+			if len(missing) != 0 {
+				out = append(out, fmt.Sprintf("document %s: field %s is missing terms %v", expectedDoc.ID, field, missing))
+			}
+
+			if len(unexpected) != 0 {
+				out = append(out, fmt.Sprintf("document %s: field %s has unexpected terms %v", expectedDoc.ID, field, unexpected))
+			}
+		}
+	}
+
+	for _, doc := range actual {
+		if _, found := actualByID[doc.ID]; found {
+			out = append(out, fmt.Sprintf("document %s: not produced by mapper", doc.ID))
+		}
+	}
+
+	return out
+}
+
+func unionFields(a, b *DocumentTerms) (out []string) {
+	seen := map[string]bool{}
+	for _, doc := range []*DocumentTerms{a, b} {
+		for field := range doc.Fields {
+			if !seen[field] {
+				seen[field] = true
+				out = append(out, field)
+			}
+		}
+	}
+
+	sort.Strings(out)
+	return out
+}
+
+func diffTerms(expected, actual []string) (missing, unexpected []string) {
+	actualSet := map[string]bool{}
+	for _, term := range actual {
+		actualSet[term] = true
+	}
+
+	expectedSet := map[string]bool{}
+	for _, term := range expected {
+		expectedSet[term] = true
+		if !actualSet[term] {
+			missing = append(missing, term)
+		}
+	}
+
+	for _, term := range actual {
+		if !expectedSet[term] {
+			unexpected = append(unexpected, term)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	return
+}
+
+func sortedDocuments(documents map[string]*DocumentTerms) (out []*DocumentTerms) {
+	for _, doc := range documents {
+		for _, terms := range doc.Fields {
+			sort.Strings(terms)
+		}
+		out = append(out, doc)
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out
+}
+
+func documentIDs(reader index.IndexReader, match func(id string) bool) (out []string, err error) {
+	err = visitDocumentIDs(reader, func(_ index.IndexInternalID, id string) {
+		if match(id) {
+			out = append(out, id)
+		}
+	})
+
+	return out, err
+}
+
+func visitDocumentIDs(reader index.IndexReader, visitor func(internalID index.IndexInternalID, id string)) error {
+	idReader, err := reader.DocIDReaderAll()
+	if err != nil {
+		return fmt.Errorf("reading document ids: %w", err)
+	}
+	defer idReader.Close()
+
+	for {
+		internalID, err := idReader.Next()
+		if err != nil {
+			return fmt.Errorf("reading document ids: %w", err)
+		}
+
+		if internalID == nil {
+			return nil
+		}
+
+		id, err := reader.ExternalID(internalID)
+		if err != nil {
+			return fmt.Errorf("resolving document id: %w", err)
+		}
+
+		visitor(append(index.IndexInternalID(nil), internalID...), id)
+	}
+}
+
+// indexedFields are the fields of the shard, without the internal `_id` and
+// `_all` ones.
+func indexedFields(reader index.IndexReader) (out []string, err error) {
+	fields, err := reader.Fields()
+	if err != nil {
+		return nil, fmt.Errorf("listing fields: %w", err)
+	}
+
+	for _, field := range fields {
+		if field != "_id" && field != "_all" {
+			out = append(out, field)
+		}
+	}
+
+	sort.Strings(out)
+	return out, nil
+}
+
+func visitFieldTerms(reader index.IndexReader, field string, visitor func(term string, count uint64) error) error {
+	dict, err := reader.FieldDict(field)
+	if err != nil {
+		return fmt.Errorf("reading terms of field %s: %w", field, err)
+	}
+	defer dict.Close()
+
+	for {
+		entry, err := dict.Next()
+		if err != nil {
+			return fmt.Errorf("reading terms of field %s: %w", field, err)
+		}
+
+		if entry == nil {
+			return nil
+		}
+
+		if err := visitor(entry.Term, entry.Count); err != nil {
+			return err
+		}
+	}
+}
+
+func printableTerm(term []byte) string {
+	if utf8.Valid(term) && strings.IndexFunc(string(term), func(r rune) bool { return !unicode.IsPrint(r) }) == -1 {
+		return string(term)
+	}
+
+	return "0x" + hex.EncodeToString(term)
+}
@@ -0,0 +1,114 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/blevesearch/bleve/index"
+	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspectShard(t *testing.T) {
+	_, reader, cleanup := buildTestShard(t)
+	defer cleanup()
+
+	info, err := ReadShardInfo(reader)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), info.StartBlockNum)
+	assert.Equal(t, "000000c8aa", info.StartBlockID)
+	assert.Equal(t, uint64(399), info.EndBlockNum)
+	assert.Equal(t, "0000018faa", info.EndBlockID)
+	assert.Equal(t, uint64(206), info.DocumentCount)
+	assert.Equal(t, []string{"account"}, info.Fields)
+	assert.Equal(t, &eosSearch.MapperConfig{FilterOn: `account == "eosio"`}, info.MapperConfig)
+
+	expected := []*DocumentTerms{{ID: eosSearch.EOSDocumentID(250, testTrxID(250), 0), Fields: map[string][]string{"account": {"eosio"}}}}
+
+	documents, err := ShardDocuments(reader, DocumentMatcher(250, ""))
+	require.NoError(t, err)
+	assert.Equal(t, expected, documents)
+
+	documents, err = ShardDocuments(reader, DocumentMatcher(0, testTrxID(250)))
+	require.NoError(t, err)
+	assert.Equal(t, expected, documents)
+
+	documents, err = ShardDocuments(reader, DocumentMatcher(100, ""))
+	require.NoError(t, err)
+	assert.Len(t, documents, 0)
+
+	terms, err := FieldTerms(reader, "account", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []*FieldTerm{{Term: "eosio", Count: 200}}, terms)
+}
+
+func TestVerifyShard(t *testing.T) {
+	blocksStore, reader, cleanup := buildTestShard(t)
+	defer cleanup()
+
+	differences, err := VerifyShard(context.Background(), reader, testMapper{account: "eosio"}, blocksStore, 100)
+	require.NoError(t, err)
+	assert.Len(t, differences, 0)
+
+	differences, err = VerifyShard(context.Background(), reader, testMapper{account: "bob"}, blocksStore, 100)
+	require.NoError(t, err)
+	assert.Len(t, differences, 400, "a missing and an unexpected term for each document")
+	assert.Contains(t, differences, "document "+eosSearch.EOSDocumentID(200, testTrxID(200), 0)+": field account is missing terms [bob]")
+	assert.Contains(t, differences, "document "+eosSearch.EOSDocumentID(200, testTrxID(200), 0)+": field account has unexpected terms [eosio]")
+}
+
+func TestDiffDocuments(t *testing.T) {
+	expected := []*DocumentTerms{
+		{ID: "a", Fields: map[string][]string{"account": {"eosio"}}},
+		{ID: "b", Fields: map[string][]string{"account": {"eosio"}}},
+	}
+	actual := []*DocumentTerms{
+		{ID: "a", Fields: map[string][]string{"account": {"eosio"}, "auth": {"bob"}}},
+		{ID: "c", Fields: map[string][]string{}},
+	}
+
+	assert.Equal(t, []string{
+		"document a: field auth has unexpected terms [bob]",
+		"document b: missing from shard",
+		"document c: not produced by mapper",
+	}, DiffDocuments(expected, actual))
+}
+
+// buildTestShard indexes the shard of blocks 200 to 399 and opens it
+func buildTestShard(t *testing.T) (dstore.Store, index.IndexReader, func()) {
+	blocksStore, indicesStore, dir := newTestStores(t)
+	writeMergedBlocks(t, blocksStore, testChain(t, 450))
+
+	config := &BatchConfig{StartBlockNum: 200, StopBlockNum: 400, ShardSize: 200, Workers: 1, WritablePath: filepath.Join(dir, "writable"), LookbackBlockCount: 100}
+	batchIndexer, err := NewBatchIndexer(config, testMapper{account: "eosio"}, blocksStore, indicesStore)
+	require.NoError(t, err)
+	require.NoError(t, batchIndexer.Run(context.Background()))
+
+	idx := openShard(t, indicesStore, ShardFilename(200, 200), filepath.Join(dir, "extracted"))
+	reader, err := idx.Reader()
+	require.NoError(t, err)
+
+	return blocksStore, reader, func() {
+		reader.Close()
+		idx.Close()
+		os.RemoveAll(dir)
+	}
+}
@@ -0,0 +1,349 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexer
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/blevesearch/bleve/document"
+	"github.com/blevesearch/bleve/index"
+	"github.com/blevesearch/bleve/index/scorch"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	"github.com/dfuse-io/dstore"
+	"github.com/dfuse-io/search"
+	"go.uber.org/zap"
+)
+
+// maxBatchDocuments is the amount of documents accumulated before they are
+// written to the shard being built.
+const maxBatchDocuments = 5000
+
+// indexShard builds the shard starting at `baseBlockNum` from the
+// irreversible blocks of the merged blocks files and uploads it. Local files
+// left by an interrupted run are discarded first.
+func (b *BatchIndexer) indexShard(ctx context.Context, baseBlockNum uint64) error {
+	zlog.Info("indexing shard", zap.Uint64("base_block_num", baseBlockNum))
+	startTime := time.Now()
+
+	if err := os.MkdirAll(b.config.WritablePath, 0755); err != nil {
+		return fmt.Errorf("creating writable path: %w", err)
+	}
+
+	indexPath := filepath.Join(b.config.WritablePath, fmt.Sprintf("%010d.bleve", baseBlockNum))
+	tarballPath := indexPath + ".tar"
+	for _, path := range []string{indexPath, tarballPath} {
+		if err := os.RemoveAll(path); err != nil {
+			return fmt.Errorf("removing stale %q: %w", path, err)
+		}
+		defer os.RemoveAll(path)
+	}
+
+	analysisQueue := index.NewAnalysisQueue(2)
+	defer analysisQueue.Close()
+
+	idx, err := scorch.NewScorch("eos", map[string]interface{}{
+		"path":         indexPath,
+		"unsafe_batch": true,
+	}, analysisQueue)
+	if err != nil {
+		return fmt.Errorf("creating index: %w", err)
+	}
+
+	if err := idx.Open(); err != nil {
+		return fmt.Errorf("opening index: %w", err)
+	}
+
+	builder := &shardBuilder{
+		mapper:  b.mapper,
+		mapping: b.mapper.IndexMapping(),
+		idx:     idx,
+		batch:   index.NewBatch(),
+	}
+	blocks := &blockRange{
+		startBlockNum: baseBlockNum,
+		endBlockNum:   baseBlockNum + b.config.ShardSize - 1,
+		process:       builder.processBlock,
+	}
+
+	err = readIrreversibleBlocks(ctx, b.blocksStore, b.config.LookbackBlockCount, blocks)
+	if err == nil {
+		err = builder.close(blocks)
+	}
+
+	if closeErr := idx.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("closing index: %w", closeErr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := writeTarball(indexPath, tarballPath); err != nil {
+		return fmt.Errorf("archiving index: %w", err)
+	}
+
+	if err := b.upload(ctx, tarballPath, ShardFilename(b.config.ShardSize, baseBlockNum)); err != nil {
+		return err
+	}
+
+	zlog.Info("shard indexed",
+		zap.Uint64("base_block_num", baseBlockNum),
+		zap.Int("document_count", builder.documentCount),
+		zap.Duration("elapsed", time.Since(startTime)),
+	)
+	return nil
+}
+
+func (b *BatchIndexer) upload(ctx context.Context, tarballPath, filename string) error {
+	tarball, err := os.Open(tarballPath)
+	if err != nil {
+		return fmt.Errorf("opening archived index: %w", err)
+	}
+	defer tarball.Close()
+
+	if err := b.indicesStore.WriteObject(ctx, filename, tarball); err != nil {
+		return fmt.Errorf("uploading shard %q: %w", filename, err)
+	}
+
+	return nil
+}
+
+// blockRange passes the irreversible blocks between `startBlockNum` and
+// `endBlockNum` (inclusive) to `process`, being done once past the range.
+type blockRange struct {
+	startBlockNum uint64
+	endBlockNum   uint64
+	process       func(blk *bstream.Block) error
+
+	firstBlock *bstream.Block
+	lastBlock  *bstream.Block
+	done       bool
+}
+
+func (r *blockRange) ProcessBlock(blk *bstream.Block, obj interface{}) error {
+	blockNum := blk.Num()
+	if r.done || blockNum < r.startBlockNum {
+		return nil
+	}
+
+	if blockNum > r.endBlockNum {
+		r.done = true
+		return nil
+	}
+
+	if r.firstBlock == nil {
+		r.firstBlock = blk
+	}
+	r.lastBlock = blk
+	r.done = blockNum == r.endBlockNum
+
+	return r.process(blk)
+}
+
+// readIrreversibleBlocks feeds the irreversible blocks of the range to its
+// handler, reading `lookbackBlockCount` blocks ahead of it so the forkable
+// links its first blocks, and past its end until they all became
+// irreversible.
+func readIrreversibleBlocks(ctx context.Context, blocksStore dstore.Store, lookbackBlockCount uint64, blocks *blockRange) error {
+	readFrom := uint64(0)
+	if blocks.startBlockNum > lookbackBlockCount {
+		readFrom = blocks.startBlockNum - lookbackBlockCount
+	}
+
+	handler := forkable.New(blocks, forkable.WithFilters(forkable.StepIrreversible))
+
+	for fileNum := readFrom - readFrom%mergedBlocksFileSize; !blocks.done; fileNum += mergedBlocksFileSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		filename := fmt.Sprintf("%010d", fileNum)
+		exists, err := blocksStore.FileExists(ctx, filename)
+		if err != nil {
+			return fmt.Errorf("checking merged blocks file %q: %w", filename, err)
+		}
+
+		if !exists {
+			return fmt.Errorf("merged blocks file %q missing, range ends at block %d", filename, blocks.endBlockNum)
+		}
+
+		if err := readMergedBlocksFile(ctx, blocksStore, filename, handler, blocks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readMergedBlocksFile(ctx context.Context, blocksStore dstore.Store, filename string, handler bstream.Handler, blocks *blockRange) error {
+	reader, err := blocksStore.OpenObject(ctx, filename)
+	if err != nil {
+		return fmt.Errorf("opening merged blocks file %q: %w", filename, err)
+	}
+	defer reader.Close()
+
+	blockReader, err := codec.NewBlockReader(reader)
+	if err != nil {
+		return fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+	}
+
+	for !blocks.done {
+		block, err := blockReader.Read()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading merged blocks file %q: %w", filename, err)
+		}
+
+		if err := handler.ProcessBlock(block, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shardBuilder maps the blocks of the shard into the index, then writes the
+// boundary documents the archive needs to serve it along with the mapper's
+// configuration.
+type shardBuilder struct {
+	mapper  BlockMapper
+	mapping *mapping.IndexMappingImpl
+	idx     index.Index
+
+	batch         *index.Batch
+	documentCount int
+}
+
+func (s *shardBuilder) processBlock(blk *bstream.Block) error {
+	docs, err := s.mapper.Map(s.mapping, blk)
+	if err != nil {
+		return fmt.Errorf("mapping block %s: %w", blk, err)
+	}
+
+	for _, doc := range docs {
+		s.batch.Update(doc)
+	}
+	s.documentCount += len(docs)
+
+	if len(s.batch.IndexOps) >= maxBatchDocuments {
+		return s.flush()
+	}
+
+	return nil
+}
+
+func (s *shardBuilder) flush() error {
+	if err := s.idx.Batch(s.batch); err != nil {
+		return fmt.Errorf("writing documents: %w", err)
+	}
+
+	s.batch = index.NewBatch()
+	return nil
+}
+
+func (s *shardBuilder) close(blocks *blockRange) error {
+	if blocks.firstBlock == nil {
+		return fmt.Errorf("no irreversible blocks between %d and %d", blocks.startBlockNum, blocks.endBlockNum)
+	}
+
+	for _, id := range []string{
+		fmt.Sprintf("meta:boundary:start_num:%d", blocks.startBlockNum),
+		fmt.Sprintf("meta:boundary:start_id:%s", blocks.firstBlock.ID()),
+		fmt.Sprintf("meta:boundary:start_time:%s", blocks.firstBlock.Timestamp.UTC().Format(search.TimeFormatBleveID)),
+		fmt.Sprintf("meta:boundary:end_num:%d", blocks.endBlockNum),
+		fmt.Sprintf("meta:boundary:end_id:%s", blocks.lastBlock.ID()),
+		fmt.Sprintf("meta:boundary:end_time:%s", blocks.lastBlock.Timestamp.UTC().Format(search.TimeFormatBleveID)),
+	} {
+		s.batch.Update(document.NewDocument(id))
+	}
+
+	if configurable, ok := s.mapper.(configurableMapper); ok {
+		config, err := json.Marshal(configurable.Config())
+		if err != nil {
+			return fmt.Errorf("encoding mapper config: %w", err)
+		}
+
+		s.batch.SetInternal([]byte(mapperConfigKey), config)
+	}
+
+	return s.flush()
+}
+
+// writeTarball archives the files of the index directory, their names
+// relative to it.
+func writeTarball(dir, tarballPath string) error {
+	file, err := os.Create(tarballPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer := tar.NewWriter(file)
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || path == dir {
+			return err
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+
+		if err := writer.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		content, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer content.Close()
+
+		_, err = io.Copy(writer, content)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
@@ -28,7 +28,17 @@ type eventsConfig struct {
 	unrestricted bool
 }
 
+// MapperConfig is the configuration an `EOSBlockMapper` was created with,
+// recorded in the shards it builds.
+type MapperConfig struct {
+	EventsActionName   string `json:"events_action_name,omitempty"`
+	EventsUnrestricted bool   `json:"events_unrestricted,omitempty"`
+	FilterOn           string `json:"filter_on,omitempty"`
+	FilterOut          string `json:"filter_out,omitempty"`
+}
+
 type EOSBlockMapper struct {
+	config           MapperConfig
 	eventsConfig     eventsConfig
 	restrictions     []*restriction
 	filterOnProgram  cel.Program
@@ -47,6 +57,12 @@ func NewEOSBlockMapper(eventsActionName string, eventsUnrestricted bool, filterO
 	}
 
 	return &EOSBlockMapper{
+		config: MapperConfig{
+			EventsActionName:   eventsActionName,
+			EventsUnrestricted: eventsUnrestricted,
+			FilterOn:           filterOn,
+			FilterOut:          filterOut,
+		},
 		eventsConfig: eventsConfig{
 			actionName:   eventsActionName,
 			unrestricted: eventsUnrestricted,
@@ -56,6 +72,10 @@ func NewEOSBlockMapper(eventsActionName string, eventsUnrestricted bool, filterO
 	}, nil
 }
 
+func (m *EOSBlockMapper) Config() MapperConfig {
+	return m.config
+}
+
 func (m *EOSBlockMapper) IndexMapping() *mapping.IndexMappingImpl {
 	// db ops
 	dbDocMapping := bleve.NewDocumentMapping()
@@ -240,6 +260,42 @@ func (m *EOSBlockMapper) filterMatches(program cel.Program, defaultVal bool, doc
 	return bool(retval)
 }
 
+// MatchingActions calls `f`, in execution order, for each action of `blk`
+// the mapper would index, so other consumers can select actions with the
+// exact same filter-on/filter-out semantics (and identifiers) as the indexer.
+func (m *EOSBlockMapper) MatchingActions(blk *pbcodec.Block, f func(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace) error) error {
+	matching := map[string]map[int]bool{}
+	err := m.prepareBatchDocuments(blk, func(trxID string, idx int, data map[string]interface{}) error {
+		if !m.shouldIndexAction(data) {
+			return nil
+		}
+
+		if matching[trxID] == nil {
+			matching[trxID] = map[int]bool{}
+		}
+
+		matching[trxID][idx] = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, trxTrace := range blk.TransactionTraces {
+		for idx, actTrace := range trxTrace.ActionTraces {
+			if !matching[trxTrace.Id][idx] {
+				continue
+			}
+
+			if err := f(trxTrace, actTrace); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (m *EOSBlockMapper) prepareBatchDocuments(blk *pbcodec.Block, batchUpdater eosBatchActionUpdater) error {
 	trxIndex := -1
 	for _, trxTrace := range blk.TransactionTraces {
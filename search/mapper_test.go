@@ -401,6 +401,48 @@ func TestFilterOut(t *testing.T) {
 	}
 }
 
+func TestMatchingActions(t *testing.T) {
+	actionTrace := func(ordinal uint32, receiver, account, action, jsonData string) *pbcodec.ActionTrace {
+		return &pbcodec.ActionTrace{
+			ActionOrdinal: ordinal,
+			Receiver:      receiver,
+			Receipt:       &pbcodec.ActionReceipt{Receiver: receiver},
+			Action:        &pbcodec.Action{Account: account, Name: action, JsonData: jsonData},
+		}
+	}
+
+	executed := &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED}
+	block := &pbcodec.Block{
+		Number: 10,
+		TransactionTraces: []*pbcodec.TransactionTrace{
+			{Id: "trx1", Receipt: executed, ActionTraces: []*pbcodec.ActionTrace{
+				actionTrace(1, "eosio.token", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`),
+				actionTrace(2, "alice", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`),
+				actionTrace(3, "bob", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`),
+			}},
+			{Id: "trx2", Receipt: &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_HARDFAIL}, ActionTraces: []*pbcodec.ActionTrace{
+				actionTrace(1, "eosio.token", "eosio.token", "transfer", `{"from":"alice","to":"bob"}`),
+			}},
+			{Id: "trx3", Receipt: executed, ActionTraces: []*pbcodec.ActionTrace{
+				actionTrace(1, "eosio", "eosio", "newaccount", `{}`),
+				actionTrace(2, "eosio.token", "eosio.token", "transfer", `{"from":"carol","to":"bob"}`),
+			}},
+		},
+	}
+
+	mapper, err := NewEOSBlockMapper("", false, `account == "eosio.token"`, `receiver == "alice"`)
+	require.NoError(t, err)
+
+	var matched []string
+	err = mapper.MatchingActions(block, func(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace) error {
+		matched = append(matched, trxTrace.Id+":"+actTrace.Receiver+":"+actTrace.GetData("from").String())
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"trx1:eosio.token:alice", "trx1:bob:alice", "trx3:eosio.token:carol"}, matched)
+}
+
 func TestCompileCELPrograms(t *testing.T) {
 	_, err := NewEOSBlockMapper("", false, "bro = '", "")
 	require.Error(t, err)
@@ -0,0 +1,208 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/blockstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dfuse-eosio/sinks"
+	"github.com/dfuse-io/dstore"
+	"github.com/dfuse-io/shutter"
+	"go.uber.org/zap"
+)
+
+type Config struct {
+	BlocksStoreURL            string   // GS path to read blocks files from
+	BlockStreamAddr           string   // Address of the gRPC endpoint streaming live blocks
+	FilterOnExpr              string   // CEL program selecting the actions to publish, same identifiers as search
+	FilterOutExpr             string   // CEL program excluding actions from publication, same identifiers as search
+	Format                    string   // Encoding of the events, either `json` or `protobuf`
+	SinkDSNs                  []string // Destinations of the events, see `sinks.NewSink`
+	CursorPath                string   // File recording the last irreversible block streamed, resumed from on start
+	IrreversibleOnly          bool     // Publish only irreversible events instead of new/undo/redo ones
+	WebhookMaxRetries         int      // Amount of retries of a failed webhook call before stopping
+	StartCursor               string   // Opaque cursor of an event to resume right after when there is no cursor file yet, see `sinks.ParseCursor`
+	StartBlockNum             uint64   // Block to start from when there is no cursor yet, 0 meaning the live head
+	NumBlocksBeforeStart      uint64   // Number of blocks to fetch before start block, to determine its fork
+	ParallelFileDownloadCount int      // Number of threads of parallel file download
+}
+
+type App struct {
+	*shutter.Shutter
+	Config *Config
+
+	streamer *sinks.Streamer
+}
+
+func New(config *Config) *App {
+	return &App{
+		Shutter: shutter.New(),
+		Config:  config,
+	}
+}
+
+func (a *App) Run() error {
+	zlog.Info("launching sinks", zap.Reflect("config", a.Config))
+
+	if len(a.Config.SinkDSNs) == 0 {
+		return fmt.Errorf("at least one sink dsn is required")
+	}
+
+	format, err := sinks.ParseFormat(a.Config.Format)
+	if err != nil {
+		return err
+	}
+
+	mapper, err := search.NewEOSBlockMapper("", false, a.Config.FilterOnExpr, a.Config.FilterOutExpr)
+	if err != nil {
+		return fmt.Errorf("unable to create action filter: %w", err)
+	}
+
+	var destinations []sinks.Sink
+	for _, dsn := range a.Config.SinkDSNs {
+		sink, err := sinks.NewSink(dsn, format, sinks.SinkOptions{WebhookMaxRetries: a.Config.WebhookMaxRetries})
+		if err != nil {
+			return err
+		}
+
+		destinations = append(destinations, sink)
+	}
+
+	a.OnTerminated(func(_ error) {
+		for _, sink := range destinations {
+			if err := sink.Close(); err != nil {
+				zlog.Warn("unable to close sink", zap.Error(err))
+			}
+		}
+	})
+
+	blocksStore, err := dstore.NewDBinStore(a.Config.BlocksStoreURL)
+	if err != nil {
+		return fmt.Errorf("setting up blocks store: %w", err)
+	}
+
+	lib, err := sinks.LoadCursor(a.Config.CursorPath)
+	if err != nil {
+		return err
+	}
+
+	a.streamer = sinks.NewStreamer(mapper, format, destinations, a.Config.IrreversibleOnly, a.Config.CursorPath)
+
+	// The cursor file, once there, takes precedence so restarts never rewind to the start cursor
+	var startCursor *sinks.Cursor
+	if lib == nil && a.Config.StartCursor != "" {
+		if startCursor, err = sinks.ParseCursor(a.Config.StartCursor); err != nil {
+			return fmt.Errorf("invalid start cursor: %w", err)
+		}
+
+		if err := a.streamer.SetStartCursor(startCursor); err != nil {
+			return err
+		}
+	}
+
+	source := a.newSource(blocksStore, lib, startCursor)
+
+	a.OnTerminating(source.Shutdown)
+	source.OnTerminated(func(err error) {
+		a.Shutdown(err)
+	})
+
+	go source.Run()
+	return nil
+}
+
+// newSource builds the pipeline feeding the streamer. It resumes right after
+// the cursor's irreversible block when there is one, otherwise it starts
+// before the start cursor's block, at the configured start block or, without
+// any, at the live head.
+func (a *App) newSource(blocksStore dstore.Store, lib bstream.BlockRef, startCursor *sinks.Cursor) bstream.Source {
+	options := []forkable.Option{
+		forkable.WithFilters(a.streamer.ForkableSteps()),
+		forkable.WithName("sinks"),
+	}
+
+	var handler bstream.Handler = a.streamer
+	var startBlockNum uint64
+	var startBlockID string
+
+	switch {
+	case lib != nil:
+		zlog.Info("resuming from cursor", zap.Stringer("lib", lib))
+		options = append(options, forkable.WithExclusiveLIB(lib))
+		startBlockNum = lib.Num()
+		startBlockID = lib.ID()
+	case startCursor != nil:
+		// No gate, the streamer skips everything up to the cursor's event itself
+		zlog.Info("starting from start cursor", zap.String("step", string(startCursor.Step)), zap.Uint64("block_num", startCursor.BlockNum), zap.String("block_id", startCursor.BlockID), zap.Int("index", startCursor.Index))
+		startBlockNum = a.blockNumBefore(startCursor.BlockNum)
+	case a.Config.StartBlockNum != 0:
+		zlog.Info("starting from start block", zap.Uint64("start_block_num", a.Config.StartBlockNum))
+		gate := bstream.NewBlockNumGate(a.Config.StartBlockNum, bstream.GateInclusive, handler)
+		gate.MaxHoldOff = 1000
+		handler = gate
+
+		startBlockNum = a.blockNumBefore(a.Config.StartBlockNum)
+	default:
+		zlog.Info("no cursor nor start block, starting from live head")
+		return blockstream.NewSource(context.Background(), a.Config.BlockStreamAddr, 300, forkable.New(handler, options...), blockstream.WithName("sinks"))
+	}
+
+	forkableHandler := forkable.New(handler, options...)
+
+	var sourceHandler bstream.Handler = forkableHandler
+	if startBlockID != "" {
+		sourceHandler = bstream.NewBlockIDGate(startBlockID, bstream.GateExclusive, forkableHandler)
+	}
+
+	liveSourceFactory := bstream.SourceFactory(func(subHandler bstream.Handler) bstream.Source {
+		src := blockstream.NewSource(context.Background(), a.Config.BlockStreamAddr, 300, subHandler)
+		src.SetName("sinks")
+		return src
+	})
+
+	fileSourceFactory := bstream.SourceFactory(func(subHandler bstream.Handler) bstream.Source {
+		return bstream.NewFileSource(blocksStore, startBlockNum, a.Config.ParallelFileDownloadCount, nil, subHandler)
+	})
+
+	// The live source is joined once the files reached the block streaming resumes after, or the first one fetched
+	targetOption := bstream.JoiningSourceTargetBlockNum(startBlockNum)
+	if startBlockID != "" {
+		targetOption = bstream.JoiningSourceTargetBlockID(startBlockID)
+	}
+
+	js := bstream.NewJoiningSource(fileSourceFactory, liveSourceFactory, sourceHandler, targetOption, bstream.JoiningSourceName("sinks"))
+	js.SetName("sinks")
+
+	return js
+}
+
+// blockNumBefore returns the block to fetch files from for `forkable` to know
+// the fork of `blockNum` once reached.
+func (a *App) blockNumBefore(blockNum uint64) uint64 {
+	if blockNum > a.Config.NumBlocksBeforeStart {
+		return blockNum - a.Config.NumBlocksBeforeStart
+	}
+
+	return 1
+}
+
+func (a *App) IsReady() bool {
+	return a.streamer != nil && a.streamer.HasProcessedBlock()
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/opaque"
+)
+
+type Step string
+
+const (
+	StepNew          Step = "new"
+	StepUndo         Step = "undo"
+	StepRedo         Step = "redo"
+	StepIrreversible Step = "irreversible"
+)
+
+func stepFromForkable(step forkable.StepType) (Step, bool) {
+	switch step {
+	case forkable.StepNew:
+		return StepNew, true
+	case forkable.StepUndo:
+		return StepUndo, true
+	case forkable.StepRedo:
+		return StepRedo, true
+	case forkable.StepIrreversible:
+		return StepIrreversible, true
+	}
+
+	return "", false
+}
+
+// Event is a single matching action as published to the sinks. An `undo`
+// event cancels the `new` (or `redo`) event with the same block ID and
+// action position, consumers are expected to revert what they did with it.
+type Event struct {
+	Step          Step            `json:"step"`
+	Cursor        string          `json:"cursor"`
+	BlockNum      uint64          `json:"block_num"`
+	BlockID       string          `json:"block_id"`
+	BlockTime     time.Time       `json:"block_time"`
+	TrxID         string          `json:"trx_id"`
+	ActionIndex   int             `json:"action_index"`
+	Receiver      string          `json:"receiver"`
+	Account       string          `json:"account"`
+	Action        string          `json:"action"`
+	Authorization []string        `json:"authorization"`
+	Data          json.RawMessage `json:"data,omitempty"`
+	HexData       string          `json:"hex_data,omitempty"`
+}
+
+// Cursor identifies an event within the stream, it is opaque to consumers.
+type Cursor struct {
+	Step     Step
+	BlockNum uint64
+	BlockID  string
+	// Index is the position of the event among the block's matching actions
+	Index int
+}
+
+func (c *Cursor) String() string {
+	encoded, err := opaque.ToOpaque(fmt.Sprintf("%s:%d:%s:%d", c.Step, c.BlockNum, c.BlockID, c.Index))
+	if err != nil {
+		panic(fmt.Errorf("unable to encode cursor: %w", err))
+	}
+
+	return encoded
+}
+
+func ParseCursor(in string) (*Cursor, error) {
+	decoded, err := opaque.FromOpaque(in)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+
+	parts := strings.Split(decoded, ":")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid cursor: expected 4 segments, got %d", len(parts))
+	}
+
+	blockNum, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor block num: %w", err)
+	}
+
+	index, err := strconv.Atoi(parts[3])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor index: %w", err)
+	}
+
+	return &Cursor{Step: Step(parts[0]), BlockNum: blockNum, BlockID: parts[2], Index: index}, nil
+}
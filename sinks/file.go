@@ -0,0 +1,67 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileSink appends events, one JSON document per line, to a local file.
+type FileSink struct {
+	file *os.File
+}
+
+func NewFileSink(path string, format Format) (*FileSink, error) {
+	if format != JSONFormat {
+		return nil, fmt.Errorf("file sink only supports %q format", JSONFormat)
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("file sink requires a path, like file:///var/lib/events.jsonl")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("creating file sink directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("opening file sink: %w", err)
+	}
+
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Publish(ctx context.Context, msgs []*Message) error {
+	writer := bufio.NewWriter(s.file)
+	for _, msg := range msgs {
+		writer.Write(msg.Payload)
+		writer.WriteByte('\n')
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("writing to file sink: %w", err)
+	}
+
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
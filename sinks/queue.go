@@ -0,0 +1,148 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// QueuePublisher is implemented by message queue clients (Kafka, NATS, ...)
+// to be used as a sink. `Publish` must return only once the broker
+// acknowledged the message.
+type QueuePublisher interface {
+	Publish(ctx context.Context, topic string, msg *Message) error
+	Close() error
+}
+
+// QueueDriverFactory creates a publisher out of a sink dsn, its host being
+// the broker address and its path the topic (or subject).
+type QueueDriverFactory func(dsn *url.URL) (QueuePublisher, error)
+
+var queueDrivers = map[string]QueueDriverFactory{}
+
+// RegisterQueueDriver makes sink dsns with the `scheme` scheme (like `kafka`
+// or `nats`) use the publishers created by `factory`.
+func RegisterQueueDriver(scheme string, factory QueueDriverFactory) {
+	queueDrivers[scheme] = factory
+}
+
+func init() {
+	RegisterQueueDriver("memory", func(dsn *url.URL) (QueuePublisher, error) {
+		return GetMemoryQueue(dsn.Host), nil
+	})
+}
+
+// QueueSink publishes messages, in order, to the topic of a message queue.
+type QueueSink struct {
+	publisher QueuePublisher
+	topic     string
+}
+
+func NewQueueSink(dsn *url.URL) (*QueueSink, error) {
+	factory, found := queueDrivers[dsn.Scheme]
+	if !found {
+		return nil, fmt.Errorf("no queue driver registered for scheme %q, known schemes are file, http, https, %s", dsn.Scheme, strings.Join(queueDriverSchemes(), ", "))
+	}
+
+	topic := strings.TrimPrefix(dsn.Path, "/")
+	if topic == "" {
+		return nil, fmt.Errorf("queue sink requires a topic, like %s://<address>/<topic>", dsn.Scheme)
+	}
+
+	publisher, err := factory(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("creating %s queue publisher: %w", dsn.Scheme, err)
+	}
+
+	return &QueueSink{publisher: publisher, topic: topic}, nil
+}
+
+func (s *QueueSink) Publish(ctx context.Context, msgs []*Message) error {
+	for _, msg := range msgs {
+		if err := s.publisher.Publish(ctx, s.topic, msg); err != nil {
+			return fmt.Errorf("publishing to topic %q: %w", s.topic, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *QueueSink) Close() error {
+	return s.publisher.Close()
+}
+
+func queueDriverSchemes() (out []string) {
+	for scheme := range queueDrivers {
+		out = append(out, scheme)
+	}
+	sort.Strings(out)
+	return
+}
+
+var memoryQueuesLock sync.Mutex
+var memoryQueues = map[string]*MemoryQueue{}
+
+// GetMemoryQueue returns the in-process queue named `name`, creating it on
+// first use. It is what `memory://<name>/<topic>` sinks publish to and stands
+// in for a real broker in tests and local development.
+func GetMemoryQueue(name string) *MemoryQueue {
+	memoryQueuesLock.Lock()
+	defer memoryQueuesLock.Unlock()
+
+	queue, found := memoryQueues[name]
+	if !found {
+		queue = NewMemoryQueue()
+		memoryQueues[name] = queue
+	}
+
+	return queue
+}
+
+// MemoryQueue is an in-process `QueuePublisher` retaining every message
+// published, by topic.
+type MemoryQueue struct {
+	lock     sync.Mutex
+	messages map[string][]*Message
+}
+
+func NewMemoryQueue() *MemoryQueue {
+	return &MemoryQueue{messages: map[string][]*Message{}}
+}
+
+func (q *MemoryQueue) Publish(ctx context.Context, topic string, msg *Message) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.messages[topic] = append(q.messages[topic], msg)
+	return nil
+}
+
+// Messages returns the messages published so far to `topic`.
+func (q *MemoryQueue) Messages(topic string) []*Message {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return append([]*Message(nil), q.messages[topic]...)
+}
+
+// Close is a no-op, the queue being shared by every sink using its name.
+func (q *MemoryQueue) Close() error {
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/golang/protobuf/proto"
+)
+
+type Format string
+
+const (
+	JSONFormat     Format = "json"
+	ProtobufFormat Format = "protobuf"
+)
+
+func ParseFormat(in string) (Format, error) {
+	switch Format(in) {
+	case JSONFormat, ProtobufFormat:
+		return Format(in), nil
+	}
+
+	return "", fmt.Errorf("unknown format %q, valid values are %q and %q", in, JSONFormat, ProtobufFormat)
+}
+
+func (f Format) ContentType() string {
+	if f == ProtobufFormat {
+		return "application/protobuf"
+	}
+
+	return "application/json"
+}
+
+// Message is an encoded event as handed to the sinks. In protobuf format,
+// the payload is the `dfuse.eosio.codec.v1.ActionTrace` of the event while
+// the event's other fields are conveyed through the headers.
+type Message struct {
+	// Key is the transaction ID, queues use it to keep a transaction's
+	// events on the same partition
+	Key     string
+	Headers map[string]string
+	Payload []byte
+}
+
+const (
+	StepHeader     = "dfuse-step"
+	CursorHeader   = "dfuse-cursor"
+	BlockNumHeader = "dfuse-block-num"
+	BlockIDHeader  = "dfuse-block-id"
+)
+
+func encodeEvent(format Format, event *Event, actTrace *pbcodec.ActionTrace) (*Message, error) {
+	msg := &Message{
+		Key: event.TrxID,
+		Headers: map[string]string{
+			StepHeader:     string(event.Step),
+			CursorHeader:   event.Cursor,
+			BlockNumHeader: strconv.FormatUint(event.BlockNum, 10),
+			BlockIDHeader:  event.BlockID,
+		},
+	}
+
+	var err error
+	switch format {
+	case JSONFormat:
+		msg.Payload, err = json.Marshal(event)
+	case ProtobufFormat:
+		msg.Payload, err = proto.Marshal(actTrace)
+	default:
+		err = fmt.Errorf("unknown format %q", format)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("encoding event: %w", err)
+	}
+
+	return msg, nil
+}
+
+// Sink publishes messages to a destination. `Publish` returns only once the
+// messages are durably handed off, the streamer does not move its cursor
+// past messages that were not published.
+type Sink interface {
+	Publish(ctx context.Context, msgs []*Message) error
+	Close() error
+}
+
+type SinkOptions struct {
+	// WebhookMaxRetries is the amount of times a failed webhook call is retried
+	WebhookMaxRetries int
+}
+
+// NewSink creates the sink for `dsn`, its scheme selecting the kind of sink:
+// `file://` for a JSON lines file, `http://` and `https://` for a webhook,
+// any registered queue driver scheme (see `RegisterQueueDriver`) otherwise.
+func NewSink(dsn string, format Format, options SinkOptions) (Sink, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sink dsn %q: %w", dsn, err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return NewFileSink(u.Path, format)
+	case "http", "https":
+		return NewWebhookSink(dsn, format, options.WebhookMaxRetries), nil
+	}
+
+	return NewQueueSink(u)
+}
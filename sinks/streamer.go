@@ -0,0 +1,310 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+)
+
+// Streamer is a `bstream.Handler`, to be placed after a `forkable`, turning
+// the actions matched by the mapper into events published to every sink.
+//
+// The blocks of an `undo` step have their events published in reverse
+// order. Once all sinks accepted a block's events, the streamer moves on; on
+// irreversible steps it records the irreversible block in the cursor file,
+// which is where streaming resumes from after a restart. Events of blocks
+// above that point can thus be delivered more than once.
+//
+// With a start cursor, nothing is published until the cursor's block goes
+// through with the cursor's step, the events following the cursor's one
+// being the first published.
+type Streamer struct {
+	mapper           *search.EOSBlockMapper
+	format           Format
+	sinks            []Sink
+	irreversibleOnly bool
+	cursorPath       string
+	startCursor      *Cursor
+
+	processedBlockCount uint64
+}
+
+// NewStreamer creates a streamer publishing `new`, `undo` and `redo` events,
+// or only `irreversible` ones when `irreversibleOnly` is set. The cursor file
+// is not maintained when `cursorPath` is empty.
+func NewStreamer(mapper *search.EOSBlockMapper, format Format, sinks []Sink, irreversibleOnly bool, cursorPath string) *Streamer {
+	return &Streamer{
+		mapper:           mapper,
+		format:           format,
+		sinks:            sinks,
+		irreversibleOnly: irreversibleOnly,
+		cursorPath:       cursorPath,
+	}
+}
+
+// SetStartCursor makes the streamer resume right after the event identified
+// by `cursor`, as handed to a consumer. The blocks preceding the cursor's
+// block must go through the streamer's `forkable` for it to replay the steps
+// leading to it.
+func (s *Streamer) SetStartCursor(cursor *Cursor) error {
+	if (cursor.Step == StepIrreversible) != s.irreversibleOnly {
+		return fmt.Errorf("cursor of a %s event cannot be resumed from when irreversible only is %t", cursor.Step, s.irreversibleOnly)
+	}
+
+	s.startCursor = cursor
+	return nil
+}
+
+// ForkableSteps returns the steps the streamer expects from its `forkable`.
+func (s *Streamer) ForkableSteps() forkable.StepType {
+	if s.irreversibleOnly {
+		return forkable.StepIrreversible
+	}
+
+	return forkable.StepNew | forkable.StepUndo | forkable.StepRedo | forkable.StepIrreversible
+}
+
+func (s *Streamer) ProcessBlock(blk *bstream.Block, obj interface{}) error {
+	fobj, ok := obj.(*forkable.ForkableObject)
+	if !ok {
+		return fmt.Errorf("expected a forkable object, got %T", obj)
+	}
+
+	step, ok := stepFromForkable(fobj.Step)
+	if !ok {
+		return nil
+	}
+
+	atomic.AddUint64(&s.processedBlockCount, 1)
+
+	if err := s.checkStartCursorReachable(step, blk); err != nil {
+		return err
+	}
+
+	if step != StepIrreversible || s.irreversibleOnly {
+		if err := s.publishBlock(step, blk.ToNative().(*pbcodec.Block)); err != nil {
+			return err
+		}
+	}
+
+	if step == StepIrreversible {
+		return s.saveCursor(blk)
+	}
+
+	return nil
+}
+
+// HasProcessedBlock returns whether at least one block went through the
+// streamer.
+func (s *Streamer) HasProcessedBlock() bool {
+	return atomic.LoadUint64(&s.processedBlockCount) > 0
+}
+
+func (s *Streamer) publishBlock(step Step, block *pbcodec.Block) error {
+	msgs, err := s.blockMessages(step, block)
+	if err != nil {
+		return err
+	}
+
+	if s.startCursor != nil {
+		if msgs, err = s.messagesAfterStartCursor(step, block, msgs); err != nil {
+			return err
+		}
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	for _, sink := range s.sinks {
+		if err := sink.Publish(ctx, msgs); err != nil {
+			return fmt.Errorf("publishing %s events of block %s: %w", step, block.AsRef(), err)
+		}
+	}
+
+	zlog.Debug("published block events", zap.String("step", string(step)), zap.Stringer("block", block.AsRef()), zap.Int("event_count", len(msgs)))
+	return nil
+}
+
+func (s *Streamer) blockMessages(step Step, block *pbcodec.Block) (msgs []*Message, err error) {
+	blockTime, err := ptypes.Timestamp(block.Header.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block time: %w", err)
+	}
+
+	index := 0
+	err = s.mapper.MatchingActions(block, func(trxTrace *pbcodec.TransactionTrace, actTrace *pbcodec.ActionTrace) error {
+		cursor := &Cursor{Step: step, BlockNum: block.Num(), BlockID: block.Id, Index: index}
+		index++
+
+		event := &Event{
+			Step:        step,
+			Cursor:      cursor.String(),
+			BlockNum:    block.Num(),
+			BlockID:     block.Id,
+			BlockTime:   blockTime.UTC(),
+			TrxID:       trxTrace.Id,
+			ActionIndex: int(actTrace.ExecutionIndex),
+			Receiver:    actTrace.Receiver,
+			Account:     actTrace.Account(),
+			Action:      actTrace.Name(),
+		}
+
+		for _, auth := range actTrace.Action.Authorization {
+			event.Authorization = append(event.Authorization, auth.Actor+"@"+auth.Permission)
+		}
+
+		if actTrace.Action.JsonData != "" {
+			event.Data = json.RawMessage(actTrace.Action.JsonData)
+		} else {
+			event.HexData = hex.EncodeToString(actTrace.Action.RawData)
+		}
+
+		msg, err := encodeEvent(s.format, event, actTrace)
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, msg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if step == StepUndo {
+		for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+			msgs[i], msgs[j] = msgs[j], msgs[i]
+		}
+	}
+
+	return msgs, nil
+}
+
+// messagesAfterStartCursor drops the messages of blocks preceding the start
+// cursor's one, and those up to the cursor's event in the cursor's block. A
+// `redo` being the replay of a `new`, either step matches the other.
+func (s *Streamer) messagesAfterStartCursor(step Step, block *pbcodec.Block, msgs []*Message) ([]*Message, error) {
+	cursor := s.startCursor
+	if block.Id != cursor.BlockID || !sameStepDirection(step, cursor.Step) {
+		return nil, nil
+	}
+
+	if cursor.Index >= len(msgs) {
+		return nil, fmt.Errorf("start cursor index %d out of range, block %s has %d %s events", cursor.Index, block.AsRef(), len(msgs), step)
+	}
+
+	s.startCursor = nil
+	zlog.Info("reached start cursor", zap.String("step", string(step)), zap.Stringer("block", block.AsRef()), zap.Int("index", cursor.Index))
+
+	// Messages of an `undo` step are in reverse order of their index
+	if step == StepUndo {
+		return msgs[len(msgs)-cursor.Index:], nil
+	}
+
+	return msgs[cursor.Index+1:], nil
+}
+
+// checkStartCursorReachable fails once the chain went irreversible past the
+// start cursor's block without it being reached, which happens when the
+// cursor's block was forked out while the app was stopped, its events then
+// being impossible to undo.
+func (s *Streamer) checkStartCursorReachable(step Step, blk *bstream.Block) error {
+	cursor := s.startCursor
+	if cursor == nil || step != StepIrreversible || blk.Num() < cursor.BlockNum {
+		return nil
+	}
+
+	if s.irreversibleOnly && blk.ID() == cursor.BlockID {
+		return nil
+	}
+
+	return fmt.Errorf("block %s became irreversible without start cursor's block %s being streamed with step %s", bstream.NewBlockRef(blk.ID(), blk.Num()), bstream.NewBlockRef(cursor.BlockID, cursor.BlockNum), cursor.Step)
+}
+
+func sameStepDirection(step, other Step) bool {
+	if step == StepRedo {
+		step = StepNew
+	}
+
+	if other == StepRedo {
+		other = StepNew
+	}
+
+	return step == other
+}
+
+type cursorFile struct {
+	BlockID  string `json:"block_id"`
+	BlockNum uint64 `json:"block_num"`
+}
+
+func (s *Streamer) saveCursor(blk *bstream.Block) error {
+	if s.cursorPath == "" {
+		return nil
+	}
+
+	content, err := json.Marshal(&cursorFile{BlockID: blk.ID(), BlockNum: blk.Num()})
+	if err != nil {
+		return err
+	}
+
+	// Written aside then renamed so a crash never leaves a truncated cursor file
+	tmpPath := s.cursorPath + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, content, 0644); err != nil {
+		return fmt.Errorf("writing cursor file: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, s.cursorPath); err != nil {
+		return fmt.Errorf("replacing cursor file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadCursor returns the last irreversible block recorded in the cursor file
+// at `path`, or nil when there is none yet.
+func LoadCursor(path string) (bstream.BlockRef, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading cursor file: %w", err)
+	}
+
+	cursor := &cursorFile{}
+	if err := json.Unmarshal(content, cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor file %q: %w", filepath.Base(path), err)
+	}
+
+	return bstream.NewBlockRef(cursor.BlockID, cursor.BlockNum), nil
+}
@@ -0,0 +1,319 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dfuse-io/bstream"
+	"github.com/dfuse-io/bstream/forkable"
+	"github.com/dfuse-io/dfuse-eosio/codec"
+	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
+	"github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamer_ForkSteps(t *testing.T) {
+	queue := NewMemoryQueue()
+	dir := testTempDir(t)
+	defer os.RemoveAll(dir)
+
+	cursorPath := filepath.Join(dir, "cursor.json")
+
+	streamer := NewStreamer(testMapper(t), JSONFormat, []Sink{&QueueSink{publisher: queue, topic: "events"}}, false, cursorPath)
+	processBlocks(t, streamer, forkingChain(t))
+
+	assert.Equal(t, []string{
+		"new 00000002aa 2a",
+		"new 00000003aa 3a-first",
+		"new 00000003aa 3a-second",
+		"undo 00000003aa 3a-second",
+		"undo 00000003aa 3a-first",
+		"new 00000003bb 3b",
+		"new 00000004bb 4b",
+		"undo 00000004bb 4b",
+		"undo 00000003bb 3b",
+		"redo 00000003aa 3a-first",
+		"redo 00000003aa 3a-second",
+		"new 00000004aa 4a",
+		"new 00000005aa 5a",
+		"new 00000006aa 6a",
+	}, eventSummaries(t, queue.Messages("events")))
+
+	var events []*Event
+	for _, msg := range queue.Messages("events") {
+		event := &Event{}
+		require.NoError(t, json.Unmarshal(msg.Payload, event))
+		events = append(events, event)
+	}
+
+	cursor, err := ParseCursor(events[4].Cursor)
+	require.NoError(t, err)
+	assert.Equal(t, &Cursor{Step: StepUndo, BlockNum: 3, BlockID: "00000003aa", Index: 0}, cursor)
+	assert.Equal(t, "trx.3a", events[4].TrxID)
+	assert.Equal(t, []string{"alice@active"}, events[4].Authorization)
+	assert.Equal(t, StepUndo, Step(queue.Messages("events")[4].Headers[StepHeader]))
+
+	lib, err := LoadCursor(cursorPath)
+	require.NoError(t, err)
+	assert.Equal(t, bstream.NewBlockRef("00000003aa", 3), lib)
+}
+
+func TestStreamer_StartCursor(t *testing.T) {
+	tests := []struct {
+		name        string
+		cursor      *Cursor
+		expectFirst []string
+	}{
+		{
+			name:        "new event",
+			cursor:      &Cursor{Step: StepNew, BlockNum: 3, BlockID: "00000003aa", Index: 0},
+			expectFirst: []string{"new 00000003aa 3a-second", "undo 00000003aa 3a-second"},
+		},
+		{
+			name:        "undo event",
+			cursor:      &Cursor{Step: StepUndo, BlockNum: 3, BlockID: "00000003aa", Index: 1},
+			expectFirst: []string{"undo 00000003aa 3a-first", "new 00000003bb 3b"},
+		},
+		{
+			name:        "redo event matching a new one",
+			cursor:      &Cursor{Step: StepRedo, BlockNum: 3, BlockID: "00000003bb", Index: 0},
+			expectFirst: []string{"new 00000004bb 4b", "undo 00000004bb 4b"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			queue := NewMemoryQueue()
+
+			streamer := NewStreamer(testMapper(t), JSONFormat, []Sink{&QueueSink{publisher: queue, topic: "events"}}, false, "")
+			require.NoError(t, streamer.SetStartCursor(test.cursor))
+			processBlocks(t, streamer, forkingChain(t))
+
+			summaries := eventSummaries(t, queue.Messages("events"))
+			require.True(t, len(summaries) >= len(test.expectFirst))
+			assert.Equal(t, test.expectFirst, summaries[:len(test.expectFirst)])
+			assert.Equal(t, "new 00000006aa 6a", summaries[len(summaries)-1])
+		})
+	}
+}
+
+func TestStreamer_StartCursorForkedOut(t *testing.T) {
+	streamer := NewStreamer(testMapper(t), JSONFormat, []Sink{&QueueSink{publisher: NewMemoryQueue(), topic: "events"}}, false, "")
+	require.NoError(t, streamer.SetStartCursor(&Cursor{Step: StepNew, BlockNum: 3, BlockID: "00000003cc", Index: 0}))
+	assert.Error(t, streamer.SetStartCursor(&Cursor{Step: StepIrreversible, BlockNum: 3, BlockID: "00000003cc", Index: 0}))
+
+	handler := forkable.New(streamer,
+		forkable.WithFilters(streamer.ForkableSteps()),
+		forkable.WithExclusiveLIB(bstream.NewBlockRef("00000001aa", 1)),
+	)
+
+	var err error
+	for _, block := range forkingChain(t) {
+		blk, blkErr := codec.BlockFromProto(block)
+		require.NoError(t, blkErr)
+
+		if err = handler.ProcessBlock(blk, nil); err != nil {
+			break
+		}
+	}
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "became irreversible without start cursor's block")
+}
+
+func TestStreamer_IrreversibleOnly(t *testing.T) {
+	queue := NewMemoryQueue()
+
+	streamer := NewStreamer(testMapper(t), ProtobufFormat, []Sink{&QueueSink{publisher: queue, topic: "events"}}, true, "")
+	processBlocks(t, streamer, forkingChain(t))
+
+	msgs := queue.Messages("events")
+	require.Len(t, msgs, 3)
+
+	var summaries []string
+	for _, msg := range msgs {
+		actTrace := &pbcodec.ActionTrace{}
+		require.NoError(t, proto.Unmarshal(msg.Payload, actTrace))
+		summaries = append(summaries, fmt.Sprintf("%s %s %s", msg.Headers[StepHeader], msg.Headers[BlockIDHeader], actTrace.GetData("memo").String()))
+	}
+
+	assert.Equal(t, []string{
+		"irreversible 00000002aa 2a",
+		"irreversible 00000003aa 3a-first",
+		"irreversible 00000003aa 3a-second",
+	}, summaries)
+}
+
+func TestLoadCursor_Missing(t *testing.T) {
+	dir := testTempDir(t)
+	defer os.RemoveAll(dir)
+
+	lib, err := LoadCursor(filepath.Join(dir, "cursor.json"))
+	require.NoError(t, err)
+	assert.Nil(t, lib)
+}
+
+func TestFileSink(t *testing.T) {
+	dir := testTempDir(t)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sub", "events.jsonl")
+
+	_, err := NewSink("file://"+path, ProtobufFormat, SinkOptions{})
+	require.Error(t, err)
+
+	sink, err := NewSink("file://"+path, JSONFormat, SinkOptions{})
+	require.NoError(t, err)
+	require.NoError(t, sink.Publish(nil, []*Message{{Payload: []byte(`{"a":1}`)}, {Payload: []byte(`{"a":2}`)}}))
+	require.NoError(t, sink.Close())
+
+	sink, err = NewSink("file://"+path, JSONFormat, SinkOptions{})
+	require.NoError(t, err)
+	require.NoError(t, sink.Publish(nil, []*Message{{Payload: []byte(`{"a":3}`)}}))
+	require.NoError(t, sink.Close())
+
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"a\":1}\n{\"a\":2}\n{\"a\":3}\n", string(content))
+}
+
+func TestQueueSink(t *testing.T) {
+	sink, err := NewSink("memory://test-queue-sink/actions", JSONFormat, SinkOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Publish(nil, []*Message{{Key: "trx.1", Payload: []byte("1")}, {Key: "trx.2", Payload: []byte("2")}}))
+	require.NoError(t, sink.Close())
+
+	msgs := GetMemoryQueue("test-queue-sink").Messages("actions")
+	require.Len(t, msgs, 2)
+	assert.Equal(t, "trx.1", msgs[0].Key)
+	assert.Equal(t, "2", string(msgs[1].Payload))
+
+	_, err = NewSink("kafka://localhost:9092/actions", JSONFormat, SinkOptions{})
+	assert.EqualError(t, err, `no queue driver registered for scheme "kafka", known schemes are file, http, https, memory`)
+
+	_, err = NewSink("memory://test-queue-sink", JSONFormat, SinkOptions{})
+	assert.Error(t, err)
+}
+
+func testMapper(t *testing.T) *search.EOSBlockMapper {
+	mapper, err := search.NewEOSBlockMapper("", false, `account == "eosio.token" && action == "transfer"`, "notif")
+	require.NoError(t, err)
+
+	return mapper
+}
+
+func processBlocks(t *testing.T, streamer *Streamer, blocks []*pbcodec.Block) {
+	handler := forkable.New(streamer,
+		forkable.WithFilters(streamer.ForkableSteps()),
+		forkable.WithExclusiveLIB(bstream.NewBlockRef("00000001aa", 1)),
+	)
+
+	for _, block := range blocks {
+		blk, err := codec.BlockFromProto(block)
+		require.NoError(t, err)
+		require.NoError(t, handler.ProcessBlock(blk, nil))
+	}
+}
+
+// forkingChain switches over to the `bb` fork at block 4 then back to the
+// `aa` fork at block 5, block 6 making block 3 irreversible.
+func forkingChain(t *testing.T) []*pbcodec.Block {
+	return []*pbcodec.Block{
+		testBlock(t, "00000002aa", "00000001aa", 1, "2a"),
+		testBlock(t, "00000003aa", "00000002aa", 1, "3a-first", "3a-second"),
+		testBlock(t, "00000003bb", "00000002aa", 1, "3b"),
+		testBlock(t, "00000004bb", "00000003bb", 1, "4b"),
+		testBlock(t, "00000004aa", "00000003aa", 1, "4a"),
+		testBlock(t, "00000005aa", "00000004aa", 1, "5a"),
+		testBlock(t, "00000006aa", "00000005aa", 3, "6a"),
+	}
+}
+
+// testBlock creates a block with a transaction holding, for each memo, a
+// transfer and its notification to the recipient.
+func testBlock(t *testing.T, id, previousID string, libNum uint32, memos ...string) *pbcodec.Block {
+	var num uint32
+	_, err := fmt.Sscanf(id[:8], "%08x", &num)
+	require.NoError(t, err)
+
+	blockTime, err := ptypes.TimestampProto(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(num) * 500 * time.Millisecond))
+	require.NoError(t, err)
+
+	trace := &pbcodec.TransactionTrace{
+		Id:      fmt.Sprintf("trx.%d%s", num, id[9:]),
+		Receipt: &pbcodec.TransactionReceiptHeader{Status: pbcodec.TransactionStatus_TRANSACTIONSTATUS_EXECUTED},
+	}
+
+	for _, memo := range memos {
+		for _, receiver := range []string{"eosio.token", "bob"} {
+			ordinal := uint32(len(trace.ActionTraces) + 1)
+			trace.ActionTraces = append(trace.ActionTraces, &pbcodec.ActionTrace{
+				Receiver:       receiver,
+				ActionOrdinal:  ordinal,
+				ExecutionIndex: ordinal - 1,
+				TransactionId:  trace.Id,
+				Receipt:        &pbcodec.ActionReceipt{Receiver: receiver},
+				Action: &pbcodec.Action{
+					Account:       "eosio.token",
+					Name:          "transfer",
+					Authorization: []*pbcodec.PermissionLevel{{Actor: "alice", Permission: "active"}},
+					JsonData:      fmt.Sprintf(`{"from":"alice","to":"bob","memo":%q}`, memo),
+				},
+			})
+		}
+	}
+
+	return &pbcodec.Block{
+		Id:                       id,
+		Number:                   num,
+		DposIrreversibleBlocknum: libNum,
+		Header: &pbcodec.BlockHeader{
+			Previous:  previousID,
+			Producer:  "eosio",
+			Timestamp: blockTime,
+		},
+		TransactionTraces: []*pbcodec.TransactionTrace{trace},
+	}
+}
+
+func eventSummaries(t *testing.T, msgs []*Message) (out []string) {
+	for _, msg := range msgs {
+		event := &Event{}
+		require.NoError(t, json.Unmarshal(msg.Payload, event))
+
+		data := map[string]string{}
+		require.NoError(t, json.Unmarshal(event.Data, &data))
+		out = append(out, fmt.Sprintf("%s %s %s", event.Step, event.BlockID, data["memo"]))
+	}
+	return
+}
+
+func testTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "sinks")
+	require.NoError(t, err)
+
+	return dir
+}
@@ -0,0 +1,117 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WebhookSink POSTs each message to an HTTP endpoint, the message headers
+// being sent as `X-Dfuse-*` HTTP headers. Network errors, `429` and `5xx`
+// responses are retried with an exponential backoff, any other non-2xx
+// response fails the publication right away.
+type WebhookSink struct {
+	url         string
+	format      Format
+	maxRetries  int
+	client      *http.Client
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+func NewWebhookSink(url string, format Format, maxRetries int) *WebhookSink {
+	return &WebhookSink{
+		url:         url,
+		format:      format,
+		maxRetries:  maxRetries,
+		client:      &http.Client{Timeout: 30 * time.Second},
+		baseBackoff: 500 * time.Millisecond,
+		maxBackoff:  30 * time.Second,
+	}
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, msgs []*Message) error {
+	for _, msg := range msgs {
+		if err := s.publish(ctx, msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *WebhookSink) publish(ctx context.Context, msg *Message) error {
+	backoff := s.baseBackoff
+	for attempt := 0; ; attempt++ {
+		retryable, err := s.post(ctx, msg)
+		if err == nil {
+			return nil
+		}
+
+		if !retryable || attempt >= s.maxRetries {
+			return fmt.Errorf("webhook %s: %w", s.url, err)
+		}
+
+		zlog.Info("webhook call failed, retrying", zap.String("url", s.url), zap.Int("attempt", attempt+1), zap.Duration("backoff", backoff), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+func (s *WebhookSink) post(ctx context.Context, msg *Message) (retryable bool, err error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url, bytes.NewReader(msg.Payload))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", s.format.ContentType())
+	for key, value := range msg.Headers {
+		req.Header.Set("X-"+key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	retryable = resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, fmt.Errorf("unexpected status %s", resp.Status)
+}
+
+func (s *WebhookSink) Close() error {
+	return nil
+}
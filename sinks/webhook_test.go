@@ -0,0 +1,87 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSink_Retries(t *testing.T) {
+	var bodies []string
+	var headers []http.Header
+	failures := 2
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		headers = append(headers, r.Header)
+	}))
+	defer server.Close()
+
+	sink := newTestWebhookSink(server.URL, 2)
+	err := sink.Publish(context.Background(), []*Message{
+		{Headers: map[string]string{StepHeader: "new", CursorHeader: "c1"}, Payload: []byte(`{"a":1}`)},
+		{Headers: map[string]string{StepHeader: "undo", CursorHeader: "c2"}, Payload: []byte(`{"a":2}`)},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{`{"a":1}`, `{"a":2}`}, bodies)
+	assert.Equal(t, "application/json", headers[0].Get("Content-Type"))
+	assert.Equal(t, "new", headers[0].Get("X-Dfuse-Step"))
+	assert.Equal(t, "c2", headers[1].Get("X-Dfuse-Cursor"))
+}
+
+func TestWebhookSink_Errors(t *testing.T) {
+	calls := 0
+	status := http.StatusInternalServerError
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	msgs := []*Message{{Payload: []byte(`{}`)}}
+
+	err := newTestWebhookSink(server.URL, 3).Publish(context.Background(), msgs)
+	require.Error(t, err)
+	assert.Equal(t, 4, calls, "initial call and 3 retries")
+
+	calls = 0
+	status = http.StatusBadRequest
+	err = newTestWebhookSink(server.URL, 3).Publish(context.Background(), msgs)
+	require.Error(t, err)
+	assert.Equal(t, 1, calls, "client errors are not retried")
+}
+
+func newTestWebhookSink(url string, maxRetries int) *WebhookSink {
+	sink := NewWebhookSink(url, JSONFormat, maxRetries)
+	sink.baseBackoff = time.Millisecond
+	return sink
+}
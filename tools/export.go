@@ -0,0 +1,141 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dfuse-io/derr"
+	"github.com/dfuse-io/dfuse-eosio/export"
+	fluxdb "github.com/dfuse-io/dfuse-eosio/fluxdb-client"
+	"github.com/dfuse-io/dstore"
+	"github.com/lithammer/dedent"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export {merged-blocks-store-url} {destination-store-url}",
+	Short: "Exports actions, db ops and transactions of irreversible blocks to Parquet or CSV files",
+	Long: dedent.Dedent(`
+		Flattens the irreversible blocks found in the merged blocks store into action, db op and
+		transaction rows and writes them, one file per row kind and block range partition, to the
+		destination store (for example 'actions/0000010000-0000019999.parquet').
+
+		A checkpoint is written to the destination after each partition, running the command
+		again resumes from it. Without a stop block, the export goes as far as the available
+		merged blocks files allow.
+
+		Each row kind can be filtered with a CEL expression seeing the row's columns as variables
+		and, for actions and db ops, their ABI decoded JSON as 'data' (respectively 'old_data' and
+		'new_data'). Db op rows are decoded against the ABIs set in the blocks read, so contracts
+		whose ABI was set before the lookback window are exported without JSON, unless
+		--fluxdb-addr is set, in which case the ABIs they had before are fetched from FluxDB.
+	`),
+	Example: dedent.Dedent(`
+		dfuseeos tools export file:///dfuse-data/storage/merged-blocks gs://bucket/export --start-block=1000000 --stop-block=2000000 \
+		    --kinds=actions --action-filter='account == "eosio.token" && action == "transfer" && !notif'
+	`),
+	Args: cobra.ExactArgs(2),
+	RunE: exportE,
+}
+
+func init() {
+	Cmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().Uint64("start-block", 0, "Block number to start exporting from, overridden by the destination's checkpoint when it's further")
+	exportCmd.Flags().Uint64("stop-block", 0, "Block number to stop exporting at (exclusive), 0 to export as far as merged blocks files allow")
+	exportCmd.Flags().Uint64("partition-size", 10000, "Amount of blocks per exported file, partitions are aligned on multiples of it")
+	exportCmd.Flags().Uint64("lookback-blocks", 1000, "Amount of blocks read before the start block to determine the irreversible chain and the ABIs in effect")
+	exportCmd.Flags().String("format", "parquet", "Format of the exported files, one of 'parquet' or 'csv'")
+	exportCmd.Flags().StringSlice("kinds", []string{"actions", "dbops", "transactions"}, "Row kinds to export")
+	exportCmd.Flags().String("action-filter", "", "CEL expression that action rows must match to be exported")
+	exportCmd.Flags().String("dbop-filter", "", "CEL expression that db op rows must match to be exported")
+	exportCmd.Flags().String("transaction-filter", "", "CEL expression that transaction rows must match to be exported")
+	exportCmd.Flags().String("fluxdb-addr", "", "FluxDB HTTP address (like 'http://localhost:9000') to fetch the ABIs contracts had before the first block read from, only the ABIs set in the blocks read are known when empty")
+}
+
+func exportE(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	format, err := export.ParseFormat(viper.GetString("format"))
+	if err != nil {
+		return err
+	}
+
+	var kinds []export.RowKind
+	for _, kind := range viper.GetStringSlice("kinds") {
+		kinds = append(kinds, export.RowKind(strings.TrimSpace(kind)))
+	}
+
+	filters := map[export.RowKind]string{}
+	for kind, flag := range map[export.RowKind]string{
+		export.ActionRowKind:      "action-filter",
+		export.DBOpRowKind:        "dbop-filter",
+		export.TransactionRowKind: "transaction-filter",
+	} {
+		if expression := viper.GetString(flag); expression != "" {
+			filters[kind] = expression
+		}
+	}
+
+	blocksStore, err := dstore.NewDBinStore(args[0])
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	destStore, err := dstore.NewSimpleStore(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to create destination store: %w", err)
+	}
+
+	var abiSource export.ABISource
+	if addr := viper.GetString("fluxdb-addr"); addr != "" {
+		abiSource = export.NewFluxDBABISource(fluxdb.NewClient(addr, nil))
+	}
+
+	exporter, err := export.NewExporter(&export.Config{
+		StartBlockNum:      viper.GetUint64("start-block"),
+		StopBlockNum:       viper.GetUint64("stop-block"),
+		PartitionSize:      viper.GetUint64("partition-size"),
+		LookbackBlockCount: viper.GetUint64("lookback-blocks"),
+		Format:             format,
+		Filters:            filters,
+		Kinds:              kinds,
+		ABISource:          abiSource,
+	}, blocksStore, destStore)
+	if err != nil {
+		return err
+	}
+
+	// On termination, the partition in progress is discarded, the next run resumes from the checkpoint
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := derr.SetupSignalHandler(0 * time.Second)
+	go func() {
+		select {
+		case <-signals:
+			fmt.Println("Received termination signal, stopping export")
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return exporter.Run(ctx)
+}
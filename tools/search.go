@@ -0,0 +1,193 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	eosSearch "github.com/dfuse-io/dfuse-eosio/search"
+	"github.com/dfuse-io/dfuse-eosio/search/indexer"
+	"github.com/dfuse-io/dstore"
+	"github.com/lithammer/dedent"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var searchCmd = &cobra.Command{Use: "search", Short: "Inspect search indexes"}
+
+var searchInspectCmd = &cobra.Command{
+	Use:   "inspect {index-path}",
+	Short: "Shows the metadata, documents and indexed terms of a local bleve shard",
+	Long: dedent.Dedent(`
+		Prints the shard's metadata (block range, document count, fields and, for shards built
+		in parallel batch mode, the mapper configuration) as JSON.
+
+		With --block and/or --trx, the matching documents are printed with the terms indexed
+		for each of their fields. With --field, the terms of the field are printed along with
+		the amount of documents containing them. Terms that are not printable, like those of
+		numeric fields, are hex encoded.
+	`),
+	Example: dedent.Dedent(`
+		dfuseeos tools search inspect ./shards-5000/0060000000.bleve --block=60000123
+		dfuseeos tools search inspect ./shards-5000/0060000000.bleve --field=receiver --field=data.to
+	`),
+	Args: cobra.ExactArgs(1),
+	RunE: searchInspectE,
+}
+
+var searchVerifyCmd = &cobra.Command{
+	Use:   "verify {index-path} {merged-blocks-store-url}",
+	Short: "Maps again the merged blocks of a shard's range and diffs the documents against the shard",
+	Long: dedent.Dedent(`
+		Reads the irreversible blocks of the shard's range from the merged blocks store, maps them
+		with the EOS block mapper and prints every document missing from the shard, not produced
+		by the mapper or whose indexed terms differ. The command fails when there is any difference.
+
+		The mapper uses the configuration recorded in the shard when there is one, the mapper
+		flags given explicitly overriding it.
+	`),
+	Args: cobra.ExactArgs(2),
+	RunE: searchVerifyE,
+}
+
+func init() {
+	Cmd.AddCommand(searchCmd)
+	searchCmd.AddCommand(searchInspectCmd)
+	searchCmd.AddCommand(searchVerifyCmd)
+
+	searchInspectCmd.Flags().Uint64("block", 0, "Print the documents of this block")
+	searchInspectCmd.Flags().String("trx", "", "Print the documents of this transaction, a prefix of its id is accepted")
+	searchInspectCmd.Flags().StringSlice("field", nil, "Print the indexed terms of this field, can be repeated")
+	searchInspectCmd.Flags().Int("terms-limit", 100, "Maximum amount of terms printed per field, 0 for all of them")
+
+	searchVerifyCmd.Flags().Uint64("shard-lookback-blocks", 1000, "Amount of blocks read before the shard to determine the irreversible chain")
+	searchVerifyCmd.Flags().String("dfuse-events-action-name", "", "Action name of dfuse Events the shard was indexed with")
+	searchVerifyCmd.Flags().Bool("dfuse-events-unrestricted", false, "Whether dfuse Events were indexed without restrictions")
+	searchVerifyCmd.Flags().String("action-filter-on-expr", "", "CEL expression of the actions the shard was indexed with")
+	searchVerifyCmd.Flags().String("action-filter-out-expr", "", "CEL expression of the actions the shard was indexed without")
+}
+
+func searchInspectE(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	idx, err := indexer.OpenShard(args[0])
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+
+	reader, err := idx.Reader()
+	if err != nil {
+		return fmt.Errorf("unable to read index: %w", err)
+	}
+	defer reader.Close()
+
+	info, err := indexer.ReadShardInfo(reader)
+	if err != nil {
+		return err
+	}
+	printEntity(info)
+
+	blockNum := viper.GetUint64("block")
+	trxID := viper.GetString("trx")
+	if blockNum != 0 || trxID != "" {
+		documents, err := indexer.ShardDocuments(reader, indexer.DocumentMatcher(blockNum, trxID))
+		if err != nil {
+			return err
+		}
+
+		for _, document := range documents {
+			printEntity(document)
+		}
+	}
+
+	for _, field := range viper.GetStringSlice("field") {
+		terms, err := indexer.FieldTerms(reader, field, viper.GetInt("terms-limit"))
+		if err != nil {
+			return err
+		}
+
+		printEntity(map[string]interface{}{"field": field, "terms": terms})
+	}
+
+	return nil
+}
+
+func searchVerifyE(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	idx, err := indexer.OpenShard(args[0])
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+
+	reader, err := idx.Reader()
+	if err != nil {
+		return fmt.Errorf("unable to read index: %w", err)
+	}
+	defer reader.Close()
+
+	blocksStore, err := dstore.NewDBinStore(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to create merged blocks store: %w", err)
+	}
+
+	info, err := indexer.ReadShardInfo(reader)
+	if err != nil {
+		return err
+	}
+
+	config := eosSearch.MapperConfig{}
+	if info.MapperConfig != nil {
+		config = *info.MapperConfig
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("dfuse-events-action-name") {
+		config.EventsActionName = viper.GetString("dfuse-events-action-name")
+	}
+	if flags.Changed("dfuse-events-unrestricted") {
+		config.EventsUnrestricted = viper.GetBool("dfuse-events-unrestricted")
+	}
+	if flags.Changed("action-filter-on-expr") {
+		config.FilterOn = viper.GetString("action-filter-on-expr")
+	}
+	if flags.Changed("action-filter-out-expr") {
+		config.FilterOut = viper.GetString("action-filter-out-expr")
+	}
+
+	mapper, err := eosSearch.NewEOSBlockMapper(config.EventsActionName, config.EventsUnrestricted, config.FilterOn, config.FilterOut)
+	if err != nil {
+		return fmt.Errorf("unable to create EOS block mapper: %w", err)
+	}
+
+	differences, err := indexer.VerifyShard(context.Background(), reader, mapper, blocksStore, viper.GetUint64("shard-lookback-blocks"))
+	if err != nil {
+		return err
+	}
+
+	for _, difference := range differences {
+		fmt.Println(difference)
+	}
+
+	if len(differences) != 0 {
+		return fmt.Errorf("shard blocks %d to %d differs from the mapped blocks, %d differences", info.StartBlockNum, info.EndBlockNum, len(differences))
+	}
+
+	fmt.Printf("shard blocks %d to %d matches the mapped blocks\n", info.StartBlockNum, info.EndBlockNum)
+	return nil
+}
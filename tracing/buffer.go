@@ -0,0 +1,149 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"sync"
+	"time"
+
+	"go.opencensus.io/trace"
+)
+
+// maxSpansPerTrace bounds the memory used by long running traces, like the
+// ones of streaming requests, their later spans being dropped
+const maxSpansPerTrace = 1000
+
+// Buffer is an OpenCensus exporter keeping the spans of the last traces in
+// memory, so they can be viewed from the dashboard without an external
+// tracing backend.
+type Buffer struct {
+	lock   sync.RWMutex
+	size   int
+	traces map[trace.TraceID]*Trace
+	order  []trace.TraceID // oldest first
+}
+
+// Trace holds the spans of a trace in the order they ended, the root span
+// being last once the request is completed.
+type Trace struct {
+	ID    trace.TraceID
+	Spans []*trace.SpanData
+}
+
+func NewBuffer(size int) *Buffer {
+	return &Buffer{
+		size:   size,
+		traces: map[trace.TraceID]*Trace{},
+	}
+}
+
+func (b *Buffer) ExportSpan(span *trace.SpanData) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	traceID := span.TraceID
+	if existing, found := b.traces[traceID]; found {
+		if len(existing.Spans) < maxSpansPerTrace {
+			existing.Spans = append(existing.Spans, span)
+		}
+		return
+	}
+
+	if len(b.order) > 0 && len(b.order) >= b.size {
+		delete(b.traces, b.order[0])
+		b.order = b.order[1:]
+	}
+
+	b.traces[traceID] = &Trace{ID: traceID, Spans: []*trace.SpanData{span}}
+	b.order = append(b.order, traceID)
+}
+
+// Traces returns up to `limit` traces, or all of them when 0, the most recent
+// first.
+func (b *Buffer) Traces(limit int) (out []*Trace) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for i := len(b.order) - 1; i >= 0; i-- {
+		if limit != 0 && len(out) >= limit {
+			break
+		}
+		out = append(out, b.traces[b.order[i]].copy())
+	}
+
+	return out
+}
+
+// Trace returns the trace with the hex encoded `traceID`, if still kept.
+func (b *Buffer) Trace(traceID string) (*Trace, bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for id, t := range b.traces {
+		if id.String() == traceID {
+			return t.copy(), true
+		}
+	}
+
+	return nil, false
+}
+
+func (t *Trace) copy() *Trace {
+	return &Trace{ID: t.ID, Spans: append([]*trace.SpanData(nil), t.Spans...)}
+}
+
+// Root returns the span without a parent in this process, or the first one
+// that started when the root span is not ended yet.
+func (t *Trace) Root() *trace.SpanData {
+	var root *trace.SpanData
+	for _, span := range t.Spans {
+		if span.ParentSpanID == (trace.SpanID{}) || span.HasRemoteParent {
+			return span
+		}
+
+		if root == nil || span.StartTime.Before(root.StartTime) {
+			root = span
+		}
+	}
+
+	return root
+}
+
+// Duration spans from the start of the first span to the end of the last one.
+func (t *Trace) Duration() time.Duration {
+	var start, end time.Time
+	for _, span := range t.Spans {
+		if start.IsZero() || span.StartTime.Before(start) {
+			start = span.StartTime
+		}
+
+		if span.EndTime.After(end) {
+			end = span.EndTime
+		}
+	}
+
+	return end.Sub(start)
+}
+
+// HasError is true when any of the spans ended with a non OK status.
+func (t *Trace) HasError() bool {
+	for _, span := range t.Spans {
+		if span.Code != trace.StatusCodeOK {
+			return true
+		}
+	}
+
+	return false
+}
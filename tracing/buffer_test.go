@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/trace"
+)
+
+func TestBuffer(t *testing.T) {
+	buffer := NewBuffer(2)
+	start := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	buffer.ExportSpan(testSpan(1, 2, 1, "read table", start.Add(10*time.Millisecond), 20*time.Millisecond, trace.StatusCodeOK))
+	buffer.ExportSpan(testSpan(1, 1, 0, "/v0/state/table", start, 50*time.Millisecond, trace.StatusCodeOK))
+	buffer.ExportSpan(testSpan(2, 3, 0, "/v1/chain/get_info", start, time.Millisecond, trace.StatusCodeNotFound))
+	buffer.ExportSpan(testSpan(3, 4, 0, "/v0/search/transactions", start, time.Millisecond, trace.StatusCodeOK))
+
+	traces := buffer.Traces(0)
+	require.Len(t, traces, 2, "oldest trace evicted")
+	assert.Equal(t, "/v0/search/transactions", traces[0].Root().Name)
+	assert.Equal(t, "/v1/chain/get_info", traces[1].Root().Name)
+	assert.True(t, traces[1].HasError())
+	assert.Len(t, buffer.Traces(1), 1)
+
+	_, found := buffer.Trace(traceID(1).String())
+	assert.False(t, found)
+
+	buffer.ExportSpan(testSpan(3, 5, 4, "search", start.Add(-time.Millisecond), 10*time.Millisecond, trace.StatusCodeOK))
+	searchTrace, found := buffer.Trace(traceID(3).String())
+	require.True(t, found)
+	assert.Len(t, searchTrace.Spans, 2)
+	assert.Equal(t, "/v0/search/transactions", searchTrace.Root().Name)
+	assert.Equal(t, 10*time.Millisecond, searchTrace.Duration())
+	assert.False(t, searchTrace.HasError())
+}
+
+func traceID(id byte) trace.TraceID {
+	return trace.TraceID{id}
+}
+
+func testSpan(traceNum, spanNum, parentNum byte, name string, start time.Time, duration time.Duration, code int32) *trace.SpanData {
+	span := &trace.SpanData{
+		SpanContext: trace.SpanContext{TraceID: traceID(traceNum), SpanID: trace.SpanID{spanNum}},
+		Name:        name,
+		StartTime:   start,
+		EndTime:     start.Add(duration),
+		Status:      trace.Status{Code: code},
+	}
+
+	if parentNum != 0 {
+		span.ParentSpanID = trace.SpanID{parentNum}
+	}
+
+	return span
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 dfuse Platform Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"net/http"
+	"time"
+
+	stackdriverPropagation "contrib.go.opencensus.io/exporter/stackdriver/propagation"
+	"go.opencensus.io/plugin/ocgrpc"
+	"go.opencensus.io/plugin/ochttp"
+	"go.opencensus.io/trace/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/keepalive"
+)
+
+// HTTPFormat is the format propagating the trace context between the HTTP
+// servers and clients of all apps, the one of the existing middlewares.
+var HTTPFormat propagation.HTTPFormat = &stackdriverPropagation.HTTPFormat{}
+
+// NewHTTPHandler starts a span for each request, as a child of the one of
+// the caller when the request carries a trace context.
+func NewHTTPHandler(next http.Handler) http.Handler {
+	return &ochttp.Handler{
+		Handler:     next,
+		Propagation: HTTPFormat,
+	}
+}
+
+// NewHTTPTransport propagates the trace context of the requests, `base` being
+// returned as is when it already does.
+func NewHTTPTransport(base http.RoundTripper) http.RoundTripper {
+	if _, ok := base.(*ochttp.Transport); ok {
+		return base
+	}
+
+	return &ochttp.Transport{
+		Base:        base,
+		Propagation: HTTPFormat,
+	}
+}
+
+// NewInternalGRPCClient dials a plain-text internal service like
+// `dgrpc.NewInternalClient` does, also propagating the trace context of the
+// calls.
+func NewInternalGRPCClient(remoteAddr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		remoteAddr,
+		grpc.WithInsecure(),
+		grpc.WithBalancerName(roundrobin.Name),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1024*1024*1024)),
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{}),
+	)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/dfuse-io/bstream"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbtrxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/trxdb/v1"
+	"github.com/dfuse-io/dtracing"
 	"github.com/dfuse-io/kvdb"
 	"github.com/dfuse-io/kvdb/store"
 	"github.com/eoscanada/eos-go"
@@ -29,6 +30,9 @@ import (
 )
 
 func (db *DB) GetLastWrittenBlockID(ctx context.Context) (blockID string, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get last written block id")
+	defer span.End()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	it := db.store.Scan(ctx, Keys.StartOfBlocksTable(), Keys.EndOfBlocksTable(), 1)
@@ -46,6 +50,9 @@ func (db *DB) GetLastWrittenBlockID(ctx context.Context) (blockID string, err er
 }
 
 func (db *DB) GetBlock(ctx context.Context, id string) (blk *pbcodec.BlockWithRefs, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get block", "block_id", id)
+	defer span.End()
+
 	value, err := db.store.Get(ctx, Keys.PackBlocksKey(id))
 
 	if err == store.ErrNotFound {
@@ -62,6 +69,9 @@ func (db *DB) GetBlock(ctx context.Context, id string) (blk *pbcodec.BlockWithRe
 }
 
 func (db *DB) GetBlockByNum(ctx context.Context, num uint32) (out []*pbcodec.BlockWithRefs, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get block by num", "block_num", num)
+	defer span.End()
+
 	zlog.Debug("get block by num", zap.Uint32("block_num", num))
 	it := db.store.Scan(ctx, Keys.PackBlockNumPrefix(num), Keys.PackBlockNumPrefix(num-1), 0)
 	for it.Next() {
@@ -106,6 +116,9 @@ func (db *DB) blockRowToBlockWithRef(ctx context.Context, blockRow *pbtrxdb.Bloc
 }
 
 func (db *DB) GetClosestIrreversibleIDAtBlockNum(ctx context.Context, num uint32) (ref bstream.BlockRef, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get closest irreversible id at block num", "block_num", num)
+	defer span.End()
+
 	zlog.Debug("get closest irr id at block num", zap.Uint32("block_num", num))
 
 	ctx, cancel := context.WithCancel(ctx)
@@ -124,6 +137,9 @@ func (db *DB) GetClosestIrreversibleIDAtBlockNum(ctx context.Context, num uint32
 }
 
 func (db *DB) GetIrreversibleIDAtBlockID(ctx context.Context, ID string) (ref bstream.BlockRef, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get irreversible id at block id", "block_id", ID)
+	defer span.End()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -183,6 +199,9 @@ func (db *DB) BlockIDBefore(ctx context.Context, start time.Time, inclusive bool
 }
 
 func (db *DB) blockIDAround(ctx context.Context, fwd bool, start time.Time, inclusive bool) (id string, foundTime time.Time, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get block id around time", "forward", fwd, "time", start.String(), "inclusive", inclusive)
+	defer span.End()
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -204,6 +223,9 @@ func (db *DB) blockIDAround(ctx context.Context, fwd bool, start time.Time, incl
 }
 
 func (db *DB) ListBlocks(ctx context.Context, highBlockNum uint32, limit int) (out []*pbcodec.BlockWithRefs, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "list blocks", "high_block_num", highBlockNum, "limit", limit)
+	defer span.End()
+
 	zlog.Debug("list blocks", zap.Uint32("high_block_num", highBlockNum), zap.Int("limit", limit))
 	it := db.store.Scan(ctx, Keys.PackBlockNumPrefix(highBlockNum), Keys.EndOfBlocksTable(), limit)
 	for it.Next() {
@@ -223,6 +245,9 @@ func (db *DB) ListBlocks(ctx context.Context, highBlockNum uint32, limit int) (o
 }
 
 func (db *DB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uint32) (out []*pbcodec.BlockWithRefs, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "list sibling blocks", "block_num", blockNum, "spread", spread)
+	defer span.End()
+
 	highBlockNum := blockNum + spread
 	lowBlockNum := blockNum - (spread + 1)
 	zlog.Debug("list sibling blocks", zap.Uint32("high_block_num", highBlockNum), zap.Uint32("low_block_num", lowBlockNum))
@@ -244,6 +269,9 @@ func (db *DB) ListSiblingBlocks(ctx context.Context, blockNum uint32, spread uin
 }
 
 func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.AccountCreationRef, error) {
+	ctx, span := dtracing.StartSpan(ctx, "get account", "account", accountName)
+	defer span.End()
+
 	value, err := db.store.Get(ctx, Keys.PackAccountKey(accountName))
 
 	if err == store.ErrNotFound {
@@ -267,6 +295,9 @@ func (db *DB) GetAccount(ctx context.Context, accountName string) (*pbcodec.Acco
 }
 
 func (db *DB) ListAccountNames(ctx context.Context, concurrentReadCount uint32) (out []string, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "list account names")
+	defer span.End()
+
 	if concurrentReadCount == 0 {
 		return nil, fmt.Errorf("invalid concurrent read")
 	}
@@ -5,17 +5,24 @@ import (
 
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbtrxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/trxdb/v1"
+	"github.com/dfuse-io/dtracing"
 	"github.com/dfuse-io/kvdb"
 	"github.com/dfuse-io/kvdb/store"
 	"github.com/eoscanada/eos-go"
 )
 
 func (db *DB) GetTransactionTraces(ctx context.Context, idPrefix string) (out []*pbcodec.TransactionEvent, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get transaction traces", "id_prefix", idPrefix)
+	defer span.End()
+
 	out, err = db.getTransactionExecutionEvents(ctx, idPrefix)
 	return
 }
 
 func (db *DB) GetTransactionEventsBatch(ctx context.Context, idPrefixes []string) (out [][]*pbcodec.TransactionEvent, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get transaction events batch", "id_prefix_count", len(idPrefixes))
+	defer span.End()
+
 	// OPTIMIZE: Parallelize access, or do requests to get things in parallel
 	for _, idPrefix := range idPrefixes {
 		trxResult, err := db.GetTransactionEvents(ctx, idPrefix)
@@ -28,6 +35,9 @@ func (db *DB) GetTransactionEventsBatch(ctx context.Context, idPrefixes []string
 }
 
 func (db *DB) GetTransactionTracesBatch(ctx context.Context, idPrefixes []string) (out [][]*pbcodec.TransactionEvent, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get transaction traces batch", "id_prefix_count", len(idPrefixes))
+	defer span.End()
+
 	// OPTIMIZE: Parallelize access, or do requests to get things in parallel
 	for _, idPrefix := range idPrefixes {
 		trxResult, err := db.GetTransactionTraces(ctx, idPrefix)
@@ -40,6 +50,9 @@ func (db *DB) GetTransactionTracesBatch(ctx context.Context, idPrefixes []string
 }
 
 func (db *DB) GetTransactionEvents(ctx context.Context, idPrefix string) (out []*pbcodec.TransactionEvent, err error) {
+	ctx, span := dtracing.StartSpan(ctx, "get transaction events", "id_prefix", idPrefix)
+	defer span.End()
+
 	evs, err := db.getTransactionAdditionEvents(ctx, idPrefix)
 	if err != nil {
 		return nil, err
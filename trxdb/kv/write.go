@@ -24,11 +24,15 @@ import (
 	"github.com/dfuse-io/dfuse-eosio/trxdb"
 	pbcodec "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/codec/v1"
 	pbtrxdb "github.com/dfuse-io/dfuse-eosio/pb/dfuse/eosio/trxdb/v1"
+	"github.com/dfuse-io/dtracing"
 	"github.com/golang/protobuf/ptypes"
 	"go.uber.org/zap"
 )
 
 func (db *DB) Flush(ctx context.Context) error {
+	ctx, span := dtracing.StartSpan(ctx, "flush puts")
+	defer span.End()
+
 	return db.store.FlushPuts(ctx)
 }
 
@@ -41,6 +45,9 @@ func (db *DB) GetLastWrittenIrreversibleBlockRef(ctx context.Context) (ref bstre
 }
 
 func (db *DB) PutBlock(ctx context.Context, blk *pbcodec.Block) error {
+	ctx, span := dtracing.StartSpan(ctx, "put block", "block_num", blk.Number)
+	defer span.End()
+
 	if err := db.putTransactions(ctx, blk); err != nil {
 		return fmt.Errorf("put block: unable to putTransactions: %w", err)
 	}
@@ -228,6 +235,9 @@ func (db *DB) putBlock(ctx context.Context, blk *pbcodec.Block) error {
 var oneByte = []byte{0x01}
 
 func (db *DB) UpdateNowIrreversibleBlock(ctx context.Context, blk *pbcodec.Block) error {
+	ctx, span := dtracing.StartSpan(ctx, "update now irreversible block", "block_num", blk.Number)
+	defer span.End()
+
 	blockTime := blk.MustTime()
 
 	if err := db.store.Put(ctx, Keys.PackTimelineKey(true, blockTime, blk.Id), oneByte); err != nil {